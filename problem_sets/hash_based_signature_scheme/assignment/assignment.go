@@ -0,0 +1,137 @@
+// Package assignment is the forgery half of the problem set: the provided
+// pubkey and 4 signatures that reuse its private key, the code that loads and
+// generates assignments like it, and Forge.
+package assignment
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+
+	"ps/01/lamport"
+)
+
+// defaultAssignment is the pubkey and 4 signatures from signatures.go, in the
+// format LoadAssignment reads.  Forge uses it when not given anything else.
+//
+//go:embed default_assignment.json
+var defaultAssignment []byte
+
+// assignmentFile is the JSON layout read by LoadAssignment:
+//
+//	{
+//		"pubkey": "<hex, as from PublicKey.ToHex>",
+//		"signatures": [
+//			{"message": "1", "signature": "<hex, as from Signature.ToHex>"},
+//			...
+//		]
+//	}
+//
+// Each message is the string that was hashed with GetMessageFromString and
+// signed.
+type assignmentFile struct {
+	Pubkey     string `json:"pubkey"`
+	Signatures []struct {
+		Message   string `json:"message"`
+		Signature string `json:"signature"`
+	} `json:"signatures"`
+}
+
+// LoadAssignment reads a pubkey, signatures and the messages they sign, so
+// Forge can be pointed at some other instructor's key without editing
+// signatures.go.  The input is either a bundle from lamport.WriteBundle or
+// the JSON file described above; gzip's magic bytes tell them apart.  Every
+// signature is checked against its message before returning; a file with a
+// signature that doesn't verify is an error, since there'd be no point
+// searching for a forgery from it.
+func LoadAssignment(r io.Reader) (lamport.PublicKey, []lamport.Signature, []lamport.Message, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(2)
+	if bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		pub, sigs, strs, err := lamport.ReadBundle(br)
+		if err != nil {
+			return lamport.PublicKey{}, nil, nil, err
+		}
+		msgs := make([]lamport.Message, len(strs))
+		for i, s := range strs {
+			msgs[i] = lamport.GetMessageFromString(s)
+		}
+		return pub, sigs, msgs, nil
+	}
+
+	var f assignmentFile
+	err := json.NewDecoder(br).Decode(&f)
+	if err != nil {
+		return lamport.PublicKey{}, nil, nil, err
+	}
+
+	pub, err := lamport.HexToPubkey(f.Pubkey)
+	if err != nil {
+		return lamport.PublicKey{}, nil, nil, err
+	}
+
+	var sigs []lamport.Signature
+	var msgs []lamport.Message
+	for i, entry := range f.Signatures {
+		sig, err := lamport.HexToSignature(entry.Signature)
+		if err != nil {
+			return lamport.PublicKey{}, nil, nil, fmt.Errorf("signature %d: %w", i+1, err)
+		}
+		msg := lamport.GetMessageFromString(entry.Message)
+		if !lamport.Verify(msg, pub, sig) {
+			return lamport.PublicKey{}, nil, nil, fmt.Errorf(
+				"signature %d doesn't verify message %q", i+1, entry.Message)
+		}
+		sigs = append(sigs, sig)
+		msgs = append(msgs, msg)
+	}
+	return pub, sigs, msgs, nil
+}
+
+// GenerateAssignment makes a new problem set in the style of signatures.go:
+// it generates a key, signs the hash of each string in msgs with it, and
+// writes Go source declaring hexPubkey1 and hexSignature1..N to w.  The
+// encoding is the usual big endian row-major hex, so Forge can use the output
+// without changes.  Signing more than once with the same key is of course
+// the whole point here.
+func GenerateAssignment(w io.Writer, msgs []string) error {
+	pri, pub, err := lamport.GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("package assignment\n\n")
+	buf.WriteString("// Here is a single public key, and signatures on different messages from\n")
+	buf.WriteString("// this public key.\n")
+	buf.WriteString("// The messages signed were ")
+	for i, msg := range msgs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%q", msg)
+	}
+	buf.WriteString(" respectively.\n\n")
+
+	buf.WriteString("var (\n")
+	fmt.Fprintf(&buf, "hexPubkey1 = %q\n\n", pub.ToHex())
+	for i, msg := range msgs {
+		sig, err := lamport.Sign(lamport.GetMessageFromString(msg), pri)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&buf, "hexSignature%d = %q\n", i+1, sig.ToHex())
+	}
+	buf.WriteString(")\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
@@ -0,0 +1,135 @@
+package assignment
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestGenerateAssignment parses the generated source, decodes its strings
+// back into a key and signatures, and verifies each one.
+func TestGenerateAssignment(t *testing.T) {
+	msgs := []string{"1", "2", "3", "4"}
+
+	var buf bytes.Buffer
+	err := GenerateAssignment(&buf, msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := buf.Bytes()
+
+	formatted, err := format.Source(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(formatted, src) {
+		t.Fatalf("generated source isn't gofmt clean")
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "signatures.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make(map[string]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		lit := spec.Values[0].(*ast.BasicLit)
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values[spec.Names[0].Name] = s
+		return false
+	})
+
+	pub, err := lamport.HexToPubkey(values["hexPubkey1"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, msg := range msgs {
+		name := fmt.Sprintf("hexSignature%d", i+1)
+		sig, err := lamport.HexToSignature(values[name])
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !lamport.Verify(lamport.GetMessageFromString(msg), pub, sig) {
+			t.Fatalf("%s doesn't verify message %q", name, msg)
+		}
+	}
+}
+
+// TestLoadAssignmentDefault loads the embedded file and checks it matches the
+// constants in signatures.go.
+func TestLoadAssignmentDefault(t *testing.T) {
+	pub, sigs, msgs, err := LoadAssignment(bytes.NewReader(defaultAssignment))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub.ToHex() != hexPubkey1 {
+		t.Fatalf("embedded pubkey doesn't match hexPubkey1")
+	}
+	expected := []string{hexSignature1, hexSignature2, hexSignature3, hexSignature4}
+	if len(sigs) != len(expected) || len(msgs) != len(expected) {
+		t.Fatalf("loaded %d signatures and %d messages, expect %d",
+			len(sigs), len(msgs), len(expected))
+	}
+	for i := range expected {
+		if sigs[i].ToHex() != expected[i] {
+			t.Fatalf("embedded signature %d doesn't match hexSignature%d", i+1, i+1)
+		}
+		if msgs[i] != lamport.GetMessageFromString(fmt.Sprint(i+1)) {
+			t.Fatalf("embedded message %d isn't the hash of %q", i+1, fmt.Sprint(i+1))
+		}
+	}
+}
+
+// TestLoadAssignmentBadSignature swaps two messages so the signatures no
+// longer verify, which LoadAssignment should catch.
+func TestLoadAssignmentBadSignature(t *testing.T) {
+	swapped := bytes.Replace(defaultAssignment,
+		[]byte(`"message": "1"`), []byte(`"message": "x"`), 1)
+	_, _, _, err := LoadAssignment(bytes.NewReader(swapped))
+	if err == nil {
+		t.Fatalf("assignment with bad signature loaded without error")
+	}
+
+	_, _, _, err = LoadAssignment(bytes.NewReader([]byte(`{"pubkey": "00"}`)))
+	if err == nil {
+		t.Fatalf("assignment with short pubkey loaded without error")
+	}
+}
+
+// TestLoadAssignmentBundle writes the embedded assignment as a bundle and
+// loads it back.
+func TestLoadAssignmentBundle(t *testing.T) {
+	pub, sigs, _, err := LoadAssignment(bytes.NewReader(defaultAssignment))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = lamport.WriteBundle(&buf, pub, sigs, []string{"1", "2", "3", "4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, bundleSigs, msgs, err := LoadAssignment(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 4 || msgs[0] != lamport.GetMessageFromString("1") {
+		t.Fatalf("LoadAssignment on bundle gave wrong messages")
+	}
+	if bundleSigs[3] != sigs[3] {
+		t.Fatalf("LoadAssignment on bundle gave wrong signatures")
+	}
+}
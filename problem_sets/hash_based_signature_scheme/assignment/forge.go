@@ -0,0 +1,210 @@
+package assignment
+
+import (
+	"bytes"
+	"fmt"
+
+	"ps/01/lamport"
+)
+
+/*
+A note about the provided keys and signatures:
+the provided pubkey and signature, as well as "HexTo___" functions may not work
+with all the different implementations people could built.  Specifically, they
+are tied to an endian-ness.  If, for example, you decided to encode your public
+keys as (according to the diagram in the slides) up to down, then left to right:
+<bit 0, row 0> <bit 0, row 1> <bit 1, row 0> <bit 1, row 1> ...
+
+then it won't work with the public key provided here, because it was encoded as
+<bit 0, row 0> <bit 1, row 0> <bit 2, row 0> ... <bit 255, row 0> <bit 0, row 1> ...
+(left to right, then up to down)
+
+so while in class I said that any decisions like this would work as long as they
+were consistent... that's not actually the case!  Because your functions will
+need to use the same ordering as the ones I wrote in order to create the signatures
+here.  I used what I thought was the most straightforward / simplest encoding, but
+endian-ness is something of a tabs-vs-spaces thing that people like to argue
+about :).
+
+So for clarity, and since it's not that obvious from the HexTo___ decoding
+functions, here's the order used:
+
+secret keys and public keys:
+all 256 elements of row 0, most significant bit to least significant bit
+(big endian) followed by all 256 elements of row 1.  Total of 512 blocks
+of 32 bytes each, for 16384 bytes.
+For an efficient check of a bit within a [32]byte array using this ordering,
+you can use:
+    arr[i/8]>>(7-(i%8)))&0x01
+where arr[] is the byte array, and i is the bit number; i=0 is left-most, and
+i=255 is right-most.  The above statement will return a 1 or a 0 depending on
+what's at that bit location.
+
+Messages: messages are encoded the same way the sha256 function outputs, so
+nothing to choose there.
+
+Signatures: Signatures are also read left to right, MSB to LSB, with 256 blocks
+of 32 bytes each, for a total of 8192 bytes.  There is no indication of whether
+the provided preimage is from the 0-row or the 1-row; the accompanying message
+hash can be used instead, or both can be tried.  This again interprets the message
+hash in big-endian format, where
+    message[i/8]>>(7-(i%8)))&0x01
+can be used to determine which preimage block to reveal, where message[] is the
+message to be signed, and i is the sequence of bits in the message, and blocks
+in the signature.
+
+Hopefully people don't have trouble with different encoding schemes.  If you
+really want to use your own method which you find easier to work with or more
+intuitive, that's OK!  You will need to re-encode the key and signatures provided
+in signatures.go to match your ordering so that they are valid signatures with
+your system.  This is probably more work though and I recommend using the big
+endian encoding described here.
+
+*/
+
+// Forge is the forgery function, to be filled in and completed.  This is a trickier
+// part of the assignment which will require the computer to do a bit of work.
+// It's possible for a single core or single thread to complete this in a reasonable
+// amount of time, but may be worthwhile to write multithreaded code to take
+// advantage of multi-core CPUs.  For programmers familiar with multithreaded code
+// in golang, the time spent on parallelizing this code will be more than offset by
+// the CPU time speedup.  For programmers with access to 2-core or below CPUs, or
+// who are less familiar with multithreaded code, the time taken in programming may
+// exceed the CPU time saved.  Still, it's all about learning.
+// The Forge() function doesn't take any inputs; it loads the public key and
+// signatures provided in the "signatures.go" file (embedded as
+// default_assignment.json) and hands them to ForgeFrom, which does the work.
+// Your job is to have this function return two things: A string containing the
+// substring "forge" as well as your name or email-address, and a valid signature
+// on the hash of that ascii string message, from the pubkey provided in the
+// signatures.go file.
+// The Forge function is tested by TestForgery() in forge_test.go, so if you
+// run "go test" and everything passes, you should be all set.
+func Forge() (string, lamport.Signature, error) {
+	f, err := ForgeDetail()
+	if err != nil {
+		return "", lamport.Signature{}, err
+	}
+	return f.fixed()
+}
+
+// ForgeDetail is Forge returning the whole Forgery, with what the search
+// learned about the provided signatures, rather than just the message and
+// signature.
+func ForgeDetail() (*Forgery, error) {
+	// decode pubkey, all 4 signatures into usable structures
+	pub, sigslice, msgslice, err := LoadAssignment(bytes.NewReader(defaultAssignment))
+	if err != nil {
+		return nil, err
+	}
+	return forgeFrom(pub, sigslice, msgslice)
+}
+
+// ForgeFrom searches for a forgery on pub given the signatures in sigslice,
+// which are on the messages in msgslice.  LoadAssignment returns these
+// structures for an assignment stored in a file.  It's ForgeParams with
+// DefaultParams, trying "zlian forge <n>" counting up, so the problem set
+// and the toy schemes are forged by the same code.  A signature that doesn't
+// verify is lamport.ErrInvalidSignature, and signatures that leave some bit
+// with no preimage revealed at all are lamport.ErrUnforgeable.
+func ForgeFrom(pub lamport.PublicKey, sigslice []lamport.Signature,
+	msgslice []lamport.Message) (string, lamport.Signature, error) {
+	f, err := forgeFrom(pub, sigslice, msgslice)
+	if err != nil {
+		return "", lamport.Signature{}, err
+	}
+	return f.fixed()
+}
+
+func forgeFrom(pub lamport.PublicKey, sigslice []lamport.Signature,
+	msgslice []lamport.Message) (*Forgery, error) {
+	sigs := make([]*lamport.GenericSignature, len(sigslice))
+	for i := range sigslice {
+		sigs[i] = sigslice[i].Generic()
+	}
+	msgs := make([][]byte, len(msgslice))
+	for i := range msgslice {
+		msgs[i] = msgslice[i][:]
+	}
+	return ForgeParams(lamport.DefaultParams, pub.Generic(), sigs, msgs,
+		CountingCandidates("zlian forge ", 555735188))
+}
+
+// A Forgery is the message ForgeParams found and its signature, along with
+// what it learned about the signatures it forged from, for the caller to
+// report.
+type Forgery struct {
+	Message   string
+	Signature *lamport.GenericSignature
+	// ZeroTaken and OneTaken have bit i set when some signature revealed
+	// the 0 or 1 preimage for message bit i.
+	ZeroTaken, OneTaken []byte
+	// Difficulty is log2 of how many candidates the search expects to try,
+	// the number of bits only one of whose preimages was revealed.
+	Difficulty int
+	// Verified has whether each of the signatures verified, in order.
+	Verified []bool
+}
+
+// fixed returns the forgery's message and its signature as a
+// lamport.Signature, for DefaultParams.
+func (self *Forgery) fixed() (string, lamport.Signature, error) {
+	sig, err := self.Signature.Fixed()
+	if err != nil {
+		return "", lamport.Signature{}, err
+	}
+	return self.Message, sig, nil
+}
+
+// ForgeParams searches the messages candidates gives for one that can be
+// signed with the preimages revealed by sigs, which are on msgs, and returns
+// it along with its forged signature in a Forgery.  Candidates are turned
+// into messages with params.Message.  The revealed preimages are gathered in
+// a lamport.GenericRevealedKey, and its errors are returned the same way as
+// ForgeFrom's.  Nothing is printed; reporting the Forgery is up to the
+// caller.
+func ForgeParams(params lamport.Params, pub *lamport.GenericPublicKey,
+	sigs []*lamport.GenericSignature, msgs [][]byte,
+	candidates Candidates) (*Forgery, error) {
+	if len(msgs) != len(sigs) {
+		return nil, fmt.Errorf(
+			"%d signatures but %d messages", len(sigs), len(msgs))
+	}
+	err := params.Check()
+	if err != nil {
+		return nil, err
+	}
+	// Collect the preimages each signature reveals
+	rk := params.NewRevealedKey()
+	for i := range sigs {
+		err := rk.Add(pub, msgs[i], sigs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	// A bit with neither preimage revealed can't be signed either way, so
+	// no message will ever be forgeable and the search below would run
+	// forever.
+	if missing := rk.MissingBits(); len(missing) > 0 {
+		return nil, fmt.Errorf(
+			"%w: no preimage revealed for %d bits, starting at bit %d",
+			lamport.ErrUnforgeable, len(missing), missing[0])
+	}
+	f := &Forgery{Difficulty: rk.Difficulty()}
+	f.ZeroTaken, f.OneTaken = rk.Coverage()
+	f.Verified = make([]bool, len(sigs))
+	for i := range sigs {
+		f.Verified[i] = params.Verify(msgs[i], pub, sigs[i]) == nil
+	}
+
+	// Check if a message contains only bits used in previous signatures
+	f.Message = search(candidates, func(msgString string) bool {
+		return rk.CanSign(params.Message([]byte(msgString)))
+	})
+	// Find corresponding signature blocks
+	f.Signature, err = rk.Sign(params.Message([]byte(f.Message)))
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
@@ -1,8 +1,10 @@
-package main
+package assignment
 
 import (
 	"strings"
 	"testing"
+
+	"ps/01/lamport"
 )
 
 // TestForgery tests the Forge() function to see that it produces a valid
@@ -11,7 +13,7 @@ import (
 func TestForgery(t *testing.T) {
 
 	// get pubkey from global variable
-	pub, err := HexToPubkey(hexPubkey1)
+	pub, err := lamport.HexToPubkey(hexPubkey1)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -41,10 +43,10 @@ func TestForgery(t *testing.T) {
 	t.Logf("Forged message string:\n%s\n cointains substring 'forge'; OK",
 		forgedString)
 
-	forgedMsg := GetMessageFromString(forgedString)
+	forgedMsg := lamport.GetMessageFromString(forgedString)
 
 	// verify signature
-	worked := Verify(forgedMsg, pub, forgedSig)
+	worked := lamport.Verify(forgedMsg, pub, forgedSig)
 
 	if !worked {
 		t.Fatalf("Verify returned false, expected true")
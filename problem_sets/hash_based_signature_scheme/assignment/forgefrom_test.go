@@ -0,0 +1,30 @@
+package assignment
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestForgeFromErrors checks ForgeFrom returns errors rather than panicking
+// or searching forever.
+func TestForgeFromErrors(t *testing.T) {
+	pub, sigs, msgs, err := LoadAssignment(bytes.NewReader(defaultAssignment))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = ForgeFrom(pub, nil, nil)
+	if !errors.Is(err, lamport.ErrUnforgeable) {
+		t.Fatalf("got %v, expect ErrUnforgeable", err)
+	}
+
+	bad := append([]lamport.Signature{}, sigs...)
+	bad[2].Preimage[100] = bad[2].Preimage[100].Hash()
+	_, _, err = ForgeFrom(pub, bad, msgs)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+}
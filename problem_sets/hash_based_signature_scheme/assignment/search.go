@@ -0,0 +1,74 @@
+package assignment
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+// SEARCH_BATCH is how many candidates search hands out to the CPUs at once.
+const SEARCH_BATCH = 1 << 14
+
+// Candidates gives the strings a forgery search tries, one per call, in
+// order.  It's only ever called from one goroutine.
+type Candidates func() string
+
+// CountingCandidates tries prefix followed by start, start+1, and so on.
+func CountingCandidates(prefix string, start int) Candidates {
+	i := start
+	return func() string {
+		s := fmt.Sprintf("%s%d", prefix, i)
+		i++
+		return s
+	}
+}
+
+// SeededCandidates tries prefix followed by 64 bit numbers from a math/rand
+// source seeded with seed, in hex.  The same seed gives the same strings in
+// the same order, so a search with it finds the same forgery every time.
+func SeededCandidates(prefix string, seed int64) Candidates {
+	rng := rand.New(rand.NewSource(seed))
+	return func() string {
+		return fmt.Sprintf("%s%016x", prefix, rng.Uint64())
+	}
+}
+
+// search returns the first string from next that ok accepts.  Candidates
+// are handed out in batches and checked on every CPU, and the earliest hit
+// in a batch wins, so the result doesn't depend on scheduling.  It runs
+// until it finds one.
+func search(next Candidates, ok func(string) bool) string {
+	workers := runtime.NumCPU()
+	batch := make([]string, SEARCH_BATCH)
+	hits := make([]int, workers)
+	for {
+		for i := range batch {
+			batch[i] = next()
+		}
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				hits[w] = len(batch)
+				for i := w; i < len(batch); i += workers {
+					if ok(batch[i]) {
+						hits[w] = i
+						return
+					}
+				}
+			}(w)
+		}
+		wg.Wait()
+		first := len(batch)
+		for _, hit := range hits {
+			if hit < first {
+				first = hit
+			}
+		}
+		if first < len(batch) {
+			return batch[first]
+		}
+	}
+}
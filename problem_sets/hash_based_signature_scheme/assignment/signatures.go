@@ -1,4 +1,4 @@
-package main
+package assignment
 
 // Here is a single public key, and 4 signatures on different messages from this
 // public key.  With these 4 signatures, you should be able to forge a signature
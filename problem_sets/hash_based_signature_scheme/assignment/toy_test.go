@@ -0,0 +1,143 @@
+package assignment
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// toyForgery signs n messages with a ParamsToy64 key made from a seeded
+// source and forges with SeededCandidates, so every run finds the same
+// message.
+func toyForgery(t *testing.T, n int) (*lamport.GenericPublicKey, string,
+	*lamport.GenericSignature, error) {
+	params := lamport.ParamsToy64
+	pri, pub, err := params.GenerateKey(rand.New(rand.NewSource(64)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigs := make([]*lamport.GenericSignature, n)
+	msgs := make([][]byte, n)
+	for i := range sigs {
+		msgs[i] = params.Message([]byte(fmt.Sprint(i + 1)))
+		sigs[i], err = params.Sign(msgs[i], pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	f, err := ForgeParams(params, pub, sigs, msgs,
+		SeededCandidates("toy forge ", 1))
+	if err != nil {
+		return pub, "", nil, err
+	}
+	return pub, f.Message, f.Signature, nil
+}
+
+// TestToyForgery forges on a toy key after four signatures, which leaves
+// around eight bits to match, and checks it finds the same message every
+// time.
+func TestToyForgery(t *testing.T) {
+	pub, forged, sig, err := toyForgery(t, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const expect = "toy forge 95406cdde5156261"
+	if forged != expect {
+		t.Fatalf("forged %q, expect %q", forged, expect)
+	}
+	params := lamport.ParamsToy64
+	err = params.Verify(params.Message([]byte(forged)), pub, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestToyForgeryReport checks the Forgery carries what ForgeParams learned
+// about the signatures: they all verify, every bit has some preimage, and
+// the difficulty counts the bits with just one.
+func TestToyForgeryReport(t *testing.T) {
+	params := lamport.ParamsToy64
+	pri, pub, err := params.GenerateKey(rand.New(rand.NewSource(64)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigs := make([]*lamport.GenericSignature, 4)
+	msgs := make([][]byte, 4)
+	for i := range sigs {
+		msgs[i] = params.Message([]byte(fmt.Sprint(i + 1)))
+		sigs[i], err = params.Sign(msgs[i], pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	f, err := ForgeParams(params, pub, sigs, msgs,
+		SeededCandidates("toy forge ", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Verified) != len(sigs) {
+		t.Fatalf("%d verified, expect %d", len(f.Verified), len(sigs))
+	}
+	for i, ok := range f.Verified {
+		if !ok {
+			t.Fatalf("signature %d didn't verify", i+1)
+		}
+	}
+	difficulty := 0
+	for i := 0; i < params.MessageBits; i++ {
+		zero, one := lamport.BitAt(f.ZeroTaken, i), lamport.BitAt(f.OneTaken, i)
+		if zero|one == 0 {
+			t.Fatalf("bit %d has no preimage", i)
+		}
+		difficulty += int(zero ^ one)
+	}
+	if f.Difficulty != difficulty {
+		t.Fatalf("difficulty %d, expect %d", f.Difficulty, difficulty)
+	}
+}
+
+// TestToyForgeryNoSignatures checks ForgeParams refuses to search when
+// nothing has been revealed, rather than searching forever.
+func TestToyForgeryNoSignatures(t *testing.T) {
+	_, _, _, err := toyForgery(t, 0)
+	if !errors.Is(err, lamport.ErrUnforgeable) {
+		t.Fatalf("got %v, expect ErrUnforgeable", err)
+	}
+}
+
+// TestSearchFirst checks search returns the earliest accepted candidate even
+// when several in a batch are accepted.
+func TestSearchFirst(t *testing.T) {
+	for _, start := range []int{0, SEARCH_BATCH - 3, 5 * SEARCH_BATCH} {
+		got := search(CountingCandidates("n", start), func(s string) bool {
+			var n int
+			fmt.Sscanf(s, "n%d", &n)
+			return n >= start+SEARCH_BATCH/2 && n%7 == 0
+		})
+		n := start + SEARCH_BATCH/2
+		for n%7 != 0 {
+			n++
+		}
+		if got != fmt.Sprintf("n%d", n) {
+			t.Fatalf("from %d got %s, expect n%d", start, got, n)
+		}
+	}
+}
+
+// TestSeededCandidates checks the same seed gives the same candidates.
+func TestSeededCandidates(t *testing.T) {
+	a, b := SeededCandidates("x", 7), SeededCandidates("x", 7)
+	c := SeededCandidates("x", 8)
+	for i := 0; i < 10; i++ {
+		sa, sb, sc := a(), b(), c()
+		if sa != sb {
+			t.Fatalf("candidate %d: %s and %s from the same seed", i, sa, sb)
+		}
+		if sa == sc {
+			t.Fatalf("candidate %d: %s from different seeds", i, sa)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Command lamport-demo generates a key, signs and verifies a message with it,
+// and then forges a signature on the problem set's pubkey.  With -gen it
+// writes a new assignment instead, with -kat a known answer test file, and
+// with -interop the default interop vectors.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"ps/01/assignment"
+	"ps/01/lamport"
+)
+
+func main() {
+	gen := flag.String("gen", "",
+		"write a new assignment (like signatures.go) to this file and exit")
+	genMsgs := flag.String("msgs", "1,2,3,4",
+		"comma separated messages to sign for -gen or -kat")
+	kat := flag.String("kat", "",
+		"write known answer tests for the seed 00 01 .. 1f to this file and exit")
+	interop := flag.String("interop", "",
+		"write interop vectors for the seed 00 01 .. 1f to this file and exit")
+	flag.Parse()
+
+	var seed [lamport.SEED_BYTES]byte
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	if *interop != "" {
+		f, err := os.Create(*interop)
+		if err != nil {
+			fmt.Printf("Error creating %s: %v\n", *interop, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		err = lamport.WriteInteropVectors(f, lamport.GenerateInteropVectors(seed, "1", "2"))
+		if err != nil {
+			fmt.Printf("Error writing %s: %v\n", *interop, err)
+			os.Exit(1)
+		}
+		return
+	}
+	if *kat != "" {
+		vf, err := lamport.GenerateVectors(seed, strings.Split(*genMsgs, ","))
+		if err != nil {
+			fmt.Printf("Error generating vectors: %v\n", err)
+			os.Exit(1)
+		}
+		f, err := os.Create(*kat)
+		if err != nil {
+			fmt.Printf("Error creating %s: %v\n", *kat, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		err = vf.Write(f)
+		if err != nil {
+			fmt.Printf("Error writing %s: %v\n", *kat, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *gen != "" {
+		f, err := os.Create(*gen)
+		if err != nil {
+			fmt.Printf("Error creating %s: %v\n", *gen, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		err = assignment.GenerateAssignment(f, strings.Split(*genMsgs, ","))
+		if err != nil {
+			fmt.Printf("Error generating assignment: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	msg := lamport.GetMessageFromString("test")
+	pri, pub, err := lamport.GenerateKey()
+	if err != nil {
+		fmt.Printf("Error generating key: %v", err)
+		return
+	}
+	fmt.Printf("Generated key %s\n", pub.Fingerprint().Short())
+	signature, err := lamport.Sign(msg, pri)
+	if err != nil {
+		fmt.Printf("Error signing: %v", err)
+		return
+	}
+	result := lamport.Verify(msg, pub, signature)
+	fmt.Printf("Verify worked? %v", result)
+
+	f, err := assignment.ForgeDetail()
+	if err != nil {
+		fmt.Printf("Error forging: %v\n", err)
+		return
+	}
+	fmt.Printf("Zero taken: %x\n", f.ZeroTaken)
+	fmt.Printf("One taken: %x\n", f.OneTaken)
+	fmt.Printf("Difficulty: 2^%d\n", f.Difficulty)
+	for i, ok := range f.Verified {
+		fmt.Printf("ok %d: %v\n", i+1, ok)
+	}
+	fmt.Printf("Found forgeable message: %s\n", f.Message)
+
+	fmt.Printf("Forged message: %s\n%x", f.Message, f.Signature.Preimage)
+}
@@ -0,0 +1,52 @@
+// Command toy runs the whole problem set on lamport.ParamsToy64, small
+// enough to watch: it generates a 64 bit key, signs some messages with it,
+// and times a forgery made by the same search assignment.Forge uses.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"ps/01/assignment"
+	"ps/01/lamport"
+)
+
+func main() {
+	n := flag.Int("n", 2,
+		"messages to sign; each one more roughly halves the work of forging")
+	prefix := flag.String("prefix", "toy forge ", "start of the forged message")
+	flag.Parse()
+
+	params := lamport.ParamsToy64
+	pri, pub, err := params.GenerateKey(nil)
+	if err != nil {
+		fmt.Printf("Error generating key: %v\n", err)
+		os.Exit(1)
+	}
+	sigs := make([]*lamport.GenericSignature, *n)
+	msgs := make([][]byte, *n)
+	for i := range sigs {
+		text := fmt.Sprint(i + 1)
+		msgs[i] = params.Message([]byte(text))
+		sigs[i], err = params.Sign(msgs[i], pri)
+		if err != nil {
+			fmt.Printf("Error signing: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Signed %q: %x\n", text, msgs[i])
+	}
+
+	start := time.Now()
+	f, err := assignment.ForgeParams(params, pub, sigs, msgs,
+		assignment.CountingCandidates(*prefix, 0))
+	if err != nil {
+		fmt.Printf("Error forging: %v\n", err)
+		os.Exit(1)
+	}
+	elapsed := time.Since(start)
+	fmt.Printf("Difficulty: 2^%d\n", f.Difficulty)
+	err = params.Verify(params.Message([]byte(f.Message)), pub, f.Signature)
+	fmt.Printf("Forged %q in %v, verifies: %v\n", f.Message, elapsed, err == nil)
+}
@@ -1,3 +1,7 @@
 module ps/01
 
 go 1.20
+
+require golang.org/x/crypto v0.25.0
+
+require golang.org/x/sys v0.22.0 // indirect
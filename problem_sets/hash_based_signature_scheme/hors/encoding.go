@@ -0,0 +1,255 @@
+package hors
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"ps/01/lamport"
+)
+
+func (self Params) bytes() []byte {
+	b := []byte{byte(self.LogT())}
+	return binary.BigEndian.AppendUint16(b, uint16(self.K))
+}
+
+func paramsFromBytes(b []byte) (Params, error) {
+	if len(b) < PARAMS_BYTES {
+		return Params{}, fmt.Errorf("%w: hors params %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), PARAMS_BYTES)
+	}
+	if b[0] > 16 {
+		return Params{}, fmt.Errorf("hors: log2(t) %d, expect at most 16", b[0])
+	}
+	p := Params{T: 1 << b[0], K: int(binary.BigEndian.Uint16(b[1:]))}
+	return p, p.Check()
+}
+
+func blocksToBytes(b []byte, blocks []lamport.Block) []byte {
+	for _, x := range blocks {
+		b = append(b, x[:]...)
+	}
+	return b
+}
+
+// keyFromBytes decodes the params and T values of a key.
+func keyFromBytes(kind string, b []byte) (Params, []lamport.Block, error) {
+	params, err := paramsFromBytes(b)
+	if err != nil {
+		return Params{}, nil, err
+	}
+	if len(b) != params.PubkeyBytes() {
+		return Params{}, nil, fmt.Errorf("%w: %s %d bytes, expect %d",
+			lamport.ErrWrongLength, kind, len(b), params.PubkeyBytes())
+	}
+	values := make([]lamport.Block, params.T)
+	for i := range values {
+		copy(values[i][:], b[PARAMS_BYTES+i*N:])
+	}
+	return params, values, nil
+}
+
+// Bytes returns the private key's encoding.
+func (self *PrivateKey) Bytes() []byte {
+	return blocksToBytes(self.Params.bytes(), self.Secrets)
+}
+
+// Bytes returns the pubkey's encoding.
+func (self *PublicKey) Bytes() []byte {
+	return blocksToBytes(self.Params.bytes(), self.Hashes)
+}
+
+// Bytes returns the signature's encoding, the revealed secrets in order.
+func (self *Signature) Bytes() []byte {
+	return blocksToBytes(nil, self.Revealed)
+}
+
+// PrivkeyFromBytes is the inverse of PrivateKey.Bytes.
+func PrivkeyFromBytes(b []byte) (*PrivateKey, error) {
+	params, secrets, err := keyFromBytes("hors private key", b)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKey{Params: params, Secrets: secrets}, nil
+}
+
+// PubkeyFromBytes is the inverse of PublicKey.Bytes.
+func PubkeyFromBytes(b []byte) (*PublicKey, error) {
+	params, hashes, err := keyFromBytes("hors pubkey", b)
+	if err != nil {
+		return nil, err
+	}
+	return &PublicKey{Params: params, Hashes: hashes}, nil
+}
+
+// SignatureFromBytes decodes a signature made with params.
+func SignatureFromBytes(params Params, b []byte) (*Signature, error) {
+	if len(b) != params.SignatureBytes() {
+		return nil, fmt.Errorf("%w: hors signature %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), params.SignatureBytes())
+	}
+	sig := &Signature{Revealed: make([]lamport.Block, params.K)}
+	for i := range sig.Revealed {
+		copy(sig.Revealed[i][:], b[i*N:])
+	}
+	return sig, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the layout of
+// Bytes().
+func (self *PublicKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  On error the
+// receiver is left untouched.
+func (self *PublicKey) UnmarshalBinary(data []byte) error {
+	pub, err := PubkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = *pub
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (self *PrivateKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *PrivateKey) UnmarshalBinary(data []byte) error {
+	pri, err := PrivkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = *pri
+	return nil
+}
+
+func (self HorstParams) bytes() []byte {
+	return append(self.Params.bytes(), byte(self.X))
+}
+
+func horstParamsFromBytes(b []byte) (HorstParams, error) {
+	if len(b) < HORST_PARAMS_BYTES {
+		return HorstParams{}, fmt.Errorf("%w: horst params %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), HORST_PARAMS_BYTES)
+	}
+	params, err := paramsFromBytes(b)
+	if err != nil {
+		return HorstParams{}, err
+	}
+	p := HorstParams{Params: params, X: int(b[PARAMS_BYTES])}
+	return p, p.Check()
+}
+
+// Bytes returns the private key's encoding.
+func (self *HorstPrivateKey) Bytes() []byte {
+	return blocksToBytes(self.Params.bytes(), self.Secrets)
+}
+
+// Bytes returns the pubkey's encoding.
+func (self *HorstPublicKey) Bytes() []byte {
+	return append(self.Params.bytes(), self.Root[:]...)
+}
+
+// Bytes returns the signature's encoding.
+func (self *HorstSignature) Bytes() []byte {
+	b := blocksToBytes(nil, self.Revealed)
+	for _, path := range self.Paths {
+		b = blocksToBytes(b, path.Siblings)
+	}
+	return blocksToBytes(b, self.Layer)
+}
+
+// HorstPrivkeyFromBytes is the inverse of HorstPrivateKey.Bytes.
+func HorstPrivkeyFromBytes(b []byte) (*HorstPrivateKey, error) {
+	params, err := horstParamsFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	expect := HORST_PARAMS_BYTES + params.T*N
+	if len(b) != expect {
+		return nil, fmt.Errorf("%w: horst private key %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), expect)
+	}
+	pri := &HorstPrivateKey{Params: params, Secrets: make([]lamport.Block, params.T)}
+	for i := range pri.Secrets {
+		copy(pri.Secrets[i][:], b[HORST_PARAMS_BYTES+i*N:])
+	}
+	return pri, nil
+}
+
+// HorstPubkeyFromBytes is the inverse of HorstPublicKey.Bytes.
+func HorstPubkeyFromBytes(b []byte) (*HorstPublicKey, error) {
+	params, err := horstParamsFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != params.PubkeyBytes() {
+		return nil, fmt.Errorf("%w: horst pubkey %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), params.PubkeyBytes())
+	}
+	pub := &HorstPublicKey{Params: params}
+	copy(pub.Root[:], b[HORST_PARAMS_BYTES:])
+	return pub, nil
+}
+
+// HorstSignatureFromBytes decodes a signature made with params.
+func HorstSignatureFromBytes(params HorstParams, b []byte) (*HorstSignature, error) {
+	err := params.Check()
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != params.SignatureBytes() {
+		return nil, fmt.Errorf("%w: horst signature %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), params.SignatureBytes())
+	}
+	blocks := make([]lamport.Block, len(b)/N)
+	for i := range blocks {
+		copy(blocks[i][:], b[i*N:])
+	}
+	h := params.PathHeight()
+	sig := &HorstSignature{
+		Revealed: blocks[:params.K],
+		Paths:    make([]lamport.AuthPath, params.K),
+		Layer:    blocks[params.K*(1+h):],
+	}
+	for i := range sig.Paths {
+		start := params.K + i*h
+		sig.Paths[i] = lamport.AuthPath{Height: h, Siblings: blocks[start : start+h]}
+	}
+	return sig, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the layout of
+// Bytes().
+func (self *HorstPublicKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  On error the
+// receiver is left untouched.
+func (self *HorstPublicKey) UnmarshalBinary(data []byte) error {
+	pub, err := HorstPubkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = *pub
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (self *HorstPrivateKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *HorstPrivateKey) UnmarshalBinary(data []byte) error {
+	pri, err := HorstPrivkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = *pri
+	return nil
+}
@@ -0,0 +1,60 @@
+package hors
+
+import (
+	"errors"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestEncoding round trips keys and a signature, and checks bad lengths and
+// params are refused.
+func TestEncoding(t *testing.T) {
+	params := Params{T: 64, K: 8}
+	pri, pub, err := GenerateKey(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("encode")
+	sig := Sign(msg, pri)
+
+	var pri2 PrivateKey
+	data, err := pri.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pri2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pub2 PublicKey
+	data, err = pub.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != params.PubkeyBytes() {
+		t.Fatalf("pubkey is %d bytes, expect %d", len(data), params.PubkeyBytes())
+	}
+	err = pub2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig2, err := SignatureFromBytes(pub2.Params, sig.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(msg, &pub2, sig2) || !Verify(msg, &pub2, Sign(msg, &pri2)) {
+		t.Fatalf("Verify returned false after decoding, expected true")
+	}
+
+	if err = pub2.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short pubkey: got %v, expect ErrWrongLength", err)
+	}
+	if _, err = SignatureFromBytes(params, sig.Bytes()[1:]); !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short signature: got %v, expect ErrWrongLength", err)
+	}
+	data[0] = 17
+	if err = pub2.UnmarshalBinary(data); err == nil {
+		t.Fatalf("log2(t) 17 returned nil, expected an error")
+	}
+}
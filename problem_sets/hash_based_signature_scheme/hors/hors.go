@@ -0,0 +1,172 @@
+// Package hors implements HORS, a few-time signature scheme.  The private
+// key is T random secrets and the pubkey is their hashes.  A message digest
+// is cut into K indexes from 0 to T-1, and the signature reveals the secret
+// at each one.
+//
+// Unlike a Lamport key, a HORS key survives a few signatures: each one
+// reveals only K of the T secrets, and a forger needs a message whose K
+// indexes all land on revealed ones.  That gets likelier with every
+// signature; ForgeProbabilityAfter says how fast.
+package hors
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+
+	"ps/01/lamport"
+)
+
+/*
+Indexes come from the 256 bit digest read big endian, log2(T) bits at a
+time: index i is bits i*log2(T) to (i+1)*log2(T)-1, where bit 0 is the high
+bit of the first byte, the same order as lamport.Message.Bit.  The same
+index can come up more than once, in which case the signature has the same
+secret at each place.
+
+Keys encode as
+
+    log2(T)  1 byte
+    K        2 bytes, big endian
+    T values of 32 bytes, secrets or their hashes
+
+and signatures as just the K revealed secrets, in index order.
+*/
+
+const N = lamport.MESSAGE_BYTES
+
+const PARAMS_BYTES = 3
+
+// Params are the number of secrets T, a power of two, and the number K
+// revealed per signature.  K*log2(T) can't be more than 256, the bits in a
+// digest.
+type Params struct {
+	T int
+	K int
+}
+
+// Check makes sure the params can be used.
+func (self Params) Check() error {
+	if self.T < 2 || self.T > 1<<16 || self.T&(self.T-1) != 0 {
+		return fmt.Errorf("hors: t=%d, must be a power of two from 2 to 65536", self.T)
+	}
+	if self.K < 1 || self.K*self.LogT() > 8*N {
+		return fmt.Errorf("hors: k=%d with t=%d needs %d digest bits, have %d",
+			self.K, self.T, self.K*self.LogT(), 8*N)
+	}
+	return nil
+}
+
+// LogT is log2(T), the bits per index.
+func (self Params) LogT() int {
+	return bits.TrailingZeros(uint(self.T))
+}
+
+// PubkeyBytes is the size of an encoded pubkey, and of a private key.
+func (self Params) PubkeyBytes() int {
+	return PARAMS_BYTES + self.T*N
+}
+
+// SignatureBytes is the size of an encoded signature.
+func (self Params) SignatureBytes() int {
+	return self.K * N
+}
+
+// Indexes returns the K indexes msg selects.
+func (self Params) Indexes(msg lamport.Message) []int {
+	idx := make([]int, self.K)
+	for i := range idx {
+		for j := 0; j < self.LogT(); j++ {
+			idx[i] = idx[i]<<1 | int(msg.Bit(i*self.LogT()+j))
+		}
+	}
+	return idx
+}
+
+// ForgeProbabilityAfter estimates the chance that a random message can be
+// signed with only what r signatures revealed.  Each secret stays hidden
+// through all r*K reveals with probability (1-1/T)^(rK), and a message is
+// forgeable when all K of its indexes hit revealed ones:
+//
+//	(1 - (1-1/T)^(rK))^K
+//
+// which treats the reveals as independent; it's an estimate, not a bound.
+func (self Params) ForgeProbabilityAfter(r int) float64 {
+	if r <= 0 {
+		return 0
+	}
+	hidden := math.Pow(1-1/float64(self.T), float64(r*self.K))
+	return math.Pow(1-hidden, float64(self.K))
+}
+
+// PrivateKey holds the T secrets.
+type PrivateKey struct {
+	Params  Params
+	Secrets []lamport.Block
+}
+
+// PublicKey holds the hash of each secret.
+type PublicKey struct {
+	Params Params
+	Hashes []lamport.Block
+}
+
+// Signature holds the K revealed secrets, in index order.
+type Signature struct {
+	Revealed []lamport.Block
+}
+
+// GenerateKey generates a key pair from crypto/rand.
+func GenerateKey(params Params) (*PrivateKey, *PublicKey, error) {
+	return GenerateKeyFrom(params, rand.Reader)
+}
+
+// GenerateKeyFrom reads the T secrets from r, in order.
+func GenerateKeyFrom(params Params, r io.Reader) (*PrivateKey, *PublicKey, error) {
+	err := params.Check()
+	if err != nil {
+		return nil, nil, err
+	}
+	pri := &PrivateKey{Params: params, Secrets: make([]lamport.Block, params.T)}
+	for i := range pri.Secrets {
+		_, err = io.ReadFull(r, pri.Secrets[i][:])
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading %d bytes of key material: %w",
+				params.T*N, err)
+		}
+	}
+	return pri, pri.PublicKey(), nil
+}
+
+// PublicKey hashes every secret.
+func (self *PrivateKey) PublicKey() *PublicKey {
+	pub := &PublicKey{Params: self.Params, Hashes: make([]lamport.Block, len(self.Secrets))}
+	for i, s := range self.Secrets {
+		pub.Hashes[i] = s.Hash()
+	}
+	return pub
+}
+
+// Sign reveals the secrets at the indexes msg selects.
+func Sign(msg lamport.Message, pri *PrivateKey) *Signature {
+	sig := &Signature{Revealed: make([]lamport.Block, pri.Params.K)}
+	for i, idx := range pri.Params.Indexes(msg) {
+		sig.Revealed[i] = pri.Secrets[idx]
+	}
+	return sig
+}
+
+// Verify checks each revealed secret hashes to the pubkey at its index.
+func Verify(msg lamport.Message, pub *PublicKey, sig *Signature) bool {
+	if len(sig.Revealed) != pub.Params.K || len(pub.Hashes) != pub.Params.T {
+		return false
+	}
+	for i, idx := range pub.Params.Indexes(msg) {
+		if !sig.Revealed[i].Hash().Equal(pub.Hashes[idx]) {
+			return false
+		}
+	}
+	return true
+}
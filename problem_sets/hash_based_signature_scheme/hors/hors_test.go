@@ -0,0 +1,126 @@
+package hors
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestIndexes checks the documented bit slicing: log2(T) bits at a time,
+// high bit first.
+func TestIndexes(t *testing.T) {
+	var msg lamport.Message
+	msg[0] = 0xa5
+	msg[1] = 0x3c
+	msg[2] = 0xff
+	for _, tc := range []struct {
+		params Params
+		expect []int
+	}{
+		{Params{T: 16, K: 4}, []int{0xa, 0x5, 0x3, 0xc}},
+		{Params{T: 256, K: 3}, []int{0xa5, 0x3c, 0xff}},
+		{Params{T: 8, K: 4}, []int{5, 1, 2, 3}},
+		{Params{T: 4096, K: 2}, []int{0xa53, 0xcff}},
+	} {
+		got := tc.params.Indexes(msg)
+		for i := range tc.expect {
+			if got[i] != tc.expect[i] {
+				t.Fatalf("%+v: got indexes %x, expect %x", tc.params, got, tc.expect)
+			}
+		}
+	}
+}
+
+// TestSignVerify signs and verifies, and checks other messages, changed
+// secrets and the wrong number of them are rejected.
+func TestSignVerify(t *testing.T) {
+	params := Params{T: 1024, K: 16}
+	pri, pub, err := GenerateKey(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("hors")
+	sig := Sign(msg, pri)
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	if Verify(lamport.GetMessageFromString("other"), pub, sig) {
+		t.Fatalf("Verify returned true for another message, expected false")
+	}
+	sig.Revealed[3][0] ^= 1
+	if Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned true for a changed secret, expected false")
+	}
+	sig.Revealed = sig.Revealed[:15]
+	if Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned true for 15 secrets, expected false")
+	}
+}
+
+// TestParamsCheck checks T that isn't a power of two, and K that needs more
+// than 256 bits, are refused.
+func TestParamsCheck(t *testing.T) {
+	for _, p := range []Params{{T: 12, K: 4}, {T: 1, K: 1}, {T: 16, K: 0},
+		{T: 16, K: 65}, {T: 1 << 17, K: 1}} {
+		if p.Check() == nil {
+			t.Fatalf("Check(%+v) returned nil, expected an error", p)
+		}
+		if _, _, err := GenerateKey(p); err == nil {
+			t.Fatalf("GenerateKey(%+v) returned nil, expected an error", p)
+		}
+	}
+	if err := (Params{T: 16, K: 64}).Check(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestForgeProbability checks the estimator grows with reuse, then runs the
+// reuse experiment: sign r random messages, and count how many other random
+// messages could be signed with only the revealed secrets.
+func TestForgeProbability(t *testing.T) {
+	params := Params{T: 16, K: 4}
+	if params.ForgeProbabilityAfter(0) != 0 {
+		t.Fatalf("unused key has a forge probability")
+	}
+	for r := 1; r < 10; r++ {
+		if params.ForgeProbabilityAfter(r) <= params.ForgeProbabilityAfter(r-1) {
+			t.Fatalf("forge probability doesn't grow from %d to %d signatures", r-1, r)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	random := func() lamport.Message {
+		var m lamport.Message
+		rng.Read(m[:])
+		return m
+	}
+	const keys, tries = 200, 200
+	for _, r := range []int{1, 2, 4, 8} {
+		forged := 0
+		for k := 0; k < keys; k++ {
+			revealed := make(map[int]bool)
+			for i := 0; i < r; i++ {
+				for _, idx := range params.Indexes(random()) {
+					revealed[idx] = true
+				}
+			}
+			for i := 0; i < tries; i++ {
+				ok := true
+				for _, idx := range params.Indexes(random()) {
+					ok = ok && revealed[idx]
+				}
+				if ok {
+					forged++
+				}
+			}
+		}
+		got := float64(forged) / (keys * tries)
+		expect := params.ForgeProbabilityAfter(r)
+		if math.Abs(got-expect) > 0.02+0.25*expect {
+			t.Fatalf("after %d signatures: forged %.4f of messages, estimate %.4f",
+				r, got, expect)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package hors
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"ps/01/lamport"
+)
+
+/*
+HORST puts the T hashes of a HORS pubkey at the leaves of a Merkle tree and
+publishes only the root.  A signature then needs an auth path for each
+revealed secret, and K full paths repeat a lot of nodes near the top.  So
+paths stop X levels below the root, and the signature carries the whole
+layer of 1<<X nodes they end at instead; the verifier climbs each path to
+that layer, checks it lands on the right node, and builds the root from the
+layer.  X around log2(K) gives the smallest signatures; see BestCut.
+
+With h = log2(T), a signature encodes as
+
+    revealed  K times 32 bytes
+    paths     K times (h-X)*32 bytes, siblings bottom first
+    layer     1<<X times 32 bytes, left to right
+
+with no indexes, since they come from the message.  Keys encode as
+
+    log2(T)  1 byte
+    K        2 bytes, big endian
+    X        1 byte
+    the root, or the T secrets
+*/
+
+const HORST_PARAMS_BYTES = PARAMS_BYTES + 1
+
+// HorstParams are HORS params and the level X, counted down from the root,
+// that auth paths are cut at.  X is from 0, full paths, to log2(T).
+type HorstParams struct {
+	Params
+	X int
+}
+
+// Check makes sure the params can be used.
+func (self HorstParams) Check() error {
+	err := self.Params.Check()
+	if err != nil {
+		return err
+	}
+	if self.X < 0 || self.X > self.LogT() {
+		return fmt.Errorf("hors: cut at x=%d, expect 0 to %d", self.X, self.LogT())
+	}
+	return nil
+}
+
+// PathHeight is the number of siblings in each truncated auth path.
+func (self HorstParams) PathHeight() int {
+	return self.LogT() - self.X
+}
+
+// PubkeyBytes is the size of an encoded HORST pubkey.
+func (self HorstParams) PubkeyBytes() int {
+	return HORST_PARAMS_BYTES + N
+}
+
+// SignatureBytes is the size of an encoded HORST signature.
+func (self HorstParams) SignatureBytes() int {
+	return (self.K*(1+self.PathHeight()) + 1<<self.X) * N
+}
+
+// BestCut returns the X that makes HORST signatures smallest with these
+// params, the lowest one if there's a tie.
+func (self Params) BestCut() int {
+	best := 0
+	for x := 1; x <= self.LogT(); x++ {
+		if (HorstParams{self, x}).SignatureBytes() <
+			(HorstParams{self, best}).SignatureBytes() {
+			best = x
+		}
+	}
+	return best
+}
+
+// SizeComparison sets the encoded sizes of HORS and HORST keys with the
+// same T and K side by side.
+type SizeComparison struct {
+	HorsPubkey     int
+	HorsSignature  int
+	HorstPubkey    int
+	HorstSignature int
+}
+
+// CompareSizes returns the sizes of plain HORS next to HORST with these
+// params.
+func (self HorstParams) CompareSizes() SizeComparison {
+	return SizeComparison{
+		HorsPubkey:     self.Params.PubkeyBytes(),
+		HorsSignature:  self.Params.SignatureBytes(),
+		HorstPubkey:    self.PubkeyBytes(),
+		HorstSignature: self.SignatureBytes(),
+	}
+}
+
+func (self SizeComparison) String() string {
+	return fmt.Sprintf("hors: pubkey %d, signature %d; horst: pubkey %d, signature %d",
+		self.HorsPubkey, self.HorsSignature, self.HorstPubkey, self.HorstSignature)
+}
+
+// HorstPrivateKey holds the T secrets, and the tree over their hashes once
+// it's been needed.
+type HorstPrivateKey struct {
+	Params  HorstParams
+	Secrets []lamport.Block
+
+	levels [][]lamport.Block
+}
+
+// HorstPublicKey is the root of the tree over the T hashes.
+type HorstPublicKey struct {
+	Params HorstParams
+	Root   lamport.Block
+}
+
+// HorstSignature holds the K revealed secrets, each one's auth path up to
+// level X, and the 1<<X nodes at level X.  The paths' Index is the leaf's
+// place in its subtree of height h-X; Verify takes it from the message, so
+// decoded signatures leave it 0.
+type HorstSignature struct {
+	Revealed []lamport.Block
+	Paths    []lamport.AuthPath
+	Layer    []lamport.Block
+}
+
+// GenerateHorstKey generates a key pair from crypto/rand.
+func GenerateHorstKey(params HorstParams) (*HorstPrivateKey, *HorstPublicKey, error) {
+	return GenerateHorstKeyFrom(params, rand.Reader)
+}
+
+// GenerateHorstKeyFrom reads the T secrets from r, as GenerateKeyFrom does.
+func GenerateHorstKeyFrom(params HorstParams, r io.Reader) (*HorstPrivateKey, *HorstPublicKey, error) {
+	err := params.Check()
+	if err != nil {
+		return nil, nil, err
+	}
+	hors, _, err := GenerateKeyFrom(params.Params, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	pri := &HorstPrivateKey{Params: params, Secrets: hors.Secrets}
+	return pri, pri.PublicKey(), nil
+}
+
+// tree returns every level of the tree, building it the first time.
+func (self *HorstPrivateKey) tree() [][]lamport.Block {
+	if self.levels == nil {
+		leaves := make([]lamport.Block, len(self.Secrets))
+		for i, s := range self.Secrets {
+			leaves[i] = s.Hash()
+		}
+		self.levels = lamport.MerkleLevels(leaves)
+	}
+	return self.levels
+}
+
+// PublicKey returns the root of the tree.
+func (self *HorstPrivateKey) PublicKey() *HorstPublicKey {
+	levels := self.tree()
+	return &HorstPublicKey{Params: self.Params, Root: levels[len(levels)-1][0]}
+}
+
+// SignHorst reveals the secrets msg selects with their paths.
+func SignHorst(msg lamport.Message, pri *HorstPrivateKey) *HorstSignature {
+	levels := pri.tree()
+	h := pri.Params.PathHeight()
+	sig := &HorstSignature{
+		Revealed: make([]lamport.Block, pri.Params.K),
+		Paths:    make([]lamport.AuthPath, pri.Params.K),
+		Layer:    append([]lamport.Block(nil), levels[h]...),
+	}
+	for i, idx := range pri.Params.Indexes(msg) {
+		sig.Revealed[i] = pri.Secrets[idx]
+		sig.Paths[i] = lamport.NewAuthPath(levels[:h+1], idx)
+		sig.Paths[i].Index &= 1<<h - 1
+	}
+	return sig
+}
+
+// VerifyHorst climbs from each revealed secret to the layer, then from the
+// layer to the root, and checks it's the pubkey.
+func VerifyHorst(msg lamport.Message, pub *HorstPublicKey, sig *HorstSignature) bool {
+	params := pub.Params
+	h := params.PathHeight()
+	if params.Check() != nil || len(sig.Revealed) != params.K ||
+		len(sig.Paths) != params.K || len(sig.Layer) != 1<<params.X {
+		return false
+	}
+	for i, idx := range params.Indexes(msg) {
+		path := lamport.AuthPath{
+			Index:    uint32(idx & (1<<h - 1)),
+			Height:   h,
+			Siblings: sig.Paths[i].Siblings,
+		}
+		if path.Validate() != nil {
+			return false
+		}
+		node := lamport.Block(path.ComputeRoot(sig.Revealed[i].Hash()))
+		if !node.Equal(sig.Layer[idx>>h]) {
+			return false
+		}
+	}
+	levels := lamport.MerkleLevels(sig.Layer)
+	return levels[len(levels)-1][0].Equal(pub.Root)
+}
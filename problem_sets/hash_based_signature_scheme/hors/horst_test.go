@@ -0,0 +1,136 @@
+package hors
+
+import (
+	"errors"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestHorstRoot checks the HORST root is the root of the tree over the HORS
+// pubkey from the same secrets.
+func TestHorstRoot(t *testing.T) {
+	pri, pub, err := GenerateHorstKey(HorstParams{Params{T: 64, K: 8}, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hors := (&PrivateKey{Params: pri.Params.Params, Secrets: pri.Secrets}).PublicKey()
+	levels := lamport.MerkleLevels(hors.Hashes)
+	if !levels[len(levels)-1][0].Equal(pub.Root) {
+		t.Fatalf("horst root isn't the root over the hors pubkey")
+	}
+}
+
+// TestHorstSignVerify signs and verifies with every cut level, and checks
+// changes to each part of the signature are caught.
+func TestHorstSignVerify(t *testing.T) {
+	params := Params{T: 256, K: 8}
+	pri, pub, err := GenerateHorstKey(HorstParams{params, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("horst")
+	for x := 0; x <= params.LogT(); x++ {
+		pri.Params.X = x
+		pub.Params.X = x
+		sig := SignHorst(msg, pri)
+		if len(sig.Bytes()) != pub.Params.SignatureBytes() {
+			t.Fatalf("x=%d: signature is %d bytes, expect %d", x,
+				len(sig.Bytes()), pub.Params.SignatureBytes())
+		}
+		if !VerifyHorst(msg, pub, sig) {
+			t.Fatalf("x=%d: VerifyHorst returned false, expected true", x)
+		}
+		if VerifyHorst(lamport.GetMessageFromString("other"), pub, sig) {
+			t.Fatalf("x=%d: VerifyHorst returned true for another message", x)
+		}
+
+		sig.Layer[len(sig.Layer)-1][0] ^= 1
+		if VerifyHorst(msg, pub, sig) {
+			t.Fatalf("x=%d: VerifyHorst returned true for a changed layer", x)
+		}
+		sig.Layer[len(sig.Layer)-1][0] ^= 1
+		if x < params.LogT() {
+			sig.Paths[2].Siblings[0][0] ^= 1
+			if VerifyHorst(msg, pub, sig) {
+				t.Fatalf("x=%d: VerifyHorst returned true for a changed path", x)
+			}
+			sig.Paths[2].Siblings[0][0] ^= 1
+		}
+		sig.Revealed[5][0] ^= 1
+		if VerifyHorst(msg, pub, sig) {
+			t.Fatalf("x=%d: VerifyHorst returned true for a changed secret", x)
+		}
+	}
+}
+
+// TestHorstSizes checks the sizes against SPHINCS-256's HORST, t=2^16 and
+// k=32, whose signatures are 13312 bytes.
+func TestHorstSizes(t *testing.T) {
+	params := Params{T: 1 << 16, K: 32}
+	if x := params.BestCut(); x != 5 {
+		t.Fatalf("BestCut returned %d, expect 5", x)
+	}
+	for _, x := range []int{5, 6} {
+		sizes := HorstParams{params, x}.CompareSizes()
+		if sizes.HorstSignature != 13312 {
+			t.Fatalf("x=%d: signature %d bytes, expect 13312", x, sizes.HorstSignature)
+		}
+		if sizes.HorstPubkey != HORST_PARAMS_BYTES+32 {
+			t.Fatalf("pubkey %d bytes, expect %d", sizes.HorstPubkey, HORST_PARAMS_BYTES+32)
+		}
+		if sizes.HorsPubkey != PARAMS_BYTES+32<<16 || sizes.HorsSignature != 32*32 {
+			t.Fatalf("wrong hors sizes: %v", sizes)
+		}
+	}
+	if (HorstParams{params, 0}).SignatureBytes() != 32*17*32+32 {
+		t.Fatalf("full paths are %d bytes", (HorstParams{params, 0}).SignatureBytes())
+	}
+	if (HorstParams{params, 17}).Check() == nil {
+		t.Fatalf("Check returned nil for x=17, expected an error")
+	}
+}
+
+// TestHorstEncoding round trips keys and a signature.
+func TestHorstEncoding(t *testing.T) {
+	params := HorstParams{Params{T: 128, K: 6}, 2}
+	pri, pub, err := GenerateHorstKey(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("encode")
+	sig, err := HorstSignatureFromBytes(params, SignHorst(msg, pri).Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pub2 HorstPublicKey
+	data, _ := pub.MarshalBinary()
+	err = pub2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyHorst(msg, &pub2, sig) {
+		t.Fatalf("VerifyHorst returned false after decoding, expected true")
+	}
+	var pri2 HorstPrivateKey
+	data, _ = pri.MarshalBinary()
+	err = pri2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyHorst(msg, &pub2, SignHorst(msg, &pri2)) {
+		t.Fatalf("VerifyHorst returned false with a decoded key, expected true")
+	}
+	if *pri2.PublicKey() != *pub {
+		t.Fatalf("decoded private key has another pubkey")
+	}
+
+	_, err = HorstSignatureFromBytes(params, sig.Bytes()[N:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short signature: got %v, expect ErrWrongLength", err)
+	}
+	err = pub2.UnmarshalBinary(data[:HORST_PARAMS_BYTES+N-1])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+}
@@ -0,0 +1,119 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+/*
+Bitcoin flavored addresses for Lamport pubkeys.  An address is the Base58Check
+encoding of
+
+    version (1 byte) || sha256(pub.Bytes()) (32 bytes)
+
+with the usual 4 byte checksum, the first 4 bytes of sha256(sha256(...)) of
+the version and hash, on the end.  The hash is the same as the pubkey's
+Fingerprint.
+*/
+
+// ADDRESS_VERSION is the version byte prefixed to every address.
+const ADDRESS_VERSION = 0x4c
+
+const addressChecksumBytes = 4
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	ErrAddressEncoding = errors.New("address: invalid base58 character")
+	ErrAddressLength   = errors.New("address: wrong length")
+	ErrAddressChecksum = errors.New("address: bad checksum")
+	ErrAddressVersion  = errors.New("address: unknown version")
+	ErrAddressMismatch = errors.New("address: doesn't match pubkey")
+)
+
+// Address returns the Base58Check address committing to the pubkey.
+func (self PublicKey) Address() string {
+	fp := self.Fingerprint()
+	payload := append([]byte{ADDRESS_VERSION}, fp[:]...)
+	return base58Encode(append(payload, addressChecksum(payload)...))
+}
+
+// VerifyAddress decodes addr, checking its encoding, length, checksum and
+// version, and then checks that it commits to pub.  Each failure has its own
+// error so callers can tell a typo from the wrong key.
+func VerifyAddress(addr string, pub PublicKey) error {
+	data, err := base58Decode(addr)
+	if err != nil {
+		return err
+	}
+	if len(data) != 1+sha256.Size+addressChecksumBytes {
+		return fmt.Errorf("%w: %d bytes, expect %d",
+			ErrAddressLength, len(data), 1+sha256.Size+addressChecksumBytes)
+	}
+	payload := data[:len(data)-addressChecksumBytes]
+	if !bytes.Equal(addressChecksum(payload), data[len(payload):]) {
+		return ErrAddressChecksum
+	}
+	if payload[0] != ADDRESS_VERSION {
+		return fmt.Errorf("%w: %#02x, expect %#02x",
+			ErrAddressVersion, payload[0], ADDRESS_VERSION)
+	}
+	fp := pub.Fingerprint()
+	if !bytes.Equal(payload[1:], fp[:]) {
+		return ErrAddressMismatch
+	}
+	return nil
+}
+
+// addressChecksum returns the first 4 bytes of the double sha256 of payload.
+func addressChecksum(payload []byte) []byte {
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	return second[:addressChecksumBytes]
+}
+
+// base58Encode encodes b with the Bitcoin alphabet; each leading zero byte
+// becomes a leading '1'.
+func base58Encode(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, '1')
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+// base58Decode is the inverse of base58Encode.
+func base58Decode(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	zeros := 0
+	for i := 0; i < len(s); i++ {
+		digit := bytes.IndexByte([]byte(base58Alphabet), s[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("%w %q at %d", ErrAddressEncoding, s[i], i)
+		}
+		if digit == 0 && zeros == i {
+			zeros++
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
@@ -0,0 +1,92 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestAddressVector pins the address of the provided pubkey.
+func TestAddressVector(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "3ZsHbP3eJkPKwBEhbMigHStUG2R33czZnwF8Gsk8nBDMjzt8FYW"
+	addr := pub.Address()
+	if addr != expected {
+		t.Fatalf("address %s, expect %s", addr, expected)
+	}
+	err = VerifyAddress(addr, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestAddressErrors checks each way an address can be wrong gets its own
+// error.
+func TestAddressErrors(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := pub.Address()
+
+	_, pub2, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyAddress(addr, pub2)
+	if !errors.Is(err, ErrAddressMismatch) {
+		t.Fatalf("got %v, expect ErrAddressMismatch", err)
+	}
+
+	// 0 isn't in the base58 alphabet
+	err = VerifyAddress(addr[:10]+"0"+addr[11:], pub)
+	if !errors.Is(err, ErrAddressEncoding) {
+		t.Fatalf("got %v, expect ErrAddressEncoding", err)
+	}
+
+	// change one character to another valid one
+	typo := []byte(addr)
+	if typo[20] == 'a' {
+		typo[20] = 'b'
+	} else {
+		typo[20] = 'a'
+	}
+	err = VerifyAddress(string(typo), pub)
+	if !errors.Is(err, ErrAddressChecksum) {
+		t.Fatalf("got %v, expect ErrAddressChecksum", err)
+	}
+
+	err = VerifyAddress(addr[:len(addr)-3], pub)
+	if !errors.Is(err, ErrAddressLength) {
+		t.Fatalf("got %v, expect ErrAddressLength", err)
+	}
+
+	// well formed address with a different version byte
+	fp := pub.Fingerprint()
+	payload := append([]byte{ADDRESS_VERSION + 1}, fp[:]...)
+	other := base58Encode(append(payload, addressChecksum(payload)...))
+	err = VerifyAddress(other, pub)
+	if !errors.Is(err, ErrAddressVersion) {
+		t.Fatalf("got %v, expect ErrAddressVersion", err)
+	}
+}
+
+// TestBase58LeadingZeros checks leading zero bytes survive a round trip.
+func TestBase58LeadingZeros(t *testing.T) {
+	in := []byte{0, 0, 1, 2, 3}
+	s := base58Encode(in)
+	if s[:2] != "11" {
+		t.Fatalf("base58 %s, expect two leading 1s", s)
+	}
+	out, err := base58Decode(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, in) {
+		t.Fatalf("base58 round trip gave %x, expect %x", out, in)
+	}
+}
@@ -0,0 +1,160 @@
+package lamport
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+PGP style ASCII armor (RFC 4880 section 6), for pasting into email.  It's
+like the PEM encoding but with a CRC24 checksum line, so corruption in
+transit is caught before spending time on Verify:
+
+    -----BEGIN LAMPORT SIGNATURE-----
+
+    <base64 of Bytes(), 64 columns>
+    =<base64 of the 3 byte CRC24>
+    -----END LAMPORT SIGNATURE-----
+
+The block types are the same as for PEM.
+*/
+
+const armorLineLength = 64
+
+// CRC24 parameters from RFC 4880.
+const crc24Init = 0xb704ce
+const crc24Poly = 0x1864cfb
+
+var ErrArmorChecksum = errors.New("armor: CRC24 checksum mismatch")
+
+// ArmorSignature returns the armored signature.
+func ArmorSignature(sig Signature) string {
+	return armor(PEM_SIGNATURE, sig.Bytes())
+}
+
+// DearmorSignature decodes an armored signature, checking the CRC before
+// decoding the signature itself.
+func DearmorSignature(s string) (Signature, error) {
+	data, err := dearmor(s, PEM_SIGNATURE)
+	if err != nil {
+		return Signature{}, err
+	}
+	return SignatureFromBytes(data)
+}
+
+// ArmorPubkey returns the armored pubkey.
+func ArmorPubkey(pub PublicKey) string {
+	return armor(PEM_PUBKEY, pub.Bytes())
+}
+
+// DearmorPubkey decodes an armored pubkey.
+func DearmorPubkey(s string) (PublicKey, error) {
+	data, err := dearmor(s, PEM_PUBKEY)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	return PubkeyFromBytes(data)
+}
+
+// crc24 computes the OpenPGP CRC24 of data.
+func crc24(data []byte) uint32 {
+	crc := uint32(crc24Init)
+	for _, b := range data {
+		crc ^= uint32(b) << 16
+		for i := 0; i < 8; i++ {
+			crc <<= 1
+			if crc&0x1000000 != 0 {
+				crc ^= crc24Poly
+			}
+		}
+	}
+	return crc & 0xffffff
+}
+
+// armor builds the armored text for data.
+func armor(blockType string, data []byte) string {
+	var sb strings.Builder
+	sb.WriteString("-----BEGIN " + blockType + "-----\n\n")
+
+	body := base64.StdEncoding.EncodeToString(data)
+	for len(body) > armorLineLength {
+		sb.WriteString(body[:armorLineLength] + "\n")
+		body = body[armorLineLength:]
+	}
+	sb.WriteString(body + "\n")
+
+	crc := crc24(data)
+	sum := []byte{byte(crc >> 16), byte(crc >> 8), byte(crc)}
+	sb.WriteString("=" + base64.StdEncoding.EncodeToString(sum) + "\n")
+	sb.WriteString("-----END " + blockType + "-----\n")
+	return sb.String()
+}
+
+// dearmor finds the armored block of blockType in s and returns its decoded
+// contents.  Text before the BEGIN line is skipped.  Errors in the body give
+// the line number within s.
+func dearmor(s string, blockType string) ([]byte, error) {
+	begin := "-----BEGIN " + blockType + "-----"
+	end := "-----END " + blockType + "-----"
+
+	lines := strings.Split(s, "\n")
+	start := -1
+	for i, line := range lines {
+		if strings.TrimSpace(line) == begin {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("armor: no %q line", begin)
+	}
+
+	var body strings.Builder
+	var data []byte
+	gotSum := false
+	for i := start + 1; i < len(lines); i++ {
+		lineNum := i + 1
+		line := strings.TrimSpace(lines[i])
+		switch {
+		case line == "":
+			continue
+		case line == end:
+			if !gotSum {
+				return nil, fmt.Errorf("armor: line %d: missing checksum line", lineNum)
+			}
+			return data, nil
+		case gotSum:
+			return nil, fmt.Errorf("armor: line %d: data after checksum", lineNum)
+		case strings.HasPrefix(line, "="):
+			sum, err := base64.StdEncoding.DecodeString(line[1:])
+			if err != nil || len(sum) != 3 {
+				return nil, fmt.Errorf("armor: line %d: bad checksum line %q",
+					lineNum, line)
+			}
+			data, err = base64.StdEncoding.DecodeString(body.String())
+			if err != nil {
+				return nil, fmt.Errorf("armor: body: %v", err)
+			}
+			crc := uint32(sum[0])<<16 | uint32(sum[1])<<8 | uint32(sum[2])
+			if crc24(data) != crc {
+				return nil, ErrArmorChecksum
+			}
+			gotSum = true
+		default:
+			// check each line on its own so the error can say where
+			if strings.Trim(line, base64Chars) != "" {
+				bad := strings.IndexFunc(line, func(r rune) bool {
+					return !strings.ContainsRune(base64Chars, r)
+				})
+				return nil, fmt.Errorf("armor: line %d column %d: invalid base64 %q",
+					lineNum, bad+1, line[bad])
+			}
+			body.WriteString(line)
+		}
+	}
+	return nil, fmt.Errorf("armor: no %q line", end)
+}
+
+const base64Chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/="
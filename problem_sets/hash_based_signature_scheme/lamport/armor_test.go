@@ -0,0 +1,94 @@
+package lamport
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestCRC24 checks the CRC against a known value for "123456789", the check
+// string used in CRC catalogues (CRC-24/OPENPGP = 0x21cf02).
+func TestCRC24(t *testing.T) {
+	if crc24([]byte("123456789")) != 0x21cf02 {
+		t.Fatalf("crc24 %06x, expect 21cf02", crc24([]byte("123456789")))
+	}
+}
+
+// TestArmorRoundTrip armors the provided signature and pubkey and reads them
+// back, with some leading email text.
+func TestArmorRoundTrip(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	armored := ArmorSignature(sig)
+	for _, line := range strings.Split(armored, "\n") {
+		if len(line) > 64 {
+			t.Fatalf("armor line %d characters long, expect at most 64", len(line))
+		}
+	}
+	sig2, err := DearmorSignature("Signed,\n  me\n\n" + armored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig2 != sig {
+		t.Fatalf("signature changed after armor round trip")
+	}
+
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub2, err := DearmorPubkey(ArmorPubkey(pub))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("pubkey changed after armor round trip")
+	}
+
+	_, err = DearmorPubkey(armored)
+	if err == nil {
+		t.Fatalf("armored signature decoded as pubkey")
+	}
+}
+
+// TestArmorCorrupt flips a character in the body, which should be caught by
+// the CRC, and puts an invalid character in, which should give its line.
+func TestArmorCorrupt(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(ArmorSignature(sig), "\n")
+
+	flipped := append([]string{}, lines...)
+	if flipped[5][10] == 'A' {
+		flipped[5] = flipped[5][:10] + "B" + flipped[5][11:]
+	} else {
+		flipped[5] = flipped[5][:10] + "A" + flipped[5][11:]
+	}
+	_, err = DearmorSignature(strings.Join(flipped, "\n"))
+	if !errors.Is(err, ErrArmorChecksum) {
+		t.Fatalf("got %v, expect ErrArmorChecksum", err)
+	}
+
+	invalid := append([]string{}, lines...)
+	invalid[7] = invalid[7][:3] + "*" + invalid[7][4:]
+	_, err = DearmorSignature(strings.Join(invalid, "\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 8 column 4") {
+		t.Fatalf("got %v, expect error at line 8 column 4", err)
+	}
+
+	// no checksum line at all
+	var noSum []string
+	for _, line := range lines {
+		if !strings.HasPrefix(line, "=") {
+			noSum = append(noSum, line)
+		}
+	}
+	_, err = DearmorSignature(strings.Join(noSum, "\n"))
+	if err == nil || !strings.Contains(err.Error(), "missing checksum") {
+		t.Fatalf("got %v, expect missing checksum error", err)
+	}
+}
@@ -0,0 +1,170 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+/*
+An AuthPath encodes as
+
+    index     4 bytes, big endian
+    height    1 byte
+    siblings  height times 32 bytes, bottom first
+
+and as hex of that.
+*/
+
+// AUTH_PATH_MAX_HEIGHT is the tallest tree an AuthPath can be for, since the
+// index is 32 bits.
+const AUTH_PATH_MAX_HEIGHT = 32
+
+var ErrInvalidAuthPath = errors.New("invalid authentication path")
+
+// AuthPath is what it takes to get from one leaf of a Merkle tree to the
+// root: the leaf's index, the height of the tree, and the sibling of every
+// node on the way up, bottom first.  Interior nodes are
+// sha256(left || right), as in compact pubkeys.
+type AuthPath struct {
+	Index    uint32
+	Height   int
+	Siblings []Block
+}
+
+// MerkleParent returns the hash of two sibling nodes, sha256(left || right).
+func MerkleParent(left, right Block) Block {
+	var buf [2 * MESSAGE_BYTES]byte
+	copy(buf[:MESSAGE_BYTES], left[:])
+	copy(buf[MESSAGE_BYTES:], right[:])
+	return sha256.Sum256(buf[:])
+}
+
+// MerkleLevels builds every level of the tree over leaves, which must be a
+// power of two in number.  levels[0] is the leaves and the last level holds
+// only the root.
+func MerkleLevels(leaves []Block) [][]Block {
+	levels := [][]Block{leaves}
+	for level := leaves; len(level) > 1; {
+		next := make([]Block, len(level)/2)
+		for i := range next {
+			next[i] = MerkleParent(level[2*i], level[2*i+1])
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return levels
+}
+
+// NewAuthPath returns the path for leaf index of the tree MerkleLevels
+// built.
+func NewAuthPath(levels [][]Block, index int) AuthPath {
+	path := AuthPath{Index: uint32(index), Height: len(levels) - 1}
+	path.Siblings = make([]Block, 0, path.Height)
+	for _, level := range levels[:path.Height] {
+		path.Siblings = append(path.Siblings, level[index^1])
+		index /= 2
+	}
+	return path
+}
+
+// Validate checks the path has Height siblings and that Index is a leaf of
+// a tree that tall.  Otherwise it's ErrInvalidAuthPath.
+func (self AuthPath) Validate() error {
+	if self.Height < 0 || self.Height > AUTH_PATH_MAX_HEIGHT {
+		return fmt.Errorf("%w: height %d, expect 0 to %d", ErrInvalidAuthPath,
+			self.Height, AUTH_PATH_MAX_HEIGHT)
+	}
+	if len(self.Siblings) != self.Height {
+		return fmt.Errorf("%w: %d siblings for height %d", ErrInvalidAuthPath,
+			len(self.Siblings), self.Height)
+	}
+	if uint64(self.Index) >= 1<<self.Height {
+		return fmt.Errorf("%w: leaf %d of %d", ErrInvalidAuthPath,
+			self.Index, uint64(1)<<self.Height)
+	}
+	return nil
+}
+
+// ComputeRoot climbs from leafHash to the root.  It doesn't check the path
+// is valid, so call Validate first on a path from outside.
+func (self AuthPath) ComputeRoot(leafHash [32]byte) [32]byte {
+	node := Block(leafHash)
+	index := self.Index
+	for _, sibling := range self.Siblings {
+		if index&1 == 0 {
+			node = MerkleParent(node, sibling)
+		} else {
+			node = MerkleParent(sibling, node)
+		}
+		index /= 2
+	}
+	return node
+}
+
+// Bytes returns the path's binary encoding.
+func (self AuthPath) Bytes() []byte {
+	b := make([]byte, 5, 5+len(self.Siblings)*MESSAGE_BYTES)
+	binary.BigEndian.PutUint32(b, self.Index)
+	b[4] = byte(self.Height)
+	for _, sibling := range self.Siblings {
+		b = append(b, sibling[:]...)
+	}
+	return b
+}
+
+// AuthPathFromBytes is the inverse of AuthPath.Bytes.  The path it returns
+// has passed Validate.
+func AuthPathFromBytes(b []byte) (AuthPath, error) {
+	if len(b) < 5 {
+		return AuthPath{}, fmt.Errorf("%w: AuthPath %d bytes, expect at least 5",
+			ErrWrongLength, len(b))
+	}
+	path := AuthPath{Index: binary.BigEndian.Uint32(b), Height: int(b[4])}
+	if expect := 5 + path.Height*MESSAGE_BYTES; len(b) != expect {
+		return AuthPath{}, fmt.Errorf("%w: AuthPath %d bytes, expect %d",
+			ErrWrongLength, len(b), expect)
+	}
+	path.Siblings = make([]Block, path.Height)
+	for i := range path.Siblings {
+		copy(path.Siblings[i][:], b[5+i*MESSAGE_BYTES:])
+	}
+	err := path.Validate()
+	if err != nil {
+		return AuthPath{}, err
+	}
+	return path, nil
+}
+
+// ToHex returns the path's binary encoding as hex.
+func (self AuthPath) ToHex() string {
+	return hex.EncodeToString(self.Bytes())
+}
+
+// HexToAuthPath is the inverse of AuthPath.ToHex.
+func HexToAuthPath(s string) (AuthPath, error) {
+	b, err := decodeHexString("AuthPath", s, len(s)+len(s)%2)
+	if err != nil {
+		return AuthPath{}, err
+	}
+	return AuthPathFromBytes(b)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the layout of
+// Bytes().
+func (self AuthPath) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  On error the
+// receiver is left untouched.
+func (self *AuthPath) UnmarshalBinary(data []byte) error {
+	path, err := AuthPathFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = path
+	return nil
+}
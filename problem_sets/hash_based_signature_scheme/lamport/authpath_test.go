@@ -0,0 +1,157 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func testLeaves(n int) []Block {
+	leaves := make([]Block, n)
+	for i := range leaves {
+		leaves[i] = sha256.Sum256([]byte(fmt.Sprint(i)))
+	}
+	return leaves
+}
+
+// TestAuthPathEveryLeaf checks every leaf of trees from 1 to 32 leaves
+// climbs to the root, and that no leaf climbs there from another index.
+func TestAuthPathEveryLeaf(t *testing.T) {
+	for height := 0; height <= 5; height++ {
+		leaves := testLeaves(1 << height)
+		levels := MerkleLevels(leaves)
+		root := levels[height][0]
+		for i, leaf := range leaves {
+			path := NewAuthPath(levels, i)
+			if err := path.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			if path.Height != height || len(path.Siblings) != height {
+				t.Fatalf("height %d leaf %d: got height %d with %d siblings",
+					height, i, path.Height, len(path.Siblings))
+			}
+			if path.ComputeRoot(leaf) != root {
+				t.Fatalf("height %d leaf %d doesn't reach the root", height, i)
+			}
+			if height > 0 {
+				path.Index ^= 1
+				if path.ComputeRoot(leaf) == root {
+					t.Fatalf("height %d leaf %d reaches the root from index %d",
+						height, i, path.Index)
+				}
+			}
+		}
+	}
+}
+
+// TestAuthPathSingleLeaf checks a tree of one leaf: its root is the leaf,
+// and the path is just index 0 with no siblings.
+func TestAuthPathSingleLeaf(t *testing.T) {
+	leaf := testLeaves(1)[0]
+	levels := MerkleLevels([]Block{leaf})
+	path := NewAuthPath(levels, 0)
+	if path.Index != 0 || path.Height != 0 || len(path.Siblings) != 0 {
+		t.Fatalf("got %+v, expect index 0 height 0 and no siblings", path)
+	}
+	if path.ComputeRoot(leaf) != leaf {
+		t.Fatalf("single leaf root isn't the leaf")
+	}
+	b := path.Bytes()
+	if len(b) != 5 {
+		t.Fatalf("encoded to %d bytes, expect 5", len(b))
+	}
+	path2, err := AuthPathFromBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path2.ComputeRoot(leaf) != leaf {
+		t.Fatalf("decoded single leaf path gives another root")
+	}
+	path.Index = 1
+	if !errors.Is(path.Validate(), ErrInvalidAuthPath) {
+		t.Fatalf("leaf 1 of a single leaf tree validated")
+	}
+}
+
+// TestAuthPathValidate checks sibling counts, indexes and heights that
+// don't fit are ErrInvalidAuthPath.
+func TestAuthPathValidate(t *testing.T) {
+	levels := MerkleLevels(testLeaves(8))
+	good := NewAuthPath(levels, 5)
+	for _, tc := range []struct {
+		name string
+		path AuthPath
+	}{
+		{"short", AuthPath{Index: 5, Height: 3, Siblings: good.Siblings[:2]}},
+		{"long", AuthPath{Index: 5, Height: 2, Siblings: good.Siblings}},
+		{"index 8", AuthPath{Index: 8, Height: 3, Siblings: good.Siblings}},
+		{"negative height", AuthPath{Height: -1}},
+		{"too tall", AuthPath{Height: AUTH_PATH_MAX_HEIGHT + 1,
+			Siblings: make([]Block, AUTH_PATH_MAX_HEIGHT+1)}},
+	} {
+		if !errors.Is(tc.path.Validate(), ErrInvalidAuthPath) {
+			t.Fatalf("%s: Validate returned %v, expect ErrInvalidAuthPath",
+				tc.name, tc.path.Validate())
+		}
+	}
+	tallest := AuthPath{Index: 0xffffffff, Height: AUTH_PATH_MAX_HEIGHT,
+		Siblings: make([]Block, AUTH_PATH_MAX_HEIGHT)}
+	if err := tallest.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestAuthPathEncoding round trips a path through binary and hex, and checks
+// truncated or inconsistent encodings are refused.
+func TestAuthPathEncoding(t *testing.T) {
+	leaves := testLeaves(16)
+	levels := MerkleLevels(leaves)
+	path := NewAuthPath(levels, 11)
+
+	path2, err := HexToAuthPath(path.ToHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var path3 AuthPath
+	data, err := path.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 5+4*MESSAGE_BYTES {
+		t.Fatalf("encoded to %d bytes, expect %d", len(data), 5+4*MESSAGE_BYTES)
+	}
+	err = path3.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []AuthPath{path2, path3} {
+		if p.Index != 11 || p.ComputeRoot(leaves[11]) != levels[4][0] {
+			t.Fatalf("round trip changed the path")
+		}
+	}
+
+	for _, n := range []int{0, 4, len(data) - 1} {
+		err = path3.UnmarshalBinary(data[:n])
+		if !errors.Is(err, ErrWrongLength) {
+			t.Fatalf("%d bytes: got %v, expect ErrWrongLength", n, err)
+		}
+	}
+	bad := append([]byte(nil), data...)
+	bad[3] = 16
+	_, err = AuthPathFromBytes(bad)
+	if !errors.Is(err, ErrInvalidAuthPath) {
+		t.Fatalf("got %v, expect ErrInvalidAuthPath", err)
+	}
+	_, err = HexToAuthPath(path.ToHex()[1:])
+	if !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("odd length hex: got %v, expect ErrWrongLength", err)
+	}
+	_, err = HexToAuthPath("zz" + path.ToHex()[2:])
+	if !errors.Is(err, ErrInvalidHex) {
+		t.Fatalf("bad hex: got %v, expect ErrInvalidHex", err)
+	}
+	if path3.Index != 11 {
+		t.Fatalf("failed UnmarshalBinary changed the path")
+	}
+}
@@ -0,0 +1,74 @@
+package lamport
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// BatchItem is one signature for VerifyBatch.  The pubkey and signature are
+// pointers so a batch of thousands doesn't copy 24KB for each one, and many
+// items can share one pubkey.
+type BatchItem struct {
+	Msg Message
+	Pub *PublicKey
+	Sig *Signature
+}
+
+var errBatchItemNil = errors.New("batch item has no pubkey or signature")
+
+// VerifyBatch checks every item on workers goroutines, or one per CPU if
+// workers is 0 or less, and returns an error for each item in order: nil
+// for a good signature, ErrInvalidSignature for a bad one and ErrZeroKey for
+// an all zero pubkey.
+func VerifyBatch(items []BatchItem, workers int) []error {
+	return VerifyBatchContext(context.Background(), items, workers)
+}
+
+// VerifyBatchContext is VerifyBatch, stopping once ctx is done.  Items that
+// weren't checked by then get ctx.Err().
+func VerifyBatchContext(ctx context.Context, items []BatchItem, workers int) []error {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
+	results := make([]error, len(items))
+	var next int64 = -1
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(items) {
+					return
+				}
+				if err := ctx.Err(); err != nil {
+					results[i] = err
+					continue
+				}
+				results[i] = verifyItem(&items[i])
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func verifyItem(item *BatchItem) error {
+	if item.Pub == nil || item.Sig == nil {
+		return errBatchItemNil
+	}
+	if VerifyPtr(item.Msg, item.Pub, item.Sig) {
+		return nil
+	}
+	if item.Pub.IsZero() {
+		return ErrZeroKey
+	}
+	return ErrInvalidSignature
+}
@@ -0,0 +1,114 @@
+package lamport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// batchItems signs n messages with keys keys, round robin, and breaks
+// every seventh signature.
+func batchItems(t testing.TB, n, keys int) []BatchItem {
+	pris := make([]PrivateKey, keys)
+	pubs := make([]PublicKey, keys)
+	for i := range pris {
+		var err error
+		pris[i], pubs[i], err = GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	items := make([]BatchItem, n)
+	for i := range items {
+		msg := GetMessageFromString(fmt.Sprint("batch ", i))
+		sig := mustSign(t, msg, pris[i%keys])
+		if i%7 == 3 {
+			sig.Preimage[i%MESSAGE_BITS][0] ^= 1
+		}
+		items[i] = BatchItem{Msg: msg, Pub: &pubs[i%keys], Sig: &sig}
+	}
+	return items
+}
+
+// TestVerifyBatch checks the results are in order and agree with Verify,
+// for several worker counts.
+func TestVerifyBatch(t *testing.T) {
+	items := batchItems(t, 50, 3)
+	for _, workers := range []int{0, 1, 4, 100} {
+		results := VerifyBatch(items, workers)
+		if len(results) != len(items) {
+			t.Fatalf("%d workers: got %d results for %d items",
+				workers, len(results), len(items))
+		}
+		for i, err := range results {
+			if i%7 == 3 {
+				if !errors.Is(err, ErrInvalidSignature) {
+					t.Fatalf("%d workers, item %d: got %v, expect ErrInvalidSignature",
+						workers, i, err)
+				}
+			} else if err != nil {
+				t.Fatalf("%d workers, item %d: %v", workers, i, err)
+			}
+		}
+	}
+
+	var zero PublicKey
+	odd := []BatchItem{{Pub: &zero, Sig: items[0].Sig}, {Sig: items[0].Sig}}
+	results := VerifyBatch(odd, 2)
+	if !errors.Is(results[0], ErrZeroKey) || results[1] == nil {
+		t.Fatalf("got %v, expect ErrZeroKey and an error", results)
+	}
+	if len(VerifyBatch(nil, 4)) != 0 {
+		t.Fatalf("empty batch gave results")
+	}
+}
+
+// TestVerifyBatchCanceled checks a canceled context leaves every item with
+// the context's error.
+func TestVerifyBatchCanceled(t *testing.T) {
+	items := batchItems(t, 20, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i, err := range VerifyBatchContext(ctx, items, 4) {
+		if err != context.Canceled {
+			t.Fatalf("item %d: got %v, expect context.Canceled", i, err)
+		}
+	}
+}
+
+// TestVerifyBatchAllocs checks the allocations don't grow with the batch,
+// so no item is copied.
+func TestVerifyBatchAllocs(t *testing.T) {
+	small := batchItems(t, 8, 1)
+	large := batchItems(t, 256, 1)
+	allocs := func(items []BatchItem) float64 {
+		return testing.AllocsPerRun(5, func() { VerifyBatch(items, 4) })
+	}
+	if a, b := allocs(small), allocs(large); b > a+2 {
+		t.Fatalf("%.0f allocations for 8 items but %.0f for 256", a, b)
+	}
+}
+
+func BenchmarkVerifySerial(b *testing.B) {
+	items := batchItems(b, 1024, 4)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range items {
+			VerifyPtr(items[j].Msg, items[j].Pub, items[j].Sig)
+		}
+	}
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	items := batchItems(b, 1024, 4)
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprint(workers, "workers"), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				VerifyBatch(items, workers)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package lamport
+
+// BitOrder is the order in which an implementation walks the bits within
+// each byte of the message when deciding which block goes where.  Everything
+// in this package is BigEndian: block i goes with bit
+//
+//	(msg[i/8]>>(7-(i%8)))&0x01
+//
+// An LSB-first (LittleEndian) implementation instead pairs block i with
+//
+//	(msg[i/8]>>(i%8))&0x01
+//
+// so its keys and signatures have the blocks of each group of 8 reversed.
+type BitOrder int
+
+const (
+	BigEndian BitOrder = iota
+	LittleEndian
+)
+
+// HexToPubkeyOrder is HexToPubkey for a pubkey encoded with the given bit
+// order.  The returned key is always in this package's big endian order, so
+// it works with Verify as usual.
+func HexToPubkeyOrder(s string, order BitOrder) (PublicKey, error) {
+	p, err := HexToPubkey(s)
+	if err != nil {
+		return p, err
+	}
+	if order == LittleEndian {
+		reverseBitOrder(p.ZeroHash[:])
+		reverseBitOrder(p.OneHash[:])
+	}
+	return p, nil
+}
+
+// HexToSignatureOrder is HexToSignature for a signature encoded with the
+// given bit order.
+func HexToSignatureOrder(s string, order BitOrder) (Signature, error) {
+	sig, err := HexToSignature(s)
+	if err != nil {
+		return sig, err
+	}
+	if order == LittleEndian {
+		reverseBitOrder(sig.Preimage[:])
+	}
+	return sig, nil
+}
+
+// ToHexOrder encodes the pubkey as hex for an implementation using the given
+// bit order.  ToHexOrder(BigEndian) is the same as ToHex.
+func (self PublicKey) ToHexOrder(order BitOrder) string {
+	if order == LittleEndian {
+		reverseBitOrder(self.ZeroHash[:])
+		reverseBitOrder(self.OneHash[:])
+	}
+	return self.ToHex()
+}
+
+// ToHexOrder encodes the signature as hex for an implementation using the
+// given bit order.
+func (self Signature) ToHexOrder(order BitOrder) string {
+	if order == LittleEndian {
+		reverseBitOrder(self.Preimage[:])
+	}
+	return self.ToHex()
+}
+
+// reverseBitOrder reverses each group of 8 blocks in place, which converts
+// between big and little endian bit order in either direction.
+func reverseBitOrder(blocks []Block) {
+	for i := 0; i < len(blocks); i += 8 {
+		for j := 0; j < 4; j++ {
+			blocks[i+j], blocks[i+7-j] = blocks[i+7-j], blocks[i+j]
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// TestLittleEndianVerify re-encodes a key and signature LSB-first, decodes
+// them with the LittleEndian option, and checks Verify still passes.
+func TestLittleEndianVerify(t *testing.T) {
+	msg := GetMessageFromString("lsb first")
+
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := mustSign(t, msg, sec)
+
+	pubHex := pub.ToHexOrder(LittleEndian)
+	sigHex := sig.ToHexOrder(LittleEndian)
+	if pubHex == pub.ToHex() || sigHex == sig.ToHex() {
+		t.Fatalf("little endian hex same as big endian hex")
+	}
+
+	pub2, err := HexToPubkeyOrder(pubHex, LittleEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig2, err := HexToSignatureOrder(sigHex, LittleEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub || sig2 != sig {
+		t.Fatalf("little endian round trip changed key or signature")
+	}
+	if !Verify(msg, pub2, sig2) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+
+	// decoding little endian material the default way fails verification
+	pub3, err := HexToPubkey(pubHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig3, err := HexToSignature(sigHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Verify(msg, pub3, sig3) {
+		t.Fatalf("Verify on mismatched bit order returned true, expected false")
+	}
+}
+
+// TestBigEndianOrderDefault makes sure the BigEndian option changes nothing.
+func TestBigEndianOrderDefault(t *testing.T) {
+	pub, err := HexToPubkeyOrder(hexPubkey1, BigEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub.ToHexOrder(BigEndian) != hexPubkey1 {
+		t.Fatalf("big endian pubkey hex doesn't match hexPubkey1")
+	}
+	sig, err := HexToSignatureOrder(hexSignature2, BigEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(GetMessageFromString("2"), pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+}
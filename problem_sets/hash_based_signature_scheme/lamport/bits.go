@@ -0,0 +1,44 @@
+package lamport
+
+// This is the one place the bit order lives.  Bit i of a message is
+//
+//	msg[i/8]>>(7-(i%8))&0x01
+//
+// so bit 0 is the most significant bit of the first byte, and block i of a
+// key or signature goes with bit i.  See BitOrder for the other way round.
+
+// BitAt returns bit i of b, 0 or 1, in the order messages use.  The coverage
+// bitmaps in RevealedKey and the variable length messages of Params are
+// indexed the same way.
+func BitAt(b []byte, i int) byte {
+	return b[i/8] >> (7 - (i % 8)) & 0x01
+}
+
+// SetBitAt sets bit i of b to the low bit of v.
+func SetBitAt(b []byte, i int, v byte) {
+	mask := byte(0x01) << (7 - (i % 8))
+	if v&0x01 == 1 {
+		b[i/8] |= mask
+	} else {
+		b[i/8] &^= mask
+	}
+}
+
+// Bit returns bit i of the message, which picks the row of block i.
+func (self Message) Bit(i int) byte {
+	return BitAt(self[:], i)
+}
+
+// SetBit sets bit i of the message to the low bit of v.
+func (self *Message) SetBit(i int, v byte) {
+	SetBitAt(self[:], i, v)
+}
+
+// Bits returns every bit of the message in block order, one per byte.
+func (self Message) Bits() [MESSAGE_BITS]byte {
+	var bits [MESSAGE_BITS]byte
+	for i := range bits {
+		bits[i] = self.Bit(i)
+	}
+	return bits
+}
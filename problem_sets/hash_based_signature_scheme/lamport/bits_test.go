@@ -0,0 +1,73 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// TestMessageBits sets each of the 256 bits to each value in turn and checks
+// exactly that bit changes, in the documented position.
+func TestMessageBits(t *testing.T) {
+	for i := 0; i < MESSAGE_BITS; i++ {
+		var msg Message
+		msg.SetBit(i, 1)
+		if msg.Bit(i) != 1 {
+			t.Fatalf("bit %d: Bit returned 0 after SetBit 1", i)
+		}
+		var expect Message
+		expect[i/8] = 0x80 >> (i % 8)
+		if msg != expect {
+			t.Fatalf("bit %d: got %x, expect %x", i, msg, expect)
+		}
+		bits := msg.Bits()
+		for j, b := range bits {
+			if (j == i) != (b == 1) {
+				t.Fatalf("bit %d: Bits()[%d] is %d", i, j, b)
+			}
+		}
+
+		for j := range msg {
+			msg[j] = 0xff
+		}
+		msg.SetBit(i, 0)
+		if msg.Bit(i) != 0 {
+			t.Fatalf("bit %d: Bit returned 1 after SetBit 0", i)
+		}
+		for j := range expect {
+			expect[j] = ^expect[j]
+		}
+		if msg != expect {
+			t.Fatalf("bit %d: got %x, expect %x", i, msg, expect)
+		}
+		// only the low bit of v counts
+		msg.SetBit(i, 2)
+		if msg.Bit(i) != 0 {
+			t.Fatalf("bit %d: SetBit 2 set the bit", i)
+		}
+		msg.SetBit(i, 3)
+		if msg.Bit(i) != 1 {
+			t.Fatalf("bit %d: SetBit 3 didn't set the bit", i)
+		}
+	}
+}
+
+// TestBitsMatchSign checks Sign picks block i by Bit(i).
+func TestBitsMatchSign(t *testing.T) {
+	pri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("bits")
+	sig := mustSign(t, msg, pri)
+	for i, bit := range msg.Bits() {
+		expect := pri.ZeroHash[i]
+		if bit == 1 {
+			expect = pri.OneHash[i]
+		}
+		if sig.Preimage[i] != expect {
+			t.Fatalf("block %d isn't from row %d", i, bit)
+		}
+		if BitAt(msg[:], i) != bit {
+			t.Fatalf("BitAt and Bit disagree at %d", i)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package lamport
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestBlockFromByteSliceStrict feeds 31, 32 and 33 byte slices.
+func TestBlockFromByteSliceStrict(t *testing.T) {
+	in := bytes.Repeat([]byte{0xab}, 33)
+
+	_, err := BlockFromByteSliceStrict(in[:31])
+	if err == nil {
+		t.Fatalf("31 byte slice made a block without error")
+	}
+	_, err = BlockFromByteSliceStrict(in)
+	if err == nil {
+		t.Fatalf("33 byte slice made a block without error")
+	}
+	_, err = BlockFromByteSliceStrict(nil)
+	if err == nil {
+		t.Fatalf("nil slice made a block without error")
+	}
+
+	bl, err := BlockFromByteSliceStrict(in[:32])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(bl[:], in[:32]) {
+		t.Fatalf("block doesn't hold the slice's bytes")
+	}
+}
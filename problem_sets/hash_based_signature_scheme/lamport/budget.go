@@ -0,0 +1,116 @@
+package lamport
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrBudgetExceeded is matched by every BudgetError.
+var ErrBudgetExceeded = errors.New("signing would leave the key too easy to forge")
+
+// BudgetError is what BudgetSigner.Sign returns for a message it refuses:
+// signing it would bring the difficulty down to Projected, below Min.
+type BudgetError struct {
+	Projected int
+	Min       int
+}
+
+func (self *BudgetError) Error() string {
+	return fmt.Sprintf("%v: difficulty would be %d, minimum %d",
+		ErrBudgetExceeded, self.Projected, self.Min)
+}
+
+// Is matches ErrBudgetExceeded.
+func (self *BudgetError) Is(target error) bool {
+	return target == ErrBudgetExceeded
+}
+
+// BudgetSigner signs with one key more than once, on purpose, for as long
+// as what it has revealed keeps forgery hard enough.  It tracks the blocks
+// its signatures have given away in a RevealedKey, and before each
+// signature works out the difficulty afterwards, the number of positions
+// with only one row known; a forger needs about 1<<difficulty tries.  A
+// message that would bring that below the minimum is refused.  The first
+// signature always leaves 256, and each later one roughly halves it, so a
+// minimum of 100 allows two signatures on random messages and 30 about four.
+//
+// This is strictly weaker than a one-time Signer, since difficulty only
+// counts against a forger hashing random messages.  It's safe to call from
+// several goroutines.
+type BudgetSigner struct {
+	mu  sync.Mutex
+	pri PrivateKey
+	pub PublicKey
+	rk  RevealedKey
+	min int
+}
+
+// NewBudgetSigner returns a BudgetSigner for pri that refuses any signature
+// that would leave difficulty below minDifficulty.
+func NewBudgetSigner(pri PrivateKey, minDifficulty int) *BudgetSigner {
+	return &BudgetSigner{pri: pri, pub: pri.GetPublicKey(), min: minDifficulty}
+}
+
+// Sign signs msg, unless that would leave the difficulty below the minimum,
+// in which case it's a *BudgetError with the projected difficulty.  Signing
+// a message again reveals nothing new, so it's always allowed once it was
+// allowed the first time.  A wiped key is ErrZeroKey.
+func (self *BudgetSigner) Sign(msg Message) (Signature, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if self.pri.IsZero() {
+		return Signature{}, ErrZeroKey
+	}
+	projected := self.rk.DifficultyAfter(msg)
+	if projected < self.min {
+		return Signature{}, &BudgetError{Projected: projected, Min: self.min}
+	}
+	sig, err := Sign(msg, self.pri)
+	if err != nil {
+		return Signature{}, err
+	}
+	err = self.rk.Add(self.pub, msg, sig)
+	if err != nil {
+		return Signature{}, err
+	}
+	return sig, nil
+}
+
+// Difficulty returns the current difficulty, MESSAGE_BITS before anything
+// is signed.
+func (self *BudgetSigner) Difficulty() int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if self.rk.Len() == 0 {
+		return MESSAGE_BITS
+	}
+	return self.rk.Difficulty()
+}
+
+// DifficultyAfter returns what the difficulty would be after signing msg.
+func (self *BudgetSigner) DifficultyAfter(msg Message) int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.rk.DifficultyAfter(msg)
+}
+
+// Len returns the number of signatures made.
+func (self *BudgetSigner) Len() int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.rk.Len()
+}
+
+// PublicKey returns the signer's pubkey.
+func (self *BudgetSigner) PublicKey() PublicKey {
+	return self.pub
+}
+
+// Zeroize wipes the private key and the revealed blocks.
+func (self *BudgetSigner) Zeroize() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.pri.Zeroize()
+	self.rk.pri.Zeroize()
+}
@@ -0,0 +1,108 @@
+package lamport
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestBudgetSigner signs messages until the signer refuses, checking every
+// difficulty it projects against a SignatureSet holding the same
+// signatures.
+func TestBudgetSigner(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const min = 30
+	bs := NewBudgetSigner(pri, min)
+	set := NewSignatureSet(pub)
+	if bs.Difficulty() != MESSAGE_BITS {
+		t.Fatalf("fresh difficulty %d, expect %d", bs.Difficulty(), MESSAGE_BITS)
+	}
+
+	for i := 0; ; i++ {
+		msg := GetMessageFromString(fmt.Sprintf("budget %d", i))
+		projected := bs.DifficultyAfter(msg)
+		sig, err := bs.Sign(msg)
+		if err != nil {
+			var budgetErr *BudgetError
+			if !errors.Is(err, ErrBudgetExceeded) || !errors.As(err, &budgetErr) {
+				t.Fatalf("signature %d: got %v, expect ErrBudgetExceeded", i, err)
+			}
+			if budgetErr.Projected != projected || budgetErr.Min != min || projected >= min {
+				t.Fatalf("refused with %+v, projected %d", budgetErr, projected)
+			}
+			// signing it anyway lands exactly where the signer said
+			if err := set.Add(msg, mustSign(t, msg, pri)); err != nil {
+				t.Fatal(err)
+			}
+			if set.Difficulty() != projected {
+				t.Fatalf("difficulty %d after the refused message, projected %d",
+					set.Difficulty(), projected)
+			}
+			break
+		}
+		if !Verify(msg, pub, sig) {
+			t.Fatalf("Verify returned false, expected true")
+		}
+		if err := set.Add(msg, sig); err != nil {
+			t.Fatal(err)
+		}
+		if set.Difficulty() != projected || bs.Difficulty() != projected {
+			t.Fatalf("signature %d: difficulty %d and %d, projected %d",
+				i, set.Difficulty(), bs.Difficulty(), projected)
+		}
+		if i == 0 && projected != MESSAGE_BITS {
+			t.Fatalf("first signature projected %d, expect %d", projected, MESSAGE_BITS)
+		}
+		if i > 20 {
+			t.Fatalf("still signing after %d messages", i)
+		}
+	}
+	if bs.Len() < 2 {
+		t.Fatalf("refused after %d signatures, expect at least 2", bs.Len())
+	}
+
+	// a message already signed reveals nothing new
+	msg, _ := set.At(0)
+	if _, err := bs.Sign(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	bs.Zeroize()
+	if _, err := bs.Sign(msg); !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("got %v, expect ErrZeroKey", err)
+	}
+}
+
+// TestDifficultyAfterComplement checks a message and its complement leave
+// nothing to guess.
+func TestDifficultyAfterComplement(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("complement")
+	var rk RevealedKey
+	if err := rk.Add(pub, msg, mustSign(t, msg, pri)); err != nil {
+		t.Fatal(err)
+	}
+	var inv Message
+	for i := range msg {
+		inv[i] = ^msg[i]
+	}
+	if got := rk.DifficultyAfter(inv); got != 0 {
+		t.Fatalf("DifficultyAfter complement %d, expect 0", got)
+	}
+	if got := rk.DifficultyAfter(msg); got != rk.Difficulty() {
+		t.Fatalf("DifficultyAfter same message %d, expect %d", got, rk.Difficulty())
+	}
+	bs := NewBudgetSigner(pri, 1)
+	if _, err := bs.Sign(msg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bs.Sign(inv); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("got %v, expect ErrBudgetExceeded", err)
+	}
+}
@@ -0,0 +1,113 @@
+package lamport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+Assignment bundles: a pubkey, the signatures made with it, and the message
+strings they sign, all in one gzip compressed file.  Inside the gzip stream
+is a CONTAINER_BUNDLE container whose payload is
+
+    pubkey   PUBKEY_BYTES
+    count    2 bytes, big endian
+    count times:
+        length     4 bytes, big endian
+        message    length bytes
+        signature  SIGNATURE_BYTES
+*/
+
+var (
+	ErrBundleCorrupt      = errors.New("bundle: corrupt")
+	ErrBundleInconsistent = errors.New("bundle: inconsistent")
+)
+
+// WriteBundle writes pub, sigs and msgs to w as a gzip compressed bundle.
+// sigs[i] is the signature on GetMessageFromString(msgs[i]).
+func WriteBundle(w io.Writer, pub PublicKey, sigs []Signature, msgs []string) error {
+	if len(sigs) != len(msgs) {
+		return fmt.Errorf("%d signatures but %d messages", len(sigs), len(msgs))
+	}
+	if len(sigs) > 0xffff {
+		return fmt.Errorf("%d signatures, at most %d fit in a bundle", len(sigs), 0xffff)
+	}
+
+	payload := bytes.NewBuffer(pub.Bytes())
+	binary.Write(payload, binary.BigEndian, uint16(len(sigs)))
+	for i := range sigs {
+		binary.Write(payload, binary.BigEndian, uint32(len(msgs[i])))
+		payload.WriteString(msgs[i])
+		payload.Write(sigs[i].Bytes())
+	}
+
+	zw := gzip.NewWriter(w)
+	err := WriteContainer(zw, CONTAINER_BUNDLE, payload.Bytes())
+	if err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// ReadBundle reads a bundle from WriteBundle.  A damaged gzip stream or a
+// malformed container is ErrBundleCorrupt; a bundle that decodes fine but has
+// a signature that doesn't verify its message is ErrBundleInconsistent.
+func ReadBundle(r io.Reader) (PublicKey, []Signature, []string, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return PublicKey{}, nil, nil, fmt.Errorf("%w: %v", ErrBundleCorrupt, err)
+	}
+	c, err := ReadContainer(zr)
+	if err != nil {
+		return PublicKey{}, nil, nil, fmt.Errorf("%w: %v", ErrBundleCorrupt, err)
+	}
+	if c.Type != CONTAINER_BUNDLE {
+		return PublicKey{}, nil, nil, fmt.Errorf("%w: %v", ErrBundleCorrupt,
+			ContainerTypeError{Type: c.Type, Expect: CONTAINER_BUNDLE})
+	}
+
+	buf := bytes.NewBuffer(c.Payload)
+	truncated := fmt.Errorf("%w: payload truncated", ErrBundleCorrupt)
+
+	if buf.Len() < PUBKEY_BYTES+2 {
+		return PublicKey{}, nil, nil, truncated
+	}
+	pub, err := PubkeyFromBytes(buf.Next(PUBKEY_BYTES))
+	if err != nil {
+		return PublicKey{}, nil, nil, err
+	}
+	count := int(binary.BigEndian.Uint16(buf.Next(2)))
+
+	var sigs []Signature
+	var msgs []string
+	for i := 0; i < count; i++ {
+		if buf.Len() < 4 {
+			return PublicKey{}, nil, nil, truncated
+		}
+		length := int(binary.BigEndian.Uint32(buf.Next(4)))
+		if buf.Len() < length+SIGNATURE_BYTES {
+			return PublicKey{}, nil, nil, truncated
+		}
+		msg := string(buf.Next(length))
+		sig, err := SignatureFromBytes(buf.Next(SIGNATURE_BYTES))
+		if err != nil {
+			return PublicKey{}, nil, nil, err
+		}
+		if !Verify(GetMessageFromString(msg), pub, sig) {
+			return PublicKey{}, nil, nil, fmt.Errorf(
+				"%w: signature %d doesn't verify message %q",
+				ErrBundleInconsistent, i+1, msg)
+		}
+		sigs = append(sigs, sig)
+		msgs = append(msgs, msg)
+	}
+	if buf.Len() != 0 {
+		return PublicKey{}, nil, nil, fmt.Errorf("%w: %d trailing bytes",
+			ErrBundleCorrupt, buf.Len())
+	}
+	return pub, sigs, msgs, nil
+}
@@ -0,0 +1,97 @@
+package lamport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+)
+
+// writeDefaultBundle writes the provided assignment as a bundle.
+func writeDefaultBundle(t *testing.T) []byte {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sigs []Signature
+	for _, s := range []string{hexSignature1, hexSignature2, hexSignature3, hexSignature4} {
+		sig, err := HexToSignature(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigs = append(sigs, sig)
+	}
+	var buf bytes.Buffer
+	err = WriteBundle(&buf, pub, sigs, []string{"1", "2", "3", "4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// TestBundleRoundTrip writes the provided assignment as a bundle and reads it
+// back.
+func TestBundleRoundTrip(t *testing.T) {
+	data := writeDefaultBundle(t)
+
+	pub, sigs, msgs, err := ReadBundle(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub.ToHex() != hexPubkey1 || len(sigs) != 4 || sigs[3].ToHex() != hexSignature4 {
+		t.Fatalf("bundle round trip changed pubkey or signatures")
+	}
+	if len(msgs) != 4 || msgs[2] != "3" {
+		t.Fatalf("bundle round trip changed messages: %q", msgs)
+	}
+}
+
+// TestBundleErrors checks corrupt gzip streams and inconsistent bundles get
+// different errors.
+func TestBundleErrors(t *testing.T) {
+	data := writeDefaultBundle(t)
+
+	// the gzip trailer holds a CRC32 of the contents
+	corrupt := append([]byte{}, data...)
+	corrupt[len(corrupt)-6] ^= 0xff
+	_, _, _, err := ReadBundle(bytes.NewReader(corrupt))
+	if !errors.Is(err, ErrBundleCorrupt) {
+		t.Fatalf("got %v, expect ErrBundleCorrupt", err)
+	}
+	_, _, _, err = ReadBundle(bytes.NewReader(data[:len(data)/2]))
+	if !errors.Is(err, ErrBundleCorrupt) {
+		t.Fatalf("got %v, expect ErrBundleCorrupt", err)
+	}
+	_, _, _, err = ReadBundle(bytes.NewReader([]byte("not gzip")))
+	if !errors.Is(err, ErrBundleCorrupt) {
+		t.Fatalf("got %v, expect ErrBundleCorrupt", err)
+	}
+
+	// a well formed bundle where the messages are in the wrong order
+	pub, sigs, _, err := ReadBundle(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = WriteBundle(&buf, pub, sigs, []string{"2", "1", "3", "4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, err = ReadBundle(&buf)
+	if !errors.Is(err, ErrBundleInconsistent) {
+		t.Fatalf("got %v, expect ErrBundleInconsistent", err)
+	}
+
+	// a gzip stream holding something that isn't a bundle
+	buf.Reset()
+	zw := gzip.NewWriter(&buf)
+	err = WriteContainer(zw, CONTAINER_SIGNATURE, sigs[0].Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw.Close()
+	_, _, _, err = ReadBundle(&buf)
+	if !errors.Is(err, ErrBundleCorrupt) {
+		t.Fatalf("got %v, expect ErrBundleCorrupt", err)
+	}
+}
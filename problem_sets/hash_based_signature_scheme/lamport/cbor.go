@@ -0,0 +1,277 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+CBOR encoding, for verifiers on small devices that already speak CBOR
+(RFC 8949).  Each type is encoded as a map with integer keys:
+
+    { 0: version (unsigned int), 1: payload (byte string) }
+
+where the payload is the same canonical binary encoding as Bytes(): blocks
+in row 0 then row 1 order for pubkeys, blocks in sequence for signatures, and
+the 32 raw bytes for messages.  Decoders skip map keys they don't know about,
+so fields can be added later without breaking old readers, but the payload
+must be exactly the right length.
+
+Only the subset of CBOR needed here is implemented: definite length items,
+no tags or floats in the parts we read.
+*/
+
+const CBOR_VERSION = 1
+
+// map keys
+const cborKeyVersion = 0
+const cborKeyPayload = 1
+
+// CBOR major types
+const (
+	cborUint   = 0
+	cborNegInt = 1
+	cborBytes  = 2
+	cborText   = 3
+	cborArray  = 4
+	cborMap    = 5
+	cborTag    = 6
+	cborSimple = 7
+)
+
+var errCBORTruncated = errors.New("cbor: truncated input")
+
+// MarshalCBOR encodes the pubkey as a versioned CBOR map.
+func (self PublicKey) MarshalCBOR() ([]byte, error) {
+	return cborEncode(self.Bytes()), nil
+}
+
+// UnmarshalCBOR decodes a pubkey from MarshalCBOR.
+func (self *PublicKey) UnmarshalCBOR(data []byte) error {
+	payload, err := cborDecode(data, PUBKEY_BYTES)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(payload)
+}
+
+// MarshalCBOR encodes the signature as a versioned CBOR map.
+func (self Signature) MarshalCBOR() ([]byte, error) {
+	return cborEncode(self.Bytes()), nil
+}
+
+// UnmarshalCBOR decodes a signature from MarshalCBOR.
+func (self *Signature) UnmarshalCBOR(data []byte) error {
+	payload, err := cborDecode(data, SIGNATURE_BYTES)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(payload)
+}
+
+// MarshalCBOR encodes the message hash as a versioned CBOR map.
+func (self Message) MarshalCBOR() ([]byte, error) {
+	return cborEncode(self[:]), nil
+}
+
+// UnmarshalCBOR decodes a message hash from MarshalCBOR.
+func (self *Message) UnmarshalCBOR(data []byte) error {
+	payload, err := cborDecode(data, MESSAGE_BYTES)
+	if err != nil {
+		return err
+	}
+	copy(self[:], payload)
+	return nil
+}
+
+// cborEncode builds the {version, payload} map.
+func cborEncode(payload []byte) []byte {
+	var buf bytes.Buffer
+	cborWriteHead(&buf, cborMap, 2)
+	cborWriteHead(&buf, cborUint, cborKeyVersion)
+	cborWriteHead(&buf, cborUint, CBOR_VERSION)
+	cborWriteHead(&buf, cborUint, cborKeyPayload)
+	cborWriteHead(&buf, cborBytes, uint64(len(payload)))
+	buf.Write(payload)
+	return buf.Bytes()
+}
+
+// cborDecode reads the {version, payload} map and returns the payload, which
+// must be exactly size bytes long.
+func cborDecode(data []byte, size int) ([]byte, error) {
+	r := bytes.NewReader(data)
+	major, n, err := cborReadHead(r)
+	if err != nil {
+		return nil, err
+	}
+	if major != cborMap {
+		return nil, fmt.Errorf("cbor: major type %d, expect map", major)
+	}
+
+	var payload []byte
+	gotVersion := false
+	for i := uint64(0); i < n; i++ {
+		major, key, err := cborReadHead(r)
+		if err != nil {
+			return nil, err
+		}
+		if major != cborUint {
+			// not one of ours; skip the key and its value
+			err = cborSkipArg(r, major, key)
+			if err != nil {
+				return nil, err
+			}
+			err = cborSkip(r)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		switch key {
+		case cborKeyVersion:
+			major, v, err := cborReadHead(r)
+			if err != nil {
+				return nil, err
+			}
+			if major != cborUint || v != CBOR_VERSION {
+				return nil, fmt.Errorf("cbor: unknown version %d", v)
+			}
+			gotVersion = true
+		case cborKeyPayload:
+			major, length, err := cborReadHead(r)
+			if err != nil {
+				return nil, err
+			}
+			if major != cborBytes {
+				return nil, fmt.Errorf("cbor: payload major type %d, expect bytes", major)
+			}
+			if length != uint64(size) {
+				return nil, fmt.Errorf("cbor: %w: payload %d bytes, expect %d",
+					ErrWrongLength, length, size)
+			}
+			payload = make([]byte, size)
+			_, err = io.ReadFull(r, payload)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			err = cborSkip(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !gotVersion {
+		return nil, errors.New("cbor: missing version")
+	}
+	if payload == nil {
+		return nil, errors.New("cbor: missing payload")
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("cbor: %d trailing bytes", r.Len())
+	}
+	return payload, nil
+}
+
+// cborWriteHead writes the initial byte(s) of an item with the shortest
+// encoding of arg, as the canonical form requires.
+func cborWriteHead(buf *bytes.Buffer, major byte, arg uint64) {
+	m := major << 5
+	switch {
+	case arg < 24:
+		buf.WriteByte(m | byte(arg))
+	case arg <= 0xff:
+		buf.WriteByte(m | 24)
+		buf.WriteByte(byte(arg))
+	case arg <= 0xffff:
+		buf.WriteByte(m | 25)
+		binary.Write(buf, binary.BigEndian, uint16(arg))
+	case arg <= 0xffffffff:
+		buf.WriteByte(m | 26)
+		binary.Write(buf, binary.BigEndian, uint32(arg))
+	default:
+		buf.WriteByte(m | 27)
+		binary.Write(buf, binary.BigEndian, arg)
+	}
+}
+
+// cborReadHead reads the initial byte(s) of an item and returns the major
+// type and argument.  Indefinite lengths are not supported.
+func cborReadHead(r *bytes.Reader) (byte, uint64, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, 0, errCBORTruncated
+	}
+	major := b >> 5
+	info := b & 0x1f
+
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, fmt.Errorf("cbor: unsupported additional info %d", info)
+	}
+
+	var arg uint64
+	for i := 0; i < size; i++ {
+		b, err = r.ReadByte()
+		if err != nil {
+			return 0, 0, errCBORTruncated
+		}
+		arg = arg<<8 | uint64(b)
+	}
+	return major, arg, nil
+}
+
+// cborSkip reads and discards one complete item.
+func cborSkip(r *bytes.Reader) error {
+	major, arg, err := cborReadHead(r)
+	if err != nil {
+		return err
+	}
+	return cborSkipArg(r, major, arg)
+}
+
+// cborSkipArg discards the rest of an item whose head has already been read.
+func cborSkipArg(r *bytes.Reader, major byte, arg uint64) error {
+	switch major {
+	case cborUint, cborNegInt, cborSimple:
+		return nil
+	case cborBytes, cborText:
+		if arg > uint64(r.Len()) {
+			return errCBORTruncated
+		}
+		_, err := r.Seek(int64(arg), io.SeekCurrent)
+		return err
+	case cborArray:
+		for i := uint64(0); i < arg; i++ {
+			err := cborSkip(r)
+			if err != nil {
+				return err
+			}
+		}
+	case cborMap:
+		for i := uint64(0); i < 2*arg; i++ {
+			err := cborSkip(r)
+			if err != nil {
+				return err
+			}
+		}
+	case cborTag:
+		return cborSkip(r)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// TestCBORMessageVector pins the encoding of the hash of "test".
+func TestCBORMessageVector(t *testing.T) {
+	msg := GetMessageFromString("test")
+	data, err := msg.MarshalCBOR()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// map(2) { 0: 1, 1: bytes(32) ... }
+	expected := "a20001015820" +
+		"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	if hex.EncodeToString(data) != expected {
+		t.Fatalf("CBOR message\n%x\nexpect\n%s", data, expected)
+	}
+
+	var msg2 Message
+	err = msg2.UnmarshalCBOR(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg2 != msg {
+		t.Fatalf("message changed after CBOR round trip")
+	}
+}
+
+// TestCBORPubkeySignature round trips the provided pubkey and signature, and
+// checks the header of the pubkey encoding.
+func TestCBORPubkeySignature(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := pub.MarshalCBOR()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 16384 byte string uses a 2 byte length: 0x59 0x4000
+	header, _ := hex.DecodeString("a2000101594000")
+	if !bytes.HasPrefix(data, header) || len(data) != len(header)+PUBKEY_BYTES {
+		t.Fatalf("CBOR pubkey header %x, expect %x", data[:len(header)], header)
+	}
+	var pub2 PublicKey
+	err = pub2.UnmarshalCBOR(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("pubkey changed after CBOR round trip")
+	}
+
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = sig.MarshalCBOR()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig2 Signature
+	err = sig2.UnmarshalCBOR(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig2 != sig {
+		t.Fatalf("signature changed after CBOR round trip")
+	}
+}
+
+// TestCBORUnknownKeys builds a map with extra entries, which should be ignored.
+func TestCBORUnknownKeys(t *testing.T) {
+	msg := GetMessageFromString("forward")
+
+	var buf bytes.Buffer
+	cborWriteHead(&buf, cborMap, 4)
+	cborWriteHead(&buf, cborUint, 7) // unknown int key, array value
+	cborWriteHead(&buf, cborArray, 2)
+	cborWriteHead(&buf, cborUint, 1)
+	cborWriteHead(&buf, cborText, 2)
+	buf.WriteString("hi")
+	cborWriteHead(&buf, cborUint, cborKeyPayload)
+	cborWriteHead(&buf, cborBytes, MESSAGE_BYTES)
+	buf.Write(msg[:])
+	cborWriteHead(&buf, cborText, 4) // unknown text key
+	buf.WriteString("note")
+	cborWriteHead(&buf, cborBytes, 3)
+	buf.WriteString("abc")
+	cborWriteHead(&buf, cborUint, cborKeyVersion)
+	cborWriteHead(&buf, cborUint, CBOR_VERSION)
+
+	var msg2 Message
+	err := msg2.UnmarshalCBOR(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg2 != msg {
+		t.Fatalf("message changed with unknown CBOR keys")
+	}
+}
+
+// TestCBORRejects checks wrong length payloads, bad versions and truncation.
+func TestCBORRejects(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := sig.MarshalCBOR()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a signature isn't a pubkey
+	var pub PublicKey
+	err = pub.UnmarshalCBOR(data)
+	if err == nil {
+		t.Fatalf("signature CBOR decoded as pubkey")
+	}
+
+	var sig2 Signature
+	err = sig2.UnmarshalCBOR(data[:len(data)-1])
+	if err == nil {
+		t.Fatalf("truncated CBOR decoded without error")
+	}
+
+	bad := append([]byte{}, data...)
+	bad[2] = 2 // version
+	err = sig2.UnmarshalCBOR(bad)
+	if err == nil {
+		t.Fatalf("CBOR with version 2 decoded without error")
+	}
+
+	var msg Message
+	short, _ := hex.DecodeString("a20001015801ff")
+	err = msg.UnmarshalCBOR(short)
+	if err == nil {
+		t.Fatalf("1 byte CBOR message decoded without error")
+	}
+}
@@ -0,0 +1,111 @@
+package lamport
+
+import (
+	"fmt"
+)
+
+// CERT_DOMAIN is the GetMessage domain certificates are signed in, so a
+// certificate signature can't be mistaken for any other kind.
+const CERT_DOMAIN = "lamport certificate v1"
+
+// Certificate says the issuer vouches for the subject pubkey.  The issuer
+// signs GetMessage(CERT_DOMAIN, Subject.Bytes()), which uses up its one
+// signature, so every key in a chain certifies exactly one key below it.
+type Certificate struct {
+	SubjectFingerprint Fingerprint
+	Subject            PublicKey
+	IssuerFingerprint  Fingerprint
+	Signature          Signature
+}
+
+// ChainError is what VerifyChain returns for a bad chain.  Index is the
+// certificate at fault, or len(chain) if the chain is fine but doesn't end
+// at the leaf, and Err says what's wrong with it.
+type ChainError struct {
+	Index int
+	Err   error
+}
+
+func (self *ChainError) Error() string {
+	return fmt.Sprintf("certificate chain: link %d: %v", self.Index, self.Err)
+}
+
+func (self *ChainError) Unwrap() error {
+	return self.Err
+}
+
+func certMessage(subject PublicKey) Message {
+	return GetMessage(CERT_DOMAIN, subject.Bytes())
+}
+
+// Certify signs a certificate for subject with the issuer's key.  Nothing
+// stops issuer being used again afterwards, so prefer Signer.Certify, which
+// makes sure it isn't.  An all zero issuer key is ErrZeroKey.
+func Certify(issuer PrivateKey, issuerPub PublicKey, subject PublicKey) (Certificate, error) {
+	sig, err := Sign(certMessage(subject), issuer)
+	if err != nil {
+		return Certificate{}, err
+	}
+	return Certificate{
+		SubjectFingerprint: subject.Fingerprint(),
+		Subject:            subject,
+		IssuerFingerprint:  issuerPub.Fingerprint(),
+		Signature:          sig,
+	}, nil
+}
+
+// Certify signs a certificate for subject with the Signer's key, which
+// counts as its one signature like any other Sign.  issuerPub has to be the
+// Signer's pubkey; a certificate that doesn't verify with it is
+// ErrInvalidSignature.
+func (self *Signer) Certify(issuerPub PublicKey, subject PublicKey) (Certificate, error) {
+	msg := certMessage(subject)
+	sig, err := self.Sign(msg)
+	if err != nil {
+		return Certificate{}, err
+	}
+	if !Verify(msg, issuerPub, sig) {
+		return Certificate{}, fmt.Errorf("%w: issuer pubkey isn't the Signer's",
+			ErrInvalidSignature)
+	}
+	return Certificate{
+		SubjectFingerprint: subject.Fingerprint(),
+		Subject:            subject,
+		IssuerFingerprint:  issuerPub.Fingerprint(),
+		Signature:          sig,
+	}, nil
+}
+
+// VerifyChain checks that root certifies the subject of chain[0], which
+// certifies the subject of chain[1], and so on down to leaf.  An empty chain
+// is only valid if leaf is root.  Errors are a *ChainError wrapping
+// ErrWrongKey for a link issued by the wrong key or a chain that ends
+// somewhere other than leaf, and ErrInvalidSignature for a bad signature.
+func VerifyChain(root PublicKey, chain []Certificate, leaf PublicKey) error {
+	issuer := root
+	issuerFp := root.Fingerprint()
+	for i, cert := range chain {
+		if cert.IssuerFingerprint != issuerFp {
+			return &ChainError{Index: i, Err: fmt.Errorf(
+				"%w: issued by %s, expect %s", ErrWrongKey,
+				cert.IssuerFingerprint.Short(), issuerFp.Short())}
+		}
+		subjectFp := cert.Subject.Fingerprint()
+		if cert.SubjectFingerprint != subjectFp {
+			return &ChainError{Index: i, Err: fmt.Errorf(
+				"%w: subject fingerprint %s doesn't match subject %s",
+				ErrWrongKey, cert.SubjectFingerprint.Short(), subjectFp.Short())}
+		}
+		if !Verify(certMessage(cert.Subject), issuer, cert.Signature) {
+			return &ChainError{Index: i, Err: ErrInvalidSignature}
+		}
+		issuer = cert.Subject
+		issuerFp = subjectFp
+	}
+	if !issuer.Equal(leaf) {
+		return &ChainError{Index: len(chain), Err: fmt.Errorf(
+			"%w: chain ends at %s, not the leaf %s", ErrWrongKey,
+			issuerFp.Short(), leaf.Fingerprint().Short())}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+// certChain makes root -> a -> b -> leaf with Signers and returns the
+// pubkeys and the three certificates.
+func certChain(t *testing.T) ([]PublicKey, []Certificate) {
+	var pris []PrivateKey
+	var pubs []PublicKey
+	for i := 0; i < 4; i++ {
+		pri, pub, err := GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		pris = append(pris, pri)
+		pubs = append(pubs, pub)
+	}
+	var chain []Certificate
+	for i := 0; i < 3; i++ {
+		cert, err := NewSigner(pris[i]).Certify(pubs[i], pubs[i+1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain = append(chain, cert)
+	}
+	return pubs, chain
+}
+
+// TestVerifyChain checks a good 3 link chain, and one with the middle link
+// broken in each way VerifyChain looks for.
+func TestVerifyChain(t *testing.T) {
+	pubs, chain := certChain(t)
+	root, leaf := pubs[0], pubs[3]
+	err := VerifyChain(root, chain, leaf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyChain(root, nil, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectLink := func(err error, index int, target error) {
+		t.Helper()
+		var cerr *ChainError
+		if !errors.As(err, &cerr) || cerr.Index != index || !errors.Is(err, target) {
+			t.Fatalf("got %v, expect link %d failing with %v", err, index, target)
+		}
+	}
+
+	bad := append([]Certificate{}, chain...)
+	bad[1].Signature.Preimage[17][3] ^= 1
+	expectLink(VerifyChain(root, bad, leaf), 1, ErrInvalidSignature)
+
+	bad = append([]Certificate{}, chain...)
+	bad[1].IssuerFingerprint = root.Fingerprint()
+	expectLink(VerifyChain(root, bad, leaf), 1, ErrWrongKey)
+
+	// swapping the subject for another key breaks the signature even with
+	// the fingerprint fixed up
+	bad = append([]Certificate{}, chain...)
+	bad[1].Subject = leaf
+	expectLink(VerifyChain(root, bad, leaf), 1, ErrWrongKey)
+	bad[1].SubjectFingerprint = leaf.Fingerprint()
+	expectLink(VerifyChain(root, bad, leaf), 1, ErrInvalidSignature)
+
+	expectLink(VerifyChain(root, chain[:2], leaf), 2, ErrWrongKey)
+	expectLink(VerifyChain(pubs[1], chain, leaf), 0, ErrWrongKey)
+}
+
+// TestSignerCertify checks a Signer certifies only once, and refuses an
+// issuer pubkey that isn't its own.
+func TestSignerCertify(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, sub1, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, sub2, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewSigner(pri).Certify(sub1, sub2)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+
+	s := NewSigner(pri)
+	cert, err := s.Certify(pub, sub1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert2, err := Certify(pri, pub, sub1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert != cert2 {
+		t.Fatalf("Signer.Certify and Certify disagree")
+	}
+	_, err = s.Certify(pub, sub2)
+	if !errors.Is(err, ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+}
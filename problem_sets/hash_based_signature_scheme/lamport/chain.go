@@ -0,0 +1,300 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+/*
+Chained signatures, as in the Guy Fawkes protocol: every one-time signature
+also signs the fingerprint of the key that will make the next one, so a
+verifier who trusts the first pubkey can follow the chain as far as it
+goes.  That's many-time signing with no tree and nothing to fix in advance,
+unlike MSS, but a verifier has to see every link in order, and a signature
+is as big as a pubkey and a one-time signature together.
+
+A link's message is signed as GetMessage(CHAIN_DOMAIN, msg.Bytes()), where
+Bytes is the canonical encoding
+
+    payload hash      32 bytes, sha256 of the data
+    next pubkey hash  32 bytes, Fingerprint of the next pubkey
+
+A Chain is saved as a CONTAINER_CHAIN container whose payload is
+
+    first    PUBKEY_BYTES
+    count    4 bytes, big endian
+    count times:
+        message      CHAINED_MESSAGE_BYTES
+        signature    SIGNATURE_BYTES
+        next pubkey  PUBKEY_BYTES
+*/
+
+const CHAIN_DOMAIN = "lamport chained message v1"
+const CHAINED_MESSAGE_BYTES = 2 * MESSAGE_BYTES // 64
+
+// A chain link whose next pubkey isn't the one its message committed to.
+var ErrBrokenChain = errors.New("chain: next pubkey doesn't match the link")
+
+// ChainedMessage is what a link of a chain signs: the data, and the key
+// that signs the next link.
+type ChainedMessage struct {
+	PayloadHash    Message
+	NextPubkeyHash Fingerprint
+}
+
+// Bytes returns the message's canonical encoding.
+func (self ChainedMessage) Bytes() []byte {
+	b := make([]byte, CHAINED_MESSAGE_BYTES)
+	copy(b, self.PayloadHash[:])
+	copy(b[MESSAGE_BYTES:], self.NextPubkeyHash[:])
+	return b
+}
+
+// ChainedMessageFromBytes is the inverse of ChainedMessage.Bytes.
+func ChainedMessageFromBytes(b []byte) (ChainedMessage, error) {
+	if len(b) != CHAINED_MESSAGE_BYTES {
+		return ChainedMessage{}, fmt.Errorf("%w: ChainedMessage %d bytes, expect %d",
+			ErrWrongLength, len(b), CHAINED_MESSAGE_BYTES)
+	}
+	var msg ChainedMessage
+	copy(msg.PayloadHash[:], b)
+	copy(msg.NextPubkeyHash[:], b[MESSAGE_BYTES:])
+	return msg, nil
+}
+
+// Message returns what gets signed for the chained message.
+func (self ChainedMessage) Message() Message {
+	return GetMessage(CHAIN_DOMAIN, self.Bytes())
+}
+
+// Covers reports whether the message is for data.
+func (self ChainedMessage) Covers(data []byte) bool {
+	return self.PayloadHash == sha256.Sum256(data)
+}
+
+// ChainLink is one signature in a chain, with the pubkey for the next.
+type ChainLink struct {
+	Message    ChainedMessage
+	Signature  Signature
+	NextPubkey PublicKey
+}
+
+// Chain is the first pubkey of a chain and the links signed so far.
+type Chain struct {
+	First PublicKey
+	Links []ChainLink
+}
+
+// ChainSigner signs a chain, generating a new key for every link and wiping
+// each key once it has signed.  It's safe to call from several goroutines;
+// links come out in the order their Signs get the lock.
+type ChainSigner struct {
+	mu   sync.Mutex
+	r    io.Reader
+	pri  PrivateKey
+	pub  PublicKey
+	done bool
+}
+
+// NewChainSigner starts a chain with keys from crypto/rand and returns its
+// first pubkey, which verifiers have to get some trusted way.
+func NewChainSigner() (*ChainSigner, PublicKey, error) {
+	return NewChainSignerFrom(rand.Reader)
+}
+
+// NewChainSignerFrom is NewChainSigner reading every key from r.
+func NewChainSignerFrom(r io.Reader) (*ChainSigner, PublicKey, error) {
+	pri, pub, err := GenerateKeyFrom(r)
+	if err != nil {
+		return nil, PublicKey{}, err
+	}
+	return &ChainSigner{r: r, pri: pri, pub: pub}, pub, nil
+}
+
+// PublicKey returns the pubkey that will sign the next link.
+func (self *ChainSigner) PublicKey() PublicKey {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.pub
+}
+
+// Sign generates the next key, signs data and the next key's fingerprint
+// with the current one, then wipes the current key and moves on.  If the
+// next key can't be generated nothing is signed.  After Zeroize it's
+// ErrZeroKey.
+func (self *ChainSigner) Sign(data []byte) (ChainLink, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if self.done {
+		return ChainLink{}, ErrZeroKey
+	}
+	nextPri, nextPub, err := GenerateKeyFrom(self.r)
+	if err != nil {
+		return ChainLink{}, err
+	}
+	link := ChainLink{
+		Message: ChainedMessage{
+			PayloadHash:    sha256.Sum256(data),
+			NextPubkeyHash: nextPub.Fingerprint(),
+		},
+		NextPubkey: nextPub,
+	}
+	err = SignInto(link.Message.Message(), &self.pri, &link.Signature)
+	if err != nil {
+		nextPri.Zeroize()
+		return ChainLink{}, err
+	}
+	self.pri.Zeroize()
+	self.pri = nextPri
+	self.pub = nextPub
+	return link, nil
+}
+
+// Zeroize wipes the current key, ending the chain.
+func (self *ChainSigner) Zeroize() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.pri.Zeroize()
+	self.done = true
+}
+
+// ChainVerifier follows a chain one link at a time from a trusted first
+// pubkey.
+type ChainVerifier struct {
+	pub   PublicKey
+	links int
+}
+
+// NewChainVerifier returns a verifier for the chain starting at first.
+func NewChainVerifier(first PublicKey) *ChainVerifier {
+	return &ChainVerifier{pub: first}
+}
+
+// PublicKey returns the pubkey the next link has to be signed by.
+func (self *ChainVerifier) PublicKey() PublicKey {
+	return self.pub
+}
+
+// Len returns the number of links verified so far.
+func (self *ChainVerifier) Len() int {
+	return self.links
+}
+
+// Verify checks the next link: that it's signed by the current pubkey, that
+// it's for data (unless data is nil, which skips that), and that its next
+// pubkey is the one it committed to, which it then moves on to.  A bad
+// signature or other data is ErrInvalidSignature and a mismatched next
+// pubkey ErrBrokenChain; either way the verifier stays where it was.
+func (self *ChainVerifier) Verify(data []byte, link *ChainLink) error {
+	if err := VerifyDetailed(link.Message.Message(), self.pub, link.Signature); err != nil {
+		return fmt.Errorf("link %d: %w", self.links, err)
+	}
+	if data != nil && !link.Message.Covers(data) {
+		return fmt.Errorf("link %d: %w: signed other data", self.links, ErrInvalidSignature)
+	}
+	if link.NextPubkey.Fingerprint() != link.Message.NextPubkeyHash {
+		return fmt.Errorf("link %d: %w", self.links, ErrBrokenChain)
+	}
+	self.pub = link.NextPubkey
+	self.links++
+	return nil
+}
+
+// Walk verifies links in order, as Verify would one at a time, and stops at
+// the first bad one.  payloads, if not nil, are the data for each link.
+func (self *ChainVerifier) Walk(links []ChainLink, payloads [][]byte) error {
+	if payloads != nil && len(payloads) != len(links) {
+		return fmt.Errorf("chain: %d payloads for %d links", len(payloads), len(links))
+	}
+	for i := range links {
+		var data []byte
+		if payloads != nil {
+			data = payloads[i]
+		}
+		if err := self.Verify(data, &links[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify walks the chain from first, which has to be its First, and checks
+// every link.  A chain that starts somewhere else is ErrWrongKey.
+func (self *Chain) Verify(first PublicKey, payloads [][]byte) error {
+	if !self.First.Equal(first) {
+		return fmt.Errorf("%w: chain starts at %s, expect %s", ErrWrongKey,
+			self.First.Fingerprint().Short(), first.Fingerprint().Short())
+	}
+	return NewChainVerifier(first).Walk(self.Links, payloads)
+}
+
+// MarshalBinary encodes the chain as a CONTAINER_CHAIN container.
+func (self *Chain) MarshalBinary() ([]byte, error) {
+	payload := new(bytes.Buffer)
+	payload.Write(self.First.Bytes())
+	binary.Write(payload, binary.BigEndian, uint32(len(self.Links)))
+	for i := range self.Links {
+		link := &self.Links[i]
+		payload.Write(link.Message.Bytes())
+		payload.Write(link.Signature.Bytes())
+		payload.Write(link.NextPubkey.Bytes())
+	}
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_CHAIN, payload.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a chain from MarshalBinary.  It doesn't verify
+// anything; use Verify for that.
+func (self *Chain) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_CHAIN {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_CHAIN}
+	}
+	const head = PUBKEY_BYTES + 4
+	const entry = CHAINED_MESSAGE_BYTES + SIGNATURE_BYTES + PUBKEY_BYTES
+	if len(c.Payload) < head {
+		return ContainerLengthError{Type: c.Type, Length: len(c.Payload), Expect: head}
+	}
+	n := int(binary.BigEndian.Uint32(c.Payload[PUBKEY_BYTES:]))
+	if uint64(len(c.Payload)) != head+uint64(n)*entry {
+		return ContainerLengthError{
+			Type: c.Type, Length: len(c.Payload), Expect: head + n*entry}
+	}
+	first, err := PubkeyFromBytes(c.Payload[:PUBKEY_BYTES])
+	if err != nil {
+		return err
+	}
+	links := make([]ChainLink, n)
+	for i := range links {
+		b := c.Payload[head+i*entry : head+(i+1)*entry]
+		links[i].Message, err = ChainedMessageFromBytes(b[:CHAINED_MESSAGE_BYTES])
+		if err != nil {
+			return err
+		}
+		b = b[CHAINED_MESSAGE_BYTES:]
+		links[i].Signature, err = SignatureFromBytes(b[:SIGNATURE_BYTES])
+		if err != nil {
+			return err
+		}
+		links[i].NextPubkey, err = PubkeyFromBytes(b[SIGNATURE_BYTES:])
+		if err != nil {
+			return err
+		}
+	}
+	self.First = first
+	self.Links = links
+	return nil
+}
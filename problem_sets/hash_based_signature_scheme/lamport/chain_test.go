@@ -0,0 +1,123 @@
+package lamport
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// testChain signs n payloads with a new ChainSigner.
+func testChain(t *testing.T, n int) (*Chain, [][]byte) {
+	signer, first, err := NewChainSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := &Chain{First: first}
+	var payloads [][]byte
+	for i := 0; i < n; i++ {
+		data := []byte(fmt.Sprint("link ", i))
+		link, err := signer.Sign(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain.Links = append(chain.Links, link)
+		payloads = append(payloads, data)
+	}
+	if !signer.PublicKey().Equal(chain.Links[n-1].NextPubkey) {
+		t.Fatalf("signer isn't at the last link's next pubkey")
+	}
+	return chain, payloads
+}
+
+// TestChain signs a chain, verifies it link by link and all at once, and
+// checks it survives encoding.
+func TestChain(t *testing.T) {
+	chain, payloads := testChain(t, 4)
+	v := NewChainVerifier(chain.First)
+	for i := range chain.Links {
+		if err := v.Verify(payloads[i], &chain.Links[i]); err != nil {
+			t.Fatal(err)
+		}
+		if v.Len() != i+1 || !v.PublicKey().Equal(chain.Links[i].NextPubkey) {
+			t.Fatalf("verifier didn't move on after link %d", i)
+		}
+	}
+	if err := chain.Verify(chain.First, payloads); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.Verify(chain.First, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := chain.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back Chain
+	if err := back.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := back.Verify(chain.First, payloads); err != nil {
+		t.Fatal(err)
+	}
+	if err := back.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+	if len(back.Links) != 4 {
+		t.Fatalf("failed UnmarshalBinary changed the chain")
+	}
+
+	msg := chain.Links[0].Message
+	msg2, err := ChainedMessageFromBytes(msg.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg2 != msg {
+		t.Fatalf("ChainedMessage round trip differs")
+	}
+}
+
+// TestChainBroken checks a link whose next pubkey was swapped, links out of
+// order, other payloads and another first key are all refused, and that a
+// refused link leaves the verifier where it was.
+func TestChainBroken(t *testing.T) {
+	chain, payloads := testChain(t, 3)
+	other, _ := testChain(t, 1)
+
+	broken := *chain
+	broken.Links = append([]ChainLink(nil), chain.Links...)
+	broken.Links[1].NextPubkey = other.Links[0].NextPubkey
+	err := broken.Verify(chain.First, payloads)
+	if !errors.Is(err, ErrBrokenChain) {
+		t.Fatalf("got %v, expect ErrBrokenChain", err)
+	}
+
+	// skipping a link means the next one is signed by the wrong key
+	v := NewChainVerifier(chain.First)
+	if err := v.Verify(payloads[1], &chain.Links[1]); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+	if v.Len() != 0 || !v.PublicKey().Equal(chain.First) {
+		t.Fatalf("refused link moved the verifier")
+	}
+
+	err = chain.Verify(chain.First, [][]byte{payloads[0], payloads[2], payloads[1]})
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+	if err := chain.Verify(other.First, payloads); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("got %v, expect ErrWrongKey", err)
+	}
+}
+
+// TestChainSignerZeroize checks a wiped signer stops signing.
+func TestChainSignerZeroize(t *testing.T) {
+	signer, _, err := NewChainSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer.Zeroize()
+	if _, err := signer.Sign([]byte("after")); !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("got %v, expect ErrZeroKey", err)
+	}
+}
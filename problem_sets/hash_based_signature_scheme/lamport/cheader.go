@@ -0,0 +1,50 @@
+package lamport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+var cIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ExportCHeader writes pub as a C array initializer named name, in canonical
+// order, for verifiers on microcontrollers:
+//
+//	static const uint8_t name[16384] = { 0x.., ... };
+//
+// The output is deterministic, with a comment giving the pubkey fingerprint.
+func ExportCHeader(w io.Writer, name string, pub PublicKey) error {
+	return writeCArray(w, name, pub.Bytes(),
+		fmt.Sprintf("Lamport public key %s", pub.Fingerprint()))
+}
+
+// ExportSignatureCHeader writes sig as a C array initializer named name, with
+// a comment giving the fingerprint of pub, the key it was made with.
+func ExportSignatureCHeader(w io.Writer, name string, sig Signature, pub PublicKey) error {
+	return writeCArray(w, name, sig.Bytes(),
+		fmt.Sprintf("Lamport signature by key %s", pub.Fingerprint()))
+}
+
+// writeCArray writes data as a static const uint8_t array, 16 bytes a line.
+func writeCArray(w io.Writer, name string, data []byte, comment string) error {
+	if !cIdentifier.MatchString(name) {
+		return fmt.Errorf("%q is not a valid C identifier", name)
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "/* %s */\n", comment)
+	fmt.Fprintf(bw, "static const uint8_t %s[%d] = {\n", name, len(data))
+	for i, b := range data {
+		if i%16 == 0 {
+			bw.WriteString("   ")
+		}
+		fmt.Fprintf(bw, " 0x%02x,", b)
+		if i%16 == 15 || i == len(data)-1 {
+			bw.WriteString("\n")
+		}
+	}
+	bw.WriteString("};\n")
+	return bw.Flush()
+}
@@ -0,0 +1,56 @@
+package lamport
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestExportCHeaderGolden compares the header for the provided pubkey and
+// first signature with the files in testdata.
+func TestExportCHeaderGolden(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = ExportCHeader(&buf, "lamport_pubkey1", pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden, err := os.ReadFile("testdata/pubkey1.h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), golden) {
+		t.Fatalf("pubkey C header doesn't match testdata/pubkey1.h")
+	}
+
+	buf.Reset()
+	err = ExportSignatureCHeader(&buf, "lamport_sig1", sig, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden, err = os.ReadFile("testdata/signature1.h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), golden) {
+		t.Fatalf("signature C header doesn't match testdata/signature1.h")
+	}
+}
+
+// TestExportCHeaderBadName checks names that aren't C identifiers.
+func TestExportCHeaderBadName(t *testing.T) {
+	for _, name := range []string{"", "1key", "my-key", "key[2]"} {
+		err := ExportCHeader(&bytes.Buffer{}, name, PublicKey{})
+		if err == nil {
+			t.Fatalf("name %q accepted", name)
+		}
+	}
+}
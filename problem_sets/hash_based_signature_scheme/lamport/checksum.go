@@ -0,0 +1,126 @@
+package lamport
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+/*
+Checksummed Lamport signs 265 bits instead of 256: the message, then the
+number of zero bits in the message as a 9 bit big endian number (it's at
+most 256).  Keys and signatures have a block for each of the 265 bits.
+
+With plain Lamport, two signatures on m1 and m2 let anyone sign any message
+that takes each bit from one or the other, which is what assignment.Forge
+searches for.  Here the checksum bits have to fit too, and they move the
+opposite way to the message: turning a 0 bit into a 1 lowers the zero count,
+and a lower count has a 0 somewhere the higher one had a 1.  So a forger
+can't just flip message bits towards whatever has been revealed, and many
+combinations are ruled out, like the one in TestChecksumStopsCombining.  It
+doesn't make reusing a key safe, but it's the same trick Winternitz
+signatures need to be secure at all.
+*/
+
+const CHECKSUM_BITS = 9
+const CHECKSUM_MESSAGE_BITS = MESSAGE_BITS + CHECKSUM_BITS // 265
+
+type ChecksumPrivateKey struct {
+	ZeroHash [CHECKSUM_MESSAGE_BITS]Block
+	OneHash  [CHECKSUM_MESSAGE_BITS]Block
+}
+
+type ChecksumPublicKey struct {
+	ZeroHash [CHECKSUM_MESSAGE_BITS]Block
+	OneHash  [CHECKSUM_MESSAGE_BITS]Block
+}
+
+type ChecksumSignature struct {
+	Preimage [CHECKSUM_MESSAGE_BITS]Block
+}
+
+// Checksum returns the number of zero bits in msg, which is what
+// checksummed signatures append to it.
+func Checksum(msg Message) int {
+	zeros := 0
+	for _, b := range msg {
+		zeros += 8 - bits.OnesCount8(b)
+	}
+	return zeros
+}
+
+// checksumBit returns bit i of msg with its checksum appended.
+func checksumBit(msg Message, checksum int, i int) byte {
+	if i < MESSAGE_BITS {
+		return msg.Bit(i)
+	}
+	return byte(checksum>>(CHECKSUM_MESSAGE_BITS-1-i)) & 0x01
+}
+
+// GenerateChecksumKey generates a checksummed key pair from crypto/rand.
+func GenerateChecksumKey() (ChecksumPrivateKey, ChecksumPublicKey, error) {
+	return GenerateChecksumKeyFrom(rand.Reader)
+}
+
+// GenerateChecksumKeyFrom is GenerateChecksumKey reading the key from r,
+// row 0 first, like GenerateKeyFrom.
+func GenerateChecksumKeyFrom(r io.Reader) (ChecksumPrivateKey, ChecksumPublicKey, error) {
+	var pri ChecksumPrivateKey
+	for _, row := range []*[CHECKSUM_MESSAGE_BITS]Block{&pri.ZeroHash, &pri.OneHash} {
+		for i := range row {
+			_, err := io.ReadFull(r, row[i][:])
+			if err != nil {
+				return ChecksumPrivateKey{}, ChecksumPublicKey{}, fmt.Errorf(
+					"reading %d bytes of key material: %w",
+					2*CHECKSUM_MESSAGE_BITS*MESSAGE_BYTES, err)
+			}
+		}
+	}
+	for i := range pri.ZeroHash {
+		if pri.ZeroHash[i] == pri.OneHash[i] {
+			return ChecksumPrivateKey{}, ChecksumPublicKey{}, fmt.Errorf(
+				"%w: both rows of bit %d are the same block", ErrWeakKey, i)
+		}
+	}
+	return pri, pri.GetPublicKey(), nil
+}
+
+// GetPublicKey hashes every block of the private key.
+func (self ChecksumPrivateKey) GetPublicKey() ChecksumPublicKey {
+	var pub ChecksumPublicKey
+	for i := range self.ZeroHash {
+		pub.ZeroHash[i] = self.ZeroHash[i].Hash()
+		pub.OneHash[i] = self.OneHash[i].Hash()
+	}
+	return pub
+}
+
+// SignChecksummed signs msg followed by its checksum.
+func SignChecksummed(msg Message, pri ChecksumPrivateKey) ChecksumSignature {
+	var sig ChecksumSignature
+	checksum := Checksum(msg)
+	for i := range sig.Preimage {
+		if checksumBit(msg, checksum, i) == 0 {
+			sig.Preimage[i] = pri.ZeroHash[i]
+		} else {
+			sig.Preimage[i] = pri.OneHash[i]
+		}
+	}
+	return sig
+}
+
+// VerifyChecksummed checks sig on msg and its checksum against pub.
+func VerifyChecksummed(msg Message, pub ChecksumPublicKey, sig ChecksumSignature) bool {
+	checksum := Checksum(msg)
+	for i := range sig.Preimage {
+		expect := pub.ZeroHash[i]
+		if checksumBit(msg, checksum, i) == 1 {
+			expect = pub.OneHash[i]
+		}
+		if !sig.Preimage[i].Hash().Equal(expect) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// messageWithZeros returns a message whose last zeros bits are 0 and the
+// rest 1.
+func messageWithZeros(zeros int) Message {
+	var msg Message
+	for i := 0; i < MESSAGE_BITS-zeros; i++ {
+		msg.SetBit(i, 1)
+	}
+	return msg
+}
+
+// TestSignChecksummed signs and verifies, and checks a one bit change
+// needs blocks from both the message and the checksum that the signature
+// didn't reveal.
+func TestSignChecksummed(t *testing.T) {
+	pri, pub, err := GenerateChecksumKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("checksum")
+	sig := SignChecksummed(msg, pri)
+	if !VerifyChecksummed(msg, pub, sig) {
+		t.Fatalf("VerifyChecksummed returned false, expected true")
+	}
+	if Checksum(Message{}) != MESSAGE_BITS || Checksum(messageWithZeros(3)) != 3 {
+		t.Fatalf("Checksum counts wrong")
+	}
+
+	checksum := Checksum(msg)
+	for i := 0; i < MESSAGE_BITS; i++ {
+		forged := msg
+		forged.SetBit(i, msg.Bit(i)^1)
+		if VerifyChecksummed(forged, pub, sig) {
+			t.Fatalf("signature verifies with bit %d flipped", i)
+		}
+		forgedChecksum := Checksum(forged)
+		unrevealed := 0
+		for j := MESSAGE_BITS; j < CHECKSUM_MESSAGE_BITS; j++ {
+			if checksumBit(forged, forgedChecksum, j) != checksumBit(msg, checksum, j) {
+				unrevealed++
+			}
+		}
+		if unrevealed == 0 {
+			t.Fatalf("flipping bit %d leaves the checksum the same", i)
+		}
+	}
+}
+
+// TestChecksumStopsCombining takes two signatures that plain Lamport would
+// combine into a third, and checks the checksum gets in the way.
+func TestChecksumStopsCombining(t *testing.T) {
+	// zero counts 4 and 2 reveal checksum bits 000000100 and 000000010, and
+	// the message in between needs 000000011
+	m1, m2, m3 := messageWithZeros(4), messageWithZeros(2), messageWithZeros(3)
+
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rk RevealedKey
+	for _, m := range []Message{m1, m2} {
+		err = rk.Add(pub, m, mustSign(t, m, pri))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	sig, err := rk.Sign(m3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(m3, pub, sig) {
+		t.Fatalf("plain Lamport forgery didn't verify")
+	}
+
+	cpri, cpub, err := GenerateChecksumKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigs := []ChecksumSignature{SignChecksummed(m1, cpri), SignChecksummed(m2, cpri)}
+	msgs := []Message{m1, m2}
+	var forged ChecksumSignature
+	missing := 0
+	for i := range forged.Preimage {
+		want := checksumBit(m3, Checksum(m3), i)
+		found := false
+		for n := range sigs {
+			if checksumBit(msgs[n], Checksum(msgs[n]), i) == want {
+				forged.Preimage[i] = sigs[n].Preimage[i]
+				found = true
+			}
+		}
+		if !found {
+			missing++
+		}
+	}
+	if missing == 0 {
+		t.Fatalf("every block needed for the forgery was revealed")
+	}
+	if VerifyChecksummed(m3, cpub, forged) {
+		t.Fatalf("checksummed forgery verified")
+	}
+}
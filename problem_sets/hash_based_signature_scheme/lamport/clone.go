@@ -0,0 +1,30 @@
+package lamport
+
+// Clone returns a copy of the private key that shares nothing with it, so
+// zeroizing or otherwise changing one leaves the other alone.  Assigning
+// the value does the same; Clone is for code holding a *PrivateKey, where
+// copying the pointer by mistake is easy.
+func (self *PrivateKey) Clone() *PrivateKey {
+	c := *self
+	return &c
+}
+
+// Clone returns a copy of the pubkey that shares nothing with it.
+func (self *PublicKey) Clone() *PublicKey {
+	c := *self
+	return &c
+}
+
+// Clone returns a copy of the signature that shares nothing with it.
+func (self *Signature) Clone() *Signature {
+	c := *self
+	return &c
+}
+
+// SignChecked is Sign taking a pointer to the private key, so it isn't
+// copied.
+func SignChecked(msg Message, pri *PrivateKey) (Signature, error) {
+	var sig Signature
+	err := SignInto(msg, pri, &sig)
+	return sig, err
+}
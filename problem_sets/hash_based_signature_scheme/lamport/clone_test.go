@@ -0,0 +1,92 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+// mustSign is Sign for a key the test knows is good.
+func mustSign(t testing.TB, msg Message, pri PrivateKey) Signature {
+	t.Helper()
+	sig, err := Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+// TestClone changes each clone and checks the original is untouched.
+func TestClone(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("clone")
+	sig := mustSign(t, msg, pri)
+
+	priClone := pri.Clone()
+	priClone.Zeroize()
+	if pri.IsZero() || !priClone.IsZero() {
+		t.Fatalf("zeroizing the clone changed the original")
+	}
+
+	pubClone := pub.Clone()
+	pubClone.ZeroHash[0] = Block{}
+	if pub.ZeroHash[0] == (Block{}) {
+		t.Fatalf("changing the pubkey clone changed the original")
+	}
+
+	sigClone := sig.Clone()
+	sigClone.Preimage[0] = Block{}
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("changing the signature clone changed the original")
+	}
+}
+
+// TestZeroKeys checks all zero keys are refused everywhere.
+func TestZeroKeys(t *testing.T) {
+	msg := GetMessageFromString("zero")
+	var pri PrivateKey
+	var pub PublicKey
+	var sig Signature
+	if !pri.IsZero() || !pub.IsZero() || !sig.IsZero() {
+		t.Fatalf("zero values aren't IsZero")
+	}
+
+	_, err := SignChecked(msg, &pri)
+	if !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("got %v, expect ErrZeroKey", err)
+	}
+	if _, err := Sign(msg, pri); !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("Sign: got %v, expect ErrZeroKey", err)
+	}
+	if _, err := pri.Sign(msg); !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("PrivateKey.Sign: got %v, expect ErrZeroKey", err)
+	}
+	if _, err := SignPtr(msg, &pri); !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("SignPtr: got %v, expect ErrZeroKey", err)
+	}
+	into := Signature{Preimage: [MESSAGE_BITS]Block{{1}}}
+	if err := SignInto(msg, &pri, &into); !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("SignInto: got %v, expect ErrZeroKey", err)
+	}
+	if into.Preimage[0] != (Block{1}) {
+		t.Fatalf("SignInto changed sig for a zero key")
+	}
+
+	if Verify(msg, pub, sig) {
+		t.Fatalf("Verify with a zero pubkey returned true, expected false")
+	}
+	err = VerifyDetailed(msg, pub, sig)
+	if !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("got %v, expect ErrZeroKey", err)
+	}
+
+	good, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mustSign(t, msg, good).IsZero() {
+		t.Fatalf("real signature IsZero")
+	}
+}
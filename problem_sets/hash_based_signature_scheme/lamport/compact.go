@@ -0,0 +1,82 @@
+package lamport
+
+/*
+Compact public keys.  The biggest practical problem with Lamport signatures
+is the 16KB public key.  In compact mode the published key is instead the
+32 byte Merkle root of a tree whose 512 leaves are the pubkey blocks, in the
+canonical order: leaf i is ZeroHash[i] for i < 256 and OneHash[i-256] for
+i >= 256.  Interior nodes are sha256(left || right).
+
+A compact signature carries each revealed preimage together with the 9
+sibling hashes needed to climb from its leaf to the root.  The tradeoff:
+
+    public key:  16384 bytes -> 32 bytes
+    signature:    8192 bytes -> 256 * (32 + 9*32) = 81920 bytes
+
+so it's worth it when pubkeys are published or stored far more often than
+signatures are sent.
+*/
+
+// COMPACT_TREE_HEIGHT is the height of the tree over the 512 pubkey blocks.
+const COMPACT_TREE_HEIGHT = 9
+
+// CompactPublicKey is the Merkle root of a PublicKey's 512 blocks.
+type CompactPublicKey struct {
+	Root Block
+}
+
+// CompactSignature holds the revealed preimages, and for each one the sibling
+// hashes from the leaf up to (not including) the root, bottom first.
+type CompactSignature struct {
+	Preimage [MESSAGE_BITS]Block
+	Path     [MESSAGE_BITS][COMPACT_TREE_HEIGHT]Block
+}
+
+// Compress returns the compact form of a pubkey.
+func Compress(pub PublicKey) CompactPublicKey {
+	levels := MerkleLevels(pubkeyLeaves(pub))
+	return CompactPublicKey{Root: levels[len(levels)-1][0]}
+}
+
+// SignCompact signs msg like Sign does, and adds the authentication path for
+// each revealed block.
+func SignCompact(msg Message, pri PrivateKey) (CompactSignature, error) {
+	var csig CompactSignature
+
+	sig, err := Sign(msg, pri)
+	if err != nil {
+		return csig, err
+	}
+	levels := MerkleLevels(pubkeyLeaves(pri.GetPublicKey()))
+	csig.Preimage = sig.Preimage
+
+	for i := range csig.Preimage {
+		bit := msg.Bit(i)
+		leaf := i + int(bit)*MESSAGE_BITS
+		copy(csig.Path[i][:], NewAuthPath(levels, leaf).Siblings)
+	}
+	return csig, nil
+}
+
+// VerifyCompact checks that every preimage in csig hashes, via its path, up
+// to the root at the leaf index selected by the corresponding message bit.
+func VerifyCompact(msg Message, cpub CompactPublicKey, csig CompactSignature) bool {
+	for i, block := range csig.Preimage {
+		bit := msg.Bit(i)
+		leaf := i + int(bit)*MESSAGE_BITS
+		path := AuthPath{Index: uint32(leaf), Height: COMPACT_TREE_HEIGHT,
+			Siblings: csig.Path[i][:]}
+		if path.ComputeRoot(block.Hash()) != cpub.Root {
+			return false
+		}
+	}
+	return true
+}
+
+// pubkeyLeaves returns the 512 pubkey blocks in canonical order.
+func pubkeyLeaves(pub PublicKey) []Block {
+	leaves := make([]Block, 0, 2*MESSAGE_BITS)
+	leaves = append(leaves, pub.ZeroHash[:]...)
+	leaves = append(leaves, pub.OneHash[:]...)
+	return leaves
+}
@@ -0,0 +1,87 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// TestCompactGoodSig signs and verifies in compact mode.
+func TestCompactGoodSig(t *testing.T) {
+	msg := GetMessageFromString("compact")
+
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cpub := Compress(pub)
+	csig, err := SignCompact(msg, sec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !VerifyCompact(msg, cpub, csig) {
+		t.Fatalf("VerifyCompact returned false, expected true")
+	}
+
+	// the preimages are the same as a normal signature's
+	sig := Signature{Preimage: csig.Preimage}
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify on compact preimages returned false, expected true")
+	}
+}
+
+// TestCompactBadSig modifies preimages, paths, and the message, each of which
+// should break verification.
+func TestCompactBadSig(t *testing.T) {
+	msg := GetMessageFromString("compact bad")
+
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cpub := Compress(pub)
+	csig, err := SignCompact(msg, sec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bad := csig
+	bad.Preimage[7] = bad.Preimage[7].Hash()
+	if VerifyCompact(msg, cpub, bad) {
+		t.Fatalf("VerifyCompact with bad preimage returned true, expected false")
+	}
+
+	bad = csig
+	bad.Path[200][4] = bad.Path[200][4].Hash()
+	if VerifyCompact(msg, cpub, bad) {
+		t.Fatalf("VerifyCompact with bad path returned true, expected false")
+	}
+
+	if VerifyCompact(GetMessageFromString("worse"), cpub, csig) {
+		t.Fatalf("VerifyCompact on other message returned true, expected false")
+	}
+
+	_, pub2, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if VerifyCompact(msg, Compress(pub2), csig) {
+		t.Fatalf("VerifyCompact with other key returned true, expected false")
+	}
+}
+
+// TestCompressLeafOrder rebuilds the root by hand from the two rows to pin down
+// the leaf order.
+func TestCompressLeafOrder(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	left := MerkleLevels(pub.ZeroHash[:])
+	right := MerkleLevels(pub.OneHash[:])
+	root := MerkleParent(left[len(left)-1][0], right[len(right)-1][0])
+
+	if Compress(pub).Root != root {
+		t.Fatalf("compact root doesn't match row 0 / row 1 subtrees")
+	}
+}
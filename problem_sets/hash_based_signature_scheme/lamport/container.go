@@ -0,0 +1,363 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+Versioned container for saving keys and signatures.  The raw 16384 byte
+blobs don't say what they are, and if the scheme parameters ever change
+(different hash, different MESSAGE_BITS) they become ambiguous.  So anything
+written to disk gets an 8 byte header first:
+
+    magic    4 bytes  "LMPT"
+    type     1 byte   CONTAINER_PUBKEY, CONTAINER_PRIVKEY, ...
+    version  1 byte   CONTAINER_VERSION
+    params   2 bytes  big endian parameter set ID, PARAMS_SHA256, ...
+    payload  the rest, the same layout as Bytes()
+
+There's no length field; the payload runs to the end of the input and its
+length has to match what the type and parameters call for.
+*/
+
+var CONTAINER_MAGIC = [4]byte{'L', 'M', 'P', 'T'}
+
+const CONTAINER_VERSION = 1
+const CONTAINER_HEADER_BYTES = 8
+
+// ContainerType says what kind of payload a container holds.
+type ContainerType byte
+
+const (
+	CONTAINER_PUBKEY       ContainerType = 1
+	CONTAINER_PRIVKEY      ContainerType = 2
+	CONTAINER_SIGNATURE    ContainerType = 3
+	CONTAINER_SIGNED       ContainerType = 4 // SignedMessage, variable length
+	CONTAINER_ZERO_HALF    ContainerType = 5
+	CONTAINER_ONE_HALF     ContainerType = 6
+	CONTAINER_BUNDLE       ContainerType = 7 // assignment bundle, variable length
+	CONTAINER_KEYPAIR      ContainerType = 8
+	CONTAINER_KEYRING      ContainerType = 9 // KeyRing, variable length
+	CONTAINER_SALTED       ContainerType = 10
+	CONTAINER_REVOCATIONS  ContainerType = 11 // RevocationList, variable length
+	CONTAINER_POLICY       ContainerType = 12 // multisig Policy, variable length
+	CONTAINER_MULTISIG     ContainerType = 13 // MultiSignature, variable length
+	CONTAINER_SEED_PRIVKEY ContainerType = 14
+	CONTAINER_CHAIN        ContainerType = 15 // Chain, variable length
+	CONTAINER_TWEAKED_PUB  ContainerType = 16
+	CONTAINER_HYBRID_PUB   ContainerType = 17
+	CONTAINER_TRANSCRIPT   ContainerType = 18
+	CONTAINER_REVOCATIONS2 ContainerType = 19 // RevocationList with reasons, variable length
+	CONTAINER_REVEAL       ContainerType = 20 // RevealBundle
+	CONTAINER_FILE_MERKLE  ContainerType = 21 // FileSignature
+)
+
+// Parameter set IDs.  All of them have 256 bit messages and 32 byte blocks,
+// and differ in the hash used for everything; see Scheme.  ReadContainer and
+// the Container methods only take PARAMS_SHA256, the hash the fixed size
+// functions use, and a Scheme reads and writes containers with its own ID.
+const (
+	PARAMS_SHA256         uint16 = 1
+	PARAMS_SHA3_256       uint16 = 2
+	PARAMS_BLAKE2B_256    uint16 = 3
+	PARAMS_SHA256_TWEAKED uint16 = 4
+)
+
+// SHAKE parameter set IDs are one of these ORed with the block size in
+// bytes; see ShakeScheme.  Their keys and signatures are sized by the block
+// size, and only pubkeys, private keys and signatures are defined for them.
+const (
+	PARAMS_SHAKE128 uint16 = 0x0100
+	PARAMS_SHAKE256 uint16 = 0x0200
+)
+
+var ErrBadMagic = errors.New("container: bad magic, not a lamport container")
+
+// ContainerVersionError is returned when a container has a version this code
+// doesn't know how to read.
+type ContainerVersionError struct {
+	Version byte
+}
+
+func (self ContainerVersionError) Error() string {
+	return fmt.Sprintf("container: unknown version %d, expect %d",
+		self.Version, CONTAINER_VERSION)
+}
+
+// ContainerTypeError is returned for an unknown type byte, or when a
+// container holds a different type than the caller asked for.
+type ContainerTypeError struct {
+	Type   ContainerType
+	Expect ContainerType // 0 if any known type would do
+}
+
+func (self ContainerTypeError) Error() string {
+	if self.Expect == 0 {
+		return fmt.Sprintf("container: unknown type %d", self.Type)
+	}
+	return fmt.Sprintf("container: type %s, expect %s", self.Type, self.Expect)
+}
+
+// ContainerParamsError is returned for a parameter set this code can't use.
+type ContainerParamsError struct {
+	Params uint16
+}
+
+func (self ContainerParamsError) Error() string {
+	return fmt.Sprintf("container: unknown parameter set %d", self.Params)
+}
+
+// ContainerLengthError is returned when the payload is the wrong size for its
+// type.
+type ContainerLengthError struct {
+	Type   ContainerType
+	Length int
+	Expect int
+}
+
+func (self ContainerLengthError) Error() string {
+	return fmt.Sprintf("container: %s payload %d bytes, expect %d",
+		self.Type, self.Length, self.Expect)
+}
+
+// Is makes ContainerLengthError match ErrWrongLength.
+func (self ContainerLengthError) Is(target error) bool {
+	return target == ErrWrongLength
+}
+
+func (self ContainerType) String() string {
+	switch self {
+	case CONTAINER_PUBKEY:
+		return "pubkey"
+	case CONTAINER_PRIVKEY:
+		return "privkey"
+	case CONTAINER_SIGNATURE:
+		return "signature"
+	case CONTAINER_SIGNED:
+		return "signed message"
+	case CONTAINER_ZERO_HALF:
+		return "zero half"
+	case CONTAINER_ONE_HALF:
+		return "one half"
+	case CONTAINER_BUNDLE:
+		return "bundle"
+	case CONTAINER_KEYPAIR:
+		return "key pair"
+	case CONTAINER_KEYRING:
+		return "key ring"
+	case CONTAINER_SALTED:
+		return "salted signature"
+	case CONTAINER_REVOCATIONS:
+		return "revocation list"
+	case CONTAINER_POLICY:
+		return "multisig policy"
+	case CONTAINER_MULTISIG:
+		return "multisig"
+	case CONTAINER_SEED_PRIVKEY:
+		return "seed privkey"
+	case CONTAINER_CHAIN:
+		return "chain"
+	case CONTAINER_TWEAKED_PUB:
+		return "tweaked pubkey"
+	case CONTAINER_HYBRID_PUB:
+		return "hybrid pubkey"
+	case CONTAINER_TRANSCRIPT:
+		return "keygen transcript"
+	case CONTAINER_REVOCATIONS2:
+		return "revocation list v2"
+	case CONTAINER_REVEAL:
+		return "reveal bundle"
+	case CONTAINER_FILE_MERKLE:
+		return "file merkle signature"
+	}
+	return fmt.Sprintf("type(%d)", byte(self))
+}
+
+// payloadSize returns the payload length for a container type, -1 for types
+// with a variable length payload, and false if it isn't a type this package
+// knows.
+func (self ContainerType) payloadSize() (int, bool) {
+	switch self {
+	case CONTAINER_PUBKEY:
+		return PUBKEY_BYTES, true
+	case CONTAINER_PRIVKEY:
+		return PRIVKEY_BYTES, true
+	case CONTAINER_SIGNATURE:
+		return SIGNATURE_BYTES, true
+	case CONTAINER_SIGNED, CONTAINER_BUNDLE, CONTAINER_KEYRING,
+		CONTAINER_REVOCATIONS, CONTAINER_POLICY, CONTAINER_MULTISIG, CONTAINER_CHAIN,
+		CONTAINER_REVOCATIONS2:
+		return -1, true
+	case CONTAINER_ZERO_HALF, CONTAINER_ONE_HALF:
+		return HALF_KEY_BYTES, true
+	case CONTAINER_KEYPAIR:
+		return PRIVKEY_BYTES + PUBKEY_BYTES, true
+	case CONTAINER_SALTED:
+		return SALTED_SIGNATURE_BYTES, true
+	case CONTAINER_SEED_PRIVKEY:
+		return SEED_BYTES, true
+	case CONTAINER_TWEAKED_PUB:
+		return TWEAKED_PUBKEY_BYTES, true
+	case CONTAINER_HYBRID_PUB:
+		return HYBRID_PUBKEY_BYTES, true
+	case CONTAINER_TRANSCRIPT:
+		return TRANSCRIPT_BYTES, true
+	case CONTAINER_REVEAL:
+		return REVEAL_BUNDLE_BYTES, true
+	case CONTAINER_FILE_MERKLE:
+		return FILE_SIGNATURE_BYTES, true
+	}
+	return 0, false
+}
+
+// containerPayloadSize is payloadSize for a type under the parameter set
+// params, which has to be one a Scheme or ShakeScheme exists for.
+func containerPayloadSize(typ ContainerType, params uint16) (int, error) {
+	size, ok := typ.payloadSize()
+	if !ok {
+		return 0, ContainerTypeError{Type: typ}
+	}
+	if shake := ShakeSchemeByID(params); shake != nil {
+		size, ok = shake.payloadSize(typ)
+		if !ok {
+			return 0, ContainerParamsError{Params: params}
+		}
+		return size, nil
+	}
+	if SchemeByID(params) == nil {
+		return 0, ContainerParamsError{Params: params}
+	}
+	return size, nil
+}
+
+// Container is a decoded container: the header fields and the payload.
+type Container struct {
+	Type    ContainerType
+	Version byte
+	Params  uint16
+	Payload []byte
+}
+
+// WriteContainer writes a header for typ followed by payload to w.  The
+// payload length is checked against the type before anything is written.
+func WriteContainer(w io.Writer, typ ContainerType, payload []byte) error {
+	return writeContainerParams(w, typ, PARAMS_SHA256, payload)
+}
+
+// writeContainerParams is WriteContainer with a parameter set other than
+// PARAMS_SHA256.
+func writeContainerParams(w io.Writer, typ ContainerType, params uint16, payload []byte) error {
+	size, err := containerPayloadSize(typ, params)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && len(payload) != size {
+		return ContainerLengthError{Type: typ, Length: len(payload), Expect: size}
+	}
+
+	var header [CONTAINER_HEADER_BYTES]byte
+	copy(header[:4], CONTAINER_MAGIC[:])
+	header[4] = byte(typ)
+	header[5] = CONTAINER_VERSION
+	binary.BigEndian.PutUint16(header[6:], params)
+
+	_, err = w.Write(header[:])
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(payload)
+	return err
+}
+
+// ReadContainer reads a container from r, all the way to EOF.  Bad magic,
+// unknown versions, types or parameters, and payloads of the wrong length are
+// all refused with the errors above.
+func ReadContainer(r io.Reader) (Container, error) {
+	c, err := readContainer(r)
+	if err != nil {
+		return c, err
+	}
+	if c.Params != PARAMS_SHA256 {
+		return c, ContainerParamsError{Params: c.Params}
+	}
+	return c, nil
+}
+
+// readContainer is ReadContainer taking any parameter set a Scheme or
+// ShakeScheme exists for.
+func readContainer(r io.Reader) (Container, error) {
+	var c Container
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return c, err
+	}
+	if len(data) < CONTAINER_HEADER_BYTES ||
+		!bytes.Equal(data[:4], CONTAINER_MAGIC[:]) {
+		return c, ErrBadMagic
+	}
+
+	c.Type = ContainerType(data[4])
+	c.Version = data[5]
+	c.Params = binary.BigEndian.Uint16(data[6:8])
+	c.Payload = data[CONTAINER_HEADER_BYTES:]
+
+	if c.Version != CONTAINER_VERSION {
+		return c, ContainerVersionError{Version: c.Version}
+	}
+	size, err := containerPayloadSize(c.Type, c.Params)
+	if err != nil {
+		return c, err
+	}
+	if size >= 0 && len(c.Payload) != size {
+		return c, ContainerLengthError{
+			Type: c.Type, Length: len(c.Payload), Expect: size}
+	}
+	return c, nil
+}
+
+// PublicKey returns the pubkey held in the container.
+func (self Container) PublicKey() (PublicKey, error) {
+	if self.Type != CONTAINER_PUBKEY {
+		return PublicKey{}, ContainerTypeError{Type: self.Type, Expect: CONTAINER_PUBKEY}
+	}
+	return PubkeyFromBytes(self.Payload)
+}
+
+// PrivateKey returns the private key held in the container.
+func (self Container) PrivateKey() (PrivateKey, error) {
+	if self.Type != CONTAINER_PRIVKEY {
+		return PrivateKey{}, ContainerTypeError{Type: self.Type, Expect: CONTAINER_PRIVKEY}
+	}
+	return PrivkeyFromBytes(self.Payload)
+}
+
+// Signature returns the signature held in the container.
+func (self Container) Signature() (Signature, error) {
+	if self.Type != CONTAINER_SIGNATURE {
+		return Signature{}, ContainerTypeError{Type: self.Type, Expect: CONTAINER_SIGNATURE}
+	}
+	return SignatureFromBytes(self.Payload)
+}
+
+// SaltedSignature returns the salted signature held in the container.
+func (self Container) SaltedSignature() (SaltedSignature, error) {
+	if self.Type != CONTAINER_SALTED {
+		return SaltedSignature{}, ContainerTypeError{Type: self.Type, Expect: CONTAINER_SALTED}
+	}
+	return SaltedSignatureFromBytes(self.Payload)
+}
+
+// SeedPrivateKey returns the seed key held in the container.
+func (self Container) SeedPrivateKey() (SeedPrivateKey, error) {
+	var pri SeedPrivateKey
+	if self.Type != CONTAINER_SEED_PRIVKEY {
+		return pri, ContainerTypeError{Type: self.Type, Expect: CONTAINER_SEED_PRIVKEY}
+	}
+	copy(pri.Seed[:], self.Payload)
+	return pri, nil
+}
@@ -0,0 +1,135 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestContainerRoundTrip writes each type into a container and reads it back.
+func TestContainerRoundTrip(t *testing.T) {
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := mustSign(t, GetMessageFromString("container"), sec)
+
+	var buf bytes.Buffer
+	err = WriteContainer(&buf, CONTAINER_PUBKEY, pub.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != CONTAINER_HEADER_BYTES+PUBKEY_BYTES {
+		t.Fatalf("container %d bytes, expect %d",
+			buf.Len(), CONTAINER_HEADER_BYTES+PUBKEY_BYTES)
+	}
+	c, err := ReadContainer(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub2, err := c.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf.Reset()
+	err = WriteContainer(&buf, CONTAINER_PRIVKEY, sec.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err = ReadContainer(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sec2, err := c.PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf.Reset()
+	err = WriteContainer(&buf, CONTAINER_SIGNATURE, sig.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err = ReadContainer(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig2, err := c.Signature()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pub2 != pub || sec2 != sec || sig2 != sig {
+		t.Fatalf("container round trip changed key or signature")
+	}
+
+	// asking for the wrong type out of a container fails
+	_, err = c.PublicKey()
+	var typeErr ContainerTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("got %v, expect ContainerTypeError", err)
+	}
+}
+
+// TestContainerErrors checks each kind of bad header and payload.
+func TestContainerErrors(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// writing the wrong length payload
+	err = WriteContainer(&bytes.Buffer{}, CONTAINER_PUBKEY, sig.Bytes())
+	var lengthErr ContainerLengthError
+	if !errors.As(err, &lengthErr) {
+		t.Fatalf("got %v, expect ContainerLengthError", err)
+	}
+
+	var buf bytes.Buffer
+	err = WriteContainer(&buf, CONTAINER_SIGNATURE, sig.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	good := buf.Bytes()
+
+	bad := append([]byte{}, good...)
+	bad[0] = 'X'
+	_, err = ReadContainer(bytes.NewReader(bad))
+	if err != ErrBadMagic {
+		t.Fatalf("got %v, expect ErrBadMagic", err)
+	}
+
+	bad = append([]byte{}, good...)
+	bad[5] = CONTAINER_VERSION + 1
+	_, err = ReadContainer(bytes.NewReader(bad))
+	var versionErr ContainerVersionError
+	if !errors.As(err, &versionErr) || versionErr.Version != CONTAINER_VERSION+1 {
+		t.Fatalf("got %v, expect ContainerVersionError", err)
+	}
+
+	bad = append([]byte{}, good...)
+	bad[4] = 99
+	_, err = ReadContainer(bytes.NewReader(bad))
+	var typeErr ContainerTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("got %v, expect ContainerTypeError", err)
+	}
+
+	bad = append([]byte{}, good...)
+	bad[7] = 0x42
+	_, err = ReadContainer(bytes.NewReader(bad))
+	var paramsErr ContainerParamsError
+	if !errors.As(err, &paramsErr) {
+		t.Fatalf("got %v, expect ContainerParamsError", err)
+	}
+
+	_, err = ReadContainer(bytes.NewReader(good[:len(good)-1]))
+	if !errors.As(err, &lengthErr) || lengthErr.Length != SIGNATURE_BYTES-1 {
+		t.Fatalf("got %v, expect ContainerLengthError", err)
+	}
+	_, err = ReadContainer(bytes.NewReader(append(good, 0)))
+	if !errors.As(err, &lengthErr) || lengthErr.Length != SIGNATURE_BYTES+1 {
+		t.Fatalf("got %v, expect ContainerLengthError", err)
+	}
+}
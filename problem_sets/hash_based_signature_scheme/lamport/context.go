@@ -0,0 +1,52 @@
+package lamport
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+// GenerateKeyContext is GenerateKey, checking ctx between each block read
+// from crypto/rand and each block hashed.  If ctx is done it returns
+// ctx.Err() and zero keys, never a partly generated one.
+func GenerateKeyContext(ctx context.Context) (PrivateKey, PublicKey, error) {
+	return generateKeyContextFrom(ctx, rand.Reader)
+}
+
+func generateKeyContextFrom(ctx context.Context, r io.Reader) (PrivateKey, PublicKey, error) {
+	var pri PrivateKey
+	fail := func(err error) (PrivateKey, PublicKey, error) {
+		pri.Zeroize()
+		return PrivateKey{}, PublicKey{}, err
+	}
+
+	rows := [][]Block{pri.ZeroHash[:], pri.OneHash[:]}
+	for _, row := range rows {
+		for i := range row {
+			if err := ctx.Err(); err != nil {
+				return fail(err)
+			}
+			_, err := io.ReadFull(r, row[i][:])
+			if err != nil {
+				return fail(fmt.Errorf("reading key material: %w", err))
+			}
+		}
+	}
+	for i := range pri.ZeroHash {
+		if pri.ZeroHash[i] == pri.OneHash[i] {
+			return fail(fmt.Errorf(
+				"%w: both rows of bit %d are the same block", ErrWeakKey, i))
+		}
+	}
+
+	var pub PublicKey
+	for i := range pri.ZeroHash {
+		if err := ctx.Err(); err != nil {
+			return fail(err)
+		}
+		pub.ZeroHash[i] = pri.ZeroHash[i].Hash()
+		pub.OneHash[i] = pri.OneHash[i].Hash()
+	}
+	return pri, pub, nil
+}
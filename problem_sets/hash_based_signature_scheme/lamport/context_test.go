@@ -0,0 +1,56 @@
+package lamport
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+)
+
+// slowReader is crypto/rand with a pause before every read.
+type slowReader struct {
+	delay time.Duration
+}
+
+func (self slowReader) Read(p []byte) (int, error) {
+	time.Sleep(self.delay)
+	return rand.Read(p)
+}
+
+// TestGenerateKeyContext generates with a live context, an already canceled
+// one, and one whose deadline passes part way through.
+func TestGenerateKeyContext(t *testing.T) {
+	pri, pub, err := GenerateKeyContext(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri.GetPublicKey() != pub {
+		t.Fatalf("pubkey doesn't match private key")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	pri, pub, err = GenerateKeyContext(ctx)
+	if err != context.Canceled {
+		t.Fatalf("got %v, expect context.Canceled", err)
+	}
+	if !pri.IsZero() || pub != (PublicKey{}) {
+		t.Fatalf("canceled generation returned key material")
+	}
+
+	// 1024 reads of at least 1ms each can't finish in 20ms
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	pri, _, err = generateKeyContextFrom(ctx, slowReader{delay: time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, expect context.DeadlineExceeded", err)
+	}
+	if !pri.IsZero() {
+		t.Fatalf("timed out generation returned key material")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("took %v to notice the deadline", elapsed)
+	}
+}
@@ -0,0 +1,77 @@
+package lamport
+
+import (
+	"crypto"
+	"fmt"
+	"io"
+)
+
+// CryptoSigner wraps a private key so it can be used anywhere a
+// crypto.Signer is accepted.  The digest passed to Sign is signed directly as
+// the Message, so it has to be a sha256 output.  Nothing stops a
+// crypto.Signer from being called more than once, so the usual one-time
+// warning applies.
+type CryptoSigner struct {
+	pri PrivateKey
+	pub PublicKey
+}
+
+// NewCryptoSigner wraps pri, computing its public key once up front.
+func NewCryptoSigner(pri PrivateKey) *CryptoSigner {
+	return &CryptoSigner{pri: pri, pub: pri.GetPublicKey()}
+}
+
+// Public returns the PublicKey (not a pointer) for the wrapped private key.
+func (self *CryptoSigner) Public() crypto.PublicKey {
+	return self.pub
+}
+
+// Sign signs digest, which must be exactly MESSAGE_BYTES long, and returns
+// the signature as from Signature.Bytes.  opts may be nil, or have a HashFunc
+// of 0 or crypto.SHA256; anything else is an error, as is a digest of the
+// wrong length.  rand is ignored since Lamport signing is deterministic.
+func (self *CryptoSigner) Sign(
+	rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	err := checkDigest(digest, opts)
+	if err != nil {
+		return nil, err
+	}
+	var msg Message
+	copy(msg[:], digest)
+	sig, err := SignChecked(msg, &self.pri)
+	if err != nil {
+		return nil, err
+	}
+	return sig.Bytes(), nil
+}
+
+// VerifyDigest checks sig, in the Signature.Bytes encoding, on digest.  It is
+// the counterpart of CryptoSigner.Sign, in the style of ecdsa.VerifyASN1: a
+// digest or signature of the wrong length just doesn't verify.
+func VerifyDigest(pub PublicKey, digest, sig []byte) bool {
+	if len(digest) != MESSAGE_BYTES {
+		return false
+	}
+	s, err := SignatureFromBytes(sig)
+	if err != nil {
+		return false
+	}
+	var msg Message
+	copy(msg[:], digest)
+	return Verify(msg, pub, s)
+}
+
+// checkDigest makes sure digest and opts describe a sha256 hash.
+func checkDigest(digest []byte, opts crypto.SignerOpts) error {
+	if opts != nil {
+		h := opts.HashFunc()
+		if h != 0 && h != crypto.SHA256 {
+			return fmt.Errorf("can only sign SHA-256 digests, not %v", h)
+		}
+	}
+	if len(digest) != MESSAGE_BYTES {
+		return fmt.Errorf("%w: digest %d bytes, expect %d",
+			ErrWrongLength, len(digest), MESSAGE_BYTES)
+	}
+	return nil
+}
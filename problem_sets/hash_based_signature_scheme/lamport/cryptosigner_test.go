@@ -0,0 +1,79 @@
+package lamport
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+)
+
+// TestCryptoSigner signs a digest through the crypto.Signer interface and
+// checks the bytes with VerifyDigest.
+func TestCryptoSigner(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var signer crypto.Signer = NewCryptoSigner(pri)
+	if signer.Public().(PublicKey) != pub {
+		t.Fatalf("Public returned a different key")
+	}
+
+	digest := sha256.Sum256([]byte("crypto signer"))
+	sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sig) != SIGNATURE_BYTES {
+		t.Fatalf("signature %d bytes, expect %d", len(sig), SIGNATURE_BYTES)
+	}
+	if !VerifyDigest(pub, digest[:], sig) {
+		t.Fatalf("VerifyDigest returned false, expected true")
+	}
+	if mustSign(t, Message(digest), pri) != mustSig(t, sig) {
+		t.Fatalf("crypto.Signer signature differs from Sign")
+	}
+
+	// nil opts is fine too
+	_, err = signer.Sign(nil, digest[:], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other := sha256.Sum256([]byte("something else"))
+	if VerifyDigest(pub, other[:], sig) {
+		t.Fatalf("VerifyDigest returned true for the wrong digest")
+	}
+	if VerifyDigest(pub, digest[:31], sig) {
+		t.Fatalf("VerifyDigest returned true for a short digest")
+	}
+}
+
+// TestCryptoSignerBadDigest checks wrong digest lengths and hash functions
+// are errors rather than being truncated or padded.
+func TestCryptoSignerBadDigest(t *testing.T) {
+	pri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := NewCryptoSigner(pri)
+
+	for _, n := range []int{0, 20, 31, 33, 64} {
+		_, err = signer.Sign(nil, make([]byte, n), crypto.SHA256)
+		if err == nil {
+			t.Fatalf("signed a %d byte digest without error", n)
+		}
+	}
+	_, err = signer.Sign(nil, make([]byte, 32), crypto.SHA512_256)
+	if err == nil {
+		t.Fatalf("signed with SHA512_256 opts without error")
+	}
+}
+
+func mustSig(t *testing.T, b []byte) Signature {
+	sig, err := SignatureFromBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
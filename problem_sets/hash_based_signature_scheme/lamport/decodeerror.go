@@ -0,0 +1,101 @@
+package lamport
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// DecodeReason says what was wrong with a hex string given to one of the
+// HexTo functions.
+type DecodeReason int
+
+const (
+	DECODE_LENGTH       DecodeReason = iota + 1 // wrong number of characters
+	DECODE_INVALID_CHAR                         // a character that isn't hex
+)
+
+func (self DecodeReason) String() string {
+	switch self {
+	case DECODE_LENGTH:
+		return "wrong length"
+	case DECODE_INVALID_CHAR:
+		return "invalid character"
+	}
+	return fmt.Sprintf("DecodeReason(%d)", int(self))
+}
+
+// DecodeError is returned by the HexTo functions.  Kind is what was being
+// decoded ("pubkey", "signature" or "privkey").  Offset is the index into the
+// string of the first bad character; for a length error that's where the
+// string stops short or the first character past the end.  Err is the
+// underlying encoding/hex error for an invalid character, and nil for a
+// length error.
+type DecodeError struct {
+	Kind   string
+	Reason DecodeReason
+	Offset int
+	Length int // len of the string given
+	Expect int // len it should have been
+	Err    error
+}
+
+func (self *DecodeError) Error() string {
+	if self.Reason == DECODE_LENGTH {
+		return fmt.Sprintf("%s string %d characters, expect %d",
+			self.Kind, self.Length, self.Expect)
+	}
+	return fmt.Sprintf("%s string: invalid character %q at offset %d",
+		self.Kind, self.badChar(), self.Offset)
+}
+
+func (self *DecodeError) Unwrap() error {
+	return self.Err
+}
+
+// Is matches ErrWrongLength for a length error and ErrInvalidHex for an
+// invalid character, alongside whatever Unwrap leads to.
+func (self *DecodeError) Is(target error) bool {
+	switch self.Reason {
+	case DECODE_LENGTH:
+		return target == ErrWrongLength
+	case DECODE_INVALID_CHAR:
+		return target == ErrInvalidHex
+	}
+	return false
+}
+
+// badChar is the character InvalidByteError reported, if there is one.
+func (self *DecodeError) badChar() byte {
+	if b, ok := self.Err.(hex.InvalidByteError); ok {
+		return byte(b)
+	}
+	return 0
+}
+
+// decodeHexString decodes s, which should be exactly expect characters of
+// hex, returning a *DecodeError for kind if it isn't.
+func decodeHexString(kind, s string, expect int) ([]byte, error) {
+	if len(s) != expect {
+		offset := len(s)
+		if offset > expect {
+			offset = expect
+		}
+		return nil, &DecodeError{Kind: kind, Reason: DECODE_LENGTH,
+			Offset: offset, Length: len(s), Expect: expect}
+	}
+	bts, err := hex.DecodeString(s)
+	if err != nil {
+		// hex doesn't say where the bad byte was, so find it
+		offset := 0
+		for offset < len(s) && isHexChar(s[offset]) {
+			offset++
+		}
+		return nil, &DecodeError{Kind: kind, Reason: DECODE_INVALID_CHAR,
+			Offset: offset, Length: len(s), Expect: expect, Err: err}
+	}
+	return bts, nil
+}
+
+func isHexChar(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
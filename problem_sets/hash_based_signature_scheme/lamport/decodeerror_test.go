@@ -0,0 +1,72 @@
+package lamport
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestDecodeErrorOffset puts a bad character at a known place in each kind of
+// hex string and checks the DecodeError points at it.
+func TestDecodeErrorOffset(t *testing.T) {
+	pri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	offset := 12345
+
+	pubHex := []byte(hexPubkey1)
+	pubHex[offset] = 'g'
+	_, err = HexToPubkey(string(pubHex))
+	checkDecodeError(t, err, "pubkey", DECODE_INVALID_CHAR, offset)
+	var ibe hex.InvalidByteError
+	if !errors.As(err, &ibe) || byte(ibe) != 'g' {
+		t.Fatalf("DecodeError doesn't unwrap to the hex.InvalidByteError")
+	}
+
+	sigHex := []byte(hexSignature1)
+	sigHex[offset] = 'z'
+	_, err = HexToSignature(string(sigHex))
+	checkDecodeError(t, err, "signature", DECODE_INVALID_CHAR, offset)
+
+	priHex := []byte(pri.ToHex())
+	priHex[0] = ' '
+	_, err = HexToPrivkey(string(priHex))
+	checkDecodeError(t, err, "privkey", DECODE_INVALID_CHAR, 0)
+
+	// an odd byte in the second character of a pair
+	pubHex = []byte(hexPubkey1)
+	pubHex[len(pubHex)-1] = 'x'
+	_, err = PubkeyFromInterleavedHex(string(pubHex))
+	checkDecodeError(t, err, "pubkey", DECODE_INVALID_CHAR, len(pubHex)-1)
+}
+
+// TestDecodeErrorLength checks short and long strings are length errors that
+// say which kind of thing was being decoded.
+func TestDecodeErrorLength(t *testing.T) {
+	_, err := HexToSignature(hexSignature1[:100])
+	checkDecodeError(t, err, "signature", DECODE_LENGTH, 100)
+	if !strings.HasPrefix(err.Error(), "signature string 100 characters") {
+		t.Fatalf("error %q doesn't describe a signature", err)
+	}
+
+	_, err = HexToPubkey(hexPubkey1 + "00")
+	checkDecodeError(t, err, "pubkey", DECODE_LENGTH, len(hexPubkey1))
+	if errors.Unwrap(err) != nil {
+		t.Fatalf("length error unwraps to %v, expect nil", errors.Unwrap(err))
+	}
+}
+
+func checkDecodeError(
+	t *testing.T, err error, kind string, reason DecodeReason, offset int) {
+	t.Helper()
+	var de *DecodeError
+	if !errors.As(err, &de) {
+		t.Fatalf("got %v, expect a *DecodeError", err)
+	}
+	if de.Kind != kind || de.Reason != reason || de.Offset != offset {
+		t.Fatalf("got %s %v at %d, expect %s %v at %d",
+			de.Kind, de.Reason, de.Offset, kind, reason, offset)
+	}
+}
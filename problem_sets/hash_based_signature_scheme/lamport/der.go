@@ -0,0 +1,73 @@
+package lamport
+
+import (
+	"encoding/asn1"
+	"fmt"
+)
+
+/*
+DER encoding, for tools that only take DER blobs.  Both keys and signatures
+are
+
+    SEQUENCE {
+        algorithm  OBJECT IDENTIFIER,
+        payload    OCTET STRING      -- same as Bytes()
+    }
+
+The OIDs hang off 1.3.6.1.4.1.32473, the private enterprise number set aside
+for examples and documentation (RFC 5612).
+*/
+
+var OID_LAMPORT_PUBKEY = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 32473, 1, 1}
+var OID_LAMPORT_SIGNATURE = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 32473, 1, 2}
+
+// derObject is the ASN.1 structure shared by keys and signatures.
+type derObject struct {
+	Algorithm asn1.ObjectIdentifier
+	Payload   []byte
+}
+
+// MarshalDER returns the DER encoding of the pubkey.
+func (self PublicKey) MarshalDER() ([]byte, error) {
+	return asn1.Marshal(derObject{OID_LAMPORT_PUBKEY, self.Bytes()})
+}
+
+// ParseDER decodes a pubkey from MarshalDER.  Other OIDs and trailing bytes
+// after the SEQUENCE are rejected.
+func (self *PublicKey) ParseDER(der []byte) error {
+	payload, err := parseDER(der, OID_LAMPORT_PUBKEY)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(payload)
+}
+
+// MarshalDER returns the DER encoding of the signature.
+func (self Signature) MarshalDER() ([]byte, error) {
+	return asn1.Marshal(derObject{OID_LAMPORT_SIGNATURE, self.Bytes()})
+}
+
+// ParseDER decodes a signature from MarshalDER.
+func (self *Signature) ParseDER(der []byte) error {
+	payload, err := parseDER(der, OID_LAMPORT_SIGNATURE)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(payload)
+}
+
+// parseDER unwraps the SEQUENCE and returns the payload if the OID is oid.
+func parseDER(der []byte, oid asn1.ObjectIdentifier) ([]byte, error) {
+	var obj derObject
+	rest, err := asn1.Unmarshal(der, &obj)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("der: %d trailing bytes", len(rest))
+	}
+	if !obj.Algorithm.Equal(oid) {
+		return nil, fmt.Errorf("der: algorithm %s, expect %s", obj.Algorithm, oid)
+	}
+	return obj.Payload, nil
+}
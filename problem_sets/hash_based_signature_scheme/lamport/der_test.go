@@ -0,0 +1,105 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/hex"
+	"testing"
+)
+
+// TestDERVectors pins the DER encoding of the provided pubkey and signature.
+func TestDERVectors(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := pub.MarshalDER()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// SEQUENCE(16400) { OID 1.3.6.1.4.1.32473.1.1, OCTET STRING(16384) }
+	header, _ := hex.DecodeString("30824010060a2b0601040181fd59010104824000")
+	if !bytes.HasPrefix(der, header) {
+		t.Fatalf("pubkey DER header %x, expect %x", der[:len(header)], header)
+	}
+	sum := sha256.Sum256(der)
+	if hex.EncodeToString(sum[:]) !=
+		"dab6f4ef00099c7d22f8e6bafd1916abfebdd361b37ebcfca292ce02837b180d" {
+		t.Fatalf("pubkey DER hash %x", sum)
+	}
+	var pub2 PublicKey
+	err = pub2.ParseDER(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("pubkey changed after DER round trip")
+	}
+
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err = sig.MarshalDER()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum = sha256.Sum256(der)
+	if hex.EncodeToString(sum[:]) !=
+		"29a5dea7f679d452afa351d538ccf0cdb042a4c1b91d68234807eb96d787d55b" {
+		t.Fatalf("signature DER hash %x", sum)
+	}
+	var sig2 Signature
+	err = sig2.ParseDER(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig2 != sig {
+		t.Fatalf("signature changed after DER round trip")
+	}
+}
+
+// TestDERRejects checks the wrong OID, trailing bytes, and wrong payload size.
+func TestDERRejects(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := sig.MarshalDER()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pub PublicKey
+	err = pub.ParseDER(der)
+	if err == nil {
+		t.Fatalf("signature DER parsed as pubkey")
+	}
+
+	var sig2 Signature
+	err = sig2.ParseDER(append(der, 0))
+	if err == nil {
+		t.Fatalf("DER with trailing byte parsed without error")
+	}
+
+	other, err := asn1.Marshal(derObject{
+		asn1.ObjectIdentifier{1, 2, 3}, sig.Bytes()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sig2.ParseDER(other)
+	if err == nil {
+		t.Fatalf("DER with unknown OID parsed without error")
+	}
+
+	short, err := asn1.Marshal(derObject{
+		OID_LAMPORT_SIGNATURE, sig.Bytes()[1:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sig2.ParseDER(short)
+	if err == nil {
+		t.Fatalf("DER with short payload parsed without error")
+	}
+}
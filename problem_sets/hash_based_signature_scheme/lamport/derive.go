@@ -0,0 +1,65 @@
+package lamport
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+/*
+Child seeds are derived from a master seed one path element at a time:
+
+    child = HMAC-SHA256(key = parent, "lamport child" || index)
+
+with index 4 bytes, big endian.  A seed for path a/b/c is the child c of
+the child b of the child a of the master, so a backup of the master, for
+instance as a mnemonic, recovers every key under it.  Without the parent,
+HMAC gives no way to relate one child to another, and without a child's
+parent nothing leads back up the path.
+*/
+
+var childLabel = []byte("lamport child")
+
+// DeriveChildSeed returns the seed at path under master.  An empty path is
+// master itself.
+func DeriveChildSeed(master [SEED_BYTES]byte, path ...uint32) [SEED_BYTES]byte {
+	seed := master
+	var index [4]byte
+	for _, i := range path {
+		mac := hmac.New(sha256.New, seed[:])
+		mac.Write(childLabel)
+		binary.BigEndian.PutUint32(index[:], i)
+		mac.Write(index[:])
+		mac.Sum(seed[:0])
+	}
+	return seed
+}
+
+// DeriveKeyPair returns the key for the seed at path under master, expanded
+// to a PrivateKey, and its pubkey.
+func DeriveKeyPair(master [SEED_BYTES]byte, path ...uint32) (PrivateKey, PublicKey) {
+	seed := SeedPrivateKey{Seed: DeriveChildSeed(master, path...)}
+	pri := seed.Expand()
+	seed.Zeroize()
+	return pri, pri.GetPublicKey()
+}
+
+// KeyRingFromSeed returns a ring of count keys where key i is
+// DeriveKeyPair(master, i), so the ring can be rebuilt from master alone.
+// Which keys have been used isn't in master, so a rebuilt ring needs a
+// Store, or its saved state, to stay one-time.
+func KeyRingFromSeed(master [SEED_BYTES]byte, count int) (*KeyRing, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("key ring: %d keys, expect at least 1", count)
+	}
+	ring := &KeyRing{
+		pri:  make([]PrivateKey, count),
+		pub:  make([]PublicKey, count),
+		used: make([]bool, count),
+	}
+	for i := 0; i < count; i++ {
+		ring.pri[i], ring.pub[i] = DeriveKeyPair(master, uint32(i))
+	}
+	return ring, nil
+}
@@ -0,0 +1,120 @@
+package lamport
+
+import (
+	"encoding/hex"
+	"math/bits"
+	"testing"
+)
+
+func deriveTestMaster() [SEED_BYTES]byte {
+	var master [SEED_BYTES]byte
+	for i := range master {
+		master[i] = byte(i)
+	}
+	return master
+}
+
+// TestDeriveChildSeedVectors checks DeriveChildSeed against values computed
+// independently with Python's hmac module.
+func TestDeriveChildSeedVectors(t *testing.T) {
+	master := deriveTestMaster()
+	vectors := []struct {
+		path   []uint32
+		expect string
+	}{
+		{nil, "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},
+		{[]uint32{0}, "764edaa3bf9c508008cb92ffe223adb9c0ef3537319f42d51f621d2c10697b14"},
+		{[]uint32{0, 1}, "df57c55e46fcf548d531ec093b42db9280d2ccd5dabb5fb2b2fb84219c4629e0"},
+		{[]uint32{0x80000000, 7, 42},
+			"15a56978d2137c22ba7fa89b267eef79069b781c2157bb047a0ab771e2e1087d"},
+	}
+	for _, v := range vectors {
+		got := DeriveChildSeed(master, v.path...)
+		if hex.EncodeToString(got[:]) != v.expect {
+			t.Fatalf("path %v: got %x, expect %s", v.path, got, v.expect)
+		}
+	}
+	// a path is the same as deriving one step at a time
+	step := DeriveChildSeed(DeriveChildSeed(master, 0), 1)
+	if step != DeriveChildSeed(master, 0, 1) {
+		t.Fatalf("0/1 differs from deriving 0 then 1")
+	}
+
+	_, pub := DeriveKeyPair(master, 3)
+	fp := pub.Fingerprint()
+	expect := "f355eebdd4eadd2c7369fef77d59b70034a021b3475cce06241360f8ff4d241a"
+	if hex.EncodeToString(fp[:]) != expect {
+		t.Fatalf("key 3 fingerprint %x, expect %s", fp, expect)
+	}
+}
+
+// TestDeriveChildSeedIndependence is a rough check that nearby paths give
+// unrelated seeds: every pair of them should differ in about half their
+// bits, and each bit should be set in about half the seeds.
+func TestDeriveChildSeedIndependence(t *testing.T) {
+	master := deriveTestMaster()
+	var seeds [][SEED_BYTES]byte
+	for i := uint32(0); i < 64; i++ {
+		seeds = append(seeds, DeriveChildSeed(master, i))
+		seeds = append(seeds, DeriveChildSeed(master, 1000, i))
+		seeds = append(seeds, DeriveChildSeed(master, i, 1000))
+	}
+	seeds = append(seeds, master)
+
+	var ones [8 * SEED_BYTES]int
+	for i, a := range seeds {
+		for j := range a {
+			for k := 0; k < 8; k++ {
+				ones[8*j+k] += int(a[j] >> (7 - k) & 1)
+			}
+		}
+		for _, b := range seeds[i+1:] {
+			distance := 0
+			for j := range a {
+				distance += bits.OnesCount8(a[j] ^ b[j])
+			}
+			// the mean is 128 with a standard deviation of 8
+			if distance < 80 || distance > 176 {
+				t.Fatalf("seeds %x and %x differ in %d bits", a, b, distance)
+			}
+		}
+	}
+	for i, n := range ones {
+		// mean 96.5 with a standard deviation under 7
+		if n < 60 || n > 133 {
+			t.Fatalf("bit %d set in %d of %d seeds", i, n, len(seeds))
+		}
+	}
+}
+
+// TestKeyRingFromSeed checks the ring's keys are the derived ones and that
+// the same master gives the same ring.
+func TestKeyRingFromSeed(t *testing.T) {
+	master := deriveTestMaster()
+	ring, err := KeyRingFromSeed(master, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := KeyRingFromSeed(master, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubs, pubs2 := ring.PublicKeys(), again.PublicKeys()
+	for i := range pubs {
+		_, pub := DeriveKeyPair(master, uint32(i))
+		if !pubs[i].Equal(pub) || !pubs2[i].Equal(pub) {
+			t.Fatalf("key %d isn't DeriveKeyPair(master, %d)", i, i)
+		}
+	}
+	msg := GetMessageFromString("derived")
+	index, sig, err := ring.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.VerifyAt(index, msg, sig) {
+		t.Fatalf("VerifyAt returned false, expected true")
+	}
+	if _, err := KeyRingFromSeed(master, 0); err == nil {
+		t.Fatalf("KeyRingFromSeed accepted 0 keys")
+	}
+}
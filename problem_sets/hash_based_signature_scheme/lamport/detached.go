@@ -0,0 +1,135 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+)
+
+/*
+Detached .lsig signature files, for signing files on disk and shipping the
+signature alongside.  The layout is:
+
+    magic        4 bytes  "LSIG"
+    version      1 byte   LSIG_VERSION
+    hash         1 byte   LSIG_HASH_SHA256, how the file was hashed
+    fingerprint  32 bytes fingerprint of the signing pubkey
+    signature    SIGNATURE_BYTES
+
+and nothing after it.
+*/
+
+var LSIG_MAGIC = [4]byte{'L', 'S', 'I', 'G'}
+
+const LSIG_VERSION = 1
+const LSIG_HASH_SHA256 = 1
+const LSIG_HEADER_BYTES = 4 + 1 + 1 + 32
+const LSIG_BYTES = LSIG_HEADER_BYTES + SIGNATURE_BYTES
+
+// DetachedSignature is a decoded .lsig file.
+type DetachedSignature struct {
+	Version     byte
+	HashAlg     byte
+	Fingerprint Fingerprint
+	Signature   Signature
+}
+
+// WriteDetached writes sig, made with the key for pub, to w in .lsig format.
+func WriteDetached(w io.Writer, sig Signature, pub PublicKey) error {
+	fp := pub.Fingerprint()
+
+	var header bytes.Buffer
+	header.Write(LSIG_MAGIC[:])
+	header.WriteByte(LSIG_VERSION)
+	header.WriteByte(LSIG_HASH_SHA256)
+	header.Write(fp[:])
+
+	_, err := header.WriteTo(w)
+	if err != nil {
+		return err
+	}
+	_, err = sig.WriteTo(w)
+	return err
+}
+
+// ReadDetached reads a .lsig file from r.  Anything that isn't exactly one
+// well formed .lsig is an error saying what was wrong with it.  An error
+// from r itself, other than running out, is wrapped and returned.
+func ReadDetached(r io.Reader) (DetachedSignature, error) {
+	var d DetachedSignature
+
+	var header [LSIG_HEADER_BYTES]byte
+	n, err := io.ReadFull(r, header[:])
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return d, fmt.Errorf("lsig: header truncated at %d of %d bytes",
+			n, LSIG_HEADER_BYTES)
+	}
+	if err != nil {
+		return d, fmt.Errorf("lsig: reading header: %w", err)
+	}
+	if !bytes.Equal(header[:4], LSIG_MAGIC[:]) {
+		return d, fmt.Errorf("lsig: bad magic %q, not a .lsig file", header[:4])
+	}
+	d.Version = header[4]
+	if d.Version != LSIG_VERSION {
+		return d, fmt.Errorf("lsig: unknown version %d, expect %d",
+			d.Version, LSIG_VERSION)
+	}
+	d.HashAlg = header[5]
+	if d.HashAlg != LSIG_HASH_SHA256 {
+		return d, fmt.Errorf("lsig: unknown hash algorithm %d", d.HashAlg)
+	}
+	copy(d.Fingerprint[:], header[6:])
+
+	m, err := d.Signature.ReadFrom(r)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return d, fmt.Errorf("lsig: signature truncated at %d of %d bytes",
+			m, SIGNATURE_BYTES)
+	}
+	if err != nil {
+		return d, fmt.Errorf("lsig: reading signature: %w", err)
+	}
+
+	var extra [1]byte
+	if k, _ := r.Read(extra[:]); k != 0 {
+		return d, fmt.Errorf("lsig: trailing data after signature")
+	}
+	return d, nil
+}
+
+// VerifyFile hashes the file at path with sha256 and checks it against the
+// detached signature in sigPath, which must have been made by pub.
+func VerifyFile(path string, sigPath string, pub PublicKey) error {
+	sf, err := os.Open(sigPath)
+	if err != nil {
+		return err
+	}
+	defer sf.Close()
+	d, err := ReadDetached(sf)
+	if err != nil {
+		return fmt.Errorf("%s: %w", sigPath, err)
+	}
+	if d.Fingerprint != pub.Fingerprint() {
+		return fmt.Errorf("%s: %w", sigPath, ErrWrongKey)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+	var msg Message
+	copy(msg[:], h.Sum(nil))
+
+	if !Verify(msg, pub, d.Signature) {
+		return fmt.Errorf("%s: %w", path, ErrInvalidSignature)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// writeSignedFile writes data to a temp file and a detached signature for it
+// next to it, returning both paths.
+func writeSignedFile(t *testing.T, data []byte, sec PrivateKey, pub PublicKey) (string, string) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.bin")
+	sigPath := path + ".lsig"
+
+	err := os.WriteFile(path, data, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = WriteDetached(&buf, mustSign(t, sha256.Sum256(data), sec), pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != LSIG_BYTES {
+		t.Fatalf("lsig %d bytes, expect %d", buf.Len(), LSIG_BYTES)
+	}
+	err = os.WriteFile(sigPath, buf.Bytes(), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path, sigPath
+}
+
+// TestVerifyFile signs a file and verifies it, then changes the file.
+func TestVerifyFile(t *testing.T) {
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, sigPath := writeSignedFile(t, []byte("firmware v1"), sec, pub)
+
+	err = VerifyFile(path, sigPath, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, pub2, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyFile(path, sigPath, pub2)
+	if !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("got %v, expect ErrWrongKey", err)
+	}
+
+	err = os.WriteFile(path, []byte("firmware v2"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyFile(path, sigPath, pub)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+}
+
+// TestReadDetachedCorrupt feeds ReadDetached damaged files and checks the
+// error says what's wrong.
+func TestReadDetachedCorrupt(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = WriteDetached(&buf, sig, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	good := buf.Bytes()
+
+	d, err := ReadDetached(bytes.NewReader(good))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Signature != sig || d.Fingerprint != pub.Fingerprint() {
+		t.Fatalf("ReadDetached changed signature or fingerprint")
+	}
+
+	corrupt := func(i int, b byte) []byte {
+		c := append([]byte{}, good...)
+		c[i] = b
+		return c
+	}
+	cases := []struct {
+		data []byte
+		msg  string
+	}{
+		{good[:10], "header truncated"},
+		{good[:LSIG_BYTES-1], "signature truncated"},
+		{append(append([]byte{}, good...), 0), "trailing data"},
+		{corrupt(0, 'X'), "bad magic"},
+		{corrupt(4, 9), "unknown version"},
+		{corrupt(5, 9), "unknown hash"},
+	}
+	for _, c := range cases {
+		_, err = ReadDetached(bytes.NewReader(c.data))
+		if err == nil || !strings.Contains(err.Error(), c.msg) {
+			t.Fatalf("got %v, expect error containing %q", err, c.msg)
+		}
+	}
+}
+
+// TestReadDetachedReadError checks an error from the reader is passed on,
+// not reported as a truncated file.
+func TestReadDetachedReadError(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = WriteDetached(&buf, sig, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	good := buf.Bytes()
+
+	for _, cut := range []int{0, 10, LSIG_HEADER_BYTES, LSIG_BYTES - 1} {
+		r := io.MultiReader(bytes.NewReader(good[:cut]),
+			iotest.ErrReader(iotest.ErrTimeout))
+		_, err = ReadDetached(r)
+		if !errors.Is(err, iotest.ErrTimeout) {
+			t.Fatalf("cut at %d: got %v, expect iotest.ErrTimeout", cut, err)
+		}
+		if strings.Contains(err.Error(), "truncated") {
+			t.Fatalf("cut at %d: got %v, expect no mention of truncation", cut, err)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"testing"
+)
+
+// TestGetMessage checks the hash layout against a hand built input.
+func TestGetMessage(t *testing.T) {
+	input := append([]byte{0, 0, 0, 0, 0, 0, 0, 5}, "email"...)
+	input = append(input, "hello"...)
+	if GetMessage("email", []byte("hello")) != sha256.Sum256(input) {
+		t.Fatalf("GetMessage doesn't hash length, domain, data")
+	}
+	if GetMessage("ab", []byte("c")) == GetMessage("a", []byte("bc")) {
+		t.Fatalf("domain boundary doesn't change the hash")
+	}
+	if GetMessage("", []byte("1")) == GetMessageFromString("1") {
+		t.Fatalf("empty domain hashes the same as no domain")
+	}
+}
+
+// TestSignInDomain checks a signature only verifies in its own domain.
+func TestSignInDomain(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("pay alice 5")
+	sig, err := SignInDomain("payments/v1", data, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !VerifyInDomain("payments/v1", data, pub, sig) {
+		t.Fatalf("VerifyInDomain returned false, expected true")
+	}
+	if VerifyInDomain("payments/v2", data, pub, sig) {
+		t.Fatalf("signature verified in another domain")
+	}
+	if VerifyBytes(data, pub, sig) {
+		t.Fatalf("domain signature verified with no domain")
+	}
+}
@@ -0,0 +1,269 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// Sizes of the raw binary encodings.  Keys are 2 rows of 256 blocks, signatures
+// are a single row.
+const PUBKEY_BYTES = 2 * MESSAGE_BITS * MESSAGE_BYTES  // 16384
+const PRIVKEY_BYTES = 2 * MESSAGE_BITS * MESSAGE_BYTES // 16384
+const SIGNATURE_BYTES = MESSAGE_BITS * MESSAGE_BYTES   // 8192
+
+// Bytes returns the raw pubkey: all 256 blocks of row 0 followed by all 256
+// blocks of row 1.  This is the same ordering as the hex encoding.
+func (self PublicKey) Bytes() []byte {
+	var buf bytes.Buffer
+	buf.Grow(PUBKEY_BYTES)
+	for _, block := range self.ZeroHash {
+		buf.Write(block[:])
+	}
+	for _, block := range self.OneHash {
+		buf.Write(block[:])
+	}
+	return buf.Bytes()
+}
+
+// Bytes returns the raw private key, using the same layout as PublicKey.Bytes.
+func (self PrivateKey) Bytes() []byte {
+	var buf bytes.Buffer
+	buf.Grow(PRIVKEY_BYTES)
+	for _, block := range self.ZeroHash {
+		buf.Write(block[:])
+	}
+	for _, block := range self.OneHash {
+		buf.Write(block[:])
+	}
+	return buf.Bytes()
+}
+
+// Bytes returns the raw signature, every block in sequence.
+func (self Signature) Bytes() []byte {
+	var buf bytes.Buffer
+	buf.Grow(SIGNATURE_BYTES)
+	for _, block := range self.Preimage {
+		buf.Write(block[:])
+	}
+	return buf.Bytes()
+}
+
+// PubkeyFromBytes is the inverse of PublicKey.Bytes.  Unlike BlockFromByteSlice
+// it won't silently truncate or pad; anything other than exactly PUBKEY_BYTES
+// is an error.
+func PubkeyFromBytes(b []byte) (PublicKey, error) {
+	var p PublicKey
+
+	if len(b) != PUBKEY_BYTES {
+		return p, fmt.Errorf(
+			"%w: Pubkey %d bytes, expect %d", ErrWrongLength, len(b), PUBKEY_BYTES)
+	}
+	buf := bytes.NewBuffer(b)
+	var err error
+
+	for i := range p.ZeroHash {
+		p.ZeroHash[i], err = BlockFromByteSliceStrict(buf.Next(MESSAGE_BYTES))
+		if err != nil {
+			return PublicKey{}, err
+		}
+	}
+	for i := range p.OneHash {
+		p.OneHash[i], err = BlockFromByteSliceStrict(buf.Next(MESSAGE_BYTES))
+		if err != nil {
+			return PublicKey{}, err
+		}
+	}
+	return p, nil
+}
+
+// PrivkeyFromBytes is the inverse of PrivateKey.Bytes.
+func PrivkeyFromBytes(b []byte) (PrivateKey, error) {
+	var p PrivateKey
+
+	if len(b) != PRIVKEY_BYTES {
+		return p, fmt.Errorf(
+			"%w: Privkey %d bytes, expect %d", ErrWrongLength, len(b), PRIVKEY_BYTES)
+	}
+	buf := bytes.NewBuffer(b)
+	var err error
+
+	for i := range p.ZeroHash {
+		p.ZeroHash[i], err = BlockFromByteSliceStrict(buf.Next(MESSAGE_BYTES))
+		if err != nil {
+			return PrivateKey{}, err
+		}
+	}
+	for i := range p.OneHash {
+		p.OneHash[i], err = BlockFromByteSliceStrict(buf.Next(MESSAGE_BYTES))
+		if err != nil {
+			return PrivateKey{}, err
+		}
+	}
+	return p, nil
+}
+
+// SignatureFromBytes is the inverse of Signature.Bytes.
+func SignatureFromBytes(b []byte) (Signature, error) {
+	var sig Signature
+
+	if len(b) != SIGNATURE_BYTES {
+		return sig, fmt.Errorf(
+			"%w: Signature %d bytes, expect %d", ErrWrongLength, len(b), SIGNATURE_BYTES)
+	}
+	buf := bytes.NewBuffer(b)
+	var err error
+
+	for i := range sig.Preimage {
+		sig.Preimage[i], err = BlockFromByteSliceStrict(buf.Next(MESSAGE_BYTES))
+		if err != nil {
+			return Signature{}, err
+		}
+	}
+	return sig, nil
+}
+
+// ToHex returns the pubkey as a hex string, in the same order that
+// HexToPubkey reads: all 256 blocks of row 0 followed by all 256 blocks of
+// row 1, 32768 characters in total.
+func (self PublicKey) ToHex() string {
+	return hex.EncodeToString(self.Bytes())
+}
+
+// ToHex returns the signature as a hex string, every block in sequence, which
+// can be read back with HexToSignature.
+func (self Signature) ToHex() string {
+	return hex.EncodeToString(self.Bytes())
+}
+
+// ToHex returns the private key as a hex string, using the same layout as
+// PublicKey.ToHex: row 0 then row 1, 512 blocks in total.  Keep it secret!
+func (self PrivateKey) ToHex() string {
+	return hex.EncodeToString(self.Bytes())
+}
+
+// HexToPrivkey takes a string from PrivateKey.ToHex() and turns it into a
+// private key.  Like HexToPubkey, it will return an error if there are non hex
+// characters or if the length is wrong.
+func HexToPrivkey(s string) (PrivateKey, error) {
+	expectedLength := 256 * 2 * 64 // 256 blocks long, 2 rows, 64 hex char per block
+
+	bts, err := decodeHexString("privkey", s, expectedLength)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	return PrivkeyFromBytes(bts)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.  The layout is the same
+// as Bytes(): row 0 then row 1, PUBKEY_BYTES long.
+func (self PublicKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  The whole key is
+// overwritten, and on error the receiver is left untouched.
+func (self *PublicKey) UnmarshalBinary(data []byte) error {
+	p, err := PubkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = p
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the same layout as
+// PublicKey.
+func (self PrivateKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *PrivateKey) UnmarshalBinary(data []byte) error {
+	p, err := PrivkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = p
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.  The layout is every
+// preimage block in sequence, SIGNATURE_BYTES long.
+func (self Signature) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *Signature) UnmarshalBinary(data []byte) error {
+	sig, err := SignatureFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = sig
+	return nil
+}
+
+// ToBase64 returns the pubkey's binary encoding as unpadded URL-safe base64,
+// suitable for URLs and JSON.
+func (self PublicKey) ToBase64() string {
+	return base64.RawURLEncoding.EncodeToString(self.Bytes())
+}
+
+// PubkeyFromBase64 decodes a string from PublicKey.ToBase64.  Only the
+// unpadded URL alphabet is accepted; padding or standard alphabet characters
+// are an error, as is anything that doesn't decode to exactly PUBKEY_BYTES.
+func PubkeyFromBase64(s string) (PublicKey, error) {
+	b, err := base64.RawURLEncoding.Strict().DecodeString(s)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	return PubkeyFromBytes(b)
+}
+
+// ToBase64 returns the signature's binary encoding as unpadded URL-safe base64.
+func (self Signature) ToBase64() string {
+	return base64.RawURLEncoding.EncodeToString(self.Bytes())
+}
+
+// SignatureFromBase64 decodes a string from Signature.ToBase64, with the same
+// strictness as PubkeyFromBase64.
+func SignatureFromBase64(s string) (Signature, error) {
+	b, err := base64.RawURLEncoding.Strict().DecodeString(s)
+	if err != nil {
+		return Signature{}, err
+	}
+	return SignatureFromBytes(b)
+}
+
+// GobEncode implements gob.GobEncoder using the compact binary form, so gob
+// doesn't walk the nested arrays element by element.
+func (self PublicKey) GobEncode() ([]byte, error) {
+	return self.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (self *PublicKey) GobDecode(data []byte) error {
+	return self.UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder using the compact binary form.
+func (self PrivateKey) GobEncode() ([]byte, error) {
+	return self.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (self *PrivateKey) GobDecode(data []byte) error {
+	return self.UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder using the compact binary form.
+func (self Signature) GobEncode() ([]byte, error) {
+	return self.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (self *Signature) GobDecode(data []byte) error {
+	return self.UnmarshalBinary(data)
+}
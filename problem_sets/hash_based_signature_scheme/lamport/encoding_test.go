@@ -0,0 +1,416 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// TestPubkeyHexRoundTrip decodes the provided pubkey and checks that ToHex
+// gives back exactly the same string, pinning down the block ordering.
+func TestPubkeyHexRoundTrip(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pub.ToHex() != hexPubkey1 {
+		t.Fatalf("PublicKey.ToHex() doesn't match hexPubkey1")
+	}
+
+	// and a freshly generated key should survive the trip as well
+	_, pub, err = GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub2, err := HexToPubkey(pub.ToHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("pubkey changed after hex round trip")
+	}
+}
+
+// TestSignatureHexRoundTrip does the same for the provided signatures.
+func TestSignatureHexRoundTrip(t *testing.T) {
+	for i, s := range []string{
+		hexSignature1, hexSignature2, hexSignature3, hexSignature4} {
+		sig, err := HexToSignature(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sig.ToHex() != s {
+			t.Fatalf("Signature.ToHex() doesn't match hexSignature%d", i+1)
+		}
+	}
+}
+
+// TestPrivkeyHexRoundTrip encodes a new private key and makes sure the decoded
+// key still signs for the same pubkey.
+func TestPrivkeyHexRoundTrip(t *testing.T) {
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sec2, err := HexToPrivkey(sec.ToHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sec2 != sec {
+		t.Fatalf("privkey changed after hex round trip")
+	}
+
+	msg := GetMessageFromString("persisted")
+	if !Verify(msg, pub, mustSign(t, msg, sec2)) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+}
+
+// TestHexToPrivkeyErrors feeds HexToPrivkey bad lengths and non hex characters.
+func TestHexToPrivkeyErrors(t *testing.T) {
+	sec, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := sec.ToHex()
+
+	_, err = HexToPrivkey(s[:len(s)-2])
+	if err == nil {
+		t.Fatalf("short privkey string decoded without error")
+	}
+	_, err = HexToPrivkey(s + "00")
+	if err == nil {
+		t.Fatalf("long privkey string decoded without error")
+	}
+	_, err = HexToPrivkey("zz" + s[2:])
+	if err == nil {
+		t.Fatalf("non hex privkey string decoded without error")
+	}
+}
+
+// TestBinaryMatchesHex checks that the binary and hex encodings describe the
+// same key, and that the binary decoders round trip.
+func TestBinaryMatchesHex(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(pub.Bytes()) != hexPubkey1 {
+		t.Fatalf("PublicKey.Bytes() doesn't match hexPubkey1")
+	}
+	pub2, err := PubkeyFromBytes(pub.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("pubkey changed after binary round trip")
+	}
+
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(sig.Bytes()) != hexSignature1 {
+		t.Fatalf("Signature.Bytes() doesn't match hexSignature1")
+	}
+	sig2, err := SignatureFromBytes(sig.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig2 != sig {
+		t.Fatalf("signature changed after binary round trip")
+	}
+
+	sec, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(sec.Bytes()) != sec.ToHex() {
+		t.Fatalf("PrivateKey.Bytes() doesn't match PrivateKey.ToHex()")
+	}
+	sec2, err := PrivkeyFromBytes(sec.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sec2 != sec {
+		t.Fatalf("privkey changed after binary round trip")
+	}
+}
+
+// TestFromBytesWrongLength makes sure the decoders reject short and long input
+// rather than truncating it.
+func TestFromBytesWrongLength(t *testing.T) {
+	for _, n := range []int{0, 1, SIGNATURE_BYTES - 1, SIGNATURE_BYTES + 1} {
+		_, err := SignatureFromBytes(make([]byte, n))
+		if err == nil {
+			t.Fatalf("SignatureFromBytes accepted %d bytes", n)
+		}
+	}
+	for _, n := range []int{0, SIGNATURE_BYTES, PUBKEY_BYTES - 1, PUBKEY_BYTES + 1} {
+		_, err := PubkeyFromBytes(make([]byte, n))
+		if err == nil {
+			t.Fatalf("PubkeyFromBytes accepted %d bytes", n)
+		}
+		_, err = PrivkeyFromBytes(make([]byte, n))
+		if err == nil {
+			t.Fatalf("PrivkeyFromBytes accepted %d bytes", n)
+		}
+	}
+}
+
+// TestUnmarshalBinaryOverwrites unmarshals into keys that already hold data
+// and checks nothing of the old value survives.
+func TestUnmarshalBinaryOverwrites(t *testing.T) {
+	sec1, pub1, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sec2, pub2, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := pub1.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pub2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub1 {
+		t.Fatalf("UnmarshalBinary didn't overwrite pubkey")
+	}
+
+	data, err = sec1.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sec2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sec2 != sec1 {
+		t.Fatalf("UnmarshalBinary didn't overwrite privkey")
+	}
+
+	msg := GetMessageFromString("overwrite")
+	sig := mustSign(t, msg, sec1)
+	sig2 := mustSign(t, GetMessageFromString("other"), sec1)
+	data, err = sig.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sig2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig2 != sig {
+		t.Fatalf("UnmarshalBinary didn't overwrite signature")
+	}
+
+	// a failed unmarshal leaves the receiver alone
+	err = sig2.UnmarshalBinary(data[1:])
+	if err == nil {
+		t.Fatalf("UnmarshalBinary accepted short signature")
+	}
+	if sig2 != sig {
+		t.Fatalf("failed UnmarshalBinary modified signature")
+	}
+}
+
+// TestGobPubkey gob-encodes a struct holding a PublicKey and gets the same key
+// back out.
+func TestGobPubkey(t *testing.T) {
+	type record struct {
+		Name string
+		Pub  PublicKey
+	}
+
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = gob.NewEncoder(&buf).Encode(record{Name: "pset", Pub: pub})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var r record
+	err = gob.NewDecoder(&buf).Decode(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Name != "pset" || r.Pub != pub {
+		t.Fatalf("gob round trip changed record")
+	}
+}
+
+// TestBase64RoundTrip encodes the provided pubkey and signature as base64 and
+// decodes them again.
+func TestBase64RoundTrip(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub2, err := PubkeyFromBase64(pub.ToBase64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("pubkey changed after base64 round trip")
+	}
+
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := sig.ToBase64()
+	if strings.ContainsAny(s, "+/=") {
+		t.Fatalf("signature base64 isn't unpadded URL alphabet")
+	}
+	sig2, err := SignatureFromBase64(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig2 != sig {
+		t.Fatalf("signature changed after base64 round trip")
+	}
+}
+
+// TestBase64Rejects checks that the std alphabet, padding, and wrong decoded
+// lengths are all refused.
+func TestBase64Rejects(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = PubkeyFromBase64(base64.StdEncoding.EncodeToString(pub.Bytes()))
+	if err == nil {
+		t.Fatalf("padded std base64 pubkey decoded without error")
+	}
+	_, err = SignatureFromBase64(base64.URLEncoding.EncodeToString(sig.Bytes()))
+	if err == nil {
+		t.Fatalf("padded URL base64 signature decoded without error")
+	}
+
+	// a pubkey is not a signature, even though it's valid base64
+	_, err = SignatureFromBase64(pub.ToBase64())
+	if err == nil {
+		t.Fatalf("pubkey base64 decoded as signature")
+	}
+	_, err = PubkeyFromBase64(sig.ToBase64())
+	if err == nil {
+		t.Fatalf("signature base64 decoded as pubkey")
+	}
+}
+
+// TestGobSize makes sure the custom gob encoding is close to the raw size, and
+// that private keys and signatures survive gob too.
+func TestGobSize(t *testing.T) {
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := mustSign(t, GetMessageFromString("gob"), sec)
+
+	var buf bytes.Buffer
+	err = gob.NewEncoder(&buf).Encode(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a few bytes of gob framing on top of the raw key is fine
+	if buf.Len() > PUBKEY_BYTES+64 {
+		t.Fatalf("gob pubkey %d bytes, expect about %d", buf.Len(), PUBKEY_BYTES)
+	}
+
+	buf.Reset()
+	enc := gob.NewEncoder(&buf)
+	err = enc.Encode(sec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = enc.Encode(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sec2 PrivateKey
+	var sig2 Signature
+	dec := gob.NewDecoder(&buf)
+	err = dec.Decode(&sec2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dec.Decode(&sig2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sec2 != sec || sig2 != sig {
+		t.Fatalf("gob round trip changed privkey or signature")
+	}
+}
+
+// plainPubkey has the same fields as PublicKey but none of its methods, so gob
+// falls back to its default reflection based encoding.
+type plainPubkey struct {
+	ZeroHash [MESSAGE_BITS]Block
+	OneHash  [MESSAGE_BITS]Block
+}
+
+// BenchmarkGobDefault encodes and decodes a pubkey with gob's default encoding.
+func BenchmarkGobDefault(b *testing.B) {
+	_, pub, err := GenerateKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	plain := plainPubkey(pub)
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		err = gob.NewEncoder(&buf).Encode(plain)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.SetBytes(int64(buf.Len()))
+		var out plainPubkey
+		err = gob.NewDecoder(&buf).Decode(&out)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkGobCustom is the same as BenchmarkGobDefault but uses GobEncode.
+func BenchmarkGobCustom(b *testing.B) {
+	_, pub, err := GenerateKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		err = gob.NewEncoder(&buf).Encode(pub)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.SetBytes(int64(buf.Len()))
+		var out PublicKey
+		err = gob.NewDecoder(&buf).Decode(&out)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
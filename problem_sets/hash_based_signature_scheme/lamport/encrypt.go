@@ -0,0 +1,165 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+/*
+Passphrase encrypted private keys.  The passphrase goes through scrypt to
+get 64 bytes: the first 32 are an AES-256-GCM key for the private key, and
+the last 32 are stored as a check value, so a wrong passphrase can be told
+apart from a damaged file.  The layout is:
+
+    magic     4 bytes  "LENC"
+    version   1 byte   ENCRYPTED_KEY_VERSION
+    kdf       1 byte   KDF_SCRYPT
+    logN      1 byte   scrypt cost is N = 1<<logN
+    r         1 byte
+    p         1 byte
+    salt      16 bytes
+    check     32 bytes
+    nonce     12 bytes
+    ciphertext PRIVKEY_BYTES + 16 byte GCM tag
+
+All the header bytes before the ciphertext are authenticated as GCM
+additional data.
+*/
+
+var ENCRYPTED_KEY_MAGIC = [4]byte{'L', 'E', 'N', 'C'}
+
+const ENCRYPTED_KEY_VERSION = 1
+const KDF_SCRYPT = 1
+
+// default scrypt parameters, the ones recommended for interactive use
+const scryptLogN = 15
+const scryptR = 8
+const scryptP = 1
+
+// The most a file can ask scrypt for.  The parameters come from the file,
+// so without a ceiling a crafted header could demand terabytes before the
+// passphrase is even checked.  N = 1<<20 with r*p = 16 is about 2 GiB,
+// well above the defaults.
+const scryptMaxLogN = 20
+const scryptMaxRP = 16
+
+const encSaltBytes = 16
+const encCheckBytes = 32
+const encNonceBytes = 12
+const encHeaderBytes = 4 + 1 + 1 + 3 + encSaltBytes + encCheckBytes + encNonceBytes
+
+var (
+	ErrWrongPassphrase = errors.New("wrong passphrase")
+	ErrCorruptKeyFile  = errors.New("encrypted key corrupted")
+	ErrKDFTooExpensive = errors.New("encrypted key: KDF parameters too expensive")
+)
+
+// EncryptPrivateKey encrypts pri under passphrase, returning the encoded
+// file contents described above.
+func EncryptPrivateKey(pri PrivateKey, passphrase []byte) ([]byte, error) {
+	var header bytes.Buffer
+	header.Write(ENCRYPTED_KEY_MAGIC[:])
+	header.WriteByte(ENCRYPTED_KEY_VERSION)
+	header.WriteByte(KDF_SCRYPT)
+	header.Write([]byte{scryptLogN, scryptR, scryptP})
+
+	salt := make([]byte, encSaltBytes)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	header.Write(salt)
+
+	key, check, err := deriveKeyFileKeys(passphrase, salt, scryptLogN, scryptR, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	header.Write(check)
+
+	nonce := make([]byte, encNonceBytes)
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+	header.Write(nonce)
+
+	aead, err := newKeyFileAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(header.Bytes(), nonce, pri.Bytes(), header.Bytes()), nil
+}
+
+// DecryptPrivateKey decrypts data from EncryptPrivateKey.  A passphrase that
+// doesn't match returns ErrWrongPassphrase; a right passphrase with damaged
+// ciphertext, or a malformed header, returns ErrCorruptKeyFile.  Scrypt
+// parameters costlier than scryptMaxLogN and scryptMaxRP are
+// ErrKDFTooExpensive, and scrypt is never run for them.
+func DecryptPrivateKey(data, passphrase []byte) (PrivateKey, error) {
+	if len(data) < encHeaderBytes ||
+		!bytes.Equal(data[:4], ENCRYPTED_KEY_MAGIC[:]) {
+		return PrivateKey{}, fmt.Errorf("%w: bad header", ErrCorruptKeyFile)
+	}
+	if data[4] != ENCRYPTED_KEY_VERSION || data[5] != KDF_SCRYPT {
+		return PrivateKey{}, fmt.Errorf("%w: unknown version %d or kdf %d",
+			ErrCorruptKeyFile, data[4], data[5])
+	}
+	logN, r, p := data[6], data[7], data[8]
+	if logN == 0 || logN > 30 || r == 0 || p == 0 {
+		return PrivateKey{}, fmt.Errorf("%w: bad scrypt parameters", ErrCorruptKeyFile)
+	}
+	if logN > scryptMaxLogN || int(r)*int(p) > scryptMaxRP {
+		return PrivateKey{}, fmt.Errorf("%w: logN %d, r %d, p %d, expect logN at most %d and r*p at most %d",
+			ErrKDFTooExpensive, logN, r, p, scryptMaxLogN, scryptMaxRP)
+	}
+	pos := 9
+	salt := data[pos : pos+encSaltBytes]
+	pos += encSaltBytes
+	storedCheck := data[pos : pos+encCheckBytes]
+	pos += encCheckBytes
+	nonce := data[pos : pos+encNonceBytes]
+
+	key, check, err := deriveKeyFileKeys(passphrase, salt, logN, r, p)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	if subtle.ConstantTimeCompare(check, storedCheck) != 1 {
+		return PrivateKey{}, ErrWrongPassphrase
+	}
+
+	aead, err := newKeyFileAEAD(key)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	plain, err := aead.Open(nil, nonce, data[encHeaderBytes:], data[:encHeaderBytes])
+	if err != nil {
+		return PrivateKey{}, fmt.Errorf("%w: %v", ErrCorruptKeyFile, err)
+	}
+	return PrivkeyFromBytes(plain)
+}
+
+// deriveKeyFileKeys runs scrypt and splits the output into the AES key and
+// the passphrase check value.
+func deriveKeyFileKeys(passphrase, salt []byte, logN, r, p byte) ([]byte, []byte, error) {
+	out, err := scrypt.Key(passphrase, salt, 1<<logN, int(r), int(p), 32+encCheckBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out[:32], out[32:], nil
+}
+
+// newKeyFileAEAD returns AES-256-GCM with key.
+func newKeyFileAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,79 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestEncryptPrivateKey covers the round trip, a wrong passphrase, and
+// tampered ciphertext, each with its own error.
+func TestEncryptPrivateKey(t *testing.T) {
+	sec, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pass := []byte("correct horse battery staple")
+
+	data, err := EncryptPrivateKey(sec, pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != encHeaderBytes+PRIVKEY_BYTES+16 {
+		t.Fatalf("encrypted key %d bytes, expect %d",
+			len(data), encHeaderBytes+PRIVKEY_BYTES+16)
+	}
+
+	sec2, err := DecryptPrivateKey(data, pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sec2 != sec {
+		t.Fatalf("privkey changed after encryption round trip")
+	}
+
+	_, err = DecryptPrivateKey(data, []byte("incorrect horse"))
+	if !errors.Is(err, ErrWrongPassphrase) {
+		t.Fatalf("got %v, expect ErrWrongPassphrase", err)
+	}
+
+	tampered := append([]byte{}, data...)
+	tampered[len(tampered)-100] ^= 0x01
+	_, err = DecryptPrivateKey(tampered, pass)
+	if !errors.Is(err, ErrCorruptKeyFile) {
+		t.Fatalf("got %v, expect ErrCorruptKeyFile", err)
+	}
+
+	// the nonce is authenticated too
+	tampered = append([]byte{}, data...)
+	tampered[encHeaderBytes-1] ^= 0x01
+	_, err = DecryptPrivateKey(tampered, pass)
+	if !errors.Is(err, ErrCorruptKeyFile) {
+		t.Fatalf("got %v, expect ErrCorruptKeyFile", err)
+	}
+
+	_, err = DecryptPrivateKey(data[:20], pass)
+	if !errors.Is(err, ErrCorruptKeyFile) {
+		t.Fatalf("got %v, expect ErrCorruptKeyFile", err)
+	}
+}
+
+// TestDecryptPrivateKeyHostileKDF checks a header asking for absurd scrypt
+// parameters is refused before scrypt runs.
+func TestDecryptPrivateKeyHostileKDF(t *testing.T) {
+	sec, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := EncryptPrivateKey(sec, []byte("pass"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, params := range [][3]byte{{30, 255, 1}, {21, 8, 1}, {15, 8, 3}} {
+		hostile := append([]byte{}, data...)
+		copy(hostile[6:9], params[:])
+		_, err = DecryptPrivateKey(hostile, []byte("pass"))
+		if !errors.Is(err, ErrKDFTooExpensive) {
+			t.Fatalf("params %v: got %v, expect ErrKDFTooExpensive", params, err)
+		}
+	}
+}
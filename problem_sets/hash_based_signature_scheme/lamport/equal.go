@@ -0,0 +1,22 @@
+package lamport
+
+import (
+	"crypto/subtle"
+)
+
+// Equal reports whether two blocks are the same, in constant time.
+func (self Block) Equal(other Block) bool {
+	return subtle.ConstantTimeCompare(self[:], other[:]) == 1
+}
+
+// Equal reports whether two pubkeys are the same, comparing their whole
+// encodings in constant time.
+func (self PublicKey) Equal(other PublicKey) bool {
+	return subtle.ConstantTimeCompare(self.Bytes(), other.Bytes()) == 1
+}
+
+// Equal reports whether two signatures are the same, comparing their whole
+// encodings in constant time.
+func (self Signature) Equal(other Signature) bool {
+	return subtle.ConstantTimeCompare(self.Bytes(), other.Bytes()) == 1
+}
@@ -0,0 +1,46 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// TestEqual checks each Equal against a copy and against a copy differing
+// in only the very last byte.
+func TestEqual(t *testing.T) {
+	_, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block := pub.ZeroHash[0]
+	other := block
+	if !block.Equal(other) {
+		t.Fatalf("Block.Equal returned false for a copy")
+	}
+	other[MESSAGE_BYTES-1] ^= 1
+	if block.Equal(other) {
+		t.Fatalf("Block.Equal returned true for different last byte")
+	}
+
+	otherPub := pub
+	if !pub.Equal(otherPub) {
+		t.Fatalf("PublicKey.Equal returned false for a copy")
+	}
+	otherPub.OneHash[MESSAGE_BITS-1][MESSAGE_BYTES-1] ^= 1
+	if pub.Equal(otherPub) {
+		t.Fatalf("PublicKey.Equal returned true for different last byte")
+	}
+
+	otherSig := sig
+	if !sig.Equal(otherSig) {
+		t.Fatalf("Signature.Equal returned false for a copy")
+	}
+	otherSig.Preimage[MESSAGE_BITS-1][MESSAGE_BYTES-1] ^= 1
+	if sig.Equal(otherSig) {
+		t.Fatalf("Signature.Equal returned true for different last byte")
+	}
+}
@@ -0,0 +1,28 @@
+package lamport
+
+import (
+	"errors"
+)
+
+// Errors that callers can check for with errors.Is.  Most failures wrap one
+// of these with more detail, or are a typed error (DecodeError, VerifyError,
+// ContainerLengthError) whose Is method matches the right one.
+var (
+	// A signature that doesn't verify.
+	ErrInvalidSignature = errors.New("invalid signature")
+	// Something signed by a different pubkey than the one given.
+	ErrWrongKey = errors.New("signed by a different pubkey")
+	// An encoded key, signature, block or digest of the wrong size.
+	ErrWrongLength = errors.New("wrong length")
+	// A hex string with a character that isn't hex.
+	ErrInvalidHex = errors.New("invalid hex")
+	// A Signer asked to sign a second message.
+	ErrKeyAlreadyUsed = errors.New("one-time key already used")
+	// A private key that's all zeros.
+	ErrZeroKey = errors.New("key is all zeros, wiped or never generated")
+	// A key with blocks that would make signatures ambiguous, like the same
+	// block in both rows for some bit.
+	ErrWeakKey = errors.New("weak key")
+	// Signatures that don't reveal enough of the key to forge with.
+	ErrUnforgeable = errors.New("not enough revealed to forge")
+)
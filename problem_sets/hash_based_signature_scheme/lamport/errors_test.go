@@ -0,0 +1,83 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"testing"
+)
+
+// TestErrorIdentities runs each failure path and checks errors.Is finds the
+// right sentinel.
+func TestErrorIdentities(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("errors")
+	sig := mustSign(t, msg, pri)
+	badSig := sig
+	badSig.Preimage[3] = Block{}
+
+	var containerErr error
+	{
+		var buf bytes.Buffer
+		WriteContainer(&buf, CONTAINER_SIGNATURE, sig.Bytes())
+		data := append(buf.Bytes(), 0)
+		_, containerErr = ReadContainer(bytes.NewReader(data))
+	}
+
+	signer := NewSigner(pri)
+	signer.Sign(msg)
+	_, signerErr := signer.Sign(GetMessageFromString("again"))
+	_, zeroErr := NewSigner(PrivateKey{}).Sign(msg)
+
+	_, _, weakErr := GenerateKeyFrom(bytes.NewReader(make([]byte, PRIVKEY_BYTES)))
+	_, digestErr := NewCryptoSigner(pri).Sign(nil, make([]byte, 20), crypto.SHA256)
+
+	_, hexLenErr := HexToPubkey("abcd")
+	_, hexCharErr := HexToSignature("g" + hexSignature1[1:])
+	_, privCharErr := HexToPrivkey(pri.ToHex()[1:] + "-")
+	_, bytesErr := PubkeyFromBytes(make([]byte, 10))
+	_, sigBytesErr := SignatureFromBytes(make([]byte, SIGNATURE_BYTES+1))
+	_, blockErr := BlockFromByteSliceStrict(make([]byte, 31))
+
+	var textPub PublicKey
+	text, _ := pub.MarshalText()
+	textErr := textPub.UnmarshalText(bytes.Replace(text, []byte("zero[5]: "),
+		[]byte("zero[5]: zz"), 1))
+
+	_, openErr := Open(SignedMessage{
+		Payload: []byte("x"), Signature: sig, Fingerprint: pub.Fingerprint()}, pub)
+
+	cases := []struct {
+		name   string
+		err    error
+		target error
+	}{
+		{"VerifyDetailed", VerifyDetailed(msg, pub, badSig), ErrInvalidSignature},
+		{"Open", openErr, ErrInvalidSignature},
+		{"HexToPubkey length", hexLenErr, ErrWrongLength},
+		{"HexToSignature character", hexCharErr, ErrInvalidHex},
+		{"HexToPrivkey character", privCharErr, ErrInvalidHex},
+		{"PubkeyFromBytes", bytesErr, ErrWrongLength},
+		{"SignatureFromBytes", sigBytesErr, ErrWrongLength},
+		{"BlockFromByteSliceStrict", blockErr, ErrWrongLength},
+		{"ReadContainer", containerErr, ErrWrongLength},
+		{"CryptoSigner digest", digestErr, ErrWrongLength},
+		{"UnmarshalText", textErr, ErrInvalidHex},
+		{"Signer twice", signerErr, ErrKeyAlreadyUsed},
+		{"Signer zero key", zeroErr, ErrZeroKey},
+		{"GenerateKeyFrom zeros", weakErr, ErrWeakKey},
+	}
+	for _, c := range cases {
+		if !errors.Is(c.err, c.target) {
+			t.Fatalf("%s: got %v, expect %v", c.name, c.err, c.target)
+		}
+	}
+
+	// and they shouldn't match each other
+	if errors.Is(hexLenErr, ErrInvalidHex) || errors.Is(hexCharErr, ErrWrongLength) {
+		t.Fatalf("DecodeError matches the wrong sentinel")
+	}
+}
@@ -0,0 +1,210 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+/*
+An evolving Signer has one one-time key per period, each a SeedPrivateKey
+whose seed comes from the one before:
+
+    seed[0]   = the seed it was made with
+    seed[i+1] = sha256("lamport evolve" || seed[i])
+
+Only the current seed is kept.  Evolve steps to the next one and wipes the
+old seed and the old key, revealed blocks and all, and since sha256 can't be
+run backwards, whoever steals the Signer later can't sign for a period that
+has passed.
+
+The pubkey is the root of a Merkle tree whose leaf i is the fingerprint of
+period i's pubkey, with zero leaves after the last period to make a power
+of two.  A PeriodSignature carries the period, that period's pubkey and its
+AuthPath, so VerifyPeriod only needs the root.
+*/
+
+var evolveLabel = []byte("lamport evolve")
+
+var (
+	// Signing for a period the Signer has evolved past.
+	ErrPeriodExpired = errors.New("period has expired")
+	// Evolving a Signer that's at its last period.
+	ErrLastPeriod = errors.New("no periods left to evolve to")
+)
+
+var errNotEvolving = errors.New("signer wasn't made by NewEvolvingSigner")
+
+// EvolvingPublicKey is the pubkey for every period of an evolving Signer.
+type EvolvingPublicKey struct {
+	Root    Block
+	Periods int
+}
+
+// Height returns the height of the key's tree, the smallest that has a leaf
+// for every period.
+func (self EvolvingPublicKey) Height() int {
+	height := 0
+	for 1<<height < self.Periods {
+		height++
+	}
+	return height
+}
+
+// PeriodSignature is a signature by the key of one period.
+type PeriodSignature struct {
+	Period    int
+	Pubkey    PublicKey
+	Path      AuthPath
+	Signature Signature
+}
+
+// evolution is what an evolving Signer keeps besides its current key.
+type evolution struct {
+	seed    SeedPrivateKey
+	period  int
+	periods int
+	pub     PublicKey
+	levels  [][]Block
+}
+
+func evolveSeed(seed SeedPrivateKey) SeedPrivateKey {
+	h := sha256.New()
+	h.Write(evolveLabel)
+	h.Write(seed.Seed[:])
+	var next SeedPrivateKey
+	h.Sum(next.Seed[:0])
+	return next
+}
+
+// NewEvolvingSigner returns a Signer at period 0 of periods, and the pubkey
+// its PeriodSignatures verify against.  Making it derives every period's
+// pubkey, about a thousand hashes each.
+func NewEvolvingSigner(seed SeedPrivateKey, periods int) (*Signer, EvolvingPublicKey, error) {
+	if periods <= 0 || periods > 1<<AUTH_PATH_MAX_HEIGHT {
+		return nil, EvolvingPublicKey{}, fmt.Errorf(
+			"evolving signer: %d periods, expect 1 to %d",
+			periods, uint64(1)<<AUTH_PATH_MAX_HEIGHT)
+	}
+	width := 1
+	for width < periods {
+		width *= 2
+	}
+	leaves := make([]Block, width)
+	next := seed
+	for i := 0; i < periods; i++ {
+		leaves[i] = Block(next.GetPublicKey().Fingerprint())
+		next = evolveSeed(next)
+	}
+	next.Zeroize()
+	levels := MerkleLevels(leaves)
+
+	signer := NewSigner(seed.Expand())
+	signer.evolve = &evolution{
+		seed:    seed,
+		periods: periods,
+		pub:     signer.pri.GetPublicKey(),
+		levels:  levels,
+	}
+	return signer, EvolvingPublicKey{Root: levels[len(levels)-1][0], Periods: periods}, nil
+}
+
+// Period returns the Signer's current period, which is 0 for a Signer that
+// isn't evolving.
+func (self *Signer) Period() int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if self.evolve == nil {
+		return 0
+	}
+	return self.evolve.period
+}
+
+// Evolve moves an evolving Signer on to its next period, with a fresh
+// unused key, and wipes the seed and key of the period it leaves.  At the
+// last period it returns ErrLastPeriod and changes nothing, and after
+// Zeroize it's ErrZeroKey.
+func (self *Signer) Evolve() error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	e := self.evolve
+	if e == nil {
+		return errNotEvolving
+	}
+	if e.seed == (SeedPrivateKey{}) {
+		return ErrZeroKey
+	}
+	if e.period+1 >= e.periods {
+		return fmt.Errorf("%w: period %d is the last of %d",
+			ErrLastPeriod, e.period, e.periods)
+	}
+	next := evolveSeed(e.seed)
+	e.seed.Zeroize()
+	self.pri.Zeroize()
+	e.seed = next
+	e.period++
+	self.pri = next.Expand()
+	e.pub = self.pri.GetPublicKey()
+	self.used = false
+	self.msgKnown = false
+	self.msg = Message{}
+	return nil
+}
+
+// SignPeriod signs msg with the key for period, which has to be the current
+// one.  A period that has passed is ErrPeriodExpired, since its key is gone;
+// one that hasn't started yet needs Evolve first.  Otherwise it fails the
+// way Sign does.
+func (self *Signer) SignPeriod(period int, msg Message) (PeriodSignature, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	e := self.evolve
+	if e == nil {
+		return PeriodSignature{}, errNotEvolving
+	}
+	if period < e.period {
+		return PeriodSignature{}, fmt.Errorf("%w: period %d, signer is at %d",
+			ErrPeriodExpired, period, e.period)
+	}
+	if period > e.period {
+		return PeriodSignature{}, fmt.Errorf(
+			"period %d hasn't started, signer is at %d", period, e.period)
+	}
+	sig, err := self.sign(msg)
+	if err != nil {
+		return PeriodSignature{}, err
+	}
+	return PeriodSignature{
+		Period:    e.period,
+		Pubkey:    e.pub,
+		Path:      NewAuthPath(e.levels, e.period),
+		Signature: sig,
+	}, nil
+}
+
+// VerifyPeriod checks sig on msg against an evolving pubkey: that sig's
+// pubkey is the one for its period in pub's tree, and that the signature
+// verifies with it.  The path has to be as tall as pub's tree, otherwise
+// it's ErrInvalidAuthPath.  A pubkey that isn't in the tree is ErrWrongKey,
+// and a bad signature is ErrInvalidSignature.
+func VerifyPeriod(msg Message, pub EvolvingPublicKey, sig PeriodSignature) error {
+	if sig.Period < 0 || sig.Period >= pub.Periods {
+		return fmt.Errorf("period %d of %d", sig.Period, pub.Periods)
+	}
+	if sig.Path.Height != pub.Height() {
+		return fmt.Errorf("%w: path height %d, expect %d for %d periods",
+			ErrInvalidAuthPath, sig.Path.Height, pub.Height(), pub.Periods)
+	}
+	err := sig.Path.Validate()
+	if err != nil {
+		return err
+	}
+	if int(sig.Path.Index) != sig.Period {
+		return fmt.Errorf("%w: path for leaf %d, signature for period %d",
+			ErrInvalidAuthPath, sig.Path.Index, sig.Period)
+	}
+	if sig.Path.ComputeRoot(sig.Pubkey.Fingerprint()) != pub.Root {
+		return fmt.Errorf("%w: not the period %d key", ErrWrongKey, sig.Period)
+	}
+	return VerifyDetailed(msg, sig.Pubkey, sig.Signature)
+}
@@ -0,0 +1,146 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+func testEvolvingSigner(t *testing.T, periods int) (*Signer, EvolvingPublicKey) {
+	var seed SeedPrivateKey
+	copy(seed.Seed[:], "evolving signer test seed 012345")
+	signer, pub, err := NewEvolvingSigner(seed, periods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer, pub
+}
+
+// TestEvolve signs in each period, checks earlier periods can't be signed
+// for after Evolve, and that their signatures still verify.
+func TestEvolve(t *testing.T) {
+	signer, pub := testEvolvingSigner(t, 3)
+	var sigs []PeriodSignature
+	for period := 0; period < 3; period++ {
+		if signer.Period() != period {
+			t.Fatalf("at period %d, expect %d", signer.Period(), period)
+		}
+		msg := GetMessageFromString("evolve")
+		sig, err := signer.SignPeriod(period, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sig.Period != period {
+			t.Fatalf("signature for period %d, expect %d", sig.Period, period)
+		}
+		_, err = signer.SignPeriod(period, GetMessageFromString("again"))
+		if !errors.Is(err, ErrKeyAlreadyUsed) {
+			t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+		}
+		sigs = append(sigs, sig)
+
+		if period < 2 {
+			_, err = signer.SignPeriod(period+1, msg)
+			if err == nil {
+				t.Fatalf("signed for period %d while at %d", period+1, period)
+			}
+			if err := signer.Evolve(); err != nil {
+				t.Fatal(err)
+			}
+			for earlier := 0; earlier <= period; earlier++ {
+				_, err = signer.SignPeriod(earlier, GetMessageFromString("late"))
+				if !errors.Is(err, ErrPeriodExpired) {
+					t.Fatalf("period %d: got %v, expect ErrPeriodExpired", earlier, err)
+				}
+			}
+		}
+	}
+	if err := signer.Evolve(); !errors.Is(err, ErrLastPeriod) {
+		t.Fatalf("got %v, expect ErrLastPeriod", err)
+	}
+	if signer.Period() != 2 {
+		t.Fatalf("failed Evolve moved to period %d", signer.Period())
+	}
+
+	msg := GetMessageFromString("evolve")
+	for i, sig := range sigs {
+		if err := VerifyPeriod(msg, pub, sig); err != nil {
+			t.Fatalf("period %d: %v", i, err)
+		}
+	}
+	err := VerifyPeriod(GetMessageFromString("other"), pub, sigs[0])
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+	// a signature moved to another period doesn't verify
+	moved := sigs[1]
+	moved.Period = 0
+	if err := VerifyPeriod(msg, pub, moved); err == nil {
+		t.Fatalf("VerifyPeriod accepted a signature for the wrong period")
+	}
+	moved.Path = sigs[0].Path
+	if err := VerifyPeriod(msg, pub, moved); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("got %v, expect ErrWrongKey", err)
+	}
+	other, _ := testEvolvingSigner(t, 4)
+	sig, err := other.SignPeriod(0, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPeriod(msg, pub, sig); err == nil {
+		t.Fatalf("VerifyPeriod accepted a signature from a 4 period tree")
+	}
+	// a path cut down to a subtree doesn't verify against that subtree's
+	// node, since the height comes from the periods
+	short := sigs[0]
+	short.Path.Height = 1
+	short.Path.Siblings = sigs[0].Path.Siblings[:1]
+	node := EvolvingPublicKey{
+		Root:    short.Path.ComputeRoot(short.Pubkey.Fingerprint()),
+		Periods: pub.Periods,
+	}
+	if err := VerifyPeriod(msg, node, short); !errors.Is(err, ErrInvalidAuthPath) {
+		t.Fatalf("got %v, expect ErrInvalidAuthPath", err)
+	}
+}
+
+// TestEvolvingPublicKeyHeight checks the tree height for a few period
+// counts.
+func TestEvolvingPublicKeyHeight(t *testing.T) {
+	for periods, height := range map[int]int{1: 0, 2: 1, 3: 2, 4: 2, 5: 3, 1024: 10} {
+		pub := EvolvingPublicKey{Periods: periods}
+		if pub.Height() != height {
+			t.Fatalf("%d periods: height %d, expect %d", periods, pub.Height(), height)
+		}
+	}
+}
+
+// TestEvolveErases checks Evolve wipes the old key, and that a seed keeps
+// nothing that leads back to earlier periods.
+func TestEvolveErases(t *testing.T) {
+	signer, _ := testEvolvingSigner(t, 4)
+	first := signer.evolve.seed
+	firstKey := first.Expand()
+	if err := signer.Evolve(); err != nil {
+		t.Fatal(err)
+	}
+	if signer.evolve.seed != evolveSeed(first) {
+		t.Fatalf("period 1 seed isn't derived from period 0's")
+	}
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if signer.pri.ZeroHash[i] == firstKey.ZeroHash[i] ||
+			signer.pri.OneHash[i] == firstKey.OneHash[i] {
+			t.Fatalf("block %d of the period 0 key is still there", i)
+		}
+	}
+
+	signer.Zeroize()
+	if err := signer.Evolve(); !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("got %v, expect ErrZeroKey", err)
+	}
+	if _, err := NewSigner(firstKey).SignPeriod(0, Message{}); err == nil {
+		t.Fatalf("SignPeriod worked on a Signer that isn't evolving")
+	}
+	if _, _, err := NewEvolvingSigner(first, 0); err == nil {
+		t.Fatalf("NewEvolvingSigner accepted 0 periods")
+	}
+}
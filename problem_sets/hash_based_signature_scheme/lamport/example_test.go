@@ -0,0 +1,54 @@
+package lamport_test
+
+import (
+	"fmt"
+
+	"ps/01/lamport"
+)
+
+func ExamplePrivateKey_Sign() {
+	pri, _, err := lamport.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+	msg := lamport.GetMessageFromString("hello")
+
+	sig, err := pri.Sign(msg)
+	if err != nil {
+		panic(err)
+	}
+	sig2, err := lamport.Sign(msg, pri)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(sig == sig2)
+	// Output: true
+}
+
+func ExamplePrivateKey_Public() {
+	pri, pub, err := lamport.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(pri.Public() == pub)
+	// Output: true
+}
+
+func ExamplePublicKey_Verify() {
+	pri, _, err := lamport.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+	pub := pri.Public()
+	msg := lamport.GetMessageFromString("hello")
+	sig, err := pri.Sign(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(pub.Verify(msg, sig))
+	fmt.Println(pub.Verify(lamport.GetMessageFromString("goodbye"), sig))
+	// Output:
+	// true
+	// false
+}
@@ -0,0 +1,240 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+/*
+Chunked file signatures, for images too big to download whole just to
+check them.  The file is cut into chunkSize byte chunks, the last one
+possibly short, and chunk i's leaf is sha256(0x00 || chunk), so no chunk
+can pass for an interior node.  The leaves sit under a MerkleLevels tree,
+padded with zero leaves to a power of two, and the key signs
+
+    GetMessage(FILE_MERKLE_DOMAIN, root || chunk size || chunk count || size)
+
+with the three numbers 8 bytes each, big endian.  A device that holds the
+FileSignature checks any one chunk with its AuthPath.  An empty file is one
+empty chunk.
+
+A FileSignature's encoding, and the payload of a CONTAINER_FILE_MERKLE
+container, is
+
+    root         32 bytes
+    chunk size    8 bytes, big endian
+    chunk count   8 bytes, big endian
+    size          8 bytes, big endian
+    signature    SIGNATURE_BYTES
+*/
+
+const FILE_MERKLE_DOMAIN = "lamport file merkle v1"
+const FILE_SIGNATURE_BYTES = 32 + 8 + 8 + 8 + SIGNATURE_BYTES
+
+// ErrChunkMismatch is wrapped by VerifyChunk when a chunk or its proof
+// doesn't fit the file signature.
+var ErrChunkMismatch = errors.New("chunk doesn't match file signature")
+
+// FileSignature is a signature on a file's Merkle root.
+type FileSignature struct {
+	Root       Block
+	ChunkSize  int
+	ChunkCount int
+	Size       int64
+	Signature  Signature
+
+	levels [][]Block // the whole tree, kept by SignFileMerkle for ProofForChunk
+}
+
+// chunkLeaf returns the leaf hash of a chunk.
+func chunkLeaf(data []byte) Block {
+	h := sha256.New()
+	h.Write([]byte{0})
+	h.Write(data)
+	var leaf Block
+	h.Sum(leaf[:0])
+	return leaf
+}
+
+// message returns what the key signs for the file.
+func (self *FileSignature) message() Message {
+	b := make([]byte, 0, 32+3*8)
+	b = append(b, self.Root[:]...)
+	b = binary.BigEndian.AppendUint64(b, uint64(self.ChunkSize))
+	b = binary.BigEndian.AppendUint64(b, uint64(self.ChunkCount))
+	b = binary.BigEndian.AppendUint64(b, uint64(self.Size))
+	return GetMessage(FILE_MERKLE_DOMAIN, b)
+}
+
+// height returns the height of the tree over the file's chunks.
+func (self *FileSignature) height() int {
+	height := 0
+	for 1<<height < self.ChunkCount {
+		height++
+	}
+	return height
+}
+
+// chunkLen returns how long chunk index should be.
+func (self *FileSignature) chunkLen(index int) int64 {
+	if index < self.ChunkCount-1 {
+		return int64(self.ChunkSize)
+	}
+	return self.Size - int64(self.ChunkCount-1)*int64(self.ChunkSize)
+}
+
+// SignFileMerkle hashes the file at path in chunkSize chunks and signs the
+// Merkle root with pri.  The FileSignature it returns keeps the tree, so
+// ProofForChunk can hand out proofs.
+func SignFileMerkle(path string, chunkSize int, pri PrivateKey) (FileSignature, error) {
+	if chunkSize <= 0 {
+		return FileSignature{}, fmt.Errorf("file merkle: chunk size %d, expect at least 1", chunkSize)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return FileSignature{}, err
+	}
+	defer f.Close()
+
+	fs := FileSignature{ChunkSize: chunkSize}
+	var leaves []Block
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n > 0 || len(leaves) == 0 && err == io.EOF {
+			leaves = append(leaves, chunkLeaf(buf[:n]))
+			fs.Size += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return FileSignature{}, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	fs.ChunkCount = len(leaves)
+	for len(leaves) < 1<<fs.height() {
+		leaves = append(leaves, Block{})
+	}
+	fs.levels = MerkleLevels(leaves)
+	fs.Root = fs.levels[len(fs.levels)-1][0]
+	fs.Signature, err = Sign(fs.message(), pri)
+	if err != nil {
+		return FileSignature{}, err
+	}
+	return fs, nil
+}
+
+// ProofForChunk returns the AuthPath for chunk index.  Only a FileSignature
+// straight from SignFileMerkle has the tree for it; a decoded one doesn't.
+func (self *FileSignature) ProofForChunk(index int) (AuthPath, error) {
+	if self.levels == nil {
+		return AuthPath{}, fmt.Errorf("file merkle: no tree, only the signer has one")
+	}
+	if index < 0 || index >= self.ChunkCount {
+		return AuthPath{}, fmt.Errorf("file merkle: chunk %d of %d", index, self.ChunkCount)
+	}
+	return NewAuthPath(self.levels, index), nil
+}
+
+// VerifyChunk checks fs is pub's signature, and then that chunkData is
+// chunk chunkIndex of the signed file, using proof to get from it to the
+// root.  A bad signature is ErrInvalidSignature; a chunk of the wrong
+// length, one that doesn't hash to the root, or a proof for another chunk
+// or another tree is ErrChunkMismatch.
+func VerifyChunk(fs FileSignature, pub PublicKey, chunkIndex int, chunkData []byte, proof AuthPath) error {
+	msg := fs.message()
+	if !VerifyPtr(msg, &pub, &fs.Signature) {
+		return ErrInvalidSignature
+	}
+	if chunkIndex < 0 || chunkIndex >= fs.ChunkCount {
+		return fmt.Errorf("%w: chunk %d of %d", ErrChunkMismatch, chunkIndex, fs.ChunkCount)
+	}
+	err := proof.Validate()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrChunkMismatch, err)
+	}
+	if int(proof.Index) != chunkIndex || proof.Height != fs.height() {
+		return fmt.Errorf("%w: proof for leaf %d of height %d, expect %d of %d",
+			ErrChunkMismatch, proof.Index, proof.Height, chunkIndex, fs.height())
+	}
+	if expect := fs.chunkLen(chunkIndex); int64(len(chunkData)) != expect {
+		return fmt.Errorf("%w: chunk %d is %d bytes, expect %d",
+			ErrChunkMismatch, chunkIndex, len(chunkData), expect)
+	}
+	if proof.ComputeRoot(chunkLeaf(chunkData)) != fs.Root {
+		return fmt.Errorf("%w: chunk %d doesn't hash to the root", ErrChunkMismatch, chunkIndex)
+	}
+	return nil
+}
+
+// Bytes returns the file signature's encoding.  The tree isn't part of it.
+func (self FileSignature) Bytes() []byte {
+	b := make([]byte, 0, FILE_SIGNATURE_BYTES)
+	b = append(b, self.Root[:]...)
+	b = binary.BigEndian.AppendUint64(b, uint64(self.ChunkSize))
+	b = binary.BigEndian.AppendUint64(b, uint64(self.ChunkCount))
+	b = binary.BigEndian.AppendUint64(b, uint64(self.Size))
+	return append(b, self.Signature.Bytes()...)
+}
+
+// FileSignatureFromBytes is the inverse of FileSignature.Bytes.
+func FileSignatureFromBytes(b []byte) (FileSignature, error) {
+	if len(b) != FILE_SIGNATURE_BYTES {
+		return FileSignature{}, fmt.Errorf("%w: file signature %d bytes, expect %d",
+			ErrWrongLength, len(b), FILE_SIGNATURE_BYTES)
+	}
+	var fs FileSignature
+	copy(fs.Root[:], b)
+	chunkSize := binary.BigEndian.Uint64(b[32:])
+	chunkCount := binary.BigEndian.Uint64(b[40:])
+	size := binary.BigEndian.Uint64(b[48:])
+	if chunkSize == 0 || chunkSize > 1<<31 || chunkCount == 0 ||
+		chunkCount > 1<<AUTH_PATH_MAX_HEIGHT || size > 1<<62 ||
+		(size+chunkSize-1)/chunkSize != chunkCount && !(size == 0 && chunkCount == 1) {
+		return FileSignature{}, fmt.Errorf(
+			"file signature: %d bytes in %d chunks of %d don't add up", size, chunkCount, chunkSize)
+	}
+	fs.ChunkSize = int(chunkSize)
+	fs.ChunkCount = int(chunkCount)
+	fs.Size = int64(size)
+	sig, err := SignatureFromBytes(b[56:])
+	if err != nil {
+		return FileSignature{}, err
+	}
+	fs.Signature = sig
+	return fs, nil
+}
+
+// MarshalBinary encodes the file signature as a CONTAINER_FILE_MERKLE
+// container.
+func (self FileSignature) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_FILE_MERKLE, self.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a file signature from MarshalBinary.
+func (self *FileSignature) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_FILE_MERKLE {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_FILE_MERKLE}
+	}
+	fs, err := FileSignatureFromBytes(c.Payload)
+	if err != nil {
+		return err
+	}
+	*self = fs
+	return nil
+}
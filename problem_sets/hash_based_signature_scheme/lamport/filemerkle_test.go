@@ -0,0 +1,169 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeTestFile writes size bytes of deterministic junk to a temp file.
+func writeTestFile(t *testing.T, size int) (string, []byte) {
+	t.Helper()
+	data := make([]byte, size)
+	rand.New(rand.NewSource(int64(size))).Read(data)
+	path := filepath.Join(t.TempDir(), "firmware.bin")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path, data
+}
+
+// TestVerifyChunk signs a file of 5 chunks, the last one short, and checks
+// every chunk verifies with its proof after the signature is sent over.
+func TestVerifyChunk(t *testing.T) {
+	const chunkSize = 1000
+	path, data := writeTestFile(t, 4*chunkSize+123)
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs, err := SignFileMerkle(path, chunkSize, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fs.ChunkCount != 5 || fs.ChunkSize != chunkSize || fs.Size != int64(len(data)) {
+		t.Fatalf("got %d chunks of %d, %d bytes", fs.ChunkCount, fs.ChunkSize, fs.Size)
+	}
+
+	// the device only has the encoded signature
+	encoded, err := fs.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var device FileSignature
+	if err := device.UnmarshalBinary(encoded); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(device.Bytes(), fs.Bytes()) {
+		t.Fatalf("file signature round trip differs")
+	}
+	if _, err := device.ProofForChunk(0); err == nil {
+		t.Fatalf("ProofForChunk worked without the tree")
+	}
+
+	for i := 0; i < fs.ChunkCount; i++ {
+		proof, err := fs.ProofForChunk(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		end := (i + 1) * chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		if err := VerifyChunk(device, pub, i, data[i*chunkSize:end], proof); err != nil {
+			t.Fatalf("chunk %d: %v", i, err)
+		}
+	}
+	if _, err := fs.ProofForChunk(fs.ChunkCount); err == nil {
+		t.Fatalf("ProofForChunk worked past the last chunk")
+	}
+}
+
+// TestVerifyChunkRejects checks a tampered chunk, a proof for another
+// chunk, a short chunk and another key's signature all fail.
+func TestVerifyChunkRejects(t *testing.T) {
+	const chunkSize = 512
+	path, data := writeTestFile(t, 4*chunkSize)
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs, err := SignFileMerkle(path, chunkSize, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunk := func(i int) []byte {
+		return data[i*chunkSize : (i+1)*chunkSize]
+	}
+	proof1, err := fs.ProofForChunk(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof2, err := fs.ProofForChunk(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := append([]byte(nil), chunk(1)...)
+	tampered[100] ^= 1
+	if err := VerifyChunk(fs, pub, 1, tampered, proof1); !errors.Is(err, ErrChunkMismatch) {
+		t.Fatalf("tampered: got %v, expect ErrChunkMismatch", err)
+	}
+	if err := VerifyChunk(fs, pub, 1, chunk(1), proof2); !errors.Is(err, ErrChunkMismatch) {
+		t.Fatalf("wrong proof: got %v, expect ErrChunkMismatch", err)
+	}
+	// chunk 2's proof relabelled as chunk 1's
+	moved := proof2
+	moved.Index = 1
+	if err := VerifyChunk(fs, pub, 1, chunk(1), moved); !errors.Is(err, ErrChunkMismatch) {
+		t.Fatalf("relabelled proof: got %v, expect ErrChunkMismatch", err)
+	}
+	if err := VerifyChunk(fs, pub, 2, chunk(1), proof2); !errors.Is(err, ErrChunkMismatch) {
+		t.Fatalf("wrong chunk: got %v, expect ErrChunkMismatch", err)
+	}
+	if err := VerifyChunk(fs, pub, 1, chunk(1)[1:], proof1); !errors.Is(err, ErrChunkMismatch) {
+		t.Fatalf("short chunk: got %v, expect ErrChunkMismatch", err)
+	}
+	if err := VerifyChunk(fs, pub, 4, chunk(1), proof1); !errors.Is(err, ErrChunkMismatch) {
+		t.Fatalf("index out of range: got %v, expect ErrChunkMismatch", err)
+	}
+
+	_, otherPub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyChunk(fs, otherPub, 1, chunk(1), proof1); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("other key: got %v, expect ErrInvalidSignature", err)
+	}
+	grown := fs
+	grown.Size++
+	if err := VerifyChunk(grown, pub, 1, chunk(1), proof1); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("changed size: got %v, expect ErrInvalidSignature", err)
+	}
+}
+
+// TestSignFileMerkleEmpty checks an empty file is one empty chunk.
+func TestSignFileMerkleEmpty(t *testing.T) {
+	path, _ := writeTestFile(t, 0)
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs, err := SignFileMerkle(path, 64, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fs.ChunkCount != 1 || fs.Size != 0 {
+		t.Fatalf("got %d chunks, %d bytes", fs.ChunkCount, fs.Size)
+	}
+	proof, err := fs.ProofForChunk(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyChunk(fs, pub, 0, nil, proof); err != nil {
+		t.Fatal(err)
+	}
+	back, err := FileSignatureFromBytes(fs.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(back.Bytes(), fs.Bytes()) {
+		t.Fatalf("empty file signature round trip differs")
+	}
+	if _, err := SignFileMerkle(path, 0, pri); err == nil {
+		t.Fatalf("SignFileMerkle accepted a chunk size of 0")
+	}
+}
@@ -0,0 +1,33 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Fingerprint is the sha256 of a pubkey's canonical binary encoding.  It's a
+// lot easier to compare two of these than two 32KB hex strings.
+type Fingerprint [32]byte
+
+// Fingerprint returns the pubkey's fingerprint.
+func (self PublicKey) Fingerprint() Fingerprint {
+	return sha256.Sum256(self.Bytes())
+}
+
+// String formats the fingerprint as hex in colon separated groups of 4
+// characters, like "9533:eb36:5fed:...".
+func (self Fingerprint) String() string {
+	h := hex.EncodeToString(self[:])
+	groups := make([]string, 0, len(h)/4)
+	for i := 0; i < len(h); i += 4 {
+		groups = append(groups, h[i:i+4])
+	}
+	return strings.Join(groups, ":")
+}
+
+// Short returns the first 8 bytes of the fingerprint as plain hex, a key ID
+// short enough for log lines.
+func (self Fingerprint) Short() string {
+	return hex.EncodeToString(self[:8])
+}
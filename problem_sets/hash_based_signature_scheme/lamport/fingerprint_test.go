@@ -0,0 +1,38 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// TestFingerprintPinned pins the fingerprint of the provided pubkey, so a
+// change to the encoding or the hash can't go unnoticed.
+func TestFingerprintPinned(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp := pub.Fingerprint()
+
+	expected := "9533:eb36:5fed:6195:aa38:c9d5:e3aa:834f:" +
+		"486f:99c4:5764:d826:e569:70c9:9359:e03d"
+	if fp.String() != expected {
+		t.Fatalf("fingerprint %s, expect %s", fp, expected)
+	}
+	if fp.Short() != "9533eb365fed6195" {
+		t.Fatalf("short fingerprint %s, expect 9533eb365fed6195", fp.Short())
+	}
+}
+
+// TestFingerprintDiffers checks that changing one block changes the
+// fingerprint.
+func TestFingerprintDiffers(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub2 := pub
+	pub2.OneHash[255][31] ^= 0x01
+	if pub.Fingerprint() == pub2.Fingerprint() {
+		t.Fatalf("different pubkeys have the same fingerprint")
+	}
+}
@@ -0,0 +1,63 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("rng broke")
+}
+
+// TestGenerateKeyFrom generates from a fixed byte string and checks the key
+// is exactly those bytes.
+func TestGenerateKeyFrom(t *testing.T) {
+	secret := make([]byte, PRIVKEY_BYTES)
+	rand.New(rand.NewSource(7)).Read(secret)
+	pri, pub, err := GenerateKeyFrom(bytes.NewReader(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pri.Bytes(), secret) {
+		t.Fatalf("private key isn't the bytes read")
+	}
+	if pri.GetPublicKey() != pub {
+		t.Fatalf("pubkey doesn't match private key")
+	}
+
+	pri2, _, err := GenerateKeyFrom(bytes.NewReader(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri2 != pri {
+		t.Fatalf("same reader input gave different keys")
+	}
+}
+
+// TestGenerateKeyFromErrors checks short and failing readers are errors.
+func TestGenerateKeyFromErrors(t *testing.T) {
+	_, _, err := GenerateKeyFrom(bytes.NewReader(make([]byte, PRIVKEY_BYTES-1)))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("got %v, expect io.ErrUnexpectedEOF", err)
+	}
+	_, _, err = GenerateKeyFrom(failingReader{})
+	if err == nil || err.Error() != "reading 16384 bytes of key material: rng broke" {
+		t.Fatalf("got %v for a failing reader", err)
+	}
+}
+
+// TestGenerateKeyFromWeak gives GenerateKeyFrom a source that repeats every
+// half key, so both rows come out the same.
+func TestGenerateKeyFromWeak(t *testing.T) {
+	half := make([]byte, PRIVKEY_BYTES/2)
+	rand.New(rand.NewSource(8)).Read(half)
+	_, _, err := GenerateKeyFrom(bytes.NewReader(append(half, half...)))
+	if !errors.Is(err, ErrWeakKey) {
+		t.Fatalf("got %v, expect ErrWeakKey", err)
+	}
+}
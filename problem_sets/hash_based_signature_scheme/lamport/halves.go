@@ -0,0 +1,129 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// HALF_KEY_BYTES is the payload size of a serialized half key: the full
+// pubkey followed by one row of secret blocks.
+const HALF_KEY_BYTES = PUBKEY_BYTES + MESSAGE_BITS*MESSAGE_BYTES
+
+var ErrHalfMismatch = errors.New("half keys don't belong to the same pubkey")
+
+// ZeroHalf is the row 0 half of a private key.  It carries the pubkey so it
+// can be checked against the other half, but has no way to sign by itself:
+// every message needs blocks from both rows (unless it hashes to all zeros).
+type ZeroHalf struct {
+	Blocks [MESSAGE_BITS]Block
+	Pub    PublicKey
+}
+
+// OneHalf is the row 1 half of a private key.
+type OneHalf struct {
+	Blocks [MESSAGE_BITS]Block
+	Pub    PublicKey
+}
+
+// SplitPrivateKey splits pri into its two rows, so they can be stored
+// separately and held by different people.
+func SplitPrivateKey(pri PrivateKey) (ZeroHalf, OneHalf) {
+	pub := pri.GetPublicKey()
+	return ZeroHalf{Blocks: pri.ZeroHash, Pub: pub},
+		OneHalf{Blocks: pri.OneHash, Pub: pub}
+}
+
+// CombineHalves puts the two halves back together.  Both halves must carry
+// the same pubkey and each row has to hash to its side of it; otherwise the
+// error is ErrHalfMismatch.
+func CombineHalves(z ZeroHalf, o OneHalf) (PrivateKey, error) {
+	if z.Pub != o.Pub {
+		return PrivateKey{}, fmt.Errorf("%w: pubkeys %s and %s", ErrHalfMismatch,
+			z.Pub.Fingerprint().Short(), o.Pub.Fingerprint().Short())
+	}
+	pri := PrivateKey{ZeroHash: z.Blocks, OneHash: o.Blocks}
+	pub := pri.GetPublicKey()
+	for i := range pub.ZeroHash {
+		if pub.ZeroHash[i] != z.Pub.ZeroHash[i] {
+			return PrivateKey{}, fmt.Errorf("%w: zero[%d] doesn't match pubkey",
+				ErrHalfMismatch, i)
+		}
+	}
+	for i := range pub.OneHash {
+		if pub.OneHash[i] != o.Pub.OneHash[i] {
+			return PrivateKey{}, fmt.Errorf("%w: one[%d] doesn't match pubkey",
+				ErrHalfMismatch, i)
+		}
+	}
+	return pri, nil
+}
+
+// MarshalBinary encodes the half as a CONTAINER_ZERO_HALF container.
+func (self ZeroHalf) MarshalBinary() ([]byte, error) {
+	return marshalHalf(CONTAINER_ZERO_HALF, self.Pub, &self.Blocks)
+}
+
+// UnmarshalBinary decodes a half from MarshalBinary.
+func (self *ZeroHalf) UnmarshalBinary(data []byte) error {
+	var z ZeroHalf
+	err := unmarshalHalf(data, CONTAINER_ZERO_HALF, &z.Pub, &z.Blocks)
+	if err != nil {
+		return err
+	}
+	*self = z
+	return nil
+}
+
+// MarshalBinary encodes the half as a CONTAINER_ONE_HALF container.
+func (self OneHalf) MarshalBinary() ([]byte, error) {
+	return marshalHalf(CONTAINER_ONE_HALF, self.Pub, &self.Blocks)
+}
+
+// UnmarshalBinary decodes a half from MarshalBinary.
+func (self *OneHalf) UnmarshalBinary(data []byte) error {
+	var o OneHalf
+	err := unmarshalHalf(data, CONTAINER_ONE_HALF, &o.Pub, &o.Blocks)
+	if err != nil {
+		return err
+	}
+	*self = o
+	return nil
+}
+
+// marshalHalf writes pub then blocks into a container of type typ.
+func marshalHalf(typ ContainerType, pub PublicKey, blocks *[MESSAGE_BITS]Block) ([]byte, error) {
+	payload := bytes.NewBuffer(pub.Bytes())
+	for _, block := range blocks {
+		payload.Write(block[:])
+	}
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, typ, payload.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// unmarshalHalf reads a container of type typ into pub and blocks.
+func unmarshalHalf(data []byte, typ ContainerType, pub *PublicKey, blocks *[MESSAGE_BITS]Block) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != typ {
+		return ContainerTypeError{Type: c.Type, Expect: typ}
+	}
+	*pub, err = PubkeyFromBytes(c.Payload[:PUBKEY_BYTES])
+	if err != nil {
+		return err
+	}
+	buf := bytes.NewBuffer(c.Payload[PUBKEY_BYTES:])
+	for i := range blocks {
+		blocks[i], err = BlockFromByteSliceStrict(buf.Next(MESSAGE_BYTES))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestSplitCombine splits a key, serializes both halves, and signs with the
+// recombined key.
+func TestSplitCombine(t *testing.T) {
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, o := SplitPrivateKey(sec)
+
+	zdata, err := z.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	odata, err := o.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var z2 ZeroHalf
+	err = z2.UnmarshalBinary(zdata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var o2 OneHalf
+	err = o2.UnmarshalBinary(odata)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the two files aren't interchangeable
+	err = o2.UnmarshalBinary(zdata)
+	var typeErr ContainerTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("got %v, expect ContainerTypeError", err)
+	}
+
+	sec2, err := CombineHalves(z2, o2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sec2 != sec {
+		t.Fatalf("recombined privkey differs from original")
+	}
+	msg := GetMessageFromString("two man rule")
+	if !Verify(msg, pub, mustSign(t, msg, sec2)) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+}
+
+// TestCombineMismatched checks halves from different keys, and a half with a
+// bad block, are rejected.
+func TestCombineMismatched(t *testing.T) {
+	sec1, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sec2, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	z1, o1 := SplitPrivateKey(sec1)
+	_, o2 := SplitPrivateKey(sec2)
+
+	_, err = CombineHalves(z1, o2)
+	if !errors.Is(err, ErrHalfMismatch) {
+		t.Fatalf("got %v, expect ErrHalfMismatch", err)
+	}
+
+	// same pubkey, but one block of the secret row swapped out
+	o1.Blocks[100] = o2.Blocks[100]
+	_, err = CombineHalves(z1, o1)
+	if !errors.Is(err, ErrHalfMismatch) {
+		t.Fatalf("got %v, expect ErrHalfMismatch", err)
+	}
+}
@@ -0,0 +1,101 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+/*
+A hybrid pubkey hashes every private block twice, with sha256 and with
+BLAKE2b-256, and a signature only verifies if every revealed block matches
+both.  Forging then means finding preimages under both hashes at once, so
+it holds up as long as either hash does.  The private key and signatures
+are the usual ones; only the pubkey doubles, to 32768 bytes:
+
+    sha256 half   PUBKEY_BYTES, as PublicKey.Bytes
+    blake2b half  PUBKEY_BYTES, as SCHEME_BLAKE2B_256 would hash it
+
+and that's the payload of a CONTAINER_HYBRID_PUB container.
+*/
+
+const HYBRID_PUBKEY_BYTES = 2 * PUBKEY_BYTES // 32768
+
+// HybridPublicKey is a pubkey under sha256 and BLAKE2b-256 at once.
+type HybridPublicKey struct {
+	SHA256  PublicKey
+	BLAKE2b PublicKey
+}
+
+// GetHybridPublicKey hashes every block of pri with both hashes.
+func GetHybridPublicKey(pri PrivateKey) HybridPublicKey {
+	return HybridPublicKey{
+		SHA256:  pri.GetPublicKey(),
+		BLAKE2b: SCHEME_BLAKE2B_256.PublicKey(pri),
+	}
+}
+
+// VerifyHybrid checks sig on msg against both halves of pub; it's true only
+// if every block matches under both hashes.  Signatures come from the
+// usual Sign.
+func VerifyHybrid(msg Message, pub HybridPublicKey, sig Signature) bool {
+	return VerifyPtr(msg, &pub.SHA256, &sig) &&
+		SCHEME_BLAKE2B_256.verify(msg, &pub.BLAKE2b, &sig, &tweak{})
+}
+
+// Bytes returns the pubkey's encoding, the sha256 half and then the BLAKE2b
+// half.
+func (self HybridPublicKey) Bytes() []byte {
+	return append(self.SHA256.Bytes(), self.BLAKE2b.Bytes()...)
+}
+
+// HybridPubkeyFromBytes is the inverse of HybridPublicKey.Bytes.
+func HybridPubkeyFromBytes(b []byte) (HybridPublicKey, error) {
+	if len(b) != HYBRID_PUBKEY_BYTES {
+		return HybridPublicKey{}, fmt.Errorf("%w: hybrid pubkey %d bytes, expect %d",
+			ErrWrongLength, len(b), HYBRID_PUBKEY_BYTES)
+	}
+	var pub HybridPublicKey
+	var err error
+	pub.SHA256, err = PubkeyFromBytes(b[:PUBKEY_BYTES])
+	if err != nil {
+		return HybridPublicKey{}, err
+	}
+	pub.BLAKE2b, err = PubkeyFromBytes(b[PUBKEY_BYTES:])
+	if err != nil {
+		return HybridPublicKey{}, err
+	}
+	return pub, nil
+}
+
+// Fingerprint is the sha256 of the pubkey's encoding.
+func (self HybridPublicKey) Fingerprint() Fingerprint {
+	return sha256.Sum256(self.Bytes())
+}
+
+// MarshalBinary encodes the pubkey as a CONTAINER_HYBRID_PUB container.
+func (self HybridPublicKey) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_HYBRID_PUB, self.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a pubkey from MarshalBinary.
+func (self *HybridPublicKey) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_HYBRID_PUB {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_HYBRID_PUB}
+	}
+	pub, err := HybridPubkeyFromBytes(c.Payload)
+	if err != nil {
+		return err
+	}
+	*self = pub
+	return nil
+}
@@ -0,0 +1,99 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestHybridVerify signs with a plain key and checks the hybrid pubkey
+// rejects the signature once either half of a revealed entry is corrupted.
+func TestHybridVerify(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hpub := GetHybridPublicKey(pri)
+	if hpub.SHA256 != pub {
+		t.Fatalf("sha256 half differs from GetPublicKey")
+	}
+	if hpub.BLAKE2b != SCHEME_BLAKE2B_256.PublicKey(pri) {
+		t.Fatalf("blake2b half differs from SCHEME_BLAKE2B_256")
+	}
+	msg := GetMessageFromString("hybrid")
+	sig := mustSign(t, msg, pri)
+	if !VerifyHybrid(msg, hpub, sig) {
+		t.Fatalf("VerifyHybrid returned false, expected true")
+	}
+	if VerifyHybrid(GetMessageFromString("other"), hpub, sig) {
+		t.Fatalf("VerifyHybrid returned true for the wrong message")
+	}
+
+	// the entry the signature reveals for bit 0
+	bad := hpub
+	if msg.Bit(0) == 1 {
+		bad.SHA256.OneHash[0][0] ^= 1
+	} else {
+		bad.SHA256.ZeroHash[0][0] ^= 1
+	}
+	if VerifyHybrid(msg, bad, sig) {
+		t.Fatalf("VerifyHybrid returned true with the sha256 half corrupted")
+	}
+	bad = hpub
+	if msg.Bit(0) == 1 {
+		bad.BLAKE2b.OneHash[0][0] ^= 1
+	} else {
+		bad.BLAKE2b.ZeroHash[0][0] ^= 1
+	}
+	if VerifyHybrid(msg, bad, sig) {
+		t.Fatalf("VerifyHybrid returned true with the blake2b half corrupted")
+	}
+}
+
+// TestHybridPublicKeyMarshal round trips a hybrid pubkey through Bytes and
+// through a container.
+func TestHybridPublicKeyMarshal(t *testing.T) {
+	pri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hpub := GetHybridPublicKey(pri)
+	b := hpub.Bytes()
+	if len(b) != HYBRID_PUBKEY_BYTES {
+		t.Fatalf("got %d bytes, expect %d", len(b), HYBRID_PUBKEY_BYTES)
+	}
+	back, err := HybridPubkeyFromBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back != hpub {
+		t.Fatalf("hybrid pubkey round trip differs")
+	}
+	if _, err := HybridPubkeyFromBytes(b[:PUBKEY_BYTES]); !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+
+	data, err := hpub.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got HybridPublicKey
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got != hpub || got.Fingerprint() != hpub.Fingerprint() {
+		t.Fatalf("hybrid pubkey container round trip differs")
+	}
+
+	var plain bytes.Buffer
+	if err := WriteContainer(&plain, CONTAINER_PUBKEY, hpub.SHA256.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	var typeErr ContainerTypeError
+	if err := got.UnmarshalBinary(plain.Bytes()); !errors.As(err, &typeErr) {
+		t.Fatalf("got %v, expect ContainerTypeError", err)
+	}
+	if !bytes.Equal(got.Bytes(), b) {
+		t.Fatalf("failed UnmarshalBinary changed the pubkey")
+	}
+}
@@ -0,0 +1,68 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/hex"
+)
+
+/*
+The "interleaved" or column-major layout is the other encoding described in
+the comment at the top of assignment/forge.go:
+
+    <bit 0, row 0> <bit 0, row 1> <bit 1, row 0> <bit 1, row 1> ...
+
+These functions convert keys in that layout to and from the row-major
+structures used everywhere else, so material from implementations that chose
+it can still be checked with Verify here.
+*/
+
+// ToInterleavedHex returns the pubkey as hex in the interleaved layout.
+func (self PublicKey) ToInterleavedHex() string {
+	var buf bytes.Buffer
+	buf.Grow(PUBKEY_BYTES)
+	for i := range self.ZeroHash {
+		buf.Write(self.ZeroHash[i][:])
+		buf.Write(self.OneHash[i][:])
+	}
+	return hex.EncodeToString(buf.Bytes())
+}
+
+// PubkeyFromInterleavedHex decodes a pubkey in the interleaved layout.  The
+// length and character checks are the same as HexToPubkey.
+func PubkeyFromInterleavedHex(s string) (PublicKey, error) {
+	var p PublicKey
+
+	expectedLength := 256 * 2 * 64 // 256 blocks long, 2 rows, 64 hex char per block
+
+	bts, err := decodeHexString("pubkey", s, expectedLength)
+	if err != nil {
+		return p, err
+	}
+	buf := bytes.NewBuffer(bts)
+
+	for i := range p.ZeroHash {
+		p.ZeroHash[i], err = BlockFromByteSliceStrict(buf.Next(32))
+		if err != nil {
+			return PublicKey{}, err
+		}
+		p.OneHash[i], err = BlockFromByteSliceStrict(buf.Next(32))
+		if err != nil {
+			return PublicKey{}, err
+		}
+	}
+	return p, nil
+}
+
+// ToInterleavedHex returns the signature as hex in the interleaved layout.
+// A signature only has one block per bit, so there's nothing to interleave and
+// this is the same string as ToHex; it's here so code converting between
+// layouts can treat keys and signatures alike.
+func (self Signature) ToInterleavedHex() string {
+	return self.ToHex()
+}
+
+// SignatureFromInterleavedHex decodes a signature in the interleaved layout,
+// which as with ToInterleavedHex is the same as HexToSignature.
+func SignatureFromInterleavedHex(s string) (Signature, error) {
+	return HexToSignature(s)
+}
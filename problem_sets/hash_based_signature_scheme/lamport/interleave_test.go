@@ -0,0 +1,73 @@
+package lamport
+
+import (
+	"strings"
+	"testing"
+)
+
+// interleaveHex builds the interleaved fixture straight from the row-major
+// hex string, without going through any of the key types.
+func interleaveHex(rowMajor string) string {
+	half := len(rowMajor) / 2
+	var sb strings.Builder
+	for i := 0; i < half; i += 64 {
+		sb.WriteString(rowMajor[i : i+64])
+		sb.WriteString(rowMajor[half+i : half+i+64])
+	}
+	return sb.String()
+}
+
+// TestInterleavedPubkey checks both directions of conversion against a
+// fixture built by string slicing.
+func TestInterleavedPubkey(t *testing.T) {
+	interleaved := interleaveHex(hexPubkey1)
+
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub.ToInterleavedHex() != interleaved {
+		t.Fatalf("ToInterleavedHex doesn't match fixture")
+	}
+
+	pub2, err := PubkeyFromInterleavedHex(interleaved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("interleaved pubkey decoded to a different key")
+	}
+
+	// reading the interleaved string as row-major gives the wrong key
+	pub3, err := HexToPubkey(interleaved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub3 == pub {
+		t.Fatalf("interleaved and row-major decode to the same key")
+	}
+}
+
+// TestInterleavedVerify converts the provided key into interleaved form,
+// decodes it again and verifies the first provided signature on "1".
+func TestInterleavedVerify(t *testing.T) {
+	pub, err := PubkeyFromInterleavedHex(interleaveHex(hexPubkey1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := SignatureFromInterleavedHex(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.ToInterleavedHex() != hexSignature1 {
+		t.Fatalf("signature interleaved hex differs from row-major hex")
+	}
+	if !Verify(GetMessageFromString("1"), pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+
+	_, err = PubkeyFromInterleavedHex(hexSignature1)
+	if err == nil {
+		t.Fatalf("signature length string decoded as interleaved pubkey")
+	}
+}
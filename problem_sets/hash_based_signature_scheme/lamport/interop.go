@@ -0,0 +1,176 @@
+package lamport
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+/*
+Interop vectors are the minimal format shared with implementations in other
+languages: no seeds or private keys, just whether a signature on a message
+should verify under a pubkey.
+
+    {
+        "vectors": [
+            {
+                "name": "<optional>",
+                "pubkey": "<hex, as from PublicKey.ToHex>",
+                "signature": "<hex, as from Signature.ToHex>",
+                "message": "<string, hashed with GetMessageFromString>",
+                "valid": true
+            },
+            ...
+        ]
+    }
+
+The loader is forgiving about what other tools write: a bare array of
+vectors is fine too, unknown fields are ignored, "sig", "msg" and
+"expected" work in place of "signature", "message" and "valid", and hex is
+read with the Lenient functions.  Only a missing message or verdict is an
+error, since there's no way to guess those.
+
+A pubkey or signature that doesn't decode simply doesn't verify, so
+negative vectors can exercise malformed input as well.
+*/
+
+// InteropVector is one entry of an interop vector file.
+type InteropVector struct {
+	Name      string `json:"name"`
+	Pubkey    string `json:"pubkey"`
+	Signature string `json:"signature"`
+	Message   string `json:"message"`
+	Valid     bool   `json:"valid"`
+}
+
+// interopJSON is the JSON layout of an InteropVector with its aliases.
+type interopJSON struct {
+	Name      string  `json:"name"`
+	Pubkey    string  `json:"pubkey"`
+	Signature string  `json:"signature"`
+	Sig       string  `json:"sig"`
+	Message   *string `json:"message"`
+	Msg       *string `json:"msg"`
+	Valid     *bool   `json:"valid"`
+	Expected  *bool   `json:"expected"`
+}
+
+// LoadInteropVectors reads an interop vector file.
+func LoadInteropVectors(r io.Reader) ([]InteropVector, error) {
+	br := bufio.NewReader(r)
+	var raw []interopJSON
+	var err error
+	if interopIsArray(br) {
+		err = json.NewDecoder(br).Decode(&raw)
+	} else {
+		var f struct {
+			Vectors []interopJSON `json:"vectors"`
+		}
+		err = json.NewDecoder(br).Decode(&f)
+		raw = f.Vectors
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading interop vectors: %w", err)
+	}
+
+	vectors := make([]InteropVector, len(raw))
+	for i, j := range raw {
+		v := InteropVector{Name: j.Name, Pubkey: j.Pubkey, Signature: j.Signature}
+		if v.Name == "" {
+			v.Name = fmt.Sprintf("vector %d", i)
+		}
+		if v.Signature == "" {
+			v.Signature = j.Sig
+		}
+		switch {
+		case j.Message != nil:
+			v.Message = *j.Message
+		case j.Msg != nil:
+			v.Message = *j.Msg
+		default:
+			return nil, fmt.Errorf("interop %s: no message", v.Name)
+		}
+		switch {
+		case j.Valid != nil:
+			v.Valid = *j.Valid
+		case j.Expected != nil:
+			v.Valid = *j.Expected
+		default:
+			return nil, fmt.Errorf("interop %s: no valid or expected verdict", v.Name)
+		}
+		vectors[i] = v
+	}
+	return vectors, nil
+}
+
+// interopIsArray reports whether the JSON in br starts with '['.
+func interopIsArray(br *bufio.Reader) bool {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return false
+		}
+		switch b[0] {
+		case ' ', '\t', '\n', '\r':
+			br.ReadByte()
+		default:
+			return b[0] == '['
+		}
+	}
+}
+
+// Verify reports whether the vector's signature verifies, whatever it's
+// expected to do.
+func (self InteropVector) Verify() bool {
+	pub, err := HexToPubkeyLenient(self.Pubkey)
+	if err != nil {
+		return false
+	}
+	sig, err := HexToSignatureLenient(self.Signature)
+	if err != nil {
+		return false
+	}
+	return VerifyPtr(GetMessageFromString(self.Message), &pub, &sig)
+}
+
+// Check returns nil if the vector verifies exactly when it should, and an
+// error wrapping ErrVectorMismatch otherwise.
+func (self InteropVector) Check() error {
+	got := self.Verify()
+	if got != self.Valid {
+		return fmt.Errorf("%w: %s verified %v, expect %v",
+			ErrVectorMismatch, self.Name, got, self.Valid)
+	}
+	return nil
+}
+
+// GenerateInteropVectors writes the default vectors for the key derived from
+// seed: msg signed properly, and then the same signature against another
+// message, in little endian bit order, and against the pubkey with its rows
+// swapped, all of which must fail.
+func GenerateInteropVectors(seed [SEED_BYTES]byte, msg, other string) []InteropVector {
+	spri, pub := GenerateKeyFromSeed(seed)
+	sig := spri.Sign(GetMessageFromString(msg))
+	swapped := PublicKey{ZeroHash: pub.OneHash, OneHash: pub.ZeroHash}
+	return []InteropVector{
+		{Name: "valid", Pubkey: pub.ToHex(), Signature: sig.ToHex(),
+			Message: msg, Valid: true},
+		{Name: "wrong message", Pubkey: pub.ToHex(), Signature: sig.ToHex(),
+			Message: other, Valid: false},
+		{Name: "wrong bit order", Pubkey: pub.ToHex(), Signature: sig.ToHexOrder(LittleEndian),
+			Message: msg, Valid: false},
+		{Name: "swapped rows", Pubkey: swapped.ToHex(), Signature: sig.ToHex(),
+			Message: msg, Valid: false},
+	}
+}
+
+// WriteInteropVectors writes vectors as an indented interop vector file.
+func WriteInteropVectors(w io.Writer, vectors []InteropVector) error {
+	f := struct {
+		Vectors []InteropVector `json:"vectors"`
+	}{vectors}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(f)
+}
@@ -0,0 +1,76 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestInteropDefault checks the default vectors are the ones
+// GenerateInteropVectors writes, and that each verifies as it says.
+func TestInteropDefault(t *testing.T) {
+	data, err := os.ReadFile("testdata/interop_v1.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = WriteInteropVectors(&buf, GenerateInteropVectors(testSeedKey().Seed, "1", "2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Fatalf("GenerateInteropVectors output differs from testdata/interop_v1.json")
+	}
+	vectors, err := LoadInteropVectors(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vectors {
+		if err := v.Check(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// TestLoadInteropVectorsLenient loads the same vectors written the way other
+// tools might write them.
+func TestLoadInteropVectorsLenient(t *testing.T) {
+	v := GenerateInteropVectors(testSeedKey().Seed, "1", "2")[0]
+	pub := "0X" + strings.ToUpper(v.Pubkey[:100]) + `\n` + v.Pubkey[100:]
+	input := `  [{"pubkey": "` + pub + `", "sig": "` + v.Signature +
+		`", "msg": "1", "expected": true, "comment": "from python"},
+		{"pubkey": "00", "signature": "00", "message": "1", "valid": false}]`
+	vectors, err := LoadInteropVectors(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) != 2 {
+		t.Fatalf("got %d vectors, expect 2", len(vectors))
+	}
+	for _, v := range vectors {
+		if err := v.Check(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if vectors[1].Name != "vector 1" {
+		t.Fatalf("unnamed vector called %q, expect \"vector 1\"", vectors[1].Name)
+	}
+
+	// a verdict the other way round is a mismatch
+	vectors[0].Valid = false
+	if err := vectors[0].Check(); !errors.Is(err, ErrVectorMismatch) {
+		t.Fatalf("got %v, expect ErrVectorMismatch", err)
+	}
+
+	for _, bad := range []string{
+		`{"vectors": [{"pubkey": "00", "signature": "00", "valid": true}]}`,
+		`{"vectors": [{"pubkey": "00", "signature": "00", "message": "1"}]}`,
+		`{"vectors": `,
+	} {
+		if _, err := LoadInteropVectors(strings.NewReader(bad)); err == nil {
+			t.Fatalf("LoadInteropVectors accepted %s", bad)
+		}
+	}
+}
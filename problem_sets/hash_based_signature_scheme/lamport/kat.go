@@ -0,0 +1,146 @@
+package lamport
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+Known answer test files, for checking another implementation against this
+one.  A file is JSON:
+
+    {
+        "version": 1,
+        "params": "sha256",
+        "seed": "<32 bytes hex>",
+        "privkey": "<hex, as from PrivateKey.ToHex>",
+        "pubkey": "<hex, as from PublicKey.ToHex>",
+        "vectors": [
+            {"message": "1", "digest": "<hex>", "signature": "<hex>"},
+            ...
+        ]
+    }
+
+The private key is the seed expanded as SeedPrivateKey does, each digest is
+GetMessageFromString of the message, and each signature is the key's
+signature on the digest.  A new parameter set gets a new version or params
+name rather than changing what these mean.
+*/
+
+const KAT_VERSION = 1
+const KAT_PARAMS = "sha256"
+
+var (
+	// ErrVectorMismatch is wrapped by RunVectors when a value in the file
+	// isn't what this implementation derives.
+	ErrVectorMismatch = errors.New("known answer mismatch")
+	// ErrVectorVersion is wrapped by RunVectors for a version or params it
+	// doesn't know.
+	ErrVectorVersion = errors.New("unsupported vector file version")
+)
+
+// VectorFile is a known answer test file.
+type VectorFile struct {
+	Version int      `json:"version"`
+	Params  string   `json:"params"`
+	Seed    string   `json:"seed"`
+	Privkey string   `json:"privkey"`
+	Pubkey  string   `json:"pubkey"`
+	Vectors []Vector `json:"vectors"`
+}
+
+// Vector is one signed message in a VectorFile.
+type Vector struct {
+	Message   string `json:"message"`
+	Digest    string `json:"digest"`
+	Signature string `json:"signature"`
+}
+
+// GenerateVectors derives the key for seed and signs each message with it.
+func GenerateVectors(seed [SEED_BYTES]byte, messages []string) (VectorFile, error) {
+	if len(messages) == 0 {
+		return VectorFile{}, fmt.Errorf("no messages to sign")
+	}
+	spri, pub := GenerateKeyFromSeed(seed)
+	pri := spri.Expand()
+	defer pri.Zeroize()
+	f := VectorFile{
+		Version: KAT_VERSION,
+		Params:  KAT_PARAMS,
+		Seed:    hex.EncodeToString(seed[:]),
+		Privkey: pri.ToHex(),
+		Pubkey:  pub.ToHex(),
+	}
+	for _, s := range messages {
+		msg := GetMessageFromString(s)
+		sig, err := Sign(msg, pri)
+		if err != nil {
+			return VectorFile{}, err
+		}
+		f.Vectors = append(f.Vectors, Vector{
+			Message:   s,
+			Digest:    hex.EncodeToString(msg[:]),
+			Signature: sig.ToHex(),
+		})
+	}
+	return f, nil
+}
+
+// Write writes the file as indented JSON.
+func (self VectorFile) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(self)
+}
+
+// RunVectors reads a vector file from r, re-derives everything in it from
+// the seed and messages, and returns an error wrapping ErrVectorMismatch at
+// the first value that differs.  Hex is compared ignoring case.
+func RunVectors(r io.Reader) error {
+	var f VectorFile
+	err := json.NewDecoder(r).Decode(&f)
+	if err != nil {
+		return fmt.Errorf("reading vector file: %w", err)
+	}
+	if f.Version != KAT_VERSION || f.Params != KAT_PARAMS {
+		return fmt.Errorf("%w: version %d params %q", ErrVectorVersion, f.Version, f.Params)
+	}
+	b, err := hex.DecodeString(f.Seed)
+	if err != nil {
+		return fmt.Errorf("vector file seed: %w", err)
+	}
+	if len(b) != SEED_BYTES {
+		return fmt.Errorf("%w: vector file seed %d bytes, expect %d",
+			ErrWrongLength, len(b), SEED_BYTES)
+	}
+	var seed [SEED_BYTES]byte
+	copy(seed[:], b)
+
+	var messages []string
+	for _, v := range f.Vectors {
+		messages = append(messages, v.Message)
+	}
+	expect, err := GenerateVectors(seed, messages)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(f.Privkey, expect.Privkey) {
+		return fmt.Errorf("%w: privkey", ErrVectorMismatch)
+	}
+	if !strings.EqualFold(f.Pubkey, expect.Pubkey) {
+		return fmt.Errorf("%w: pubkey", ErrVectorMismatch)
+	}
+	for i, v := range f.Vectors {
+		if !strings.EqualFold(v.Digest, expect.Vectors[i].Digest) {
+			return fmt.Errorf("%w: vector %d (%q) digest", ErrVectorMismatch, i, v.Message)
+		}
+		if !strings.EqualFold(v.Signature, expect.Vectors[i].Signature) {
+			return fmt.Errorf("%w: vector %d (%q) signature", ErrVectorMismatch, i, v.Message)
+		}
+	}
+	return nil
+}
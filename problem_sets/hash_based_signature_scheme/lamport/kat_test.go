@@ -0,0 +1,101 @@
+package lamport
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// katV1 is the canonical vector file, written by lamport-demo -kat and
+// checked independently with Python's hashlib.
+//
+//go:embed testdata/kat_v1.json
+var katV1 []byte
+
+// TestRunVectors runs the canonical file, and checks GenerateVectors still
+// writes it byte for byte.
+func TestRunVectors(t *testing.T) {
+	if err := RunVectors(bytes.NewReader(katV1)); err != nil {
+		t.Fatal(err)
+	}
+
+	var f VectorFile
+	if err := json.Unmarshal(katV1, &f); err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for _, v := range f.Vectors {
+		messages = append(messages, v.Message)
+	}
+	vf, err := GenerateVectors(testSeedKey().Seed, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := vf.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), katV1) {
+		t.Fatalf("GenerateVectors output differs from testdata/kat_v1.json")
+	}
+}
+
+// TestRunVectorsMismatch changes one value at a time and checks RunVectors
+// rejects the file.
+func TestRunVectorsMismatch(t *testing.T) {
+	var f VectorFile
+	if err := json.Unmarshal(katV1, &f); err != nil {
+		t.Fatal(err)
+	}
+	run := func(f VectorFile) error {
+		var buf bytes.Buffer
+		if err := f.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		return RunVectors(&buf)
+	}
+	// flip the last hex digit of s
+	flip := func(s string) string {
+		if strings.HasSuffix(s, "0") {
+			return s[:len(s)-1] + "1"
+		}
+		return s[:len(s)-1] + "0"
+	}
+
+	bad := f
+	bad.Pubkey = flip(f.Pubkey)
+	if err := run(bad); !errors.Is(err, ErrVectorMismatch) {
+		t.Fatalf("pubkey: got %v, expect ErrVectorMismatch", err)
+	}
+	bad = f
+	bad.Privkey = flip(f.Privkey)
+	if err := run(bad); !errors.Is(err, ErrVectorMismatch) {
+		t.Fatalf("privkey: got %v, expect ErrVectorMismatch", err)
+	}
+	bad = f
+	bad.Vectors = append([]Vector(nil), f.Vectors...)
+	bad.Vectors[1].Signature = flip(f.Vectors[1].Signature)
+	if err := run(bad); !errors.Is(err, ErrVectorMismatch) {
+		t.Fatalf("signature: got %v, expect ErrVectorMismatch", err)
+	}
+	bad.Vectors[1] = f.Vectors[1]
+	bad.Vectors[2].Message += "!"
+	if err := run(bad); !errors.Is(err, ErrVectorMismatch) {
+		t.Fatalf("message: got %v, expect ErrVectorMismatch", err)
+	}
+	bad = f
+	bad.Version = KAT_VERSION + 1
+	if err := run(bad); !errors.Is(err, ErrVectorVersion) {
+		t.Fatalf("version: got %v, expect ErrVectorVersion", err)
+	}
+
+	// upper case hex is the same answer
+	upper := f
+	upper.Pubkey = strings.ToUpper(f.Pubkey)
+	if err := run(upper); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,112 @@
+package lamport
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// File names used by SaveKeyPair and LoadKeyPair.
+const PRIVKEY_FILE = "lamport.key"
+const PUBKEY_FILE = "lamport.pub"
+
+// SaveKeyPair writes pri to dir/lamport.key (mode 0600) and pub to
+// dir/lamport.pub (mode 0644), both in the versioned container format.  Each
+// file is written to a temp file in dir and renamed into place, so a crash
+// part way through never leaves a truncated key behind.
+func SaveKeyPair(dir string, pri PrivateKey, pub PublicKey) error {
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_PRIVKEY, pri.Bytes())
+	if err != nil {
+		return err
+	}
+	err = writeFileAtomic(filepath.Join(dir, PRIVKEY_FILE), buf.Bytes(), 0600)
+	if err != nil {
+		return err
+	}
+
+	buf.Reset()
+	err = WriteContainer(&buf, CONTAINER_PUBKEY, pub.Bytes())
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(dir, PUBKEY_FILE), buf.Bytes(), 0644)
+}
+
+// LoadKeyPair reads the files written by SaveKeyPair.  It refuses a private
+// key file that is readable by group or others, and checks that the private
+// key actually derives the stored pubkey.
+func LoadKeyPair(dir string) (PrivateKey, PublicKey, error) {
+	priPath := filepath.Join(dir, PRIVKEY_FILE)
+	info, err := os.Stat(priPath)
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, err
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return PrivateKey{}, PublicKey{}, fmt.Errorf(
+			"%s has permissions %#o, must not be accessible by group or others",
+			priPath, info.Mode().Perm())
+	}
+
+	f, err := os.Open(priPath)
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, err
+	}
+	c, err := ReadContainer(f)
+	f.Close()
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, fmt.Errorf("%s: %w", priPath, err)
+	}
+	pri, err := c.PrivateKey()
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, fmt.Errorf("%s: %w", priPath, err)
+	}
+
+	pubPath := filepath.Join(dir, PUBKEY_FILE)
+	f, err = os.Open(pubPath)
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, err
+	}
+	c, err = ReadContainer(f)
+	f.Close()
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, fmt.Errorf("%s: %w", pubPath, err)
+	}
+	pub, err := c.PublicKey()
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, fmt.Errorf("%s: %w", pubPath, err)
+	}
+
+	if pri.GetPublicKey() != pub {
+		return PrivateKey{}, PublicKey{}, fmt.Errorf(
+			"%s doesn't match the private key in %s", pubPath, priPath)
+	}
+	return pri, pub, nil
+}
+
+// writeFileAtomic writes data to a temp file next to path, syncs it, and
+// renames it over path.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	// if anything goes wrong, don't leave the temp file lying around
+	defer os.Remove(tmp.Name())
+
+	err = tmp.Chmod(perm)
+	if err == nil {
+		_, err = tmp.Write(data)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
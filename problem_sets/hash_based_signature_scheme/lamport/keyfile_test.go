@@ -0,0 +1,84 @@
+package lamport
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSaveLoadKeyPair saves a key pair, checks the file modes, and loads it.
+func TestSaveLoadKeyPair(t *testing.T) {
+	dir := t.TempDir()
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = SaveKeyPair(dir, sec, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, perm := range map[string]os.FileMode{
+		PRIVKEY_FILE: 0600, PUBKEY_FILE: 0644} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != perm {
+			t.Fatalf("%s mode %#o, expect %#o", name, info.Mode().Perm(), perm)
+		}
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("%d files in key dir, expect 2 (temp files left behind?)", len(entries))
+	}
+
+	sec2, pub2, err := LoadKeyPair(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sec2 != sec || pub2 != pub {
+		t.Fatalf("key pair changed after save and load")
+	}
+}
+
+// TestLoadKeyPairErrors checks loose permissions and mismatched files.
+func TestLoadKeyPairErrors(t *testing.T) {
+	dir := t.TempDir()
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = SaveKeyPair(dir, sec, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.Chmod(filepath.Join(dir, PRIVKEY_FILE), 0640)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = LoadKeyPair(dir)
+	if err == nil || !strings.Contains(err.Error(), "permissions") {
+		t.Fatalf("got %v, expect permissions error", err)
+	}
+
+	// overwrite the pubkey with somebody else's
+	_, pub2, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = SaveKeyPair(dir, sec, pub2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = LoadKeyPair(dir)
+	if err == nil || !strings.Contains(err.Error(), "doesn't match") {
+		t.Fatalf("got %v, expect mismatch error", err)
+	}
+}
@@ -0,0 +1,74 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+)
+
+var ErrKeyPairMismatch = errors.New("key pair: pubkey doesn't match private key")
+
+// KeyPair keeps a private key together with its pubkey, so the two can't be
+// passed around in the wrong order or mixed up with some other key's.  The
+// free functions GenerateKey, Sign and Verify still work as before.
+type KeyPair struct {
+	Private PrivateKey
+	Public  PublicKey
+}
+
+// GenerateKeyPair is GenerateKey returning a *KeyPair.
+func GenerateKeyPair() (*KeyPair, error) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{Private: pri, Public: pub}, nil
+}
+
+// Sign signs msg with the private key.
+func (self *KeyPair) Sign(msg Message) (Signature, error) {
+	return Sign(msg, self.Private)
+}
+
+// Verify checks sig on msg against the pubkey.
+func (self *KeyPair) Verify(msg Message, sig Signature) bool {
+	return Verify(msg, self.Public, sig)
+}
+
+// MarshalBinary encodes the pair as a CONTAINER_KEYPAIR container, with the
+// private key followed by the pubkey.
+func (self *KeyPair) MarshalBinary() ([]byte, error) {
+	payload := bytes.NewBuffer(self.Private.Bytes())
+	payload.Write(self.Public.Bytes())
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_KEYPAIR, payload.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a pair from MarshalBinary.  The stored pubkey has
+// to be the one the private key derives, otherwise it's ErrKeyPairMismatch.
+func (self *KeyPair) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_KEYPAIR {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_KEYPAIR}
+	}
+	pri, err := PrivkeyFromBytes(c.Payload[:PRIVKEY_BYTES])
+	if err != nil {
+		return err
+	}
+	pub, err := PubkeyFromBytes(c.Payload[PRIVKEY_BYTES:])
+	if err != nil {
+		return err
+	}
+	if pri.GetPublicKey() != pub {
+		return ErrKeyPairMismatch
+	}
+	self.Private = pri
+	self.Public = pub
+	return nil
+}
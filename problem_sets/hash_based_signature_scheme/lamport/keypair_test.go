@@ -0,0 +1,72 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// TestKeyPair signs and verifies with a KeyPair, and round trips it through
+// its container encoding.
+func TestKeyPair(t *testing.T) {
+	kp, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kp.Private.GetPublicKey() != kp.Public {
+		t.Fatalf("GenerateKeyPair pubkey doesn't match private key")
+	}
+
+	msg := GetMessageFromString("pair")
+	sig, err := kp.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !kp.Verify(msg, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	if !Verify(msg, kp.Public, sig) {
+		t.Fatalf("free Verify returned false, expected true")
+	}
+
+	data, err := kp.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != CONTAINER_HEADER_BYTES+PRIVKEY_BYTES+PUBKEY_BYTES {
+		t.Fatalf("key pair %d bytes, expect %d", len(data),
+			CONTAINER_HEADER_BYTES+PRIVKEY_BYTES+PUBKEY_BYTES)
+	}
+	var decoded KeyPair
+	err = decoded.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded != *kp {
+		t.Fatalf("key pair round trip changed keys")
+	}
+}
+
+// TestKeyPairMismatch stores a pubkey from a different key and checks
+// UnmarshalBinary refuses it.
+func TestKeyPairMismatch(t *testing.T) {
+	kp, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mixed := KeyPair{Private: kp.Private, Public: other.Public}
+	data, err := mixed.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded KeyPair
+	err = decoded.UnmarshalBinary(data)
+	if err != ErrKeyPairMismatch {
+		t.Fatalf("got %v, expect ErrKeyPairMismatch", err)
+	}
+	if decoded != (KeyPair{}) {
+		t.Fatalf("failed UnmarshalBinary modified the receiver")
+	}
+}
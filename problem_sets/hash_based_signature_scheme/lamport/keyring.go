@@ -0,0 +1,265 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+/*
+A KeyRing is saved as a CONTAINER_KEYRING container whose payload is
+
+    count    4 bytes, big endian
+    count times:
+        flags    1 byte: 1 once used, plus 2 on the last key if it's the
+                 ring's PoP leaf
+        pubkey   PUBKEY_BYTES
+        privkey  PRIVKEY_BYTES, all zeros once used
+
+Private keys are wiped as soon as they sign, so a saved ring never holds a
+key that's been used.
+*/
+
+var ErrRingExhausted = errors.New("key ring: every key has been used")
+
+// KeyRing holds a batch of one-time keys and hands each one out for exactly
+// one signature, in index order.  Signatures are identified by the index of
+// the key that made them, which the verifier needs along with the ring's
+// pubkeys.  ReservePoPLeaf sets the last key aside for ProvePossession.
+// It's safe to call from several goroutines.
+type KeyRing struct {
+	// Store, if set, is told about each signature before Sign returns it,
+	// and keys it already has a record for are skipped as used.
+	Store StateStore
+
+	mu   sync.Mutex
+	pri  []PrivateKey
+	pub  []PublicKey
+	used []bool
+	next int
+	// the last key is the PoP leaf, which Sign doesn't hand out
+	pop bool
+}
+
+// NewKeyRing generates a ring of n keys from crypto/rand.
+func NewKeyRing(n int) (*KeyRing, error) {
+	return NewKeyRingFrom(rand.Reader, n)
+}
+
+// NewKeyRingFrom generates a ring of n keys with GenerateKeyFrom, reading
+// all of them from r in order.  A deterministic r gives the same ring every
+// time, which is only safe if r is seeded with a secret.
+func NewKeyRingFrom(r io.Reader, n int) (*KeyRing, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("key ring: %d keys, expect at least 1", n)
+	}
+	ring := &KeyRing{
+		pri:  make([]PrivateKey, n),
+		pub:  make([]PublicKey, n),
+		used: make([]bool, n),
+	}
+	for i := 0; i < n; i++ {
+		var err error
+		ring.pri[i], ring.pub[i], err = GenerateKeyFrom(r)
+		if err != nil {
+			ring.Zeroize()
+			return nil, err
+		}
+	}
+	return ring, nil
+}
+
+// Len returns the number of keys in the ring, used or not.
+func (self *KeyRing) Len() int {
+	return len(self.pub)
+}
+
+// Remaining returns the number of keys Sign can still use, which doesn't
+// count a PoP leaf.
+func (self *KeyRing) Remaining() int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	n := 0
+	for _, used := range self.used[:self.signable()] {
+		if !used {
+			n++
+		}
+	}
+	return n
+}
+
+// signable returns how many keys, from the start of the ring, Sign may use:
+// all of them, or all but the PoP leaf.  It's called with mu held.
+func (self *KeyRing) signable() int {
+	if self.pop {
+		return len(self.used) - 1
+	}
+	return len(self.used)
+}
+
+// Used reports whether the key at index has been used.
+func (self *KeyRing) Used(index int) bool {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.used[index]
+}
+
+// PublicKeys returns the pubkeys of every key in the ring, by index.
+func (self *KeyRing) PublicKeys() []PublicKey {
+	return append([]PublicKey(nil), self.pub...)
+}
+
+// Sign signs msg with the next unused key and returns its index.  The key is
+// marked used and wiped before Sign returns, so concurrent calls always get
+// different keys.  When none are left, not counting a PoP leaf, it's
+// ErrRingExhausted, and a next key that's all zeros is ErrZeroKey.
+func (self *KeyRing) Sign(msg Message) (index int, sig Signature, err error) {
+	self.mu.Lock()
+	last := self.signable()
+	for self.next < last {
+		if !self.used[self.next] && self.Store != nil {
+			fp := self.pub[self.next].Fingerprint()
+			if _, ok := self.Store.Lookup(fp); ok {
+				self.used[self.next] = true
+				self.pri[self.next].Zeroize()
+			} else if err := self.Store.Record(fp, msg); err != nil {
+				self.mu.Unlock()
+				return -1, Signature{}, err
+			}
+		}
+		if !self.used[self.next] {
+			break
+		}
+		self.next++
+	}
+	if self.next >= last {
+		self.mu.Unlock()
+		return -1, Signature{}, ErrRingExhausted
+	}
+	index = self.next
+	if self.pri[index].IsZero() {
+		self.mu.Unlock()
+		return -1, Signature{}, ErrZeroKey
+	}
+	self.used[index] = true
+	pri := self.pri[index]
+	self.pri[index].Zeroize()
+	self.mu.Unlock()
+
+	err = SignInto(msg, &pri, &sig)
+	pri.Zeroize()
+	if err != nil {
+		return -1, Signature{}, err
+	}
+	return index, sig, nil
+}
+
+// VerifyAt checks sig on msg against the pubkey at index.  An index outside
+// the ring is false.
+func (self *KeyRing) VerifyAt(index int, msg Message, sig Signature) bool {
+	if index < 0 || index >= len(self.pub) {
+		return false
+	}
+	return Verify(msg, self.pub[index], sig)
+}
+
+// Zeroize wipes every private key in the ring and marks them all used.
+func (self *KeyRing) Zeroize() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	for i := range self.pri {
+		self.pri[i].Zeroize()
+		self.used[i] = true
+	}
+}
+
+// MarshalBinary encodes the whole ring, including which keys are used, as a
+// CONTAINER_KEYRING container.
+func (self *KeyRing) MarshalBinary() ([]byte, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	payload := new(bytes.Buffer)
+	binary.Write(payload, binary.BigEndian, uint32(len(self.pub)))
+	for i := range self.pub {
+		var flags byte
+		if self.used[i] {
+			flags |= 1
+		}
+		if self.pop && i == len(self.pub)-1 {
+			flags |= 2
+		}
+		payload.WriteByte(flags)
+		payload.Write(self.pub[i].Bytes())
+		payload.Write(self.pri[i].Bytes())
+	}
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_KEYRING, payload.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a ring from MarshalBinary.  Every unused private
+// key has to derive its pubkey, otherwise it's ErrKeyPairMismatch, and
+// can't be all zeros, otherwise it's ErrZeroKey.
+func (self *KeyRing) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_KEYRING {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_KEYRING}
+	}
+	const entry = 1 + PUBKEY_BYTES + PRIVKEY_BYTES
+	if len(c.Payload) < 4 {
+		return ContainerLengthError{Type: c.Type, Length: len(c.Payload), Expect: 4}
+	}
+	n := int(binary.BigEndian.Uint32(c.Payload))
+	if n == 0 || len(c.Payload) != 4+n*entry {
+		return ContainerLengthError{
+			Type: c.Type, Length: len(c.Payload), Expect: 4 + n*entry}
+	}
+
+	pri := make([]PrivateKey, n)
+	pub := make([]PublicKey, n)
+	used := make([]bool, n)
+	pop := false
+	for i := 0; i < n; i++ {
+		b := c.Payload[4+i*entry:]
+		if b[0] > 3 || b[0]&2 != 0 && (i != n-1 || n < 2) {
+			return fmt.Errorf("key ring: key %d of %d has flags %d", i, n, b[0])
+		}
+		used[i] = b[0]&1 == 1
+		pop = b[0]&2 != 0
+		pub[i], err = PubkeyFromBytes(b[1 : 1+PUBKEY_BYTES])
+		if err != nil {
+			return err
+		}
+		pri[i], err = PrivkeyFromBytes(b[1+PUBKEY_BYTES : entry])
+		if err != nil {
+			return err
+		}
+		if used[i] {
+			pri[i].Zeroize()
+		} else if pri[i].IsZero() {
+			return fmt.Errorf("key %d: %w", i, ErrZeroKey)
+		} else if pri[i].GetPublicKey() != pub[i] {
+			return fmt.Errorf("key %d: %w", i, ErrKeyPairMismatch)
+		}
+	}
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.pri = pri
+	self.pub = pub
+	self.used = used
+	self.next = 0
+	self.pop = pop
+	return nil
+}
@@ -0,0 +1,171 @@
+package lamport
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// TestKeyRingSign uses up a small ring and checks every signature verifies
+// at its own index only.
+func TestKeyRingSign(t *testing.T) {
+	ring, err := NewKeyRing(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		msg := GetMessageFromString(fmt.Sprint(i))
+		index, sig, err := ring.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if index != i {
+			t.Fatalf("got index %d, expect %d", index, i)
+		}
+		if !ring.VerifyAt(index, msg, sig) {
+			t.Fatalf("VerifyAt returned false, expected true")
+		}
+		if ring.VerifyAt((index+1)%3, msg, sig) {
+			t.Fatalf("VerifyAt with another index returned true, expected false")
+		}
+		if !ring.Used(index) {
+			t.Fatalf("Used(%d) returned false, expected true", index)
+		}
+	}
+	if ring.VerifyAt(3, Message{}, Signature{}) {
+		t.Fatalf("VerifyAt out of range returned true, expected false")
+	}
+	_, _, err = ring.Sign(GetMessageFromString("more"))
+	if !errors.Is(err, ErrRingExhausted) {
+		t.Fatalf("got %v, expect ErrRingExhausted", err)
+	}
+}
+
+// TestKeyRingFrom checks a seeded reader gives the same ring twice.
+func TestKeyRingFrom(t *testing.T) {
+	a, err := NewKeyRingFrom(rand.New(rand.NewSource(7)), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewKeyRingFrom(rand.New(rand.NewSource(7)), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pa, pb := a.PublicKeys(), b.PublicKeys()
+	if len(pa) != 2 || pa[0] != pb[0] || pa[1] != pb[1] || pa[0] == pa[1] {
+		t.Fatalf("seeded rings don't match")
+	}
+	_, err = NewKeyRing(0)
+	if err == nil {
+		t.Fatalf("NewKeyRing(0) returned no error")
+	}
+}
+
+// TestKeyRingConcurrent checks concurrent Signs get distinct indices.
+func TestKeyRingConcurrent(t *testing.T) {
+	const n = 16
+	ring, err := NewKeyRing(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	indices := make(chan int, 2*n)
+	for i := 0; i < 2*n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			index, _, err := ring.Sign(GetMessageFromString(fmt.Sprint(i)))
+			if err == nil {
+				indices <- index
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(indices)
+	seen := make(map[int]bool)
+	for index := range indices {
+		if seen[index] {
+			t.Fatalf("index %d handed out twice", index)
+		}
+		seen[index] = true
+	}
+	if len(seen) != n || ring.Remaining() != 0 {
+		t.Fatalf("got %d signatures and %d keys left, expect %d and 0",
+			len(seen), ring.Remaining(), n)
+	}
+}
+
+// TestKeyRingMarshal saves a half used ring and checks the copy carries on
+// where the original left off.
+func TestKeyRingMarshal(t *testing.T) {
+	ring, err := NewKeyRing(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = ring.Sign(GetMessageFromString("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ring.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var loaded KeyRing
+	err = loaded.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Len() != 3 || loaded.Remaining() != 2 || !loaded.Used(0) {
+		t.Fatalf("got %d keys with %d left, expect 3 with 2", loaded.Len(),
+			loaded.Remaining())
+	}
+	if loaded.PublicKeys()[2] != ring.PublicKeys()[2] {
+		t.Fatalf("pubkeys changed")
+	}
+	msg := GetMessageFromString("second")
+	index, sig, err := loaded.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index != 1 || !ring.VerifyAt(index, msg, sig) {
+		t.Fatalf("loaded ring signed with key %d, expect 1", index)
+	}
+
+	// Break the private key of the last, unused key.
+	data[len(data)-1] ^= 0xff
+	err = loaded.UnmarshalBinary(data)
+	if !errors.Is(err, ErrKeyPairMismatch) {
+		t.Fatalf("got %v, expect ErrKeyPairMismatch", err)
+	}
+	err = loaded.UnmarshalBinary(data[:len(data)-1])
+	if !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+}
+
+// TestKeyRingZeroKey checks a saved ring with an unused all zero key, even
+// one next to its matching pubkey, is refused rather than signed with.
+func TestKeyRingZeroKey(t *testing.T) {
+	ring, err := NewKeyRing(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ring.pri[0] = PrivateKey{}
+	ring.pub[0] = ring.pri[0].GetPublicKey()
+	_, _, err = ring.Sign(GetMessageFromString("zero"))
+	if !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("got %v, expect ErrZeroKey", err)
+	}
+	data, err := ring.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded KeyRing
+	err = loaded.UnmarshalBinary(data)
+	if !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("got %v, expect ErrZeroKey", err)
+	}
+}
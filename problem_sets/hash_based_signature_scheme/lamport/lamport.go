@@ -0,0 +1,249 @@
+// Package lamport implements Lamport one-time signatures over sha256, along
+// with the encodings, file formats and helpers built up around them.  The
+// forgery part of the problem set is in the assignment package, and
+// cmd/lamport-demo is the program that used to be this package's main().
+package lamport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+)
+
+// --Helper Functions defined for test and forge
+// GetMessageFromString returns a Message which is the hash of the given string.
+func GetMessageFromString(s string) Message {
+	return sha256.Sum256([]byte(s))
+}
+
+// BlockFromByteSlice returns a block from a variable length byte slice.
+// Watch out!  Silently ignores potential errors like the slice being too
+// long or too short!
+//
+// Deprecated: use BlockFromByteSliceStrict, which returns an error instead.
+func BlockFromByteSlice(by []byte) Block {
+	var bl Block
+	copy(bl[:], by)
+	return bl
+}
+
+// BlockFromByteSliceStrict returns a block from a byte slice, which must be
+// exactly MESSAGE_BYTES long.  Decoders read blocks with bytes.Buffer.Next,
+// which returns a short slice when it runs out, so this is what catches a
+// truncated input.
+func BlockFromByteSliceStrict(by []byte) (Block, error) {
+	var bl Block
+	if len(by) != MESSAGE_BYTES {
+		return bl, fmt.Errorf("%w: block %d bytes, expect %d",
+			ErrWrongLength, len(by), MESSAGE_BYTES)
+	}
+	copy(bl[:], by)
+	return bl, nil
+}
+
+// HexToPubkey takes a string from PublicKey.ToHex() and turns it into a pubkey
+// will return a *DecodeError if there are non hex characters or if the lenght
+// is wrong.
+func HexToPubkey(s string) (PublicKey, error) {
+	var p PublicKey
+
+	expectedLength := 256 * 2 * 64 // 256 blocks long, 2 rows, 64 hex char per block
+
+	// decode from hex to a byte slice, checking the length first
+	bts, err := decodeHexString("pubkey", s, expectedLength)
+	if err != nil {
+		return p, err
+	}
+	// we already checked the length of the hex string so don't need to re-check
+	buf := bytes.NewBuffer(bts)
+
+	for i := range p.ZeroHash {
+		p.ZeroHash[i], err = BlockFromByteSliceStrict(buf.Next(32))
+		if err != nil {
+			return PublicKey{}, err
+		}
+	}
+	for i := range p.OneHash {
+		p.OneHash[i], err = BlockFromByteSliceStrict(buf.Next(32))
+		if err != nil {
+			return PublicKey{}, err
+		}
+	}
+
+	return p, nil
+}
+
+// HexToSignature is the same idea as HexToPubkey, but half as big.  Format is just
+// every block of the signature in sequence.
+func HexToSignature(s string) (Signature, error) {
+	var sig Signature
+
+	expectedLength := 256 * 64 // 256 blocks long, 1 row, 64 hex char per block
+
+	// decode from hex to a byte slice, checking the length first
+	bts, err := decodeHexString("signature", s, expectedLength)
+	if err != nil {
+		return sig, err
+	}
+	// we already checked the length of the hex string so don't need to re-check
+	buf := bytes.NewBuffer(bts)
+
+	for i := range sig.Preimage {
+		sig.Preimage[i], err = BlockFromByteSliceStrict(buf.Next(32))
+		if err != nil {
+			return Signature{}, err
+		}
+	}
+	return sig, nil
+}
+
+const MESSAGE_BITS = 256
+const MESSAGE_BYTES = MESSAGE_BITS / 8
+
+type Block [MESSAGE_BYTES]byte
+
+// Hash returns the sha256 hash of the block.
+func (self Block) Hash() Block {
+	return sha256.Sum256(self[:])
+}
+
+type Message [MESSAGE_BYTES]byte // 256 bits
+type PublicKey struct {
+	ZeroHash [MESSAGE_BITS]Block
+	OneHash  [MESSAGE_BITS]Block
+}
+type PrivateKey struct {
+	ZeroHash [MESSAGE_BITS]Block
+	OneHash  [MESSAGE_BITS]Block
+}
+
+func (self PrivateKey) GetPublicKey() PublicKey {
+	pub := PublicKey{ZeroHash: [MESSAGE_BITS]Block{}, OneHash: [MESSAGE_BITS]Block{}}
+	publicKeyInto(&self, &pub)
+	return pub
+}
+
+// publicKeyInto is GetPublicKey writing into pub, shared with
+// GetPublicKeyPtr.
+func publicKeyInto(pri *PrivateKey, pub *PublicKey) {
+	for i, block := range pri.ZeroHash {
+		pub.ZeroHash[i] = block.Hash()
+	}
+	for i, block := range pri.OneHash {
+		pub.OneHash[i] = block.Hash()
+	}
+}
+
+type Signature struct {
+	Preimage [MESSAGE_BITS]Block
+}
+
+func ReadHash() ([MESSAGE_BITS]Block, error) {
+	hash := [MESSAGE_BITS]Block{}
+	for i := 0; i < MESSAGE_BITS; i++ {
+		block := make([]byte, MESSAGE_BYTES)
+		_, err := rand.Read(block)
+		if err != nil {
+			fmt.Println("error:", err)
+			return [MESSAGE_BITS]Block{}, err
+		}
+
+		hash[i], err = BlockFromByteSliceStrict(block)
+		if err != nil {
+			return [MESSAGE_BITS]Block{}, err
+		}
+	}
+
+	return hash, nil
+}
+
+// GenerateKey takes no arguments, and returns a keypair and potentially an
+// error.  It gets randomness from the OS via crypto/rand
+// This can return an error if there is a problem with reading random bytes
+func GenerateKey() (PrivateKey, PublicKey, error) {
+	return GenerateKeyFrom(rand.Reader)
+}
+
+// GenerateKeyFrom is GenerateKey with the randomness read from r instead of
+// crypto/rand: all PRIVKEY_BYTES of secret key, row 0 then row 1.  A read
+// error or a short read from r is returned as an error, never a partly
+// random key.  So is a source that gives the same block for both rows of a
+// bit, which is ErrWeakKey.
+func GenerateKeyFrom(r io.Reader) (PrivateKey, PublicKey, error) {
+	secret := make([]byte, PRIVKEY_BYTES)
+	_, err := io.ReadFull(r, secret)
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, fmt.Errorf(
+			"reading %d bytes of key material: %w", PRIVKEY_BYTES, err)
+	}
+	pri, err := PrivkeyFromBytes(secret)
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, err
+	}
+	// a broken or repeating source can give the same block for both rows,
+	// and then signatures don't commit to that bit at all
+	for i := range pri.ZeroHash {
+		if pri.ZeroHash[i] == pri.OneHash[i] {
+			return PrivateKey{}, PublicKey{}, fmt.Errorf(
+				"%w: both rows of bit %d are the same block", ErrWeakKey, i)
+		}
+	}
+	pub := pri.GetPublicKey()
+
+	return pri, pub, nil
+}
+
+// Sign takes a message and secret key, and returns a signature.
+// msg is expected to be a digest already, like GetMessageFromString returns;
+// use SignBytes or SignString to sign data that hasn't been hashed.
+// An all zero key, which is never a real one, is ErrZeroKey.
+func Sign(msg Message, pri PrivateKey) (Signature, error) {
+	sig := Signature{}
+	err := SignInto(msg, &pri, &sig)
+	return sig, err
+}
+
+// SignInto is Sign writing the signature into sig, which lets a loop reuse
+// one Signature instead of copying or allocating a new one each time.  On
+// error sig is left untouched.
+func SignInto(msg Message, pri *PrivateKey, sig *Signature) error {
+	if pri.IsZero() {
+		return ErrZeroKey
+	}
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if msg.Bit(i) == 0 {
+			sig.Preimage[i] = pri.ZeroHash[i]
+		} else {
+			sig.Preimage[i] = pri.OneHash[i]
+		}
+	}
+	return nil
+}
+
+// Verify takes a message, public key and signature, and returns a boolean
+// describing the validity of the signature.  Nothing verifies with an all
+// zero pubkey.
+func Verify(msg Message, pub PublicKey, sig Signature) bool {
+	return VerifyPtr(msg, &pub, &sig)
+}
+
+// VerifyPtr is Verify taking pointers, so neither the 16KB pubkey nor the 8KB
+// signature is copied.
+func VerifyPtr(msg Message, pub *PublicKey, sig *Signature) bool {
+	if pub.IsZero() {
+		return false
+	}
+	for i := 0; i < MESSAGE_BITS; i++ {
+		expect := &pub.ZeroHash[i]
+		if msg.Bit(i) == 1 {
+			expect = &pub.OneHash[i]
+		}
+		if !sig.Preimage[i].Hash().Equal(*expect) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,39 @@
+// Package lamporttest has test helpers for checking an implementation
+// against shared vector files, kept out of package lamport so that it
+// doesn't import testing.
+package lamporttest
+
+import (
+	"os"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// AssertVectors loads the interop vector file at path and runs every vector
+// as a subtest, failing each one that doesn't verify exactly when it should.
+// See lamport.LoadInteropVectors for the format.
+func AssertVectors(t *testing.T, path string) {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	vectors, err := lamport.LoadInteropVectors(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) == 0 {
+		t.Fatalf("%s has no vectors", path)
+	}
+	for _, v := range vectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			err := v.Check()
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
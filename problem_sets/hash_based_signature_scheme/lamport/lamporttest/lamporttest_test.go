@@ -0,0 +1,11 @@
+package lamporttest
+
+import (
+	"testing"
+)
+
+// TestAssertVectors runs the default interop vectors, which include
+// signatures in the wrong bit order and a pubkey with its rows swapped.
+func TestAssertVectors(t *testing.T) {
+	AssertVectors(t, "../testdata/interop_v1.json")
+}
@@ -0,0 +1,40 @@
+package lamport
+
+import (
+	"strings"
+)
+
+// NormalizeHex cleans up hex pasted from chat, email or a PDF: all ASCII
+// whitespace (including line breaks) is removed, and then a single leading
+// "0x" or "0X" is dropped.  Case is left alone, since hex decoding accepts
+// either.
+func NormalizeHex(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\n', '\r', '\v', '\f':
+			return -1
+		}
+		return r
+	}, s)
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	return s
+}
+
+// HexToPubkeyLenient is HexToPubkey on NormalizeHex(s).  A length error
+// reports the length after normalization.  Use HexToPubkey for input that
+// should already be canonical.
+func HexToPubkeyLenient(s string) (PublicKey, error) {
+	return HexToPubkey(NormalizeHex(s))
+}
+
+// HexToSignatureLenient is HexToSignature on NormalizeHex(s).
+func HexToSignatureLenient(s string) (Signature, error) {
+	return HexToSignature(NormalizeHex(s))
+}
+
+// HexToPrivkeyLenient is HexToPrivkey on NormalizeHex(s).
+func HexToPrivkeyLenient(s string) (PrivateKey, error) {
+	return HexToPrivkey(NormalizeHex(s))
+}
@@ -0,0 +1,66 @@
+package lamport
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// wrapHex breaks s into lines of n characters, the way a mail client would.
+func wrapHex(s string, n int) string {
+	var lines []string
+	for len(s) > n {
+		lines = append(lines, s[:n])
+		s = s[n:]
+	}
+	return strings.Join(append(lines, s), "\r\n")
+}
+
+// TestLenientWrapped decodes a pubkey and signature wrapped at 64 characters,
+// with a 0x prefix, upper case, and stray indentation.
+func TestLenientWrapped(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messy := "  0x" + wrapHex(strings.ToUpper(hexPubkey1), 64) + "\n\t"
+	pub2, err := HexToPubkeyLenient(messy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("lenient pubkey decode gave a different key")
+	}
+
+	// strict decoding still refuses it
+	_, err = HexToPubkey(messy)
+	if err == nil {
+		t.Fatalf("strict decode accepted wrapped pubkey")
+	}
+
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mixed := hexSignature1[:100] + strings.ToUpper(hexSignature1[100:])
+	sig2, err := HexToSignatureLenient(wrapHex(mixed, 64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig2 != sig {
+		t.Fatalf("lenient signature decode gave a different signature")
+	}
+}
+
+// TestLenientLengthError checks the length in the error is counted after
+// whitespace is removed.
+func TestLenientLengthError(t *testing.T) {
+	short := hexSignature1[:len(hexSignature1)-64]
+	_, err := HexToSignatureLenient(wrapHex(short, 64))
+	if err == nil {
+		t.Fatalf("short signature decoded without error")
+	}
+	if !strings.Contains(err.Error(), fmt.Sprint(len(short))) {
+		t.Fatalf("error %q doesn't report normalized length %d", err, len(short))
+	}
+}
@@ -1,7 +1,7 @@
 // You should not need to modify this file.  We will replace
 // main_test.go after submission, so all changes will be lost.
 
-package main
+package lamport
 
 import (
 	"fmt"
@@ -21,7 +21,7 @@ func TestGoodSig(t *testing.T) {
 	}
 
 	// sign message
-	sig := Sign(msg, sec)
+	sig := mustSign(t, msg, sec)
 
 	// verify signature
 	worked := Verify(msg, pub, sig)
@@ -46,7 +46,7 @@ func TestBadSig(t *testing.T) {
 	}
 
 	// sign message
-	sig := Sign(msg, sec)
+	sig := mustSign(t, msg, sec)
 
 	// alter signature.  Hashing a part should break it except with 2^-256 chance
 	sig.Preimage[16] = sig.Preimage[26].Hash()
@@ -78,7 +78,7 @@ func TestGoodMany(t *testing.T) {
 			t.Fatal(err)
 		}
 		// sign message
-		sig := Sign(msg, sec)
+		sig := mustSign(t, msg, sec)
 		// verify signature
 		worked := Verify(msg, pub, sig)
 		if !worked {
@@ -99,7 +99,7 @@ func TestBadMany(t *testing.T) {
 			t.Fatal(err)
 		}
 		// sign message
-		sig := Sign(msg, sec)
+		sig := mustSign(t, msg, sec)
 		sig.Preimage[i%10] = sig.Preimage[i%11].Hash()
 		// verify signature
 		worked := Verify(msg, pub, sig)
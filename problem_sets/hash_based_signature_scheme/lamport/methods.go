@@ -0,0 +1,17 @@
+package lamport
+
+// Sign signs msg with the key.  It's the same as Sign(msg, self).
+func (self PrivateKey) Sign(msg Message) (Signature, error) {
+	return Sign(msg, self)
+}
+
+// Public returns the key's pubkey; a shorter name for GetPublicKey.
+func (self PrivateKey) Public() PublicKey {
+	return self.GetPublicKey()
+}
+
+// Verify checks sig on msg against the pubkey.  It's the same as
+// Verify(msg, self, sig).
+func (self PublicKey) Verify(msg Message, sig Signature) bool {
+	return Verify(msg, self, sig)
+}
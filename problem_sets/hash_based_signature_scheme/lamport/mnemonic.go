@@ -0,0 +1,154 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+/*
+A seed can be written down as a BIP39 mnemonic, so a SeedPrivateKey can be
+backed up on paper.  The seed is the BIP39 entropy: 32 bytes, 256 bits, plus
+the first 8 bits of its sha256 as a checksum make 264 bits, and each 11 of
+those, most significant first, pick one of 2048 words, 24 words in all.
+This is the same mnemonic a BIP39 wallet shows for that entropy, but the
+words map straight back to the seed; BIP39's PBKDF2 step to a 64 byte
+wallet seed isn't used.
+
+Mnemonics are normalized before decoding: any run of whitespace separates
+words, and case is ignored.  The English wordlist is all ASCII, so the
+NFKD normalization BIP39 asks for can't change a word that's on it.
+*/
+
+// MNEMONIC_WORDS is the number of words in the mnemonic for a seed.
+const MNEMONIC_WORDS = 24
+
+var (
+	// A mnemonic with a word that isn't on the BIP39 English wordlist.
+	ErrUnknownWord = errors.New("mnemonic: word not in wordlist")
+	// A mnemonic whose checksum bits don't match its entropy.
+	ErrMnemonicChecksum = errors.New("mnemonic: bad checksum")
+)
+
+// bip39English is the BIP39 English wordlist, one word per line in index
+// order.  Its sha256 is
+// 2f5eed53a4727b4bf8880d8f3f199efc90e58503646d9ff8eff3a2ed3b24dbda.
+//
+//go:embed bip39_english.txt
+var bip39English string
+
+var (
+	wordlistOnce  sync.Once
+	wordlist      []string
+	wordlistIndex map[string]int
+)
+
+func loadWordlist() {
+	wordlistOnce.Do(func() {
+		wordlist = strings.Fields(bip39English)
+		wordlistIndex = make(map[string]int, len(wordlist))
+		for i, w := range wordlist {
+			wordlistIndex[w] = i
+		}
+	})
+}
+
+// SeedToMnemonic returns the 24 word mnemonic for seed.
+func SeedToMnemonic(seed [SEED_BYTES]byte) (string, error) {
+	return entropyToMnemonic(seed[:])
+}
+
+// MnemonicToSeed decodes a mnemonic from SeedToMnemonic.  A word that isn't
+// on the wordlist is ErrUnknownWord, a bad checksum is ErrMnemonicChecksum,
+// and anything but 24 words is ErrWrongLength.
+func MnemonicToSeed(words string) ([SEED_BYTES]byte, error) {
+	var seed [SEED_BYTES]byte
+	entropy, err := mnemonicToEntropy(words)
+	if err != nil {
+		return seed, err
+	}
+	if len(entropy) != SEED_BYTES {
+		return seed, fmt.Errorf("%w: mnemonic has %d words, expect %d",
+			ErrWrongLength, len(strings.Fields(words)), MNEMONIC_WORDS)
+	}
+	copy(seed[:], entropy)
+	return seed, nil
+}
+
+// entropyToMnemonic encodes any entropy BIP39 allows, 16 to 32 bytes in
+// steps of 4.
+func entropyToMnemonic(entropy []byte) (string, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return "", fmt.Errorf("%w: %d bytes of entropy, expect 16 to 32 in steps of 4",
+			ErrWrongLength, len(entropy))
+	}
+	loadWordlist()
+	sum := sha256.Sum256(entropy)
+	// the checksum is the first len/4 bits of the hash, so one byte is
+	// always enough
+	bits := append(append([]byte(nil), entropy...), sum[0])
+	n := (len(entropy)*8 + len(entropy)/4) / 11
+	words := make([]string, n)
+	for i := range words {
+		index := 0
+		for j := 0; j < 11; j++ {
+			index = index<<1 | int(BitAt(bits, i*11+j))
+		}
+		words[i] = wordlist[index]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// mnemonicToEntropy is the inverse of entropyToMnemonic.
+func mnemonicToEntropy(mnemonic string) ([]byte, error) {
+	loadWordlist()
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, fmt.Errorf("%w: mnemonic has %d words, expect 12 to 24 in steps of 3",
+			ErrWrongLength, len(words))
+	}
+	bits := make([]byte, (len(words)*11+7)/8)
+	for i, w := range words {
+		index, ok := wordlistIndex[w]
+		if !ok {
+			return nil, fmt.Errorf("%w: word %d, %q", ErrUnknownWord, i+1, w)
+		}
+		for j := 0; j < 11; j++ {
+			SetBitAt(bits, i*11+j, byte(index>>(10-j)))
+		}
+	}
+	checksumBits := len(words) / 3
+	entropy := bits[:(len(words)*11-checksumBits)/8]
+	sum := sha256.Sum256(entropy)
+	for j := 0; j < checksumBits; j++ {
+		if BitAt(bits, len(entropy)*8+j) != BitAt(sum[:], j) {
+			return nil, ErrMnemonicChecksum
+		}
+	}
+	return append([]byte(nil), entropy...), nil
+}
+
+// GenerateKeyFromSeed returns the SeedPrivateKey for seed and its pubkey.
+func GenerateKeyFromSeed(seed [SEED_BYTES]byte) (SeedPrivateKey, PublicKey) {
+	pri := SeedPrivateKey{Seed: seed}
+	return pri, pri.GetPublicKey()
+}
+
+// GenerateKeyFromMnemonic decodes words with MnemonicToSeed and returns the
+// key it backs up, for restoring a key from paper.
+func GenerateKeyFromMnemonic(words string) (SeedPrivateKey, PublicKey, error) {
+	seed, err := MnemonicToSeed(words)
+	if err != nil {
+		return SeedPrivateKey{}, PublicKey{}, err
+	}
+	pri, pub := GenerateKeyFromSeed(seed)
+	return pri, pub, nil
+}
+
+// Mnemonic returns the mnemonic that backs up the key.
+func (self SeedPrivateKey) Mnemonic() (string, error) {
+	return SeedToMnemonic(self.Seed)
+}
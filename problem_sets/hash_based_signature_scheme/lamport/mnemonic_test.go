@@ -0,0 +1,164 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// bip39Vectors are entropy and mnemonic pairs from the BIP39 test vectors.
+var bip39Vectors = []struct {
+	entropy  string
+	mnemonic string
+}{
+	{"00000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"},
+	{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow"},
+	{"80808080808080808080808080808080",
+		"letter advice cage absurd amount doctor acoustic avoid letter advice cage above"},
+	{"ffffffffffffffffffffffffffffffff",
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong"},
+	{"000000000000000000000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon agent"},
+	{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal will"},
+	{"9e885d952ad362caeb4efe34a8e91bd2",
+		"ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic"},
+	{"c0ba5a8e914111210f2bd131f3d5e08d",
+		"scheme spot photo card baby mountain device kick cradle pact join borrow"},
+	{"6610b25967cdcca9d59875f5cb50b0ea75433311869e930b",
+		"gravity machine north sort system female filter attitude volume fold club stay feature office ecology stable narrow fog"},
+	{"0000000000000000000000000000000000000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art"},
+	{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth title"},
+	{"8080808080808080808080808080808080808080808080808080808080808080",
+		"letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless"},
+	{"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote"},
+	{"68a79eaca2324873eacc50cb9c6eca8cc68ea5d936f98787c60c7ebc74e6ce7c",
+		"hamster diagram private dutch cause delay private meat slide toddler razor book happy fancy gospel tennis maple dilemma loan word shrug inflict delay length"},
+	{"f585c11aec520db57dd353c69554b21a89b20fb0650966fa0a9d6f74fd989d8f",
+		"void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold"},
+}
+
+// TestBIP39Wordlist checks the embedded wordlist is the one from the spec.
+func TestBIP39Wordlist(t *testing.T) {
+	sum := sha256.Sum256([]byte(bip39English))
+	expect := "2f5eed53a4727b4bf8880d8f3f199efc90e58503646d9ff8eff3a2ed3b24dbda"
+	if hex.EncodeToString(sum[:]) != expect {
+		t.Fatalf("wordlist sha256 %x, expect %s", sum, expect)
+	}
+	loadWordlist()
+	if len(wordlist) != 2048 || len(wordlistIndex) != 2048 {
+		t.Fatalf("got %d words, %d distinct, expect 2048", len(wordlist), len(wordlistIndex))
+	}
+}
+
+// TestBIP39Vectors checks both directions against the spec's vectors, and
+// the 32 byte ones through SeedToMnemonic and MnemonicToSeed.
+func TestBIP39Vectors(t *testing.T) {
+	for _, v := range bip39Vectors {
+		entropy, _ := hex.DecodeString(v.entropy)
+		got, err := entropyToMnemonic(entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != v.mnemonic {
+			t.Fatalf("%s: got %q, expect %q", v.entropy, got, v.mnemonic)
+		}
+		back, err := mnemonicToEntropy(v.mnemonic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(back) != v.entropy {
+			t.Fatalf("%q: got %x, expect %s", v.mnemonic, back, v.entropy)
+		}
+
+		if len(entropy) != SEED_BYTES {
+			if _, err := MnemonicToSeed(v.mnemonic); !errors.Is(err, ErrWrongLength) {
+				t.Fatalf("got %v, expect ErrWrongLength", err)
+			}
+			continue
+		}
+		var seed [SEED_BYTES]byte
+		copy(seed[:], entropy)
+		words, err := SeedToMnemonic(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if words != v.mnemonic {
+			t.Fatalf("SeedToMnemonic got %q, expect %q", words, v.mnemonic)
+		}
+		seed2, err := MnemonicToSeed(words)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seed2 != seed {
+			t.Fatalf("MnemonicToSeed got %x, expect %x", seed2, seed)
+		}
+	}
+}
+
+// TestMnemonicKey restores a key from its mnemonic, with the words
+// mangled the ways normalization should undo.
+func TestMnemonicKey(t *testing.T) {
+	pri, pub, err := GenerateSeedKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	words, err := pri.Mnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(strings.Fields(words)); n != MNEMONIC_WORDS {
+		t.Fatalf("got %d words, expect %d", n, MNEMONIC_WORDS)
+	}
+	mangled := "  " + strings.ToUpper(strings.ReplaceAll(words, " ", " \n\t ")) + "\n"
+	pri2, pub2, err := GenerateKeyFromMnemonic(mangled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri2 != pri || !pub2.Equal(pub) {
+		t.Fatalf("restored key differs")
+	}
+}
+
+// TestMnemonicErrors checks each kind of bad mnemonic gets its own error.
+func TestMnemonicErrors(t *testing.T) {
+	good := bip39Vectors[len(bip39Vectors)-1].mnemonic
+	words := strings.Fields(good)
+
+	unknown := append([]string(nil), words...)
+	unknown[5] = "zombie"
+	_, err := MnemonicToSeed(strings.Join(unknown, " "))
+	if !errors.Is(err, ErrUnknownWord) {
+		t.Fatalf("got %v, expect ErrUnknownWord", err)
+	}
+	if !strings.Contains(err.Error(), "word 6") {
+		t.Fatalf("error %q doesn't say which word", err)
+	}
+
+	// swapping two different words keeps every word valid but changes the
+	// entropy, so the checksum is wrong
+	swapped := append([]string(nil), words...)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	_, err = MnemonicToSeed(strings.Join(swapped, " "))
+	if !errors.Is(err, ErrMnemonicChecksum) {
+		t.Fatalf("got %v, expect ErrMnemonicChecksum", err)
+	}
+	_, err = MnemonicToSeed(strings.Repeat("abandon ", 24))
+	if !errors.Is(err, ErrMnemonicChecksum) {
+		t.Fatalf("got %v, expect ErrMnemonicChecksum", err)
+	}
+
+	for _, n := range []int{0, 23, 25} {
+		_, err = MnemonicToSeed(strings.Repeat("zoo ", n))
+		if !errors.Is(err, ErrWrongLength) {
+			t.Fatalf("%d words: got %v, expect ErrWrongLength", n, err)
+		}
+	}
+}
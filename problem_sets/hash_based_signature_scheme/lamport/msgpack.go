@@ -0,0 +1,330 @@
+package lamport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+/*
+MessagePack encoding, so keys can ride on a msgpack message bus as bin
+fields instead of hex strings.  Each type is a map with string keys:
+
+    { "v": MSGPACK_VERSION, "data": bin }
+
+where data is the canonical binary encoding, the same as Bytes().  The
+method names match the Marshaler / Unmarshaler interfaces of the common Go
+msgpack library, so the types can be dropped straight into it, but nothing
+here depends on it.  Unknown keys are skipped on decode.
+*/
+
+const MSGPACK_VERSION = 1
+
+var errMsgpackTruncated = errors.New("msgpack: truncated input")
+
+// MarshalMsgpack encodes the pubkey as a msgpack map.
+func (self PublicKey) MarshalMsgpack() ([]byte, error) {
+	return msgpackEncode(self.Bytes()), nil
+}
+
+// UnmarshalMsgpack decodes a pubkey from MarshalMsgpack.
+func (self *PublicKey) UnmarshalMsgpack(data []byte) error {
+	payload, err := msgpackDecode(data, PUBKEY_BYTES)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(payload)
+}
+
+// MarshalMsgpack encodes the signature as a msgpack map.
+func (self Signature) MarshalMsgpack() ([]byte, error) {
+	return msgpackEncode(self.Bytes()), nil
+}
+
+// UnmarshalMsgpack decodes a signature from MarshalMsgpack.
+func (self *Signature) UnmarshalMsgpack(data []byte) error {
+	payload, err := msgpackDecode(data, SIGNATURE_BYTES)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(payload)
+}
+
+// msgpackEncode builds the {"v", "data"} map.
+func msgpackEncode(payload []byte) []byte {
+	buf := []byte{0x82} // fixmap, 2 entries
+	buf = append(buf, 0xa1, 'v', MSGPACK_VERSION)
+	buf = append(buf, 0xa4, 'd', 'a', 't', 'a')
+	switch {
+	case len(payload) <= 0xff:
+		buf = append(buf, 0xc4, byte(len(payload)))
+	case len(payload) <= 0xffff:
+		buf = append(buf, 0xc5)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(len(payload)))
+	default:
+		buf = append(buf, 0xc6)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(payload)))
+	}
+	return append(buf, payload...)
+}
+
+// msgpackDecode reads the {"v", "data"} map and returns data, which must be
+// exactly size bytes.
+func msgpackDecode(data []byte, size int) ([]byte, error) {
+	d := msgpackReader{data}
+	n, err := d.mapHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	var payload []byte
+	gotVersion := false
+	for i := 0; i < n; i++ {
+		key, err := d.str()
+		if err != nil {
+			return nil, err
+		}
+		switch key {
+		case "v":
+			v, err := d.uint()
+			if err != nil {
+				return nil, err
+			}
+			if v != MSGPACK_VERSION {
+				return nil, fmt.Errorf("msgpack: unknown version %d", v)
+			}
+			gotVersion = true
+		case "data":
+			payload, err = d.bin()
+			if err != nil {
+				return nil, err
+			}
+			if len(payload) != size {
+				return nil, fmt.Errorf("msgpack: %w: data %d bytes, expect %d",
+					ErrWrongLength, len(payload), size)
+			}
+		default:
+			err = d.skip()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !gotVersion {
+		return nil, errors.New("msgpack: missing version")
+	}
+	if payload == nil {
+		return nil, errors.New("msgpack: missing data")
+	}
+	if len(d.buf) != 0 {
+		return nil, fmt.Errorf("msgpack: %d trailing bytes", len(d.buf))
+	}
+	return payload, nil
+}
+
+// msgpackReader reads the subset of msgpack needed above from buf.
+type msgpackReader struct {
+	buf []byte
+}
+
+// next returns the next n bytes.
+func (self *msgpackReader) next(n int) ([]byte, error) {
+	if n < 0 || n > len(self.buf) {
+		return nil, errMsgpackTruncated
+	}
+	b := self.buf[:n]
+	self.buf = self.buf[n:]
+	return b, nil
+}
+
+// length reads an n byte big endian length.
+func (self *msgpackReader) length(n int) (int, error) {
+	b, err := self.next(n)
+	if err != nil {
+		return 0, err
+	}
+	var l uint64
+	for _, c := range b {
+		l = l<<8 | uint64(c)
+	}
+	// every length or count is at least that many bytes still to come
+	if l > uint64(len(self.buf)) {
+		return 0, errMsgpackTruncated
+	}
+	return int(l), nil
+}
+
+func (self *msgpackReader) mapHeader() (int, error) {
+	b, err := self.next(1)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case b[0]&0xf0 == 0x80:
+		return int(b[0] & 0x0f), nil
+	case b[0] == 0xde:
+		return self.length(2)
+	case b[0] == 0xdf:
+		return self.length(4)
+	}
+	return 0, fmt.Errorf("msgpack: type %#02x, expect map", b[0])
+}
+
+func (self *msgpackReader) str() (string, error) {
+	b, err := self.next(1)
+	if err != nil {
+		return "", err
+	}
+	var n int
+	switch {
+	case b[0]&0xe0 == 0xa0:
+		n = int(b[0] & 0x1f)
+	case b[0] == 0xd9:
+		n, err = self.length(1)
+	case b[0] == 0xda:
+		n, err = self.length(2)
+	case b[0] == 0xdb:
+		n, err = self.length(4)
+	default:
+		return "", fmt.Errorf("msgpack: type %#02x, expect string", b[0])
+	}
+	if err != nil {
+		return "", err
+	}
+	s, err := self.next(n)
+	return string(s), err
+}
+
+func (self *msgpackReader) uint() (uint64, error) {
+	b, err := self.next(1)
+	if err != nil {
+		return 0, err
+	}
+	if b[0] <= 0x7f {
+		return uint64(b[0]), nil
+	}
+	sizes := map[byte]int{0xcc: 1, 0xcd: 2, 0xce: 4, 0xcf: 8}
+	n, ok := sizes[b[0]]
+	if !ok {
+		return 0, fmt.Errorf("msgpack: type %#02x, expect unsigned int", b[0])
+	}
+	v, err := self.next(n)
+	if err != nil {
+		return 0, err
+	}
+	var u uint64
+	for _, c := range v {
+		u = u<<8 | uint64(c)
+	}
+	return u, nil
+}
+
+func (self *msgpackReader) bin() ([]byte, error) {
+	b, err := self.next(1)
+	if err != nil {
+		return nil, err
+	}
+	var n int
+	switch b[0] {
+	case 0xc4:
+		n, err = self.length(1)
+	case 0xc5:
+		n, err = self.length(2)
+	case 0xc6:
+		n, err = self.length(4)
+	default:
+		return nil, fmt.Errorf("msgpack: type %#02x, expect bin", b[0])
+	}
+	if err != nil {
+		return nil, err
+	}
+	return self.next(n)
+}
+
+// skip reads and discards one complete value of any type.
+func (self *msgpackReader) skip() error {
+	b, err := self.next(1)
+	if err != nil {
+		return err
+	}
+	t := b[0]
+
+	var n int
+	switch {
+	case t <= 0x7f || t >= 0xe0 || t == 0xc0 || t == 0xc2 || t == 0xc3:
+		return nil // fixints, nil, bools
+	case t&0xe0 == 0xa0: // fixstr
+		_, err = self.next(int(t & 0x1f))
+		return err
+	case t&0xf0 == 0x90: // fixarray
+		return self.skipN(int(t & 0x0f))
+	case t&0xf0 == 0x80: // fixmap
+		return self.skipN(2 * int(t&0x0f))
+	case t == 0xcc || t == 0xd0:
+		_, err = self.next(1)
+		return err
+	case t == 0xcd || t == 0xd1:
+		_, err = self.next(2)
+		return err
+	case t == 0xce || t == 0xd2 || t == 0xca:
+		_, err = self.next(4)
+		return err
+	case t == 0xcf || t == 0xd3 || t == 0xcb:
+		_, err = self.next(8)
+		return err
+	case t >= 0xd4 && t <= 0xd8: // fixext 1, 2, 4, 8, 16 plus the type byte
+		_, err = self.next(1 + 1<<(t-0xd4))
+		return err
+	case t == 0xc4 || t == 0xd9:
+		n, err = self.length(1)
+	case t == 0xc5 || t == 0xda:
+		n, err = self.length(2)
+	case t == 0xc6 || t == 0xdb:
+		n, err = self.length(4)
+	case t == 0xc7 || t == 0xc8 || t == 0xc9: // ext 8, 16, 32
+		n, err = self.length(1 << (t - 0xc7))
+		n++ // type byte
+	case t == 0xdc:
+		n, err = self.length(2)
+		if err != nil {
+			return err
+		}
+		return self.skipN(n)
+	case t == 0xdd:
+		n, err = self.length(4)
+		if err != nil {
+			return err
+		}
+		return self.skipN(n)
+	case t == 0xde:
+		n, err = self.length(2)
+		if err != nil {
+			return err
+		}
+		return self.skipN(2 * n)
+	case t == 0xdf:
+		n, err = self.length(4)
+		if err != nil {
+			return err
+		}
+		return self.skipN(2 * n)
+	default:
+		return fmt.Errorf("msgpack: unsupported type %#02x", t)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = self.next(n)
+	return err
+}
+
+// skipN skips n values.
+func (self *msgpackReader) skipN(n int) error {
+	for i := 0; i < n; i++ {
+		err := self.skip()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// TestMsgpackRoundTrip round trips the provided pubkey and signature.
+func TestMsgpackRoundTrip(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := pub.MarshalMsgpack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// {"v": 1, "data": bin16(16384)}
+	header, _ := hex.DecodeString("82a17601a464617461c54000")
+	if !bytes.HasPrefix(data, header) {
+		t.Fatalf("pubkey msgpack header %x, expect %x", data[:len(header)], header)
+	}
+	var pub2 PublicKey
+	err = pub2.UnmarshalMsgpack(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = sig.MarshalMsgpack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig2 Signature
+	err = sig2.UnmarshalMsgpack(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pub2 != pub || sig2 != sig {
+		t.Fatalf("msgpack round trip changed pubkey or signature")
+	}
+}
+
+// TestMsgpackFixture decodes a hand built blob with the keys in the other
+// order, a uint16 version, and extra keys of assorted types.
+func TestMsgpackFixture(t *testing.T) {
+	sig, err := HexToSignature(hexSignature2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var blob []byte
+	blob = append(blob, 0x85)                                     // fixmap, 5 entries
+	blob = append(blob, 0xa4, 'n', 'o', 't', 'e', 0xa2, 'h', 'i') // "note": "hi"
+	blob = append(blob, 0xa4, 'd', 'a', 't', 'a', 0xc5, 0x20, 0x00)
+	blob = append(blob, sig.Bytes()...)
+	blob = append(blob, 0xa4, 'l', 'i', 's', 't', 0x92, 0xc3, 0xcb) // [true, float64]
+	blob = append(blob, 0, 0, 0, 0, 0, 0, 0, 0)
+	blob = append(blob, 0xa1, 'm', 0x81, 0xa1, 'k', 0xc0) // "m": {"k": nil}
+	blob = append(blob, 0xa1, 'v', 0xcd, 0x00, 0x01)      // "v": uint16 1
+
+	var sig2 Signature
+	err = sig2.UnmarshalMsgpack(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig2 != sig {
+		t.Fatalf("msgpack fixture decoded to a different signature")
+	}
+}
+
+// TestMsgpackRejects checks wrong length data, bad versions and truncation.
+func TestMsgpackRejects(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := sig.MarshalMsgpack()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pub PublicKey
+	err = pub.UnmarshalMsgpack(data)
+	if err == nil {
+		t.Fatalf("signature msgpack decoded as pubkey")
+	}
+
+	var sig2 Signature
+	err = sig2.UnmarshalMsgpack(data[:len(data)-1])
+	if err == nil {
+		t.Fatalf("truncated msgpack decoded without error")
+	}
+
+	bad := append([]byte{}, data...)
+	bad[3] = 2
+	err = sig2.UnmarshalMsgpack(bad)
+	if err == nil {
+		t.Fatalf("msgpack version 2 decoded without error")
+	}
+}
@@ -0,0 +1,226 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+/*
+A Policy is saved as a CONTAINER_POLICY container whose payload is
+
+    n        2 bytes, big endian
+    m        2 bytes, big endian
+    pubkeys  m times PUBKEY_BYTES
+
+and a MultiSignature as a CONTAINER_MULTISIG container whose payload is
+
+    count    2 bytes, big endian
+    count times:
+        index      2 bytes, big endian
+        signature  SIGNATURE_BYTES
+*/
+
+var (
+	ErrInvalidPolicy   = errors.New("multisig: invalid policy")
+	ErrDuplicateSigner = errors.New("multisig: same key signed twice")
+	ErrBelowThreshold  = errors.New("multisig: not enough signatures")
+)
+
+// Policy says a message counts as signed once N of PubKeys have signed it.
+type Policy struct {
+	N       int
+	PubKeys []PublicKey
+}
+
+// NewPolicy returns the policy for n of pubs, which have to be distinct.
+// n has to be between 1 and len(pubs), otherwise it's ErrInvalidPolicy.
+func NewPolicy(n int, pubs []PublicKey) (Policy, error) {
+	p := Policy{N: n, PubKeys: append([]PublicKey(nil), pubs...)}
+	err := p.check()
+	if err != nil {
+		return Policy{}, err
+	}
+	return p, nil
+}
+
+func (self Policy) check() error {
+	m := len(self.PubKeys)
+	if self.N < 1 || self.N > m || m > 0xffff {
+		return fmt.Errorf("%w: %d of %d", ErrInvalidPolicy, self.N, m)
+	}
+	seen := make(map[Fingerprint]int, m)
+	for i, pub := range self.PubKeys {
+		fp := pub.Fingerprint()
+		if j, ok := seen[fp]; ok {
+			return fmt.Errorf("%w: keys %d and %d are the same",
+				ErrInvalidPolicy, j, i)
+		}
+		seen[fp] = i
+	}
+	return nil
+}
+
+// MultiSigPart is one signer's contribution: the index of its key in the
+// Policy and its signature.
+type MultiSigPart struct {
+	Index     int
+	Signature Signature
+}
+
+// MultiSignature collects the signatures of some of a Policy's keys on one
+// message.
+type MultiSignature struct {
+	Parts []MultiSigPart
+}
+
+// AddSignature adds the signature made by the key at index.  Adding a
+// second signature for an index is ErrDuplicateSigner.
+func (self *MultiSignature) AddSignature(index int, sig Signature) error {
+	for _, part := range self.Parts {
+		if part.Index == index {
+			return fmt.Errorf("%w: key %d", ErrDuplicateSigner, index)
+		}
+	}
+	self.Parts = append(self.Parts, MultiSigPart{Index: index, Signature: sig})
+	return nil
+}
+
+// VerifyMulti checks msig on msg against policy.  Every part has to verify
+// with its key, even if there are enough good ones without it, and no key
+// can be counted twice.  Errors are ErrInvalidPolicy, ErrDuplicateSigner,
+// ErrInvalidSignature for a bad part, or ErrBelowThreshold for too few.
+func VerifyMulti(msg Message, policy Policy, msig MultiSignature) error {
+	err := policy.check()
+	if err != nil {
+		return err
+	}
+	seen := make(map[int]bool, len(msig.Parts))
+	for _, part := range msig.Parts {
+		if part.Index < 0 || part.Index >= len(policy.PubKeys) {
+			return fmt.Errorf("%w: part for key %d of %d", ErrInvalidSignature,
+				part.Index, len(policy.PubKeys))
+		}
+		if seen[part.Index] {
+			return fmt.Errorf("%w: key %d", ErrDuplicateSigner, part.Index)
+		}
+		seen[part.Index] = true
+		if !Verify(msg, policy.PubKeys[part.Index], part.Signature) {
+			return fmt.Errorf("%w: part for key %d", ErrInvalidSignature, part.Index)
+		}
+	}
+	if len(seen) < policy.N {
+		return fmt.Errorf("%w: %d of %d", ErrBelowThreshold, len(seen), policy.N)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the policy as a CONTAINER_POLICY container.
+func (self Policy) MarshalBinary() ([]byte, error) {
+	err := self.check()
+	if err != nil {
+		return nil, err
+	}
+	payload := new(bytes.Buffer)
+	binary.Write(payload, binary.BigEndian, uint16(self.N))
+	binary.Write(payload, binary.BigEndian, uint16(len(self.PubKeys)))
+	for _, pub := range self.PubKeys {
+		payload.Write(pub.Bytes())
+	}
+	var buf bytes.Buffer
+	err = WriteContainer(&buf, CONTAINER_POLICY, payload.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a policy from MarshalBinary, which has to pass
+// the same checks as NewPolicy.
+func (self *Policy) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_POLICY {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_POLICY}
+	}
+	if len(c.Payload) < 4 {
+		return ContainerLengthError{Type: c.Type, Length: len(c.Payload), Expect: 4}
+	}
+	n := int(binary.BigEndian.Uint16(c.Payload))
+	m := int(binary.BigEndian.Uint16(c.Payload[2:]))
+	if len(c.Payload) != 4+m*PUBKEY_BYTES {
+		return ContainerLengthError{
+			Type: c.Type, Length: len(c.Payload), Expect: 4 + m*PUBKEY_BYTES}
+	}
+	pubs := make([]PublicKey, m)
+	for i := range pubs {
+		pubs[i], err = PubkeyFromBytes(c.Payload[4+i*PUBKEY_BYTES : 4+(i+1)*PUBKEY_BYTES])
+		if err != nil {
+			return err
+		}
+	}
+	p, err := NewPolicy(n, pubs)
+	if err != nil {
+		return err
+	}
+	*self = p
+	return nil
+}
+
+// MarshalBinary encodes the multi-signature as a CONTAINER_MULTISIG
+// container.
+func (self MultiSignature) MarshalBinary() ([]byte, error) {
+	if len(self.Parts) > 0xffff {
+		return nil, fmt.Errorf("multisig: %d parts, at most %d fit", len(self.Parts), 0xffff)
+	}
+	payload := new(bytes.Buffer)
+	binary.Write(payload, binary.BigEndian, uint16(len(self.Parts)))
+	for _, part := range self.Parts {
+		if part.Index < 0 || part.Index > 0xffff {
+			return nil, fmt.Errorf("multisig: key index %d out of range", part.Index)
+		}
+		binary.Write(payload, binary.BigEndian, uint16(part.Index))
+		payload.Write(part.Signature.Bytes())
+	}
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_MULTISIG, payload.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a multi-signature from MarshalBinary.  Duplicate
+// indices are kept for VerifyMulti to refuse.
+func (self *MultiSignature) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_MULTISIG {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_MULTISIG}
+	}
+	const entry = 2 + SIGNATURE_BYTES
+	if len(c.Payload) < 2 {
+		return ContainerLengthError{Type: c.Type, Length: len(c.Payload), Expect: 2}
+	}
+	count := int(binary.BigEndian.Uint16(c.Payload))
+	if len(c.Payload) != 2+count*entry {
+		return ContainerLengthError{
+			Type: c.Type, Length: len(c.Payload), Expect: 2 + count*entry}
+	}
+	parts := make([]MultiSigPart, count)
+	for i := range parts {
+		b := c.Payload[2+i*entry : 2+(i+1)*entry]
+		parts[i].Index = int(binary.BigEndian.Uint16(b))
+		parts[i].Signature, err = SignatureFromBytes(b[2:])
+		if err != nil {
+			return err
+		}
+	}
+	self.Parts = parts
+	return nil
+}
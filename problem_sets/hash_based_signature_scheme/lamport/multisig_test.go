@@ -0,0 +1,147 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+// multisigKeys generates m keys for a multisig test.
+func multisigKeys(t *testing.T, m int) ([]PrivateKey, []PublicKey) {
+	var pris []PrivateKey
+	var pubs []PublicKey
+	for i := 0; i < m; i++ {
+		pri, pub, err := GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		pris = append(pris, pri)
+		pubs = append(pubs, pub)
+	}
+	return pris, pubs
+}
+
+// TestNewPolicy checks out of range thresholds and repeated keys are
+// refused.
+func TestNewPolicy(t *testing.T) {
+	_, pubs := multisigKeys(t, 3)
+	for _, n := range []int{0, 4, -1} {
+		_, err := NewPolicy(n, pubs)
+		if !errors.Is(err, ErrInvalidPolicy) {
+			t.Fatalf("%d of 3: got %v, expect ErrInvalidPolicy", n, err)
+		}
+	}
+	_, err := NewPolicy(2, []PublicKey{pubs[0], pubs[1], pubs[0]})
+	if !errors.Is(err, ErrInvalidPolicy) {
+		t.Fatalf("got %v, expect ErrInvalidPolicy", err)
+	}
+}
+
+// TestVerifyMulti runs 2 of 3 through the threshold, a duplicate signer and
+// a bad component.
+func TestVerifyMulti(t *testing.T) {
+	pris, pubs := multisigKeys(t, 3)
+	policy, err := NewPolicy(2, pubs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("approved")
+
+	var msig MultiSignature
+	err = msig.AddSignature(0, mustSign(t, msg, pris[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyMulti(msg, policy, msig)
+	if !errors.Is(err, ErrBelowThreshold) {
+		t.Fatalf("got %v, expect ErrBelowThreshold", err)
+	}
+	err = msig.AddSignature(0, mustSign(t, msg, pris[0]))
+	if !errors.Is(err, ErrDuplicateSigner) {
+		t.Fatalf("got %v, expect ErrDuplicateSigner", err)
+	}
+
+	// a duplicate slipped in by hand doesn't count twice
+	dup := MultiSignature{Parts: append(msig.Parts, msig.Parts[0])}
+	err = VerifyMulti(msg, policy, dup)
+	if !errors.Is(err, ErrDuplicateSigner) {
+		t.Fatalf("got %v, expect ErrDuplicateSigner", err)
+	}
+
+	err = msig.AddSignature(2, mustSign(t, msg, pris[2]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyMulti(msg, policy, msig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyMulti(GetMessageFromString("rejected"), policy, msig)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+
+	// two good parts are enough, but a third bad one still fails it
+	bad := MultiSignature{Parts: append([]MultiSigPart{}, msig.Parts...)}
+	err = bad.AddSignature(1, mustSign(t, msg, pris[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyMulti(msg, policy, bad)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+
+	out := MultiSignature{Parts: []MultiSigPart{{Index: 3, Signature: mustSign(t, msg, pris[0])}}}
+	err = VerifyMulti(msg, policy, out)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+}
+
+// TestMultiSigEncoding round trips a policy and a multi-signature.
+func TestMultiSigEncoding(t *testing.T) {
+	pris, pubs := multisigKeys(t, 3)
+	policy, err := NewPolicy(2, pubs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("encode")
+	var msig MultiSignature
+	for _, i := range []int{2, 1} {
+		err = msig.AddSignature(i, mustSign(t, msg, pris[i]))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := policy.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var policy2 Policy
+	err = policy2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msig.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msig2 MultiSignature
+	err = msig2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy2.N != 2 || len(policy2.PubKeys) != 3 || len(msig2.Parts) != 2 ||
+		msig2.Parts[0].Index != 2 {
+		t.Fatalf("round trip changed the policy or multi-signature")
+	}
+	err = VerifyMulti(msg, policy2, msig2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = msig2.UnmarshalBinary(data[:len(data)-1])
+	if !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+}
@@ -0,0 +1,411 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+The fixed size types (PublicKey and friends) are arrays sized by MESSAGE_BITS
+and sha256, which is fast and what the problem set wants, but means trying a
+128 bit toy scheme or a 512 bit one needs a recompile.  Params describes a
+scheme at run time instead: how many message bits are signed, and the hash
+used for the key blocks.  The block size is the hash's output size.  The
+Generic types hold the same rows as the fixed ones, as slices of blocks.
+
+The message signed is MessageBits/8 bytes, bits read big endian as usual, so
+with DefaultParams everything matches Sign and Verify bit for bit.
+*/
+
+// Params sets the size of a scheme and the hash it uses.  MessageHash, if
+// set, turns data into messages instead of Hash, for schemes whose blocks
+// are smaller than their messages.
+type Params struct {
+	MessageBits int
+	Hash        func([]byte) []byte
+	MessageHash func([]byte) []byte
+}
+
+// DefaultParams are the parameters of the fixed size types: 256 message bits
+// and sha256.
+var DefaultParams = Params{MessageBits: MESSAGE_BITS, Hash: sha256Hash}
+
+func sha256Hash(b []byte) []byte {
+	h := sha256.Sum256(b)
+	return h[:]
+}
+
+// ParamsError is returned when a key, signature or message doesn't have the
+// shape the Params call for.
+type ParamsError struct {
+	Field  string
+	Got    int
+	Expect int
+}
+
+func (self *ParamsError) Error() string {
+	return fmt.Sprintf("params: %s %d, expect %d", self.Field, self.Got, self.Expect)
+}
+
+// BlockBytes is the size of one block, the hash's output size.
+func (self Params) BlockBytes() int {
+	return len(self.Hash(nil))
+}
+
+// MessageBytes is the size of the messages signed.
+func (self Params) MessageBytes() int {
+	return self.MessageBits / 8
+}
+
+// Check makes sure the Params can be used: a hash, and a positive whole
+// number of message bytes no longer than the message hash output (so
+// Message can make one).
+func (self Params) Check() error {
+	if self.Hash == nil {
+		return errors.New("params: no hash function")
+	}
+	max := 8 * len(self.messageHash()(nil))
+	if self.MessageBits <= 0 || self.MessageBits%8 != 0 || self.MessageBits > max {
+		return fmt.Errorf(
+			"params: %d message bits, must be a positive multiple of 8 up to %d",
+			self.MessageBits, max)
+	}
+	return nil
+}
+
+func (self Params) messageHash() func([]byte) []byte {
+	if self.MessageHash != nil {
+		return self.MessageHash
+	}
+	return self.Hash
+}
+
+// GenericPrivateKey is a private key for some Params: MessageBits blocks in
+// each row.
+type GenericPrivateKey struct {
+	ZeroHash [][]byte
+	OneHash  [][]byte
+}
+
+// GenericPublicKey is a pubkey for some Params.
+type GenericPublicKey struct {
+	ZeroHash [][]byte
+	OneHash  [][]byte
+}
+
+// GenericSignature is a signature for some Params: one block per message
+// bit.
+type GenericSignature struct {
+	Preimage [][]byte
+}
+
+// Message hashes data with the Params' message hash and keeps the first
+// MessageBytes, giving a message to sign.
+func (self Params) Message(data []byte) []byte {
+	return self.messageHash()(data)[:self.MessageBytes()]
+}
+
+// GenerateKey makes a key pair, reading the private blocks from r (or
+// crypto/rand if r is nil).
+func (self Params) GenerateKey(r io.Reader) (*GenericPrivateKey, *GenericPublicKey, error) {
+	err := self.Check()
+	if err != nil {
+		return nil, nil, err
+	}
+	if r == nil {
+		r = rand.Reader
+	}
+	size := self.BlockBytes()
+	secret := make([]byte, 2*self.MessageBits*size)
+	_, err = io.ReadFull(r, secret)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"reading %d bytes of key material: %w", len(secret), err)
+	}
+
+	pri := &GenericPrivateKey{
+		ZeroHash: make([][]byte, self.MessageBits),
+		OneHash:  make([][]byte, self.MessageBits),
+	}
+	for i := 0; i < self.MessageBits; i++ {
+		pri.ZeroHash[i] = secret[i*size : (i+1)*size]
+		pri.OneHash[i] = secret[(self.MessageBits+i)*size : (self.MessageBits+i+1)*size]
+		if bytes.Equal(pri.ZeroHash[i], pri.OneHash[i]) {
+			return nil, nil, fmt.Errorf(
+				"%w: both rows of bit %d are the same block", ErrWeakKey, i)
+		}
+	}
+	return pri, self.PublicKey(pri), nil
+}
+
+// PublicKey hashes every block of pri.  pri must have the Params' shape;
+// GenerateKey and the adapters below always do.
+func (self Params) PublicKey(pri *GenericPrivateKey) *GenericPublicKey {
+	pub := &GenericPublicKey{
+		ZeroHash: make([][]byte, len(pri.ZeroHash)),
+		OneHash:  make([][]byte, len(pri.OneHash)),
+	}
+	for i, block := range pri.ZeroHash {
+		pub.ZeroHash[i] = self.Hash(block)
+	}
+	for i, block := range pri.OneHash {
+		pub.OneHash[i] = self.Hash(block)
+	}
+	return pub
+}
+
+// Sign signs msg, which must be MessageBytes long, with pri.
+func (self Params) Sign(msg []byte, pri *GenericPrivateKey) (*GenericSignature, error) {
+	err := self.checkShape(msg, pri.ZeroHash, pri.OneHash)
+	if err != nil {
+		return nil, err
+	}
+	sig := &GenericSignature{Preimage: make([][]byte, self.MessageBits)}
+	for i := range sig.Preimage {
+		if BitAt(msg, i) == 0 {
+			sig.Preimage[i] = pri.ZeroHash[i]
+		} else {
+			sig.Preimage[i] = pri.OneHash[i]
+		}
+	}
+	return sig, nil
+}
+
+// Verify checks sig on msg against pub.  It returns nil for a good
+// signature, ErrInvalidSignature for a bad one, and a *ParamsError if msg,
+// pub or sig are the wrong shape for the Params, rather than indexing off
+// the end of something.
+func (self Params) Verify(msg []byte, pub *GenericPublicKey, sig *GenericSignature) error {
+	err := self.checkShape(msg, pub.ZeroHash, pub.OneHash)
+	if err != nil {
+		return err
+	}
+	if len(sig.Preimage) != self.MessageBits {
+		return &ParamsError{Field: "signature blocks",
+			Got: len(sig.Preimage), Expect: self.MessageBits}
+	}
+	for i, block := range sig.Preimage {
+		expect := pub.ZeroHash[i]
+		if BitAt(msg, i) == 1 {
+			expect = pub.OneHash[i]
+		}
+		if !bytes.Equal(self.Hash(block), expect) {
+			return ErrInvalidSignature
+		}
+	}
+	return nil
+}
+
+// checkShape checks the Params, the message length and a key's rows.
+func (self Params) checkShape(msg []byte, zero, one [][]byte) error {
+	err := self.Check()
+	if err != nil {
+		return err
+	}
+	if len(msg) != self.MessageBytes() {
+		return &ParamsError{Field: "message bytes",
+			Got: len(msg), Expect: self.MessageBytes()}
+	}
+	if len(zero) != self.MessageBits || len(one) != self.MessageBits {
+		got := len(zero)
+		if got == self.MessageBits {
+			got = len(one)
+		}
+		return &ParamsError{Field: "key blocks per row",
+			Got: got, Expect: self.MessageBits}
+	}
+	size := self.BlockBytes()
+	for i := range zero {
+		if len(zero[i]) != size {
+			return &ParamsError{Field: "key block bytes", Got: len(zero[i]), Expect: size}
+		}
+		if len(one[i]) != size {
+			return &ParamsError{Field: "key block bytes", Got: len(one[i]), Expect: size}
+		}
+	}
+	return nil
+}
+
+// Generic returns the key as a GenericPrivateKey for DefaultParams.
+func (self PrivateKey) Generic() *GenericPrivateKey {
+	return &GenericPrivateKey{
+		ZeroHash: blockSlices(self.ZeroHash[:]),
+		OneHash:  blockSlices(self.OneHash[:]),
+	}
+}
+
+// Generic returns the pubkey as a GenericPublicKey for DefaultParams.
+func (self PublicKey) Generic() *GenericPublicKey {
+	return &GenericPublicKey{
+		ZeroHash: blockSlices(self.ZeroHash[:]),
+		OneHash:  blockSlices(self.OneHash[:]),
+	}
+}
+
+// Generic returns the signature as a GenericSignature for DefaultParams.
+func (self Signature) Generic() *GenericSignature {
+	return &GenericSignature{Preimage: blockSlices(self.Preimage[:])}
+}
+
+// Fixed converts a pubkey made with DefaultParams (or any Params with 256
+// message bits and 32 byte blocks) back to a PublicKey.
+func (self *GenericPublicKey) Fixed() (PublicKey, error) {
+	var pub PublicKey
+	err := fixedBlocks(pub.ZeroHash[:], self.ZeroHash)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	err = fixedBlocks(pub.OneHash[:], self.OneHash)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	return pub, nil
+}
+
+// Fixed converts a private key back to a PrivateKey, like
+// GenericPublicKey.Fixed.
+func (self *GenericPrivateKey) Fixed() (PrivateKey, error) {
+	var pri PrivateKey
+	err := fixedBlocks(pri.ZeroHash[:], self.ZeroHash)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	err = fixedBlocks(pri.OneHash[:], self.OneHash)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	return pri, nil
+}
+
+// Fixed converts a signature back to a Signature, like
+// GenericPublicKey.Fixed.
+func (self *GenericSignature) Fixed() (Signature, error) {
+	var sig Signature
+	err := fixedBlocks(sig.Preimage[:], self.Preimage)
+	if err != nil {
+		return Signature{}, err
+	}
+	return sig, nil
+}
+
+// blockSlices copies blocks into a slice of byte slices.
+func blockSlices(blocks []Block) [][]byte {
+	out := make([][]byte, len(blocks))
+	for i := range blocks {
+		out[i] = append([]byte{}, blocks[i][:]...)
+	}
+	return out
+}
+
+// fixedBlocks copies in into out, which must be the same length, checking
+// every block is MESSAGE_BYTES.
+func fixedBlocks(out []Block, in [][]byte) error {
+	if len(in) != len(out) {
+		return &ParamsError{Field: "blocks", Got: len(in), Expect: len(out)}
+	}
+	for i := range in {
+		var err error
+		out[i], err = BlockFromByteSliceStrict(in[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PubkeyToHex returns the pubkey as hex, the zero row then the one row, like
+// PublicKey.ToHex.
+func (self Params) PubkeyToHex(pub *GenericPublicKey) string {
+	return rowsToHex(pub.ZeroHash, pub.OneHash)
+}
+
+// PrivkeyToHex returns the private key as hex, laid out like PubkeyToHex.
+func (self Params) PrivkeyToHex(pri *GenericPrivateKey) string {
+	return rowsToHex(pri.ZeroHash, pri.OneHash)
+}
+
+// SignatureToHex returns the signature as hex, every block in order.
+func (self Params) SignatureToHex(sig *GenericSignature) string {
+	return rowsToHex(sig.Preimage)
+}
+
+// HexToPubkey is the inverse of PubkeyToHex.  The string has to be exactly
+// as long as the Params call for, otherwise it's a *DecodeError.
+func (self Params) HexToPubkey(s string) (*GenericPublicKey, error) {
+	rows, err := self.hexToRows("pubkey", s, 2)
+	if err != nil {
+		return nil, err
+	}
+	return &GenericPublicKey{ZeroHash: rows[0], OneHash: rows[1]}, nil
+}
+
+// HexToPrivkey is the inverse of PrivkeyToHex.
+func (self Params) HexToPrivkey(s string) (*GenericPrivateKey, error) {
+	rows, err := self.hexToRows("privkey", s, 2)
+	if err != nil {
+		return nil, err
+	}
+	return &GenericPrivateKey{ZeroHash: rows[0], OneHash: rows[1]}, nil
+}
+
+// HexToSignature is the inverse of SignatureToHex.
+func (self Params) HexToSignature(s string) (*GenericSignature, error) {
+	rows, err := self.hexToRows("signature", s, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &GenericSignature{Preimage: rows[0]}, nil
+}
+
+func rowsToHex(rows ...[][]byte) string {
+	var b strings.Builder
+	for _, row := range rows {
+		for _, block := range row {
+			b.WriteString(hex.EncodeToString(block))
+		}
+	}
+	return b.String()
+}
+
+// hexToRows decodes n rows of MessageBits blocks.
+func (self Params) hexToRows(kind, s string, n int) ([][][]byte, error) {
+	err := self.Check()
+	if err != nil {
+		return nil, err
+	}
+	bts, err := decodeHexString(kind, s, 2*n*self.MessageBits*self.BlockBytes())
+	if err != nil {
+		return nil, err
+	}
+	return self.bytesToRows(bts, n), nil
+}
+
+func rowsToBytes(rows ...[][]byte) []byte {
+	var b []byte
+	for _, row := range rows {
+		for _, block := range row {
+			b = append(b, block...)
+		}
+	}
+	return b
+}
+
+// bytesToRows splits b, which must be n rows long, into rows of blocks.
+func (self Params) bytesToRows(bts []byte, n int) [][][]byte {
+	size := self.BlockBytes()
+	rows := make([][][]byte, n)
+	for r := range rows {
+		rows[r] = make([][]byte, self.MessageBits)
+		for i := range rows[r] {
+			rows[r][i] = bts[:size:size]
+			bts = bts[size:]
+		}
+	}
+	return rows
+}
@@ -0,0 +1,136 @@
+package lamport
+
+import (
+	"crypto/sha512"
+	"errors"
+	"testing"
+)
+
+// TestParamsDefault checks the generic code with DefaultParams agrees with
+// the fixed size Sign and Verify.
+func TestParamsDefault(t *testing.T) {
+	p := DefaultParams
+	gpri, gpub, err := p.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pri, err := gpri.Fixed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := gpub.Fixed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri.GetPublicKey() != pub {
+		t.Fatalf("generic pubkey doesn't match fixed GetPublicKey")
+	}
+
+	msg := GetMessageFromString("generic")
+	if string(p.Message([]byte("generic"))) != string(msg[:]) {
+		t.Fatalf("DefaultParams.Message differs from GetMessageFromString")
+	}
+	gsig, err := p.Sign(msg[:], gpri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := gsig.Fixed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig != mustSign(t, msg, pri) {
+		t.Fatalf("generic signature differs from Sign")
+	}
+
+	// and the other way, through the adapters
+	err = p.Verify(msg[:], pub.Generic(), mustSign(t, msg, pri).Generic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri.Generic().ZeroHash[7][3] != pri.ZeroHash[7][3] {
+		t.Fatalf("Generic adapter moved blocks around")
+	}
+}
+
+// TestParamsSizes signs and verifies with a 128 bit and a 512 bit scheme.
+func TestParamsSizes(t *testing.T) {
+	sha512Hash := func(b []byte) []byte {
+		h := sha512.Sum512(b)
+		return h[:]
+	}
+	for _, p := range []Params{
+		{MessageBits: 128, Hash: sha256Hash},
+		{MessageBits: 512, Hash: sha512Hash},
+	} {
+		pri, pub, err := p.GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(pub.ZeroHash) != p.MessageBits || len(pub.ZeroHash[0]) != p.BlockBytes() {
+			t.Fatalf("%d bit pubkey has the wrong shape", p.MessageBits)
+		}
+		msg := p.Message([]byte("sized"))
+		sig, err := p.Sign(msg, pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = p.Verify(msg, pub, sig)
+		if err != nil {
+			t.Fatalf("%d bits: %v", p.MessageBits, err)
+		}
+		err = p.Verify(p.Message([]byte("other")), pub, sig)
+		if err != ErrInvalidSignature {
+			t.Fatalf("%d bits: got %v, expect ErrInvalidSignature", p.MessageBits, err)
+		}
+	}
+}
+
+// TestParamsMismatch verifies with keys and signatures from other Params and
+// checks for a ParamsError instead of a panic.
+func TestParamsMismatch(t *testing.T) {
+	small := Params{MessageBits: 128, Hash: sha256Hash}
+	pri, pub, err := small.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := small.Message([]byte("mismatch"))
+	sig, err := small.Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, bigPub, err := DefaultParams.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bigMsg := DefaultParams.Message([]byte("mismatch"))
+
+	var perr *ParamsError
+	errs := []error{
+		DefaultParams.Verify(bigMsg, pub, sig),
+		DefaultParams.Verify(bigMsg, bigPub, sig),
+		DefaultParams.Verify(msg, bigPub, sig),
+		small.Verify(msg, bigPub, sig),
+	}
+	_, err = DefaultParams.Sign(bigMsg, pri)
+	errs = append(errs, err)
+	for i, err := range errs {
+		if !errors.As(err, &perr) {
+			t.Fatalf("case %d: got %v, expect a *ParamsError", i, err)
+		}
+	}
+
+	_, err = sig.Fixed()
+	if !errors.As(err, &perr) {
+		t.Fatalf("128 bit signature converted to fixed: %v", err)
+	}
+
+	err = Params{MessageBits: 100, Hash: sha256Hash}.Check()
+	if err == nil {
+		t.Fatalf("100 bit params passed Check")
+	}
+	err = Params{MessageBits: 512, Hash: sha256Hash}.Check()
+	if err == nil {
+		t.Fatalf("512 bit params with sha256 passed Check")
+	}
+}
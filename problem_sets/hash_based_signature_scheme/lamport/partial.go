@@ -0,0 +1,112 @@
+package lamport
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+/*
+Two-party signing by row: one party holds the ZeroHalf, the other the
+OneHalf, and each reveals only the blocks of its own row that the message
+picks.  Neither can sign alone, and neither sees the other's secret blocks
+beyond what the finished signature shows anyway.
+*/
+
+// ErrBadPartial is matched by every PartialIndexError.
+var ErrBadPartial = errors.New("bad partial signature")
+
+// RowHalf is one row of a private key, a ZeroHalf or a OneHalf.
+type RowHalf interface {
+	Row() int
+	Block(index int) Block
+}
+
+// Row returns 0.
+func (self ZeroHalf) Row() int { return 0 }
+
+// Block returns the secret block at index.
+func (self ZeroHalf) Block(index int) Block { return self.Blocks[index] }
+
+// Row returns 1.
+func (self OneHalf) Row() int { return 1 }
+
+// Block returns the secret block at index.
+func (self OneHalf) Block(index int) Block { return self.Blocks[index] }
+
+// PartialEntry is one revealed block.
+type PartialEntry struct {
+	Index int
+	Block Block
+}
+
+// PartialSignature is what one party reveals: the blocks of its row at the
+// indexes where the message bit is that row.
+type PartialSignature struct {
+	Row     int
+	Entries []PartialEntry
+}
+
+// PartialIndexError says which index made CombinePartials fail and why.
+type PartialIndexError struct {
+	Index  int
+	Reason string
+}
+
+func (self *PartialIndexError) Error() string {
+	return fmt.Sprintf("partial signature index %d: %s", self.Index, self.Reason)
+}
+
+// Is matches ErrBadPartial.
+func (self *PartialIndexError) Is(target error) bool {
+	return target == ErrBadPartial
+}
+
+// SignPartial reveals half's blocks for the bits of msg in its row.
+func SignPartial(msg Message, half RowHalf) PartialSignature {
+	row := half.Row()
+	p := PartialSignature{Row: row}
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if int(msg.Bit(i)) == row {
+			p.Entries = append(p.Entries, PartialEntry{Index: i, Block: half.Block(i)})
+		}
+	}
+	return p
+}
+
+// CombinePartials merges two partial signatures on msg.  Every index has to
+// come from exactly one of them, from the partial whose row matches the
+// message bit there; otherwise the error is a *PartialIndexError for the
+// lowest offending index.  It doesn't check the blocks against a pubkey,
+// so Verify the result.
+func CombinePartials(msg Message, a, b PartialSignature) (Signature, error) {
+	var sig Signature
+	var have [MESSAGE_BITS]bool
+	var errs []*PartialIndexError
+	for _, p := range []PartialSignature{a, b} {
+		for _, e := range p.Entries {
+			switch {
+			case e.Index < 0 || e.Index >= MESSAGE_BITS:
+				errs = append(errs, &PartialIndexError{e.Index, "out of range"})
+			case int(msg.Bit(e.Index)) != p.Row:
+				errs = append(errs, &PartialIndexError{e.Index,
+					fmt.Sprintf("row %d block for a %d bit", p.Row, msg.Bit(e.Index))})
+			case have[e.Index]:
+				errs = append(errs, &PartialIndexError{e.Index, "covered twice"})
+			default:
+				have[e.Index] = true
+				sig.Preimage[e.Index] = e.Block
+			}
+		}
+	}
+	for i, ok := range have {
+		if !ok {
+			errs = append(errs, &PartialIndexError{i, "not covered"})
+		}
+	}
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool { return errs[i].Index < errs[j].Index })
+		return Signature{}, errs[0]
+	}
+	return sig, nil
+}
@@ -0,0 +1,82 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestCombinePartials has each half sign its row and checks the combined
+// signature is the whole key's and verifies, in either order.
+func TestCombinePartials(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, o := SplitPrivateKey(pri)
+	msg := GetMessageFromString("two party")
+	pz := SignPartial(msg, z)
+	po := SignPartial(msg, o)
+	if len(pz.Entries)+len(po.Entries) != MESSAGE_BITS {
+		t.Fatalf("partials have %d and %d entries, expect %d in all",
+			len(pz.Entries), len(po.Entries), MESSAGE_BITS)
+	}
+	for _, pair := range [][2]PartialSignature{{pz, po}, {po, pz}} {
+		sig, err := CombinePartials(msg, pair[0], pair[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sig != mustSign(t, msg, pri) {
+			t.Fatalf("combined signature differs from Sign")
+		}
+		if !Verify(msg, pub, sig) {
+			t.Fatalf("Verify returned false, expected true")
+		}
+	}
+}
+
+// TestCombinePartialsRejects checks partials that lie about, repeat or
+// leave out an index are rejected, naming that index.
+func TestCombinePartialsRejects(t *testing.T) {
+	pri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, o := SplitPrivateKey(pri)
+	msg := GetMessageFromString("two party")
+	pz := SignPartial(msg, z)
+	po := SignPartial(msg, o)
+	lie := po.Entries[0].Index
+
+	check := func(name string, a, b PartialSignature, index int) {
+		t.Helper()
+		_, err := CombinePartials(msg, a, b)
+		if !errors.Is(err, ErrBadPartial) {
+			t.Fatalf("%s: got %v, expect ErrBadPartial", name, err)
+		}
+		var indexErr *PartialIndexError
+		if !errors.As(err, &indexErr) || indexErr.Index != index {
+			t.Fatalf("%s: got %v, expect index %d", name, err, index)
+		}
+	}
+
+	// the zero party claims an index whose bit is 1, handing over its own
+	// row's block there
+	bad := pz
+	bad.Entries = append(append([]PartialEntry(nil), pz.Entries...),
+		PartialEntry{Index: lie, Block: z.Blocks[lie]})
+	check("lie", bad, po, lie)
+
+	// both parties claim the same row
+	check("same row", pz, pz, pz.Entries[0].Index)
+
+	// an index left out
+	short := po
+	short.Entries = po.Entries[1:]
+	check("missing", pz, short, lie)
+
+	// an index out of range
+	bad = pz
+	bad.Entries = append(append([]PartialEntry(nil), pz.Entries...),
+		PartialEntry{Index: MESSAGE_BITS})
+	check("out of range", bad, po, MESSAGE_BITS)
+}
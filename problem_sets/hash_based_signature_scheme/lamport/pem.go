@@ -0,0 +1,73 @@
+package lamport
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io"
+)
+
+// PEM block types used for armored keys and signatures.
+const PEM_PUBKEY = "LAMPORT PUBLIC KEY"
+const PEM_PRIVKEY = "LAMPORT PRIVATE KEY"
+const PEM_SIGNATURE = "LAMPORT SIGNATURE"
+
+// EncodePEM writes the pubkey to w as a PEM block, base64 of the binary
+// encoding at 64 characters per line.
+func (self PublicKey) EncodePEM(w io.Writer) error {
+	return pem.Encode(w, &pem.Block{Type: PEM_PUBKEY, Bytes: self.Bytes()})
+}
+
+// DecodePEM reads a pubkey PEM block from r.
+func (self *PublicKey) DecodePEM(r io.Reader) error {
+	data, err := readPEM(r, PEM_PUBKEY)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(data)
+}
+
+// EncodePEM writes the private key to w as a PEM block.
+func (self PrivateKey) EncodePEM(w io.Writer) error {
+	return pem.Encode(w, &pem.Block{Type: PEM_PRIVKEY, Bytes: self.Bytes()})
+}
+
+// DecodePEM reads a private key PEM block from r.
+func (self *PrivateKey) DecodePEM(r io.Reader) error {
+	data, err := readPEM(r, PEM_PRIVKEY)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(data)
+}
+
+// EncodePEM writes the signature to w as a PEM block.
+func (self Signature) EncodePEM(w io.Writer) error {
+	return pem.Encode(w, &pem.Block{Type: PEM_SIGNATURE, Bytes: self.Bytes()})
+}
+
+// DecodePEM reads a signature PEM block from r.
+func (self *Signature) DecodePEM(r io.Reader) error {
+	data, err := readPEM(r, PEM_SIGNATURE)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(data)
+}
+
+// readPEM reads all of r and returns the contents of the first PEM block,
+// which must be of type blockType.  Any text before the BEGIN line is skipped,
+// the same way openssl does.
+func readPEM(r io.Reader, blockType string) ([]byte, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no complete PEM block found for %s", blockType)
+	}
+	if block.Type != blockType {
+		return nil, fmt.Errorf("PEM block is %s, expect %s", block.Type, blockType)
+	}
+	return block.Bytes, nil
+}
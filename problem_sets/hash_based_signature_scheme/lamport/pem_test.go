@@ -0,0 +1,112 @@
+package lamport
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestPEMRoundTrip armors a key pair and a signature and reads them back.
+func TestPEMRoundTrip(t *testing.T) {
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("pem")
+	sig := mustSign(t, msg, sec)
+
+	var buf bytes.Buffer
+	err = pub.EncodePEM(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if len(line) > 64 {
+			t.Fatalf("PEM line %d characters long, expect at most 64", len(line))
+		}
+	}
+	var pub2 PublicKey
+	err = pub2.DecodePEM(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf.Reset()
+	err = sec.EncodePEM(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sec2 PrivateKey
+	err = sec2.DecodePEM(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf.Reset()
+	err = sig.EncodePEM(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig2 Signature
+	err = sig2.DecodePEM(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pub2 != pub || sec2 != sec || sig2 != sig {
+		t.Fatalf("PEM round trip changed key or signature")
+	}
+}
+
+// TestPEMLeadingText makes sure junk before the BEGIN line is ignored, like in
+// an email body.
+func TestPEMLeadingText(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("Hi, here's my key:\n\n")
+	err = pub.EncodePEM(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pub2 PublicKey
+	err = pub2.DecodePEM(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("PEM decode changed pubkey")
+	}
+}
+
+// TestPEMBadInput checks truncated blocks and mismatched block types.
+func TestPEMBadInput(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = sig.EncodePEM(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	armored := buf.String()
+
+	// cut off the END line
+	var sig2 Signature
+	err = sig2.DecodePEM(strings.NewReader(armored[:len(armored)/2]))
+	if err == nil {
+		t.Fatalf("truncated PEM block decoded without error")
+	}
+
+	// signature block read as a pubkey
+	var pub PublicKey
+	err = pub.DecodePEM(strings.NewReader(armored))
+	if err == nil {
+		t.Fatalf("signature PEM block decoded as pubkey")
+	}
+}
@@ -0,0 +1,30 @@
+package lamport
+
+/*
+Sign, Verify and GetPublicKey take their keys and signatures by value, which
+copies 8-16KB per argument on every call.  That's fine for the odd signature
+but shows up in loops like Forge or batch verification, so here are versions
+taking pointers.  VerifyPtr and SignInto live next to Verify and Sign in
+lamport.go, since those are now shims over them.
+
+SignPtr has to put the new signature on the heap, so in a loop SignInto with
+a reused Signature is cheaper still; see the benchmarks in pointer_test.go.
+*/
+
+// SignPtr is Sign taking a pointer to the private key and returning a
+// pointer to a new signature.
+func SignPtr(msg Message, pri *PrivateKey) (*Signature, error) {
+	sig := new(Signature)
+	err := SignInto(msg, pri, sig)
+	if err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// GetPublicKeyPtr is pri.GetPublicKey() without copying pri.
+func GetPublicKeyPtr(pri *PrivateKey) *PublicKey {
+	pub := new(PublicKey)
+	publicKeyInto(pri, pub)
+	return pub
+}
@@ -0,0 +1,113 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// TestPointerAPI checks the pointer functions agree with the value ones.
+func TestPointerAPI(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("pointer")
+
+	if *GetPublicKeyPtr(&pri) != pub {
+		t.Fatalf("GetPublicKeyPtr differs from GetPublicKey")
+	}
+	sig, err := SignPtr(msg, &pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *sig != mustSign(t, msg, pri) {
+		t.Fatalf("SignPtr differs from Sign")
+	}
+	var into Signature
+	err = SignInto(msg, &pri, &into)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if into != *sig {
+		t.Fatalf("SignInto differs from Sign")
+	}
+	if !VerifyPtr(msg, &pub, sig) {
+		t.Fatalf("VerifyPtr returned false, expected true")
+	}
+	if VerifyPtr(GetMessageFromString("other"), &pub, sig) {
+		t.Fatalf("VerifyPtr returned true for the wrong message")
+	}
+}
+
+func benchKey(b *testing.B) (PrivateKey, PublicKey, Message) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	return pri, pub, GetMessageFromString("bench")
+}
+
+func BenchmarkSign(b *testing.B) {
+	pri, _, msg := benchKey(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Sign(msg, pri)
+	}
+}
+
+func BenchmarkSignPtr(b *testing.B) {
+	pri, _, msg := benchKey(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SignPtr(msg, &pri)
+	}
+}
+
+func BenchmarkSignInto(b *testing.B) {
+	pri, _, msg := benchKey(b)
+	var sig Signature
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SignInto(msg, &pri, &sig)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	pri, pub, msg := benchKey(b)
+	sig := mustSign(b, msg, pri)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(msg, pub, sig)
+	}
+}
+
+func BenchmarkVerifyPtr(b *testing.B) {
+	pri, pub, msg := benchKey(b)
+	sig := mustSign(b, msg, pri)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyPtr(msg, &pub, &sig)
+	}
+}
+
+func BenchmarkGetPublicKey(b *testing.B) {
+	pri, _, _ := benchKey(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pri.GetPublicKey()
+	}
+}
+
+func BenchmarkGetPublicKeyPtr(b *testing.B) {
+	pri, _, _ := benchKey(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		GetPublicKeyPtr(&pri)
+	}
+}
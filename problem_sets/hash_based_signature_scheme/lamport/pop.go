@@ -0,0 +1,255 @@
+package lamport
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+Proof of possession: before taking someone's pubkey into a registry, the
+registry sends a fresh random challenge and the key's owner signs
+
+    GetMessage(POP_DOMAIN, challenge || fingerprint)
+
+where fingerprint is the Fingerprint of the proving key.  The challenge
+makes every proof good for one challenge only, so an old proof replayed
+against a new challenge fails, and the fingerprint stops a proof made with
+one key being passed off as one for another.
+
+A one-time key has nothing to spare for this.  A plain Signer spends its
+only signature on the proof and can't sign anything afterwards, so it only
+makes sense for a key that's registered and then retired.  A KeyRing
+instead spends its last key, once ReservePoPLeaf has set it aside as the
+ring's dedicated PoP leaf: Sign never hands it out, so a ring can prove
+possession before or after signing and proving never costs it a signing
+key.  A ring's proof has to vouch for every key in it, not just the leaf
+that signs, so it signs
+
+    GetMessage(POP_DOMAIN, challenge || fingerprint || RingFingerprint)
+
+and is checked with VerifyRingPossession.  Merkle keys in packages mss and
+xmss reserve a leaf the same way, and sign with the root in place of the
+fingerprint.
+*/
+
+const POP_DOMAIN = "pop-v1"
+const POP_CHALLENGE_BYTES = 32
+const POSSESSION_PROOF_BYTES = 32 + SIGNATURE_BYTES
+
+var ringLabel = []byte("lamport key ring")
+
+// ErrNoPoPLeaf is ProvePossession with a many-time key that hasn't set a PoP
+// leaf aside.
+var ErrNoPoPLeaf = errors.New("no PoP leaf reserved, see ReservePoPLeaf")
+
+// Challenge is a registry's random challenge for a proof of possession.
+type Challenge [POP_CHALLENGE_BYTES]byte
+
+// NewChallenge returns a challenge from crypto/rand.
+func NewChallenge() (Challenge, error) {
+	return NewChallengeFrom(rand.Reader)
+}
+
+// NewChallengeFrom reads a challenge from r.
+func NewChallengeFrom(r io.Reader) (Challenge, error) {
+	var c Challenge
+	_, err := io.ReadFull(r, c[:])
+	if err != nil {
+		return Challenge{}, fmt.Errorf("reading %d byte challenge: %w", POP_CHALLENGE_BYTES, err)
+	}
+	return c, nil
+}
+
+// PossessionProof is the answer to a Challenge: the fingerprint of the key
+// that signed it and the signature.
+type PossessionProof struct {
+	Fingerprint Fingerprint
+	Signature   Signature
+}
+
+// Prover is a key that can answer a Challenge: a *Signer or a *KeyRing.
+type Prover interface {
+	provePossession(c Challenge) (PossessionProof, error)
+}
+
+func popMessage(c Challenge, fp Fingerprint) Message {
+	return GetMessage(POP_DOMAIN, append(c[:], fp[:]...))
+}
+
+// ProvePossession signs c with prover, which uses up a one-time key; see
+// Prover.
+func ProvePossession(c Challenge, prover Prover) (PossessionProof, error) {
+	return prover.provePossession(c)
+}
+
+// RingFingerprint identifies a ring by all of its pubkeys, in order: the
+// sha256 of "lamport key ring" and each of their fingerprints.
+func RingFingerprint(pubs []PublicKey) Fingerprint {
+	h := sha256.New()
+	h.Write(ringLabel)
+	for i := range pubs {
+		fp := pubs[i].Fingerprint()
+		h.Write(fp[:])
+	}
+	var fp Fingerprint
+	h.Sum(fp[:0])
+	return fp
+}
+
+func ringPoPMessage(c Challenge, fp, ring Fingerprint) Message {
+	b := append(append(c[:], fp[:]...), ring[:]...)
+	return GetMessage(POP_DOMAIN, b)
+}
+
+// VerifyPossession checks proof answers c for pub.  A proof made by another
+// key is ErrWrongKey, and one that doesn't verify, as for a replayed proof
+// made for another challenge, is ErrInvalidSignature.
+func VerifyPossession(c Challenge, pub PublicKey, proof PossessionProof) error {
+	fp := pub.Fingerprint()
+	if proof.Fingerprint != fp {
+		return fmt.Errorf("%w: proof by %s, expect %s", ErrWrongKey,
+			proof.Fingerprint.Short(), fp.Short())
+	}
+	if !VerifyPtr(popMessage(c, fp), &pub, &proof.Signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifyRingPossession checks proof answers c for the ring with pubkeys
+// pubs, whose last key is its PoP leaf.  A proof by another key is
+// ErrWrongKey, and one that doesn't verify, as for a replayed proof or one
+// made by a ring with any other key in it, is ErrInvalidSignature.
+func VerifyRingPossession(c Challenge, pubs []PublicKey, proof PossessionProof) error {
+	if len(pubs) < 2 {
+		return fmt.Errorf("key ring: %d keys, a PoP leaf needs at least 2", len(pubs))
+	}
+	leaf := &pubs[len(pubs)-1]
+	fp := leaf.Fingerprint()
+	if proof.Fingerprint != fp {
+		return fmt.Errorf("%w: proof by %s, expect PoP leaf %s", ErrWrongKey,
+			proof.Fingerprint.Short(), fp.Short())
+	}
+	if !VerifyPtr(ringPoPMessage(c, fp, RingFingerprint(pubs)), leaf, &proof.Signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// provePossession spends the Signer's signature on the proof.
+func (self *Signer) provePossession(c Challenge) (PossessionProof, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if self.pri.IsZero() {
+		return PossessionProof{}, ErrZeroKey
+	}
+	fp := self.pri.GetPublicKey().Fingerprint()
+	sig, err := self.sign(popMessage(c, fp))
+	if err != nil {
+		return PossessionProof{}, err
+	}
+	return PossessionProof{Fingerprint: fp, Signature: sig}, nil
+}
+
+// ReservePoPLeaf sets the ring's last key aside as its PoP leaf, so Sign
+// stops before it and ProvePossession can use it.  The ring needs at least
+// one other key to sign with, and the last key mustn't have signed yet.
+// Reserving it again does nothing.
+func (self *KeyRing) ReservePoPLeaf() error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if len(self.pub) < 2 {
+		return fmt.Errorf("key ring: %d keys, a PoP leaf needs at least 2",
+			len(self.pub))
+	}
+	if self.pop {
+		return nil
+	}
+	index := len(self.pub) - 1
+	if self.used[index] {
+		return fmt.Errorf("%w: key ring key %d", ErrKeyAlreadyUsed, index)
+	}
+	self.pop = true
+	return nil
+}
+
+// PoPIndex returns the index of the ring's PoP leaf, its last key, or -1 if
+// it hasn't reserved one.
+func (self *KeyRing) PoPIndex() int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if !self.pop {
+		return -1
+	}
+	return len(self.pub) - 1
+}
+
+// Fingerprint returns the RingFingerprint of the ring's pubkeys.
+func (self *KeyRing) Fingerprint() Fingerprint {
+	return RingFingerprint(self.pub)
+}
+
+// provePossession spends the ring's PoP leaf on a proof that covers the
+// whole ring.  A ring without one is ErrNoPoPLeaf, and a second proof is
+// ErrKeyAlreadyUsed.
+func (self *KeyRing) provePossession(c Challenge) (PossessionProof, error) {
+	index := self.PoPIndex()
+	if index < 0 {
+		return PossessionProof{}, ErrNoPoPLeaf
+	}
+	fp := self.pub[index].Fingerprint()
+	msg := ringPoPMessage(c, fp, self.Fingerprint())
+
+	self.mu.Lock()
+	if !self.used[index] && self.Store != nil {
+		if _, ok := self.Store.Lookup(fp); ok {
+			self.used[index] = true
+			self.pri[index].Zeroize()
+		} else if err := self.Store.Record(fp, msg); err != nil {
+			self.mu.Unlock()
+			return PossessionProof{}, err
+		}
+	}
+	if self.used[index] {
+		self.mu.Unlock()
+		return PossessionProof{}, fmt.Errorf("%w: key ring PoP leaf %d", ErrKeyAlreadyUsed, index)
+	}
+	self.used[index] = true
+	pri := self.pri[index]
+	self.pri[index].Zeroize()
+	self.mu.Unlock()
+
+	var proof PossessionProof
+	proof.Fingerprint = fp
+	err := SignInto(msg, &pri, &proof.Signature)
+	pri.Zeroize()
+	if err != nil {
+		return PossessionProof{}, err
+	}
+	return proof, nil
+}
+
+// Bytes returns the proof's encoding, the fingerprint and then the
+// signature.
+func (self PossessionProof) Bytes() []byte {
+	return append(append([]byte(nil), self.Fingerprint[:]...), self.Signature.Bytes()...)
+}
+
+// PossessionProofFromBytes is the inverse of PossessionProof.Bytes.
+func PossessionProofFromBytes(b []byte) (PossessionProof, error) {
+	if len(b) != POSSESSION_PROOF_BYTES {
+		return PossessionProof{}, fmt.Errorf("%w: possession proof %d bytes, expect %d",
+			ErrWrongLength, len(b), POSSESSION_PROOF_BYTES)
+	}
+	var proof PossessionProof
+	copy(proof.Fingerprint[:], b)
+	sig, err := SignatureFromBytes(b[32:])
+	if err != nil {
+		return PossessionProof{}, err
+	}
+	proof.Signature = sig
+	return proof, nil
+}
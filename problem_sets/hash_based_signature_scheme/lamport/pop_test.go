@@ -0,0 +1,232 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestProvePossession proves possession with a plain Signer and checks the
+// proof only answers its own challenge, for its own key, and that the
+// Signer is used up afterwards.
+func TestProvePossession(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSigner(pri)
+	proof, err := ProvePossession(c, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPossession(c, pub, proof); err != nil {
+		t.Fatal(err)
+	}
+
+	// replayed against a new challenge
+	c2, err := NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPossession(c2, pub, proof); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("replay: got %v, expect ErrInvalidSignature", err)
+	}
+
+	// passed off as a proof for another key
+	otherPri, otherPub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPossession(c, otherPub, proof); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("cross key: got %v, expect ErrWrongKey", err)
+	}
+	forged := proof
+	forged.Fingerprint = otherPub.Fingerprint()
+	if err := VerifyPossession(c, otherPub, forged); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("cross key fingerprint: got %v, expect ErrInvalidSignature", err)
+	}
+	// the other key's proof for the same challenge doesn't stand in either
+	otherProof, err := ProvePossession(c, NewSigner(otherPri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherProof.Fingerprint = pub.Fingerprint()
+	if err := VerifyPossession(c, pub, otherProof); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("other proof: got %v, expect ErrInvalidSignature", err)
+	}
+
+	// the plain key's one signature is gone
+	if _, err := s.Sign(GetMessageFromString("after")); !errors.Is(err, ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+	if _, err := ProvePossession(c2, s); !errors.Is(err, ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+
+	back, err := PossessionProofFromBytes(proof.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back != proof {
+		t.Fatalf("proof round trip differs")
+	}
+	if _, err := PossessionProofFromBytes(proof.Bytes()[1:]); !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+}
+
+// TestProvePossessionKeyRing proves possession with a ring's PoP leaf and
+// checks the rest of the ring still signs.
+func TestProvePossessionKeyRing(t *testing.T) {
+	ring, err := NewKeyRing(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ring.ReservePoPLeaf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewChallengeFrom(bytes.NewReader(make([]byte, POP_CHALLENGE_BYTES)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := ProvePossession(c, ring)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubs := ring.PublicKeys()
+	if err := VerifyRingPossession(c, pubs, proof); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyRingPossession(c, pubs[:2], proof); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("got %v, expect ErrWrongKey", err)
+	}
+	// the proof is for the whole ring, not just its PoP leaf
+	if err := VerifyPossession(c, pubs[2], proof); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("leaf alone: got %v, expect ErrInvalidSignature", err)
+	}
+	_, otherPub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	swapped := append([]PublicKey{otherPub}, pubs[1:]...)
+	if err := VerifyRingPossession(c, swapped, proof); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("other ring: got %v, expect ErrInvalidSignature", err)
+	}
+	if _, err := ProvePossession(c, ring); !errors.Is(err, ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+
+	msg := GetMessageFromString("ring")
+	for i := 0; i < ring.PoPIndex(); i++ {
+		index, sig, err := ring.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if index != i || !ring.VerifyAt(index, msg, sig) {
+			t.Fatalf("signature %d from key %d doesn't verify", i, index)
+		}
+	}
+	if _, _, err := ring.Sign(msg); !errors.Is(err, ErrRingExhausted) {
+		t.Fatalf("got %v, expect ErrRingExhausted", err)
+	}
+}
+
+// TestProvePossessionAfterSign signs a ring dry and checks its PoP leaf is
+// still there to prove with.
+func TestProvePossessionAfterSign(t *testing.T) {
+	ring, err := NewKeyRing(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ring.ReservePoPLeaf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("ring")
+	for {
+		_, _, err := ring.Sign(msg)
+		if errors.Is(err, ErrRingExhausted) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ring.Remaining() != 0 || ring.Used(ring.PoPIndex()) {
+		t.Fatalf("got %d keys left and PoP leaf used, expect 0 and unused",
+			ring.Remaining())
+	}
+	c, err := NewChallengeFrom(bytes.NewReader(make([]byte, POP_CHALLENGE_BYTES)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := ProvePossession(c, ring)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyRingPossession(c, ring.PublicKeys(), proof); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReservePoPLeaf checks a ring only has a PoP leaf once it's reserved
+// one, that a ring too small for one or whose last key has signed can't,
+// and that the reservation is saved with the ring.
+func TestReservePoPLeaf(t *testing.T) {
+	c, err := NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	single, err := NewKeyRing(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := single.ReservePoPLeaf(); err == nil {
+		t.Fatalf("reserved a PoP leaf in a ring of 1")
+	}
+	if single.PoPIndex() != -1 || single.Remaining() != 1 {
+		t.Fatalf("got PoP leaf %d and %d keys left, expect -1 and 1",
+			single.PoPIndex(), single.Remaining())
+	}
+	if _, err := ProvePossession(c, single); !errors.Is(err, ErrNoPoPLeaf) {
+		t.Fatalf("got %v, expect ErrNoPoPLeaf", err)
+	}
+	if _, _, err := single.Sign(GetMessageFromString("one")); err != nil {
+		t.Fatal(err)
+	}
+
+	ring, err := NewKeyRing(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ring.Sign(GetMessageFromString("first"))
+	ring.Sign(GetMessageFromString("second"))
+	if err := ring.ReservePoPLeaf(); !errors.Is(err, ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+
+	ring, err = NewKeyRing(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ring.ReservePoPLeaf(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ring.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded KeyRing
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.PoPIndex() != 2 || loaded.Remaining() != 2 {
+		t.Fatalf("got PoP leaf %d and %d keys left, expect 2 and 2",
+			loaded.PoPIndex(), loaded.Remaining())
+	}
+}
@@ -0,0 +1,145 @@
+package lamport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Handwritten protobuf wire format for the messages in lamport.proto.  It
+// only knows the handful of fields defined there; unknown fields are skipped
+// so newer writers can add to the messages.
+
+// field numbers and wire types from lamport.proto
+const (
+	protoFieldData   = 1
+	protoFieldParams = 2
+
+	protoWireVarint  = 0
+	protoWireFixed64 = 1
+	protoWireBytes   = 2
+	protoWireFixed32 = 5
+)
+
+// values of the Params enum
+const (
+	protoParamsUnspecified = 0
+	protoParamsSHA256      = 1
+)
+
+var errProtoTruncated = errors.New("protobuf: truncated message")
+
+// MarshalProto encodes the pubkey as a lamport.PublicKey message.
+func (self PublicKey) MarshalProto() ([]byte, error) {
+	return protoEncode(self.Bytes(), true), nil
+}
+
+// UnmarshalProto decodes a lamport.PublicKey message.
+func (self *PublicKey) UnmarshalProto(data []byte) error {
+	payload, err := protoDecode(data, PUBKEY_BYTES)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(payload)
+}
+
+// MarshalProto encodes the signature as a lamport.Signature message.
+func (self Signature) MarshalProto() ([]byte, error) {
+	return protoEncode(self.Bytes(), true), nil
+}
+
+// UnmarshalProto decodes a lamport.Signature message.
+func (self *Signature) UnmarshalProto(data []byte) error {
+	payload, err := protoDecode(data, SIGNATURE_BYTES)
+	if err != nil {
+		return err
+	}
+	return self.UnmarshalBinary(payload)
+}
+
+// MarshalProto encodes the message hash as a lamport.Message message.
+func (self Message) MarshalProto() ([]byte, error) {
+	return protoEncode(self[:], false), nil
+}
+
+// UnmarshalProto decodes a lamport.Message message.
+func (self *Message) UnmarshalProto(data []byte) error {
+	payload, err := protoDecode(data, MESSAGE_BYTES)
+	if err != nil {
+		return err
+	}
+	copy(self[:], payload)
+	return nil
+}
+
+// protoEncode writes field 1 as payload, and field 2 as PARAMS_SHA256 if
+// withParams is set.
+func protoEncode(payload []byte, withParams bool) []byte {
+	buf := make([]byte, 0, len(payload)+16)
+	buf = binary.AppendUvarint(buf, protoFieldData<<3|protoWireBytes)
+	buf = binary.AppendUvarint(buf, uint64(len(payload)))
+	buf = append(buf, payload...)
+	if withParams {
+		buf = binary.AppendUvarint(buf, protoFieldParams<<3|protoWireVarint)
+		buf = binary.AppendUvarint(buf, protoParamsSHA256)
+	}
+	return buf
+}
+
+// protoDecode returns field 1, which must be exactly size bytes, and checks
+// field 2 if present.  As protobuf specifies, if a field appears more than
+// once the last one wins.
+func protoDecode(data []byte, size int) ([]byte, error) {
+	var payload []byte
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, errProtoTruncated
+		}
+		data = data[n:]
+		field, wire := key>>3, key&7
+
+		switch wire {
+		case protoWireVarint:
+			v, n := binary.Uvarint(data)
+			if n <= 0 {
+				return nil, errProtoTruncated
+			}
+			data = data[n:]
+			if field == protoFieldParams &&
+				v != protoParamsUnspecified && v != protoParamsSHA256 {
+				return nil, fmt.Errorf("protobuf: unknown params %d", v)
+			}
+		case protoWireBytes:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || length > uint64(len(data)-n) {
+				return nil, errProtoTruncated
+			}
+			value := data[n : n+int(length)]
+			data = data[n+int(length):]
+			if field == protoFieldData {
+				if len(value) != size {
+					return nil, fmt.Errorf("protobuf: %w: data %d bytes, expect %d",
+						ErrWrongLength, len(value), size)
+				}
+				payload = value
+			}
+		case protoWireFixed64:
+			if len(data) < 8 {
+				return nil, errProtoTruncated
+			}
+			data = data[8:]
+		case protoWireFixed32:
+			if len(data) < 4 {
+				return nil, errProtoTruncated
+			}
+			data = data[4:]
+		default:
+			return nil, fmt.Errorf("protobuf: unsupported wire type %d", wire)
+		}
+	}
+	if payload == nil {
+		return nil, errors.New("protobuf: missing data field")
+	}
+	return payload, nil
+}
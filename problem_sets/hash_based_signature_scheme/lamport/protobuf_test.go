@@ -0,0 +1,103 @@
+package lamport
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// TestProtoRoundTrip round trips a pubkey, signature and message.
+func TestProtoRoundTrip(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := pub.MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// field 1, bytes, length 16384 as varint 0x80 0x80 0x01
+	if hex.EncodeToString(data[:4]) != "0a808001" {
+		t.Fatalf("pubkey proto header %x", data[:4])
+	}
+	var pub2 PublicKey
+	err = pub2.UnmarshalProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = sig.MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig2 Signature
+	err = sig2.UnmarshalProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := GetMessageFromString("test")
+	data, err = msg.MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "0a20" +
+		"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	if hex.EncodeToString(data) != expected {
+		t.Fatalf("message proto %x, expect %s", data, expected)
+	}
+	var msg2 Message
+	err = msg2.UnmarshalProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pub2 != pub || sig2 != sig || msg2 != msg {
+		t.Fatalf("proto round trip changed pubkey, signature or message")
+	}
+}
+
+// TestProtoRejects checks truncated and wrong length data fields, unknown
+// params, and that unknown fields are skipped.
+func TestProtoRejects(t *testing.T) {
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := sig.MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sig2 Signature
+	err = sig2.UnmarshalProto(data[:100])
+	if err == nil {
+		t.Fatalf("truncated bytes field decoded without error")
+	}
+
+	var pub PublicKey
+	err = pub.UnmarshalProto(data)
+	if err == nil {
+		t.Fatalf("signature proto decoded as pubkey")
+	}
+
+	bad := append([]byte{}, data...)
+	bad[len(bad)-1] = 7 // params
+	err = sig2.UnmarshalProto(bad)
+	if err == nil {
+		t.Fatalf("unknown params decoded without error")
+	}
+
+	// field 9 varint and field 10 fixed32 in front
+	extra, _ := hex.DecodeString("4801" + "5501020304")
+	err = sig2.UnmarshalProto(append(extra, data...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig2 != sig {
+		t.Fatalf("signature changed with unknown fields")
+	}
+}
@@ -0,0 +1,50 @@
+package lamport
+
+import (
+	"fmt"
+)
+
+// AmbiguousBitsError is what RecoverMessage returns when some signature
+// blocks hash to both rows of the pubkey, which only happens with a weak key
+// (see Validate).  Bits lists those positions; the message returned with the
+// error has them set to 0.
+type AmbiguousBitsError struct {
+	Bits []int
+}
+
+func (self *AmbiguousBitsError) Error() string {
+	return fmt.Sprintf("weak key: signature blocks match both rows at bits %v",
+		self.Bits)
+}
+
+// Is makes AmbiguousBitsError match ErrWeakKey.
+func (self *AmbiguousBitsError) Is(target error) bool {
+	return target == ErrWeakKey
+}
+
+// RecoverMessage works out which message sig signs by checking which row of
+// pub each preimage hashes to.  A block that matches neither row is
+// ErrInvalidSignature.  If sig is otherwise good but some blocks match both
+// rows, the message is returned along with an *AmbiguousBitsError.
+func RecoverMessage(pub PublicKey, sig Signature) (Message, error) {
+	var msg Message
+	var ambiguous []int
+	for i, block := range sig.Preimage {
+		hash := block.Hash()
+		zero := hash.Equal(pub.ZeroHash[i])
+		one := hash.Equal(pub.OneHash[i])
+		switch {
+		case zero && one:
+			ambiguous = append(ambiguous, i)
+		case one:
+			msg.SetBit(i, 1)
+		case !zero:
+			return Message{}, fmt.Errorf("%w: block %d matches neither row",
+				ErrInvalidSignature, i)
+		}
+	}
+	if ambiguous != nil {
+		return msg, &AmbiguousBitsError{Bits: ambiguous}
+	}
+	return msg, nil
+}
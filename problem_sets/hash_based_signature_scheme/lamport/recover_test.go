@@ -0,0 +1,70 @@
+package lamport
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// TestRecoverMessage recovers the messages "1".."4" from the provided
+// signatures.
+func TestRecoverMessage(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range []string{hexSignature1, hexSignature2, hexSignature3, hexSignature4} {
+		sig, err := HexToSignature(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg, err := RecoverMessage(pub, sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := GetMessageFromString(fmt.Sprint(i + 1))
+		if msg != expect {
+			t.Fatalf("signature %d: got %x, expect %x", i+1, msg, expect)
+		}
+	}
+}
+
+// TestRecoverMessageErrors checks a corrupted signature and a weak key are
+// reported instead of panicking.
+func TestRecoverMessageErrors(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("recover")
+	sig := mustSign(t, msg, pri)
+
+	bad := sig
+	bad.Preimage[9] = bad.Preimage[9].Hash()
+	_, err = RecoverMessage(pub, bad)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+
+	// Make bits 3 and 200 the same block in both rows.
+	for _, i := range []int{3, 200} {
+		pri.OneHash[i] = pri.ZeroHash[i]
+		pub.OneHash[i] = pub.ZeroHash[i]
+	}
+	sig = mustSign(t, msg, pri)
+	got, err := RecoverMessage(pub, sig)
+	var aerr *AmbiguousBitsError
+	if !errors.As(err, &aerr) || !errors.Is(err, ErrWeakKey) {
+		t.Fatalf("got %v, expect an *AmbiguousBitsError", err)
+	}
+	if !reflect.DeepEqual(aerr.Bits, []int{3, 200}) {
+		t.Fatalf("got ambiguous bits %v, expect [3 200]", aerr.Bits)
+	}
+	for _, i := range []int{3, 200} {
+		msg[i/8] &^= 0x01 << (7 - (i % 8))
+	}
+	if got != msg {
+		t.Fatalf("got %x, expect %x", got, msg)
+	}
+}
@@ -0,0 +1,139 @@
+package lamport
+
+import (
+	"fmt"
+)
+
+// Report is what VerifyReport finds out about a signature: every block that
+// doesn't hash to the pubkey block its message bit selects.  Mismatches is
+// len(Failing), and Failing is in block order.
+type Report struct {
+	Mismatches int
+	Failing    []int
+
+	// where each failing block's hash turned up in the pubkey, if it did
+	found []blockLocation
+}
+
+// blockLocation is a position in a pubkey; row is -1 for a hash that isn't
+// anywhere in it.
+type blockLocation struct {
+	row   int
+	index int
+}
+
+// VerifyReport checks every block of sig on msg against pub and reports all
+// the ones that fail, along with enough about them for Diagnose to guess
+// why.  It's for debugging interop with other implementations; Verify is
+// still the way to check a signature.
+func VerifyReport(msg Message, pub PublicKey, sig Signature) Report {
+	var report Report
+	var rows map[Block]blockLocation
+	for i := 0; i < MESSAGE_BITS; i++ {
+		hash := sig.Preimage[i].Hash()
+		expect := pub.ZeroHash[i]
+		if msg.Bit(i) == 1 {
+			expect = pub.OneHash[i]
+		}
+		if hash.Equal(expect) {
+			continue
+		}
+		if rows == nil {
+			rows = make(map[Block]blockLocation, 2*MESSAGE_BITS)
+			for j := 0; j < MESSAGE_BITS; j++ {
+				rows[pub.ZeroHash[j]] = blockLocation{0, j}
+				rows[pub.OneHash[j]] = blockLocation{1, j}
+			}
+		}
+		loc, ok := rows[hash]
+		if !ok {
+			loc = blockLocation{-1, -1}
+		}
+		report.Failing = append(report.Failing, i)
+		report.found = append(report.found, loc)
+	}
+	report.Mismatches = len(report.Failing)
+	return report
+}
+
+// OK reports whether the signature verified.
+func (self Report) OK() bool {
+	return self.Mismatches == 0
+}
+
+// Diagnose describes the pattern of the failures in a sentence, naming the
+// usual interop mistakes when it sees them:
+//
+//   - bit order reversed: block i is the one for bit i^7, as an LSB first
+//     implementation would give (see BitOrder)
+//   - rows swapped: every bad block is from the other row, as if the bits of
+//     the message or the rows of the key were swapped
+//   - off by one block: block i is the one for bit i+1, or i-1, as if a block
+//     were dropped from or added to the front
+//
+// Anything else gets a plain count of the bad blocks.
+func (self Report) Diagnose() string {
+	if self.OK() {
+		return "signature verifies"
+	}
+	if self.allFound(func(i int, loc blockLocation) bool {
+		return loc.index == i^7
+	}, 0) {
+		return fmt.Sprintf("bit order reversed within each byte (%d blocks bad)",
+			self.Mismatches)
+	}
+	if self.allFound(func(i int, loc blockLocation) bool {
+		return loc.index == i
+	}, 0) {
+		return fmt.Sprintf("rows swapped: %d blocks are from the other row",
+			self.Mismatches)
+	}
+	// a shifted signature has one block at the end that isn't anywhere
+	if self.Mismatches > 1 {
+		if self.allFound(func(i int, loc blockLocation) bool {
+			return loc.index == i+1
+		}, 1) {
+			return "off by one block: block i is the preimage for bit i+1"
+		}
+		if self.allFound(func(i int, loc blockLocation) bool {
+			return loc.index == i-1
+		}, 1) {
+			return "off by one block: block i is the preimage for bit i-1"
+		}
+	}
+
+	unknown := 0
+	for _, loc := range self.found {
+		if loc.row < 0 {
+			unknown++
+		}
+	}
+	if unknown == self.Mismatches {
+		if self.Mismatches == MESSAGE_BITS {
+			return "no block matches the pubkey: wrong key, or not a signature"
+		}
+		return fmt.Sprintf("%d corrupted blocks, first at bit %d",
+			self.Mismatches, self.Failing[0])
+	}
+	return fmt.Sprintf("%d blocks bad with no recognized pattern, first at bit %d",
+		self.Mismatches, self.Failing[0])
+}
+
+// allFound reports whether every failing block was found in the pubkey at
+// the place match wants, allowing up to slack blocks found nowhere.
+func (self Report) allFound(match func(i int, loc blockLocation) bool, slack int) bool {
+	for n, i := range self.Failing {
+		loc := self.found[n]
+		if loc.row < 0 {
+			if slack == 0 {
+				return false
+			}
+			slack--
+			continue
+		}
+		if !match(i, loc) {
+			return false
+		}
+	}
+	return true
+}
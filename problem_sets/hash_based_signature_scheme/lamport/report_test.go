@@ -0,0 +1,84 @@
+package lamport
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestVerifyReportDiagnose breaks a good signature in each of the ways
+// Diagnose knows about and checks it names the right one.
+func TestVerifyReportDiagnose(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("report")
+	sig := mustSign(t, msg, pri)
+
+	report := VerifyReport(msg, pub, sig)
+	if !report.OK() || report.Failing != nil {
+		t.Fatalf("good signature: %s", report.Diagnose())
+	}
+
+	reversed := sig
+	reverseBitOrder(reversed.Preimage[:])
+
+	var inverse Message
+	for i := range msg {
+		inverse[i] = ^msg[i]
+	}
+	swapped := mustSign(t, inverse, pri)
+
+	var next, prev Signature
+	for i := 0; i < MESSAGE_BITS-1; i++ {
+		next.Preimage[i] = sig.Preimage[i+1]
+		prev.Preimage[i+1] = sig.Preimage[i]
+	}
+
+	corrupted := sig
+	for _, i := range []int{5, 99, 200} {
+		corrupted.Preimage[i][0] ^= 1
+	}
+
+	other, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		sig    Signature
+		expect string
+	}{
+		{"reversed", reversed, "bit order reversed"},
+		{"swapped", swapped, "rows swapped"},
+		{"next", next, "preimage for bit i+1"},
+		{"prev", prev, "preimage for bit i-1"},
+		{"corrupted", corrupted, "3 corrupted blocks, first at bit 5"},
+		{"other key", mustSign(t, msg, other), "wrong key"},
+	}
+	for _, test := range tests {
+		report := VerifyReport(msg, pub, test.sig)
+		if report.OK() || Verify(msg, pub, test.sig) {
+			t.Fatalf("%s: bad signature verified", test.name)
+		}
+		if report.Mismatches != len(report.Failing) {
+			t.Fatalf("%s: %d mismatches but %d failing", test.name,
+				report.Mismatches, len(report.Failing))
+		}
+		if d := report.Diagnose(); !strings.Contains(d, test.expect) {
+			t.Fatalf("%s: got %q, expect %q", test.name, d, test.expect)
+		}
+	}
+
+	// rows swapped for only some bits is still every bad block being from
+	// the other row
+	half := sig
+	for i := 0; i < 16; i++ {
+		half.Preimage[i] = swapped.Preimage[i]
+	}
+	report = VerifyReport(msg, pub, half)
+	if report.Mismatches != 16 || !strings.Contains(report.Diagnose(), "rows swapped") {
+		t.Fatalf("got %d mismatches, %q", report.Mismatches, report.Diagnose())
+	}
+}
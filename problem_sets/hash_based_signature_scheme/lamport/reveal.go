@@ -0,0 +1,97 @@
+package lamport
+
+import (
+	"bytes"
+	"fmt"
+)
+
+/*
+Pay to pubkey hash, as Bitcoin does it: the identity published ahead of
+time is just the 32 byte pubkey hash, the same as the pubkey's Fingerprint
+and what an Address encodes, and the full 16384 byte pubkey only comes out
+with a signature.  A RevealBundle's encoding, and the payload of a
+CONTAINER_REVEAL container, is
+
+    pubkey     PUBKEY_BYTES
+    signature  SIGNATURE_BYTES
+*/
+
+const REVEAL_BUNDLE_BYTES = PUBKEY_BYTES + SIGNATURE_BYTES // 24576
+
+// RevealBundle is a signature together with the pubkey it verifies under.
+type RevealBundle struct {
+	PublicKey PublicKey
+	Signature Signature
+}
+
+// SignReveal signs msg with pri and bundles the signature with pri's pubkey.
+func SignReveal(msg Message, pri PrivateKey) (RevealBundle, error) {
+	sig, err := Sign(msg, pri)
+	if err != nil {
+		return RevealBundle{}, err
+	}
+	return RevealBundle{PublicKey: pri.GetPublicKey(), Signature: sig}, nil
+}
+
+// VerifyAgainstAddress checks the bundle's pubkey hashes to addr and then
+// that its signature on msg verifies.  A pubkey for some other address is
+// ErrAddressMismatch, and a bad signature ErrInvalidSignature.
+func VerifyAgainstAddress(msg Message, addr [32]byte, b RevealBundle) error {
+	if fp := b.PublicKey.Fingerprint(); fp != addr {
+		return fmt.Errorf("%w: bundle pubkey hashes to %s, expect %s",
+			ErrAddressMismatch, fp.Short(), Fingerprint(addr).Short())
+	}
+	if !VerifyPtr(msg, &b.PublicKey, &b.Signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Bytes returns the bundle's encoding, the pubkey and then the signature.
+func (self RevealBundle) Bytes() []byte {
+	return append(self.PublicKey.Bytes(), self.Signature.Bytes()...)
+}
+
+// RevealBundleFromBytes is the inverse of RevealBundle.Bytes.
+func RevealBundleFromBytes(b []byte) (RevealBundle, error) {
+	if len(b) != REVEAL_BUNDLE_BYTES {
+		return RevealBundle{}, fmt.Errorf("%w: reveal bundle %d bytes, expect %d",
+			ErrWrongLength, len(b), REVEAL_BUNDLE_BYTES)
+	}
+	pub, err := PubkeyFromBytes(b[:PUBKEY_BYTES])
+	if err != nil {
+		return RevealBundle{}, err
+	}
+	sig, err := SignatureFromBytes(b[PUBKEY_BYTES:])
+	if err != nil {
+		return RevealBundle{}, err
+	}
+	return RevealBundle{PublicKey: pub, Signature: sig}, nil
+}
+
+// MarshalBinary encodes the bundle as a CONTAINER_REVEAL container.
+func (self RevealBundle) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_REVEAL, self.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a bundle from MarshalBinary.
+func (self *RevealBundle) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_REVEAL {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_REVEAL}
+	}
+	b, err := RevealBundleFromBytes(c.Payload)
+	if err != nil {
+		return err
+	}
+	*self = b
+	return nil
+}
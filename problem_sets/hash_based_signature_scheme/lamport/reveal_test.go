@@ -0,0 +1,95 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestVerifyAgainstAddress checks a bundle verifies against its pubkey's
+// hash, and that a wrong pubkey and a bad signature fail differently.
+func TestVerifyAgainstAddress(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := [32]byte(pub.Fingerprint())
+	msg := GetMessageFromString("reveal")
+	b, err := SignReveal(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.PublicKey != pub {
+		t.Fatalf("bundle pubkey isn't the key's")
+	}
+	if err := VerifyAgainstAddress(msg, addr, b); err != nil {
+		t.Fatal(err)
+	}
+
+	// someone else's key and signature, put forward for this address
+	otherPri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := SignReveal(msg, otherPri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyAgainstAddress(msg, addr, other)
+	if !errors.Is(err, ErrAddressMismatch) || errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrAddressMismatch", err)
+	}
+
+	err = VerifyAgainstAddress(GetMessageFromString("other"), addr, b)
+	if !errors.Is(err, ErrInvalidSignature) || errors.Is(err, ErrAddressMismatch) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+}
+
+// TestRevealBundleMarshal round trips a bundle through Bytes and a
+// container.
+func TestRevealBundleMarshal(t *testing.T) {
+	pri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := SignReveal(GetMessageFromString("reveal"), pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	back, err := RevealBundleFromBytes(b.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back != b {
+		t.Fatalf("bundle round trip differs")
+	}
+	if _, err := RevealBundleFromBytes(b.Bytes()[:PUBKEY_BYTES]); !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != CONTAINER_HEADER_BYTES+REVEAL_BUNDLE_BYTES {
+		t.Fatalf("got %d bytes, expect %d", len(data), CONTAINER_HEADER_BYTES+REVEAL_BUNDLE_BYTES)
+	}
+	var got RevealBundle
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got != b {
+		t.Fatalf("bundle container round trip differs")
+	}
+	seedData, err := testSeedKey().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var typeErr ContainerTypeError
+	if err := got.UnmarshalBinary(seedData); !errors.As(err, &typeErr) {
+		t.Fatalf("got %v, expect ContainerTypeError", err)
+	}
+	if got != b {
+		t.Fatalf("failed UnmarshalBinary changed the bundle")
+	}
+}
@@ -0,0 +1,253 @@
+package lamport
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+)
+
+// RevealedKey collects the private key blocks given away by signatures made
+// with one pubkey.  Every signature reveals one row for each of the 256
+// positions; once a few signatures are in, positions where both rows are
+// known can be signed either way, and any message whose remaining bits
+// match what has been revealed can be signed by anyone.
+//
+// The zero value is ready to use and takes its pubkey from the first Add.
+type RevealedKey struct {
+	pub   PublicKey
+	count int
+	zero  Message
+	one   Message
+	pri   PrivateKey
+}
+
+// Add verifies sig over msg with pub and records the blocks it reveals.  A
+// signature that doesn't verify is ErrInvalidSignature, and one for a
+// pubkey other than the one given to the first Add is ErrWrongKey; neither
+// changes what has been collected.
+func (self *RevealedKey) Add(pub PublicKey, msg Message, sig Signature) error {
+	if self.count > 0 && !pub.Equal(self.pub) {
+		return fmt.Errorf("%w: revealed key is %s, got %s",
+			ErrWrongKey, self.pub.Fingerprint().Short(),
+			pub.Fingerprint().Short())
+	}
+	if err := VerifyDetailed(msg, pub, sig); err != nil {
+		return fmt.Errorf("signature %d: %w", self.count+1, err)
+	}
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if msg.Bit(i) == 1 {
+			self.one.SetBit(i, 1)
+			self.pri.OneHash[i] = sig.Preimage[i]
+		} else {
+			self.zero.SetBit(i, 1)
+			self.pri.ZeroHash[i] = sig.Preimage[i]
+		}
+	}
+	self.pub = pub
+	self.count++
+	return nil
+}
+
+// Len returns the number of signatures added so far.
+func (self *RevealedKey) Len() int {
+	return self.count
+}
+
+// PublicKey returns the pubkey the revealed blocks belong to.
+func (self *RevealedKey) PublicKey() PublicKey {
+	return self.pub
+}
+
+// Coverage returns bitmaps of the positions whose zero and one rows are
+// known, in the same bit order as Message.
+func (self *RevealedKey) Coverage() (zero, one Message) {
+	return self.zero, self.one
+}
+
+// MissingBits returns the positions where neither row is known.  While any
+// are missing no message can be forged.
+func (self *RevealedKey) MissingBits() []int {
+	var missing []int
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if self.zero.Bit(i)|self.one.Bit(i) == 0 {
+			missing = append(missing, i)
+		}
+	}
+	return missing
+}
+
+// Difficulty returns the number of positions where only one row is known.
+// A random message has to match all of them to be forgeable, so finding
+// one takes about 1<<Difficulty() tries.
+func (self *RevealedKey) Difficulty() int {
+	difficulty := 0
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if self.zero.Bit(i)^self.one.Bit(i) == 1 {
+			difficulty++
+		}
+	}
+	return difficulty
+}
+
+// DifficultyAfter returns what Difficulty would be once a signature on msg
+// was added as well.
+func (self *RevealedKey) DifficultyAfter(msg Message) int {
+	difficulty := 0
+	for i := range msg {
+		zero := self.zero[i] | ^msg[i]
+		one := self.one[i] | msg[i]
+		difficulty += bits.OnesCount8(zero ^ one)
+	}
+	return difficulty
+}
+
+// CanSign reports whether every block needed to sign msg has been revealed.
+func (self *RevealedKey) CanSign(msg Message) bool {
+	for i, b := range msg {
+		if b&self.one[i]|^b&self.zero[i] != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// Sign builds a signature on msg out of the revealed blocks, or returns
+// ErrUnforgeable if some of them are still unknown.
+func (self *RevealedKey) Sign(msg Message) (Signature, error) {
+	if !self.CanSign(msg) {
+		return Signature{}, fmt.Errorf("%w: %x", ErrUnforgeable, msg)
+	}
+	var sig Signature
+	err := SignInto(msg, &self.pri, &sig)
+	return sig, err
+}
+
+// GenericRevealedKey is a RevealedKey for keys of any Params, with messages
+// and bitmaps MessageBytes long.  Make one with Params.NewRevealedKey.
+type GenericRevealedKey struct {
+	params Params
+	pub    *GenericPublicKey
+	count  int
+	zero   []byte
+	one    []byte
+	pri    GenericPrivateKey
+}
+
+// NewRevealedKey returns an empty GenericRevealedKey for the Params, which
+// takes its pubkey from the first Add.
+func (self Params) NewRevealedKey() *GenericRevealedKey {
+	return &GenericRevealedKey{
+		params: self,
+		zero:   make([]byte, self.MessageBytes()),
+		one:    make([]byte, self.MessageBytes()),
+		pri: GenericPrivateKey{
+			ZeroHash: make([][]byte, self.MessageBits),
+			OneHash:  make([][]byte, self.MessageBits),
+		},
+	}
+}
+
+// Add verifies sig over msg with pub and records the blocks it reveals, the
+// same as RevealedKey.Add.  A signature the Params don't accept is
+// ErrInvalidSignature or a *ParamsError, and a different pubkey is
+// ErrWrongKey; neither changes what has been collected.
+func (self *GenericRevealedKey) Add(pub *GenericPublicKey, msg []byte,
+	sig *GenericSignature) error {
+	if self.count > 0 && !(equalRows(pub.ZeroHash, self.pub.ZeroHash) &&
+		equalRows(pub.OneHash, self.pub.OneHash)) {
+		return fmt.Errorf("%w: not the pubkey of the first signature", ErrWrongKey)
+	}
+	if err := self.params.Verify(msg, pub, sig); err != nil {
+		return fmt.Errorf("signature %d: %w", self.count+1, err)
+	}
+	for i := 0; i < self.params.MessageBits; i++ {
+		if BitAt(msg, i) == 1 {
+			SetBitAt(self.one, i, 1)
+			self.pri.OneHash[i] = sig.Preimage[i]
+		} else {
+			SetBitAt(self.zero, i, 1)
+			self.pri.ZeroHash[i] = sig.Preimage[i]
+		}
+	}
+	self.pub = pub
+	self.count++
+	return nil
+}
+
+func equalRows(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of signatures added so far.
+func (self *GenericRevealedKey) Len() int {
+	return self.count
+}
+
+// Coverage returns bitmaps of the positions whose zero and one rows are
+// known.
+func (self *GenericRevealedKey) Coverage() (zero, one []byte) {
+	return append([]byte(nil), self.zero...), append([]byte(nil), self.one...)
+}
+
+// MissingBits returns the positions where neither row is known.
+func (self *GenericRevealedKey) MissingBits() []int {
+	var missing []int
+	for i := 0; i < self.params.MessageBits; i++ {
+		if BitAt(self.zero, i)|BitAt(self.one, i) == 0 {
+			missing = append(missing, i)
+		}
+	}
+	return missing
+}
+
+// Difficulty returns the number of positions where only one row is known,
+// so finding a forgeable message takes about 1<<Difficulty() tries.
+func (self *GenericRevealedKey) Difficulty() int {
+	difficulty := 0
+	for i := 0; i < self.params.MessageBits; i++ {
+		if BitAt(self.zero, i)^BitAt(self.one, i) == 1 {
+			difficulty++
+		}
+	}
+	return difficulty
+}
+
+// CanSign reports whether every block needed to sign msg has been revealed.
+// A message of the wrong length can't be signed.
+func (self *GenericRevealedKey) CanSign(msg []byte) bool {
+	if len(msg) != len(self.zero) {
+		return false
+	}
+	for i, b := range msg {
+		if b&self.one[i]|^b&self.zero[i] != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// Sign builds a signature on msg out of the revealed blocks, or returns
+// ErrUnforgeable if some of them are still unknown.
+func (self *GenericRevealedKey) Sign(msg []byte) (*GenericSignature, error) {
+	if !self.CanSign(msg) {
+		return nil, fmt.Errorf("%w: %x", ErrUnforgeable, msg)
+	}
+	// not params.Sign, which wants every block of the key
+	sig := &GenericSignature{Preimage: make([][]byte, self.params.MessageBits)}
+	for i := range sig.Preimage {
+		if BitAt(msg, i) == 0 {
+			sig.Preimage[i] = self.pri.ZeroHash[i]
+		} else {
+			sig.Preimage[i] = self.pri.OneHash[i]
+		}
+	}
+	return sig, nil
+}
@@ -0,0 +1,151 @@
+package lamport
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// providedRevealed loads the four provided signatures into a RevealedKey.
+func providedRevealed(t *testing.T) (*RevealedKey, PublicKey) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rk := &RevealedKey{}
+	for i, s := range []string{hexSignature1, hexSignature2, hexSignature3, hexSignature4} {
+		sig, err := HexToSignature(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = rk.Add(pub, GetMessageFromString(fmt.Sprint(i+1)), sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return rk, pub
+}
+
+// TestRevealedKeyProvided checks the numbers Forge has always printed for
+// the provided signatures: difficulty 1<<31 and nothing missing.
+func TestRevealedKeyProvided(t *testing.T) {
+	rk, _ := providedRevealed(t)
+	if rk.Len() != 4 {
+		t.Fatalf("got %d signatures, expect 4", rk.Len())
+	}
+	if d := rk.Difficulty(); d != 31 {
+		t.Fatalf("got difficulty %d, expect 31", d)
+	}
+	if m := rk.MissingBits(); len(m) != 0 {
+		t.Fatalf("got missing bits %v, expect none", m)
+	}
+
+	zero, one := rk.Coverage()
+	both := 0
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if (zero[i/8]&one[i/8])>>(7-(i%8))&0x01 == 1 {
+			both++
+		}
+	}
+	if both+rk.Difficulty() != MESSAGE_BITS {
+		t.Fatalf("%d positions with both rows and %d with one don't add up",
+			both, rk.Difficulty())
+	}
+
+	for i := 1; i <= 4; i++ {
+		if !rk.CanSign(GetMessageFromString(fmt.Sprint(i))) {
+			t.Fatalf("CanSign(%d) returned false, expected true", i)
+		}
+	}
+}
+
+// TestRevealedKeySign forges with a fresh key whose every bit has been
+// revealed both ways, and checks a key with only one signature can sign
+// just that message.
+func TestRevealedKeySign(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("revealed")
+	rk := &RevealedKey{}
+	err = rk.Add(pub, msg, mustSign(t, msg, pri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rk.Difficulty() != MESSAGE_BITS {
+		t.Fatalf("got difficulty %d, expect %d", rk.Difficulty(), MESSAGE_BITS)
+	}
+	if len(rk.MissingBits()) != 0 {
+		t.Fatalf("got %d missing bits, expect 0", len(rk.MissingBits()))
+	}
+	_, err = rk.Sign(GetMessageFromString("other"))
+	if !errors.Is(err, ErrUnforgeable) {
+		t.Fatalf("got %v, expect ErrUnforgeable", err)
+	}
+
+	var inverse Message
+	for i := range msg {
+		inverse[i] = ^msg[i]
+	}
+	err = rk.Add(pub, inverse, mustSign(t, inverse, pri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rk.Difficulty() != 0 {
+		t.Fatalf("got difficulty %d, expect 0", rk.Difficulty())
+	}
+	other := GetMessageFromString("other")
+	sig, err := rk.Sign(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(other, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+}
+
+// TestRevealedKeyAddErrors checks bad signatures and other pubkeys are
+// rejected without changing what was collected.
+func TestRevealedKeyAddErrors(t *testing.T) {
+	rk, pub := providedRevealed(t)
+	zero, one := rk.Coverage()
+
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rk.Add(pub, GetMessageFromString("2"), sig)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+
+	pri2, pub2, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("x")
+	err = rk.Add(pub2, msg, mustSign(t, msg, pri2))
+	if !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("got %v, expect ErrWrongKey", err)
+	}
+
+	z, o := rk.Coverage()
+	if rk.Len() != 4 || z != zero || o != one {
+		t.Fatalf("failed Add changed the revealed key")
+	}
+	if !rk.PublicKey().Equal(pub) {
+		t.Fatalf("PublicKey changed after failed Add")
+	}
+}
+
+// TestRevealedKeyMissing checks an empty RevealedKey is missing every bit.
+func TestRevealedKeyMissing(t *testing.T) {
+	var rk RevealedKey
+	if len(rk.MissingBits()) != MESSAGE_BITS {
+		t.Fatalf("got %d missing bits, expect %d", len(rk.MissingBits()), MESSAGE_BITS)
+	}
+	if rk.Difficulty() != 0 {
+		t.Fatalf("got difficulty %d, expect 0", rk.Difficulty())
+	}
+}
@@ -0,0 +1,480 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+/*
+A RevocationList is saved as a CONTAINER_REVOCATIONS2 container whose
+payload is
+
+    count         4 bytes, big endian
+    count times, in ascending order of fingerprint:
+        fingerprint  32 bytes
+        revoked at    8 bytes, big endian unix seconds
+        reason len    2 bytes, big endian
+        reason        reason len bytes, UTF-8
+    signed        1 byte, 0 or 1
+    if signed:
+        authority    32 bytes, Fingerprint of the authority's pubkey
+        signature    SIGNATURE_BYTES
+
+The authority signs GetMessage(REVOCATION_LIST_DOMAIN, entries), where
+entries is the payload up to the signed byte.  Lists from before reasons
+were kept are CONTAINER_REVOCATIONS containers, just the count and the
+fingerprints, and still load, with no reasons or times.
+*/
+
+const REVOCATION_LIST_DOMAIN = "lamport revocation list v1"
+
+// REVOCATION_LOCK_TIMEOUT is how long UpdateRevocationFile waits for
+// another update of the same file to finish.
+const REVOCATION_LOCK_TIMEOUT = 5 * time.Second
+
+var (
+	ErrInvalidRevocation = errors.New("revocation doesn't match pubkey")
+	ErrRevoked           = errors.New("key has been revoked")
+	// ErrKeyRevoked is ErrRevoked, under the name VerifyWithPolicy
+	// documents.
+	ErrKeyRevoked = ErrRevoked
+	// ErrListUnsigned is returned by VerifyAuthority for a list with no
+	// authority signature.
+	ErrListUnsigned = errors.New("revocation list isn't signed")
+)
+
+// REASON_KEY_PUBLISHED is the reason Revoke records.
+const REASON_KEY_PUBLISHED = "private key published"
+
+// Revocation is a whole private key, published.  Only the key's owner could
+// have produced it, and once it's out anyone can sign anything with the key,
+// so it's a statement nobody can forge that the key is burned.
+type Revocation struct {
+	ZeroHash [MESSAGE_BITS]Block
+	OneHash  [MESSAGE_BITS]Block
+}
+
+// CreateRevocation returns the revocation for pri.  Publishing it gives away
+// pri completely.
+func CreateRevocation(pri PrivateKey) Revocation {
+	return Revocation{ZeroHash: pri.ZeroHash, OneHash: pri.OneHash}
+}
+
+// CheckRevocation checks every block of rev hashes to the same block of pub.
+// Otherwise it's ErrInvalidRevocation, saying how many blocks are wrong.
+func CheckRevocation(pub PublicKey, rev Revocation) error {
+	bad := 0
+	first := ""
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if !rev.ZeroHash[i].Hash().Equal(pub.ZeroHash[i]) {
+			if bad == 0 {
+				first = fmt.Sprintf("zero[%d]", i)
+			}
+			bad++
+		}
+		if !rev.OneHash[i].Hash().Equal(pub.OneHash[i]) {
+			if bad == 0 {
+				first = fmt.Sprintf("one[%d]", i)
+			}
+			bad++
+		}
+	}
+	if bad > 0 {
+		return fmt.Errorf("%w: %d of %d blocks wrong, first %s",
+			ErrInvalidRevocation, bad, 2*MESSAGE_BITS, first)
+	}
+	return nil
+}
+
+// Bytes returns the revocation in the same layout as PrivateKey.Bytes.
+func (self Revocation) Bytes() []byte {
+	return PrivateKey(self).Bytes()
+}
+
+// RevocationFromBytes is the inverse of Revocation.Bytes.
+func RevocationFromBytes(b []byte) (Revocation, error) {
+	pri, err := PrivkeyFromBytes(b)
+	if err != nil {
+		return Revocation{}, err
+	}
+	return Revocation(pri), nil
+}
+
+// RevocationEntry is one key on a RevocationList.
+type RevocationEntry struct {
+	Fingerprint Fingerprint
+	Reason      string
+	RevokedAt   int64 // unix seconds, 0 if the list didn't record it
+}
+
+// KeyRevokedError is what VerifyWithPolicy returns for a revoked key.  It
+// matches ErrKeyRevoked.
+type KeyRevokedError struct {
+	Entry RevocationEntry
+}
+
+func (self *KeyRevokedError) Error() string {
+	e := self.Entry
+	s := fmt.Sprintf("%v: %s", ErrKeyRevoked, e.Fingerprint.Short())
+	if e.RevokedAt != 0 {
+		s += " at " + time.Unix(e.RevokedAt, 0).UTC().Format(time.RFC3339)
+	}
+	if e.Reason != "" {
+		s += ": " + e.Reason
+	}
+	return s
+}
+
+// Is matches ErrKeyRevoked.
+func (self *KeyRevokedError) Is(target error) bool {
+	return target == ErrKeyRevoked
+}
+
+// RevocationList is a set of revoked keys, by fingerprint, each with why and
+// when.  It can carry an authority's signature over the lot, which any Add
+// drops.  The zero value is an empty, unsigned list.
+type RevocationList struct {
+	revoked   map[Fingerprint]RevocationEntry
+	authority *listSignature
+}
+
+// listSignature is an authority's signature on a RevocationList.
+type listSignature struct {
+	Fingerprint Fingerprint
+	Signature   Signature
+}
+
+// Add puts fp on the list, replacing any entry it already has.  The reason
+// can be at most 65535 bytes.
+func (self *RevocationList) Add(fp Fingerprint, reason string, at time.Time) error {
+	if len(reason) > 0xffff {
+		return fmt.Errorf("%w: revocation reason %d bytes, expect at most %d",
+			ErrWrongLength, len(reason), 0xffff)
+	}
+	if self.revoked == nil {
+		self.revoked = make(map[Fingerprint]RevocationEntry)
+	}
+	self.revoked[fp] = RevocationEntry{Fingerprint: fp, Reason: reason, RevokedAt: at.Unix()}
+	self.authority = nil
+	return nil
+}
+
+// Revoke checks rev against pub and, if it's good, adds pub to the list
+// with REASON_KEY_PUBLISHED and the current time.
+func (self *RevocationList) Revoke(pub PublicKey, rev Revocation) error {
+	err := CheckRevocation(pub, rev)
+	if err != nil {
+		return err
+	}
+	return self.Add(pub.Fingerprint(), REASON_KEY_PUBLISHED, time.Now())
+}
+
+// Contains reports whether the key with fingerprint fp is on the list.  A
+// nil list contains nothing.
+func (self *RevocationList) Contains(fp Fingerprint) bool {
+	_, ok := self.Lookup(fp)
+	return ok
+}
+
+// Lookup returns the list's entry for fp, if it has one.
+func (self *RevocationList) Lookup(fp Fingerprint) (RevocationEntry, bool) {
+	if self == nil {
+		return RevocationEntry{}, false
+	}
+	e, ok := self.revoked[fp]
+	return e, ok
+}
+
+// Len returns the number of keys on the list, 0 for a nil list.
+func (self *RevocationList) Len() int {
+	if self == nil {
+		return 0
+	}
+	return len(self.revoked)
+}
+
+// Fingerprints returns the fingerprints on the list in ascending order.  A
+// nil list has none.
+func (self *RevocationList) Fingerprints() []Fingerprint {
+	if self == nil {
+		return []Fingerprint{}
+	}
+	fps := make([]Fingerprint, 0, len(self.revoked))
+	for fp := range self.revoked {
+		fps = append(fps, fp)
+	}
+	sort.Slice(fps, func(i, j int) bool {
+		return bytes.Compare(fps[i][:], fps[j][:]) < 0
+	})
+	return fps
+}
+
+// entriesBytes encodes the count and entries, the part of the payload the
+// authority signs.
+func (self *RevocationList) entriesBytes() []byte {
+	fps := self.Fingerprints()
+	b := binary.BigEndian.AppendUint32(nil, uint32(len(fps)))
+	for _, fp := range fps {
+		e := self.revoked[fp]
+		b = append(b, fp[:]...)
+		b = binary.BigEndian.AppendUint64(b, uint64(e.RevokedAt))
+		b = binary.BigEndian.AppendUint16(b, uint16(len(e.Reason)))
+		b = append(b, e.Reason...)
+	}
+	return b
+}
+
+// SignRevocationList signs rl as the authority, which counts as the
+// Signer's one signature like any other Sign.  authorityPub has to be the
+// Signer's pubkey; a signature that doesn't verify with it is
+// ErrInvalidSignature.
+func (self *Signer) SignRevocationList(authorityPub PublicKey, rl *RevocationList) error {
+	msg := GetMessage(REVOCATION_LIST_DOMAIN, rl.entriesBytes())
+	sig, err := self.Sign(msg)
+	if err != nil {
+		return err
+	}
+	if !Verify(msg, authorityPub, sig) {
+		return fmt.Errorf("%w: authority pubkey isn't the Signer's", ErrInvalidSignature)
+	}
+	rl.authority = &listSignature{Fingerprint: authorityPub.Fingerprint(), Signature: sig}
+	return nil
+}
+
+// Authority returns the fingerprint of the key that signed the list, if
+// it's signed.
+func (self *RevocationList) Authority() (Fingerprint, bool) {
+	if self == nil || self.authority == nil {
+		return Fingerprint{}, false
+	}
+	return self.authority.Fingerprint, true
+}
+
+// VerifyAuthority checks the list is signed by authorityPub.  An unsigned
+// list is ErrListUnsigned, one signed by another key ErrWrongKey, and a bad
+// signature ErrInvalidSignature.
+func (self *RevocationList) VerifyAuthority(authorityPub PublicKey) error {
+	if self.authority == nil {
+		return ErrListUnsigned
+	}
+	if fp := authorityPub.Fingerprint(); self.authority.Fingerprint != fp {
+		return fmt.Errorf("%w: list signed by %s, expect %s", ErrWrongKey,
+			self.authority.Fingerprint.Short(), fp.Short())
+	}
+	msg := GetMessage(REVOCATION_LIST_DOMAIN, self.entriesBytes())
+	if !Verify(msg, authorityPub, self.authority.Signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// MarshalBinary encodes the list as a CONTAINER_REVOCATIONS2 container.
+func (self *RevocationList) MarshalBinary() ([]byte, error) {
+	payload := self.entriesBytes()
+	if self.authority == nil {
+		payload = append(payload, 0)
+	} else {
+		payload = append(payload, 1)
+		payload = append(payload, self.authority.Fingerprint[:]...)
+		payload = append(payload, self.authority.Signature.Bytes()...)
+	}
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_REVOCATIONS2, payload)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a list from MarshalBinary, or an old
+// CONTAINER_REVOCATIONS list, replacing what's in the receiver.
+func (self *RevocationList) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	var rl RevocationList
+	switch c.Type {
+	case CONTAINER_REVOCATIONS:
+		err = rl.decodeV1(c.Payload)
+	case CONTAINER_REVOCATIONS2:
+		err = rl.decodeV2(c.Payload)
+	default:
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_REVOCATIONS2}
+	}
+	if err != nil {
+		return err
+	}
+	*self = rl
+	return nil
+}
+
+// decodeV1 decodes a CONTAINER_REVOCATIONS payload.
+func (self *RevocationList) decodeV1(p []byte) error {
+	typ := CONTAINER_REVOCATIONS
+	if len(p) < 4 {
+		return ContainerLengthError{Type: typ, Length: len(p), Expect: 4}
+	}
+	n := int(binary.BigEndian.Uint32(p))
+	if len(p) != 4+n*len(Fingerprint{}) {
+		return ContainerLengthError{Type: typ, Length: len(p), Expect: 4 + n*len(Fingerprint{})}
+	}
+	self.revoked = make(map[Fingerprint]RevocationEntry, n)
+	for i := 0; i < n; i++ {
+		var fp Fingerprint
+		copy(fp[:], p[4+i*len(fp):])
+		self.revoked[fp] = RevocationEntry{Fingerprint: fp}
+	}
+	return nil
+}
+
+// decodeV2 decodes a CONTAINER_REVOCATIONS2 payload.  Entries have to be
+// in strictly ascending order of fingerprint, as entriesBytes writes them,
+// so a list has only one encoding for its signature to cover.
+func (self *RevocationList) decodeV2(p []byte) error {
+	typ := CONTAINER_REVOCATIONS2
+	short := func(expect int) error {
+		return ContainerLengthError{Type: typ, Length: len(p), Expect: expect}
+	}
+	if len(p) < 4 {
+		return short(4)
+	}
+	n := int(binary.BigEndian.Uint32(p))
+	off := 4
+	self.revoked = make(map[Fingerprint]RevocationEntry)
+	var prev []byte
+	for i := 0; i < n; i++ {
+		if len(p) < off+32+8+2 {
+			return short(off + 32 + 8 + 2)
+		}
+		var e RevocationEntry
+		copy(e.Fingerprint[:], p[off:])
+		if prev != nil && bytes.Compare(prev, e.Fingerprint[:]) >= 0 {
+			return fmt.Errorf("revocation list: entry %d is out of order or repeated", i)
+		}
+		prev = p[off : off+32]
+		e.RevokedAt = int64(binary.BigEndian.Uint64(p[off+32:]))
+		reasonLen := int(binary.BigEndian.Uint16(p[off+40:]))
+		off += 42
+		if len(p) < off+reasonLen {
+			return short(off + reasonLen)
+		}
+		e.Reason = string(p[off : off+reasonLen])
+		off += reasonLen
+		self.revoked[e.Fingerprint] = e
+	}
+	if len(p) < off+1 {
+		return short(off + 1)
+	}
+	switch p[off] {
+	case 0:
+		off++
+	case 1:
+		off++
+		if len(p) < off+32+SIGNATURE_BYTES {
+			return short(off + 32 + SIGNATURE_BYTES)
+		}
+		a := &listSignature{}
+		copy(a.Fingerprint[:], p[off:])
+		sig, err := SignatureFromBytes(p[off+32 : off+32+SIGNATURE_BYTES])
+		if err != nil {
+			return err
+		}
+		a.Signature = sig
+		self.authority = a
+		off += 32 + SIGNATURE_BYTES
+	default:
+		return fmt.Errorf("revocation list: signed byte %d, expect 0 or 1", p[off])
+	}
+	if len(p) != off {
+		return short(off)
+	}
+	return nil
+}
+
+// LoadRevocationList reads a list saved by Save.
+func LoadRevocationList(path string) (*RevocationList, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rl := new(RevocationList)
+	err = rl.UnmarshalBinary(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rl, nil
+}
+
+// Save writes the list to path (mode 0644), through a temp file renamed
+// into place, so a reader sees either the old list or the new one.
+func (self *RevocationList) Save(path string) error {
+	data, err := self.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data, 0644)
+}
+
+// UpdateRevocationFile loads the list at path, or starts an empty one if
+// there's no file yet, calls update on it and saves the result.  Updates
+// through it are serialized with a lock file, path + ".lock", so two of
+// them never lose each other's Adds; it waits up to
+// REVOCATION_LOCK_TIMEOUT for the lock.  A lock file left by a crash has to
+// be removed by hand.  If update returns an error nothing is saved.
+func UpdateRevocationFile(path string, update func(rl *RevocationList) error) error {
+	lock := path + ".lock"
+	deadline := time.Now().Add(REVOCATION_LOCK_TIMEOUT)
+	for {
+		f, err := os.OpenFile(lock, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			break
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("revocation list %s: locked by %s", path, lock)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	defer os.Remove(lock)
+
+	rl, err := LoadRevocationList(path)
+	if errors.Is(err, os.ErrNotExist) {
+		rl, err = new(RevocationList), nil
+	}
+	if err != nil {
+		return err
+	}
+	err = update(rl)
+	if err != nil {
+		return err
+	}
+	return rl.Save(path)
+}
+
+// VerifyWithPolicy is Verify for keys that might have been revoked: a key
+// on rl is a *KeyRevokedError, matching ErrKeyRevoked and saying why and
+// when, whatever the signature; a signature that doesn't verify is
+// ErrInvalidSignature.  A nil rl revokes nothing.
+func VerifyWithPolicy(msg Message, pub PublicKey, sig Signature, rl *RevocationList) error {
+	if e, ok := rl.Lookup(pub.Fingerprint()); ok {
+		return &KeyRevokedError{Entry: e}
+	}
+	if !VerifyPtr(msg, &pub, &sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifyWithRevocation is VerifyWithPolicy, from before lists kept reasons.
+func VerifyWithRevocation(msg Message, pub PublicKey, sig Signature, rl *RevocationList) error {
+	return VerifyWithPolicy(msg, pub, sig, rl)
+}
@@ -0,0 +1,343 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestCheckRevocation checks a good revocation, and one with a single wrong
+// block.
+func TestCheckRevocation(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rev := CreateRevocation(pri)
+	err = CheckRevocation(pub, rev)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bad := rev
+	bad.OneHash[42][0] ^= 1
+	err = CheckRevocation(pub, bad)
+	if !errors.Is(err, ErrInvalidRevocation) {
+		t.Fatalf("got %v, expect ErrInvalidRevocation", err)
+	}
+
+	decoded, err := RevocationFromBytes(rev.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded != rev {
+		t.Fatalf("revocation changed in a round trip")
+	}
+}
+
+// TestVerifyWithRevocation checks a signature stops verifying once its key
+// is revoked, and that the list survives a round trip.
+func TestVerifyWithRevocation(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("revoke")
+	sig := mustSign(t, msg, pri)
+
+	var rl RevocationList
+	err = VerifyWithRevocation(msg, pub, sig, &rl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyWithRevocation(msg, pub, sig, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyWithRevocation(GetMessageFromString("other"), pub, sig, &rl)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+
+	bad := CreateRevocation(pri)
+	bad.ZeroHash[0] = Block{}
+	err = rl.Revoke(pub, bad)
+	if !errors.Is(err, ErrInvalidRevocation) || rl.Len() != 0 {
+		t.Fatalf("got %v with %d keys, expect ErrInvalidRevocation", err, rl.Len())
+	}
+	err = rl.Revoke(pub, CreateRevocation(pri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rl.Contains(pub.Fingerprint()) {
+		t.Fatalf("Contains returned false, expected true")
+	}
+	err = VerifyWithRevocation(msg, pub, sig, &rl)
+	if !errors.Is(err, ErrRevoked) {
+		t.Fatalf("got %v, expect ErrRevoked", err)
+	}
+
+	_, pub2, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := rl.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded RevocationList
+	err = loaded.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Len() != 1 || !loaded.Contains(pub.Fingerprint()) ||
+		loaded.Contains(pub2.Fingerprint()) {
+		t.Fatalf("revocation list changed in a round trip")
+	}
+	err = loaded.UnmarshalBinary(data[:len(data)-1])
+	if !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+}
+
+// TestVerifyWithPolicy checks a revoked key is rejected with its reason and
+// time, and an unrevoked one still verifies.
+func TestVerifyWithPolicy(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, other, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("policy")
+	sig := mustSign(t, msg, pri)
+
+	var rl RevocationList
+	at := time.Date(2018, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := rl.Add(other.Fingerprint(), "laptop stolen", at); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyWithPolicy(msg, pub, sig, &rl); err != nil {
+		t.Fatal(err)
+	}
+	if err := rl.Add(pub.Fingerprint(), "superseded", at); err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyWithPolicy(msg, pub, sig, &rl)
+	if !errors.Is(err, ErrKeyRevoked) || !errors.Is(err, ErrRevoked) {
+		t.Fatalf("got %v, expect ErrKeyRevoked", err)
+	}
+	var revoked *KeyRevokedError
+	if !errors.As(err, &revoked) || revoked.Entry.Reason != "superseded" ||
+		revoked.Entry.RevokedAt != at.Unix() {
+		t.Fatalf("got %v, expect the superseded entry", err)
+	}
+	if !strings.Contains(err.Error(), "superseded") ||
+		!strings.Contains(err.Error(), "2018-03-01T12:00:00Z") {
+		t.Fatalf("error %q doesn't give the reason and time", err)
+	}
+
+	if err := rl.Add(pub.Fingerprint(), strings.Repeat("x", 0x10000), at); !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+	if e, _ := rl.Lookup(pub.Fingerprint()); e.Reason != "superseded" {
+		t.Fatalf("failed Add changed the entry to %+v", e)
+	}
+}
+
+// TestRevocationListSigned round trips a list signed by an authority, and
+// checks any change to it breaks the signature.
+func TestRevocationListSigned(t *testing.T) {
+	authPri, authPub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rl RevocationList
+	for i := 0; i < 3; i++ {
+		var fp Fingerprint
+		fp[0] = byte(i)
+		if err := rl.Add(fp, fmt.Sprintf("reason %d", i), time.Unix(int64(1e9+i), 0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rl.VerifyAuthority(authPub); !errors.Is(err, ErrListUnsigned) {
+		t.Fatalf("got %v, expect ErrListUnsigned", err)
+	}
+	s := NewSigner(authPri)
+	if err := s.SignRevocationList(authPub, &rl); err != nil {
+		t.Fatal(err)
+	}
+	if fp, ok := rl.Authority(); !ok || fp != authPub.Fingerprint() {
+		t.Fatalf("Authority returned %v, %v", fp, ok)
+	}
+
+	data, err := rl.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded RevocationList
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := loaded.VerifyAuthority(authPub); err != nil {
+		t.Fatal(err)
+	}
+	for _, fp := range rl.Fingerprints() {
+		want, _ := rl.Lookup(fp)
+		got, ok := loaded.Lookup(fp)
+		if !ok || got != want {
+			t.Fatalf("entry %+v changed in a round trip to %+v", want, got)
+		}
+	}
+
+	_, otherPub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := loaded.VerifyAuthority(otherPub); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("got %v, expect ErrWrongKey", err)
+	}
+	// change the first reason in place
+	tampered := bytes.Replace(data, []byte("reason 0"), []byte("reason 9"), 1)
+	if err := loaded.UnmarshalBinary(tampered); err != nil {
+		t.Fatal(err)
+	}
+	if err := loaded.VerifyAuthority(authPub); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+	// adding drops the signature
+	if err := rl.Add(Fingerprint{9}, "late", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := rl.VerifyAuthority(authPub); !errors.Is(err, ErrListUnsigned) {
+		t.Fatalf("got %v, expect ErrListUnsigned", err)
+	}
+	if err := s.SignRevocationList(authPub, &rl); !errors.Is(err, ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+}
+
+// TestRevocationListV1 loads a list saved before reasons were kept.
+func TestRevocationListV1(t *testing.T) {
+	fp := Fingerprint{1, 2, 3}
+	payload := append([]byte{0, 0, 0, 1}, fp[:]...)
+	var buf bytes.Buffer
+	if err := WriteContainer(&buf, CONTAINER_REVOCATIONS, payload); err != nil {
+		t.Fatal(err)
+	}
+	var rl RevocationList
+	if err := rl.UnmarshalBinary(buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	e, ok := rl.Lookup(fp)
+	if rl.Len() != 1 || !ok || e.Reason != "" || e.RevokedAt != 0 {
+		t.Fatalf("got %d entries, %+v, expect just %v", rl.Len(), e, fp)
+	}
+}
+
+// TestRevocationListNil checks a nil list reads as an empty one.
+func TestRevocationListNil(t *testing.T) {
+	var rl *RevocationList
+	if rl.Contains(Fingerprint{1}) {
+		t.Fatalf("nil list contains a key")
+	}
+	if rl.Len() != 0 {
+		t.Fatalf("nil list has %d entries, expect 0", rl.Len())
+	}
+	if fps := rl.Fingerprints(); len(fps) != 0 {
+		t.Fatalf("nil list has fingerprints %v", fps)
+	}
+	if _, ok := rl.Authority(); ok {
+		t.Fatalf("nil list has an authority")
+	}
+}
+
+// TestRevocationListOrder checks a list whose entries are out of order or
+// repeated doesn't load.
+func TestRevocationListOrder(t *testing.T) {
+	entry := func(fp Fingerprint) []byte {
+		return append(fp[:], make([]byte, 8+2)...)
+	}
+	a, b := Fingerprint{1}, Fingerprint{2}
+	for _, tc := range []struct {
+		name  string
+		first Fingerprint
+		last  Fingerprint
+		ok    bool
+	}{
+		{"ascending", a, b, true},
+		{"descending", b, a, false},
+		{"repeated", a, a, false},
+	} {
+		payload := []byte{0, 0, 0, 2}
+		payload = append(payload, entry(tc.first)...)
+		payload = append(payload, entry(tc.last)...)
+		payload = append(payload, 0)
+		var buf bytes.Buffer
+		if err := WriteContainer(&buf, CONTAINER_REVOCATIONS2, payload); err != nil {
+			t.Fatal(err)
+		}
+		var rl RevocationList
+		err := rl.UnmarshalBinary(buf.Bytes())
+		if tc.ok && err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if !tc.ok && err == nil {
+			t.Fatalf("%s: loaded, expect an error", tc.name)
+		}
+	}
+}
+
+// TestUpdateRevocationFile has several goroutines add to the same file at
+// once and checks none of their entries are lost.
+func TestUpdateRevocationFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "revoked.lrl")
+	const n = 8
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			errs <- UpdateRevocationFile(path, func(rl *RevocationList) error {
+				return rl.Add(Fingerprint{byte(i)}, "compromised", time.Now())
+			})
+		}(i)
+	}
+	for i := 0; i < n; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	rl, err := LoadRevocationList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rl.Len() != n {
+		t.Fatalf("got %d entries, expect %d", rl.Len(), n)
+	}
+
+	// a failed update leaves the file alone
+	failed := errors.New("no")
+	err = UpdateRevocationFile(path, func(rl *RevocationList) error {
+		rl.Add(Fingerprint{0xff}, "", time.Now())
+		return failed
+	})
+	if !errors.Is(err, failed) {
+		t.Fatalf("got %v, expect the update's error", err)
+	}
+	rl, err = LoadRevocationList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rl.Contains(Fingerprint{0xff}) {
+		t.Fatalf("failed update was saved")
+	}
+	if _, err := os.Stat(path + ".lock"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("lock file left behind: %v", err)
+	}
+}
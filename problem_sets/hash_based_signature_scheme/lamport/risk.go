@@ -0,0 +1,86 @@
+package lamport
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+// RiskReport is what ReuseRisk measured for k signatures by one key,
+// alongside the closed form, one row of a plot against k.
+//
+// After k signatures on random messages, a position has both rows revealed
+// unless all k bits there came out the same, so with probability
+// 1 - 2^(1-k); otherwise only one row is known, and a forger's message has
+// to match it.  With u such positions that's about 2^u tries, and since the
+// positions are independent, E[2^u] = (1 + 2^(1-k))^256.
+type RiskReport struct {
+	K      int `json:"k"`
+	Trials int `json:"trials"`
+
+	// CoveredFraction is the mean fraction of the 256 positions with both
+	// rows revealed.
+	CoveredFraction float64 `json:"covered_fraction"`
+	// Uncovered is the mean number of positions with only one row revealed,
+	// the same as RevealedKey.Difficulty.
+	Uncovered float64 `json:"uncovered"`
+	// ExpectedWork is the mean of 2^uncovered over the trials, the number
+	// of random messages a forger expects to hash.
+	ExpectedWork float64 `json:"expected_work"`
+
+	AnalyticCoveredFraction float64 `json:"analytic_covered_fraction"`
+	AnalyticUncovered       float64 `json:"analytic_uncovered"`
+	AnalyticExpectedWork    float64 `json:"analytic_expected_work"`
+}
+
+// ReuseRisk simulates trials keys each signing k uniformly random messages
+// and reports how much of the key they give away.  Which blocks a signature
+// reveals depends only on the message, so it draws the messages from rng
+// and tracks their coverage the way RevealedKey does rather than generating
+// and hashing real keys.  A nil rng is seeded with 1, and trials below 1
+// count as 1.  With k below 1 nothing is revealed and forgery is impossible,
+// so ExpectedWork is +Inf.
+func ReuseRisk(k int, trials int, rng *rand.Rand) RiskReport {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(1))
+	}
+	if trials < 1 {
+		trials = 1
+	}
+	report := RiskReport{K: k, Trials: trials}
+	if k < 1 {
+		report.Uncovered = MESSAGE_BITS
+		report.ExpectedWork = math.Inf(1)
+		report.AnalyticUncovered = MESSAGE_BITS
+		report.AnalyticExpectedWork = math.Inf(1)
+		return report
+	}
+
+	var covered, uncovered, work float64
+	for t := 0; t < trials; t++ {
+		var zero, one, msg Message
+		for i := 0; i < k; i++ {
+			rng.Read(msg[:])
+			for j := range msg {
+				zero[j] |= ^msg[j]
+				one[j] |= msg[j]
+			}
+		}
+		both := 0
+		for j := range zero {
+			both += bits.OnesCount8(zero[j] & one[j])
+		}
+		covered += float64(both)
+		uncovered += float64(MESSAGE_BITS - both)
+		work += math.Ldexp(1, MESSAGE_BITS-both)
+	}
+	report.CoveredFraction = covered / float64(trials) / MESSAGE_BITS
+	report.Uncovered = uncovered / float64(trials)
+	report.ExpectedWork = work / float64(trials)
+
+	single := math.Ldexp(1, 1-k) // P(only one row revealed at a position)
+	report.AnalyticCoveredFraction = 1 - single
+	report.AnalyticUncovered = MESSAGE_BITS * single
+	report.AnalyticExpectedWork = math.Pow(1+single, MESSAGE_BITS)
+	return report
+}
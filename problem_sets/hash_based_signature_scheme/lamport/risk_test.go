@@ -0,0 +1,49 @@
+package lamport
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestReuseRisk checks the simulated coverage lands near the closed form
+// for k from 2 to 6.
+func TestReuseRisk(t *testing.T) {
+	rng := rand.New(rand.NewSource(62))
+	for k := 2; k <= 6; k++ {
+		r := ReuseRisk(k, 2000, rng)
+		if r.K != k || r.Trials != 2000 {
+			t.Fatalf("report for k=%d trials=%d", r.K, r.Trials)
+		}
+		if math.Abs(r.Uncovered-r.AnalyticUncovered) > 0.5 {
+			t.Fatalf("k=%d: uncovered %.2f, analytic %.2f", k, r.Uncovered, r.AnalyticUncovered)
+		}
+		if math.Abs(r.CoveredFraction-r.AnalyticCoveredFraction) > 0.005 {
+			t.Fatalf("k=%d: covered %.4f, analytic %.4f",
+				k, r.CoveredFraction, r.AnalyticCoveredFraction)
+		}
+		// 2^x is convex, so the mean work is at least 2^(mean uncovered).
+		// The mean itself is dominated by rare trials with many positions
+		// uncovered, so it's too noisy to hold to the closed form.
+		if r.ExpectedWork < math.Exp2(r.Uncovered) {
+			t.Fatalf("k=%d: expected work %g below 2^%.2f", k, r.ExpectedWork, r.Uncovered)
+		}
+	}
+}
+
+// TestReuseRiskEdges checks one signature leaves every position with one
+// row known, and none leaves nothing to forge with.
+func TestReuseRiskEdges(t *testing.T) {
+	r := ReuseRisk(1, 10, nil)
+	if r.CoveredFraction != 0 || r.Uncovered != MESSAGE_BITS ||
+		r.AnalyticUncovered != MESSAGE_BITS {
+		t.Fatalf("k=1: %+v", r)
+	}
+	r = ReuseRisk(0, 10, nil)
+	if !math.IsInf(r.ExpectedWork, 1) || !math.IsInf(r.AnalyticExpectedWork, 1) {
+		t.Fatalf("k=0: %+v", r)
+	}
+	if ReuseRisk(3, 100, nil) != ReuseRisk(3, 100, nil) {
+		t.Fatalf("nil rng isn't deterministic")
+	}
+}
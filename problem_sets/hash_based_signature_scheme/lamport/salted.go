@@ -0,0 +1,96 @@
+package lamport
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+)
+
+const SALT_BYTES = 32
+const SALTED_SIGNATURE_BYTES = SALT_BYTES + SIGNATURE_BYTES // 8224
+
+// SaltedSignature is a signature on sha256(Salt || data) rather than on
+// sha256(data).  Since the signer picks the salt at random after the data is
+// fixed, whoever chose the data can't grind it towards a digest whose bits
+// have already been revealed, which is how assignment.Forge works.
+type SaltedSignature struct {
+	Salt      [SALT_BYTES]byte
+	Signature Signature
+}
+
+// SaltedDigest returns the message a salted signature with salt on data
+// signs: sha256(salt || data).
+func SaltedDigest(salt [SALT_BYTES]byte, data []byte) Message {
+	h := sha256.New()
+	h.Write(salt[:])
+	h.Write(data)
+	var msg Message
+	h.Sum(msg[:0])
+	return msg
+}
+
+// SignSalted draws a random salt from crypto/rand and signs
+// SaltedDigest(salt, data).
+func SignSalted(data []byte, pri PrivateKey) (SaltedSignature, error) {
+	return SignSaltedFrom(rand.Reader, data, pri)
+}
+
+// SignSaltedFrom is SignSalted reading the salt from r.
+func SignSaltedFrom(r io.Reader, data []byte, pri PrivateKey) (SaltedSignature, error) {
+	var ssig SaltedSignature
+	_, err := io.ReadFull(r, ssig.Salt[:])
+	if err != nil {
+		return SaltedSignature{}, err
+	}
+	ssig.Signature, err = SignChecked(SaltedDigest(ssig.Salt, data), &pri)
+	if err != nil {
+		return SaltedSignature{}, err
+	}
+	return ssig, nil
+}
+
+// VerifySalted checks ssig on data against pub.
+func VerifySalted(data []byte, pub PublicKey, ssig SaltedSignature) bool {
+	return Verify(SaltedDigest(ssig.Salt, data), pub, ssig.Signature)
+}
+
+// Bytes returns the salt followed by the signature, SALTED_SIGNATURE_BYTES
+// long.
+func (self SaltedSignature) Bytes() []byte {
+	b := make([]byte, 0, SALTED_SIGNATURE_BYTES)
+	b = append(b, self.Salt[:]...)
+	return append(b, self.Signature.Bytes()...)
+}
+
+// SaltedSignatureFromBytes is the inverse of SaltedSignature.Bytes.
+func SaltedSignatureFromBytes(b []byte) (SaltedSignature, error) {
+	var ssig SaltedSignature
+	if len(b) != SALTED_SIGNATURE_BYTES {
+		return ssig, fmt.Errorf("%w: SaltedSignature %d bytes, expect %d",
+			ErrWrongLength, len(b), SALTED_SIGNATURE_BYTES)
+	}
+	copy(ssig.Salt[:], b)
+	sig, err := SignatureFromBytes(b[SALT_BYTES:])
+	if err != nil {
+		return SaltedSignature{}, err
+	}
+	ssig.Signature = sig
+	return ssig, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the layout of
+// Bytes().
+func (self SaltedSignature) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *SaltedSignature) UnmarshalBinary(data []byte) error {
+	ssig, err := SaltedSignatureFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = ssig
+	return nil
+}
@@ -0,0 +1,96 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestSignSalted signs the same data with two keys and checks the salts,
+// and so the signed digests, differ.
+func TestSignSalted(t *testing.T) {
+	data := []byte("salted")
+	var digests []Message
+	for i := 0; i < 2; i++ {
+		pri, pub, err := GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ssig, err := SignSalted(data, pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !VerifySalted(data, pub, ssig) {
+			t.Fatalf("VerifySalted returned false, expected true")
+		}
+		if VerifySalted([]byte("salted!"), pub, ssig) {
+			t.Fatalf("VerifySalted on other data returned true, expected false")
+		}
+		if VerifyBytes(data, pub, ssig.Signature) {
+			t.Fatalf("salted signature verified as unsalted")
+		}
+		digests = append(digests, SaltedDigest(ssig.Salt, data))
+	}
+	if digests[0] == digests[1] {
+		t.Fatalf("two salted signatures signed the same digest %x", digests[0])
+	}
+
+	_, err := SignSaltedFrom(bytes.NewReader(make([]byte, 10)), data, PrivateKey{})
+	if err == nil {
+		t.Fatalf("short salt source returned no error")
+	}
+	_, err = SignSaltedFrom(bytes.NewReader(make([]byte, SALT_BYTES)), data, PrivateKey{})
+	if !errors.Is(err, ErrZeroKey) {
+		t.Fatalf("got %v, expect ErrZeroKey", err)
+	}
+}
+
+// TestSaltedSignatureEncoding round trips a salted signature through the
+// binary encoding and a container.
+func TestSaltedSignatureEncoding(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("encode me")
+	ssig, err := SignSalted(data, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ssig.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != SALTED_SIGNATURE_BYTES {
+		t.Fatalf("got %d bytes, expect %d", len(b), SALTED_SIGNATURE_BYTES)
+	}
+	var decoded SaltedSignature
+	err = decoded.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded != ssig || !VerifySalted(data, pub, decoded) {
+		t.Fatalf("decoded salted signature doesn't match")
+	}
+	err = decoded.UnmarshalBinary(b[1:])
+	if !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+
+	var buf bytes.Buffer
+	err = WriteContainer(&buf, CONTAINER_SALTED, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ReadContainer(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromContainer, err := c.SaltedSignature()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromContainer != ssig {
+		t.Fatalf("container round trip changed the salted signature")
+	}
+}
@@ -0,0 +1,162 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+var allSchemes = []*Scheme{SCHEME_SHA256, SCHEME_SHA3_256, SCHEME_BLAKE2B_256,
+	SCHEME_SHA256_TWEAKED}
+
+// schemeVectors has, for each scheme, the hash of "abc" (the standard test
+// vector for each hash) and the fingerprint of the key generated from
+// schemeTestSecret.
+var schemeVectors = map[*Scheme][2]string{
+	SCHEME_SHA256: {
+		"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		"e53f95421669e1b5d2f66d213a135d39f7f38709861dbcc1359d1aba985a9e5e"},
+	SCHEME_SHA3_256: {
+		"3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532",
+		"0608109f36df8ce116c13e63f354e45f9ebe589587c6ef701908f64dfb31ac80"},
+	SCHEME_BLAKE2B_256: {
+		"bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319",
+		"441470ff6590068ad264477ecb1310d002d11807ae5e70668806650bef5b2c50"},
+	SCHEME_SHA256_TWEAKED: {
+		"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		"ccfc2dcb2bb1e9fd2c0e5220cf451c8454e2977bdd74dc46aed0793fc8a73361"},
+}
+
+func schemeTestSecret() []byte {
+	secret := make([]byte, PRIVKEY_BYTES)
+	for i := range secret {
+		secret[i] = byte(i % 251)
+	}
+	return secret
+}
+
+// TestSchemeVectors checks each scheme's message hash and pubkey against
+// fixed vectors, and that sha256 matches the package level functions.
+func TestSchemeVectors(t *testing.T) {
+	for _, s := range allSchemes {
+		pri, pub, err := s.GenerateKeyFrom(bytes.NewReader(schemeTestSecret()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := s.GetMessage([]byte("abc"))
+		if hex.EncodeToString(msg[:]) != schemeVectors[s][0] {
+			t.Fatalf("%s: hash of \"abc\" is %x", s.Name, msg)
+		}
+		fp := pub.Fingerprint()
+		if hex.EncodeToString(fp[:]) != schemeVectors[s][1] {
+			t.Fatalf("%s: pubkey fingerprint is %x", s.Name, fp)
+		}
+
+		sig, err := s.Sign(msg, pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !s.Verify(msg, pub, sig) {
+			t.Fatalf("%s: Verify returned false, expected true", s.Name)
+		}
+		if s.Verify(s.GetMessage([]byte("abd")), pub, sig) {
+			t.Fatalf("%s: Verify returned true for the wrong message", s.Name)
+		}
+	}
+
+	pri, pub, err := GenerateKeyFrom(bytes.NewReader(schemeTestSecret()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if SCHEME_SHA256.PublicKey(pri) != pub {
+		t.Fatalf("SCHEME_SHA256 pubkey differs from GetPublicKey")
+	}
+}
+
+// TestSchemeCross checks keys and signatures from one scheme don't verify
+// or load under another.
+func TestSchemeCross(t *testing.T) {
+	for _, a := range allSchemes {
+		pri, pub, err := a.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := a.GetMessage([]byte("cross"))
+		sig, err := a.Sign(msg, pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := a.MarshalPublicKey(pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigData, err := a.MarshalSignature(sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, b := range allSchemes {
+			_, err := b.ParsePublicKey(data)
+			_, sigErr := b.ParseSignature(sigData)
+			if a == b {
+				if err != nil || sigErr != nil {
+					t.Fatalf("%s: can't parse own container: %v %v", a.Name, err, sigErr)
+				}
+				continue
+			}
+			if b.Verify(msg, pub, sig) {
+				t.Fatalf("%s signature verified under %s", a.Name, b.Name)
+			}
+			var mismatch SchemeMismatchError
+			if !errors.As(err, &mismatch) || mismatch.Got != a.ID || mismatch.Expect != b.ID {
+				t.Fatalf("%s pubkey parsed by %s: got %v", a.Name, b.Name, err)
+			}
+			if !errors.As(sigErr, &mismatch) {
+				t.Fatalf("%s signature parsed by %s: got %v", a.Name, b.Name, sigErr)
+			}
+		}
+
+		// the plain container reader only takes sha256
+		c, err := ReadContainer(bytes.NewReader(data))
+		if a == SCHEME_SHA256 {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = c.PublicKey(); err != nil {
+				t.Fatal(err)
+			}
+		} else {
+			var paramsErr ContainerParamsError
+			if !errors.As(err, &paramsErr) {
+				t.Fatalf("%s container read by ReadContainer: got %v", a.Name, err)
+			}
+		}
+	}
+}
+
+// TestSchemeParams checks the generic code under a scheme's Params agrees
+// with the scheme.
+func TestSchemeParams(t *testing.T) {
+	s := SCHEME_BLAKE2B_256
+	pri, pub, err := s.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := s.GetMessage([]byte("params"))
+	sig, err := s.Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Params().Verify(msg[:], pub.Generic(), sig.Generic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	gpub, err := s.Params().PublicKey(pri.Generic()).Fixed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gpub != pub {
+		t.Fatalf("generic pubkey under %s differs from the scheme's", s.Name)
+	}
+}
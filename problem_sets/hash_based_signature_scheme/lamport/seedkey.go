@@ -0,0 +1,114 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+)
+
+/*
+A SeedPrivateKey derives each block of a PrivateKey from its seed:
+
+    PRF(seed, row, index) = sha256(seed || row || index)
+
+with row one byte, 0 for ZeroHash and 1 for OneHash, and index two bytes,
+big endian.  It's saved as a CONTAINER_SEED_PRIVKEY container holding just
+the seed.
+*/
+
+const SEED_BYTES = 32
+
+// SeedPrivateKey is a private key kept as the 32 byte seed its blocks are
+// derived from, rather than as the 16384 bytes of the blocks.  Its pubkeys
+// and signatures are the usual PublicKey and Signature, and Verify checks
+// them the same way.
+type SeedPrivateKey struct {
+	Seed [SEED_BYTES]byte
+}
+
+// GenerateSeedKey generates a seed key from crypto/rand.
+func GenerateSeedKey() (SeedPrivateKey, PublicKey, error) {
+	return GenerateSeedKeyFrom(rand.Reader)
+}
+
+// GenerateSeedKeyFrom reads the seed from r.
+func GenerateSeedKeyFrom(r io.Reader) (SeedPrivateKey, PublicKey, error) {
+	var pri SeedPrivateKey
+	_, err := io.ReadFull(r, pri.Seed[:])
+	if err != nil {
+		return SeedPrivateKey{}, PublicKey{}, fmt.Errorf("reading %d byte seed: %w",
+			SEED_BYTES, err)
+	}
+	return pri, pri.GetPublicKey(), nil
+}
+
+// Block derives the private key block for row (0 or 1) at index.
+func (self SeedPrivateKey) Block(row, index int) Block {
+	var buf [SEED_BYTES + 3]byte
+	copy(buf[:], self.Seed[:])
+	buf[SEED_BYTES] = byte(row)
+	buf[SEED_BYTES+1] = byte(index >> 8)
+	buf[SEED_BYTES+2] = byte(index)
+	return sha256.Sum256(buf[:])
+}
+
+// Expand derives all 512 blocks as a PrivateKey.  Wipe it with Zeroize once
+// it's no longer needed.
+func (self SeedPrivateKey) Expand() PrivateKey {
+	var pri PrivateKey
+	for i := 0; i < MESSAGE_BITS; i++ {
+		pri.ZeroHash[i] = self.Block(0, i)
+		pri.OneHash[i] = self.Block(1, i)
+	}
+	return pri
+}
+
+// GetPublicKey derives every block and hashes it.
+func (self SeedPrivateKey) GetPublicKey() PublicKey {
+	var pub PublicKey
+	for i := 0; i < MESSAGE_BITS; i++ {
+		pub.ZeroHash[i] = self.Block(0, i).Hash()
+		pub.OneHash[i] = self.Block(1, i).Hash()
+	}
+	return pub
+}
+
+// Sign signs msg, deriving only the 256 blocks it reveals.
+func (self SeedPrivateKey) Sign(msg Message) Signature {
+	var sig Signature
+	for i := 0; i < MESSAGE_BITS; i++ {
+		sig.Preimage[i] = self.Block(int(msg.Bit(i)), i)
+	}
+	return sig
+}
+
+// Zeroize overwrites the seed with zeros.
+func (self *SeedPrivateKey) Zeroize() {
+	self.Seed = [SEED_BYTES]byte{}
+}
+
+// MarshalBinary encodes the seed as a CONTAINER_SEED_PRIVKEY container.
+func (self SeedPrivateKey) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_SEED_PRIVKEY, self.Seed[:])
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a seed key from MarshalBinary.
+func (self *SeedPrivateKey) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	pri, err := c.SeedPrivateKey()
+	if err != nil {
+		return err
+	}
+	*self = pri
+	return nil
+}
@@ -0,0 +1,109 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// Reference expansion of the seed 00 01 .. 1f: block (1, 255), and the
+// sha256 of the whole pubkey.
+const (
+	SEED_KAT_BLOCK  = "366d3a7afd41ed4ae7d1af6ff2538a701ff4032a1c1ae1d0d411e25b15a29021"
+	SEED_KAT_PUBKEY = "02cac1f7f37cac868f08ab062482704a1838329d2bb87a3d8afe5c4514b3b407"
+)
+
+func testSeedKey() SeedPrivateKey {
+	var pri SeedPrivateKey
+	for i := range pri.Seed {
+		pri.Seed[i] = byte(i)
+	}
+	return pri
+}
+
+// TestSeedKeyVector checks the reference expansion, and that the expanded
+// key and the seed key give the same pubkey.
+func TestSeedKeyVector(t *testing.T) {
+	pri := testSeedKey()
+	block := pri.Block(1, 255)
+	if hex.EncodeToString(block[:]) != SEED_KAT_BLOCK {
+		t.Fatalf("block (1, 255) %x, expect %s", block, SEED_KAT_BLOCK)
+	}
+	pub := pri.Expand().GetPublicKey()
+	h := sha256.Sum256(pub.Bytes())
+	if hex.EncodeToString(h[:]) != SEED_KAT_PUBKEY {
+		t.Fatalf("pubkey hash %x, expect %s", h, SEED_KAT_PUBKEY)
+	}
+	if pri.GetPublicKey() != pub {
+		t.Fatalf("seed key and expanded key have different pubkeys")
+	}
+}
+
+// TestSeedKeySign checks seed key signatures are the expanded key's and
+// pass the usual Verify.
+func TestSeedKeySign(t *testing.T) {
+	pri, pub, err := GenerateSeedKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("seed")
+	sig := pri.Sign(msg)
+	if sig != mustSign(t, msg, pri.Expand()) {
+		t.Fatalf("seed key signature differs from the expanded key's")
+	}
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	if Verify(GetMessageFromString("other"), pub, sig) {
+		t.Fatalf("Verify returned true for another message, expected false")
+	}
+
+	_, _, err = GenerateSeedKeyFrom(bytes.NewReader(make([]byte, SEED_BYTES-1)))
+	if err == nil {
+		t.Fatalf("short seed returned nil, expected an error")
+	}
+}
+
+// TestSeedKeyContainer round trips a seed key through its container, and
+// checks a plain private key container isn't taken for one.
+func TestSeedKeyContainer(t *testing.T) {
+	pri := testSeedKey()
+	data, err := pri.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != CONTAINER_HEADER_BYTES+SEED_BYTES {
+		t.Fatalf("container %d bytes, expect %d", len(data),
+			CONTAINER_HEADER_BYTES+SEED_BYTES)
+	}
+	var pri2 SeedPrivateKey
+	err = pri2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri2 != pri {
+		t.Fatalf("container round trip changed the seed")
+	}
+
+	var buf bytes.Buffer
+	err = WriteContainer(&buf, CONTAINER_PRIVKEY, pri.Expand().Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pri2.UnmarshalBinary(buf.Bytes())
+	var typeErr ContainerTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("got %v, expect ContainerTypeError", err)
+	}
+	err = pri2.UnmarshalBinary(data[:len(data)-1])
+	if !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+
+	pri2.Zeroize()
+	if pri2.Seed != [SEED_BYTES]byte{} {
+		t.Fatalf("Zeroize left the seed")
+	}
+}
@@ -0,0 +1,18 @@
+package lamport
+
+import (
+	"crypto/sha512"
+)
+
+// SHA512Params sign 512 bit messages, a whole SHA-512 digest, with two rows
+// of 512 blocks of 64 bytes hashed with SHA-512.  Keys are 65536 bytes and
+// signatures 32768.  Use SHA512Params.Message to turn data into a message,
+// and the Params hex functions to encode keys and signatures; a 256 bit
+// key or signature is the wrong length for them, and the wrong shape for
+// SHA512Params.Verify, which says so with a *ParamsError.
+var SHA512Params = Params{MessageBits: 512, Hash: sha512Hash}
+
+func sha512Hash(b []byte) []byte {
+	h := sha512.Sum512(b)
+	return h[:]
+}
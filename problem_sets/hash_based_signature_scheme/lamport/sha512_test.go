@@ -0,0 +1,167 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+)
+
+// sha512KAT is testdata/sha512_kat.json, a key generated from a seed as its
+// note says, and its signature on sha512("abc").
+type sha512KAT struct {
+	Seed      string `json:"seed"`
+	Data      string `json:"data"`
+	Message   string `json:"message"`
+	Pubkey    string `json:"pubkey"`
+	Signature string `json:"signature"`
+}
+
+func readSHA512KAT(t *testing.T) sha512KAT {
+	data, err := os.ReadFile("testdata/sha512_kat.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kat sha512KAT
+	err = json.Unmarshal(data, &kat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return kat
+}
+
+// sha512KeyMaterial is the KAT's keygen input: block j is sha512(seed || j).
+func sha512KeyMaterial(seed []byte) *bytes.Reader {
+	var material []byte
+	for j := uint32(0); j < 2*512; j++ {
+		h := sha512.Sum512(binary.BigEndian.AppendUint32(append([]byte{}, seed...), j))
+		material = append(material, h[:]...)
+	}
+	return bytes.NewReader(material)
+}
+
+// TestSHA512KAT generates the KAT key, signs the KAT message, and checks
+// both against the file, along with its decoded pubkey and signature.
+func TestSHA512KAT(t *testing.T) {
+	kat := readSHA512KAT(t)
+	seed, err := hex.DecodeString(kat.Seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pri, pub, err := SHA512Params.GenerateKey(sha512KeyMaterial(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if SHA512Params.PubkeyToHex(pub) != kat.Pubkey {
+		t.Fatalf("generated pubkey isn't the KAT's")
+	}
+
+	msg := SHA512Params.Message([]byte(kat.Data))
+	if hex.EncodeToString(msg) != kat.Message {
+		t.Fatalf("message %x, expect %s", msg, kat.Message)
+	}
+	sig, err := SHA512Params.Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if SHA512Params.SignatureToHex(sig) != kat.Signature {
+		t.Fatalf("signature isn't the KAT's")
+	}
+
+	katPub, err := SHA512Params.HexToPubkey(kat.Pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	katSig, err := SHA512Params.HexToSignature(kat.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = SHA512Params.Verify(msg, katPub, katSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg[63] ^= 1
+	err = SHA512Params.Verify(msg, katPub, katSig)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("changed message: got %v, expect ErrInvalidSignature", err)
+	}
+}
+
+// TestSHA512Hex round trips a private key through hex, and checks the
+// decoders hold hex of the 256 bit variant to their own lengths.
+func TestSHA512Hex(t *testing.T) {
+	pri, _, err := SHA512Params.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pri2, err := SHA512Params.HexToPrivkey(SHA512Params.PrivkeyToHex(pri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if SHA512Params.PubkeyToHex(SHA512Params.PublicKey(pri2)) !=
+		SHA512Params.PubkeyToHex(SHA512Params.PublicKey(pri)) {
+		t.Fatalf("hex round trip changed the private key")
+	}
+
+	var decodeErr *DecodeError
+	_, err = SHA512Params.HexToPubkey(hexPubkey1)
+	if !errors.As(err, &decodeErr) || decodeErr.Reason != DECODE_LENGTH {
+		t.Fatalf("256 bit pubkey: got %v, expect a length DecodeError", err)
+	}
+	_, err = SHA512Params.HexToSignature(hexSignature1)
+	if !errors.As(err, &decodeErr) || decodeErr.Reason != DECODE_LENGTH {
+		t.Fatalf("256 bit signature: got %v, expect a length DecodeError", err)
+	}
+	_, err = HexToSignature(readSHA512KAT(t).Signature)
+	if !errors.As(err, &decodeErr) || decodeErr.Reason != DECODE_LENGTH {
+		t.Fatalf("512 bit signature as 256: got %v, expect a length DecodeError", err)
+	}
+}
+
+// TestSHA512CrossVariant checks keys, signatures and messages of one
+// variant are refused by the other with a *ParamsError, not a panic.
+func TestSHA512CrossVariant(t *testing.T) {
+	kat := readSHA512KAT(t)
+	pub512, err := SHA512Params.HexToPubkey(kat.Pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig512, err := SHA512Params.HexToSignature(kat.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg512 := SHA512Params.Message([]byte(kat.Data))
+	pub256, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig256, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg256 := GetMessageFromString("1")
+
+	var paramsErr *ParamsError
+	for _, tc := range []struct {
+		name string
+		err  error
+	}{
+		{"256 bit key under sha512", SHA512Params.Verify(msg512, pub256.Generic(), sig512)},
+		{"256 bit signature under sha512", SHA512Params.Verify(msg512, pub512, sig256.Generic())},
+		{"256 bit message under sha512", SHA512Params.Verify(msg256[:], pub512, sig512)},
+		{"512 bit key under sha256", DefaultParams.Verify(msg256[:], pub512, sig256.Generic())},
+		{"512 bit signature under sha256", DefaultParams.Verify(msg256[:], pub256.Generic(), sig512)},
+	} {
+		if !errors.As(tc.err, &paramsErr) {
+			t.Fatalf("%s: got %v, expect *ParamsError", tc.name, tc.err)
+		}
+	}
+	_, err = pub512.Fixed()
+	if !errors.As(err, &paramsErr) {
+		t.Fatalf("Fixed on a 512 bit pubkey: got %v, expect *ParamsError", err)
+	}
+}
@@ -0,0 +1,202 @@
+package lamport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// SHAKE_MIN_BLOCK_BYTES and SHAKE_MAX_BLOCK_BYTES bound a ShakeScheme's
+// block size.  Below 16 bytes a block is a brute force target in reach of
+// a laptop; above 64 there's no point.
+const (
+	SHAKE_MIN_BLOCK_BYTES = 16
+	SHAKE_MAX_BLOCK_BYTES = 64
+)
+
+// ShakeScheme is Lamport signing with SHAKE128 or SHAKE256, squeezing
+// exactly BlockBytes for each key block, so blocks can be any size, 20
+// bytes say, trading security for smaller keys.  Messages are still 256
+// bits, squeezed from the same SHAKE.  Keys and signatures are the generic
+// types, from Params().
+//
+// ID is the SHAKE parameter set ID ORed with BlockBytes, so containers
+// from different SHAKEs or block sizes can't be mistaken for each other or
+// for a Scheme's.
+type ShakeScheme struct {
+	ID         uint16
+	Name       string
+	BlockBytes int
+	newShake   func() sha3.ShakeHash
+}
+
+// NewShakeScheme returns the scheme for SHAKE128 or SHAKE256 (bits 128 or
+// 256) with blocks of blockBytes.
+func NewShakeScheme(bits, blockBytes int) (*ShakeScheme, error) {
+	if blockBytes < SHAKE_MIN_BLOCK_BYTES || blockBytes > SHAKE_MAX_BLOCK_BYTES {
+		return nil, fmt.Errorf("shake: %d byte blocks, expect %d to %d",
+			blockBytes, SHAKE_MIN_BLOCK_BYTES, SHAKE_MAX_BLOCK_BYTES)
+	}
+	s := &ShakeScheme{BlockBytes: blockBytes}
+	switch bits {
+	case 128:
+		s.ID = PARAMS_SHAKE128
+		s.newShake = sha3.NewShake128
+	case 256:
+		s.ID = PARAMS_SHAKE256
+		s.newShake = sha3.NewShake256
+	default:
+		return nil, fmt.Errorf("shake: SHAKE%d, expect SHAKE128 or SHAKE256", bits)
+	}
+	s.ID |= uint16(blockBytes)
+	s.Name = fmt.Sprintf("shake%d/%d", bits, blockBytes)
+	return s, nil
+}
+
+// ShakeSchemeByID returns the scheme for a container params ID, or nil.
+func ShakeSchemeByID(id uint16) *ShakeScheme {
+	bits := 0
+	switch id &^ 0xff {
+	case PARAMS_SHAKE128:
+		bits = 128
+	case PARAMS_SHAKE256:
+		bits = 256
+	}
+	s, err := NewShakeScheme(bits, int(id&0xff))
+	if err != nil {
+		return nil
+	}
+	return s
+}
+
+// squeeze returns the first n bytes of SHAKE over b.
+func (self *ShakeScheme) squeeze(b []byte, n int) []byte {
+	h := self.newShake()
+	h.Write(b)
+	out := make([]byte, n)
+	h.Read(out)
+	return out
+}
+
+// Params returns the scheme as Params: 256 message bits, blocks hashed to
+// BlockBytes, and messages to 32 bytes.
+func (self *ShakeScheme) Params() Params {
+	return Params{
+		MessageBits: MESSAGE_BITS,
+		Hash:        func(b []byte) []byte { return self.squeeze(b, self.BlockBytes) },
+		MessageHash: func(b []byte) []byte { return self.squeeze(b, MESSAGE_BYTES) },
+	}
+}
+
+// Message squeezes a 256 bit message from data.
+func (self *ShakeScheme) Message(data []byte) []byte {
+	return self.squeeze(data, MESSAGE_BYTES)
+}
+
+// GenerateKey makes a key pair, reading the private blocks from r (or
+// crypto/rand if r is nil).
+func (self *ShakeScheme) GenerateKey(r io.Reader) (*GenericPrivateKey, *GenericPublicKey, error) {
+	return self.Params().GenerateKey(r)
+}
+
+// Sign signs msg, a 32 byte message, with pri.
+func (self *ShakeScheme) Sign(msg []byte, pri *GenericPrivateKey) (*GenericSignature, error) {
+	return self.Params().Sign(msg, pri)
+}
+
+// Verify checks sig on msg against pub, with the errors of Params.Verify.
+// Keys and signatures with other block sizes are *ParamsErrors.
+func (self *ShakeScheme) Verify(msg []byte, pub *GenericPublicKey, sig *GenericSignature) error {
+	for _, block := range sig.Preimage {
+		if len(block) != self.BlockBytes {
+			return &ParamsError{Field: "signature block bytes", Got: len(block),
+				Expect: self.BlockBytes}
+		}
+	}
+	return self.Params().Verify(msg, pub, sig)
+}
+
+// payloadSize is the size of a type's payload with BlockBytes blocks: the
+// rows of blocks Bytes() would write for a 32 byte block scheme.
+func (self *ShakeScheme) payloadSize(typ ContainerType) (int, bool) {
+	switch typ {
+	case CONTAINER_PUBKEY, CONTAINER_PRIVKEY:
+		return 2 * MESSAGE_BITS * self.BlockBytes, true
+	case CONTAINER_SIGNATURE:
+		return MESSAGE_BITS * self.BlockBytes, true
+	}
+	return 0, false
+}
+
+// MarshalPublicKey writes pub in a CONTAINER_PUBKEY container with the
+// scheme's ID, the zero row then the one row.
+func (self *ShakeScheme) MarshalPublicKey(pub *GenericPublicKey) ([]byte, error) {
+	return self.marshal(CONTAINER_PUBKEY, rowsToBytes(pub.ZeroHash, pub.OneHash))
+}
+
+// ParsePublicKey reads a pubkey written by MarshalPublicKey.  A container
+// from another scheme or block size is a SchemeMismatchError.
+func (self *ShakeScheme) ParsePublicKey(data []byte) (*GenericPublicKey, error) {
+	payload, err := self.parse(data, CONTAINER_PUBKEY)
+	if err != nil {
+		return nil, err
+	}
+	rows := self.Params().bytesToRows(payload, 2)
+	return &GenericPublicKey{ZeroHash: rows[0], OneHash: rows[1]}, nil
+}
+
+// MarshalPrivateKey writes pri in a CONTAINER_PRIVKEY container, laid out
+// like MarshalPublicKey.
+func (self *ShakeScheme) MarshalPrivateKey(pri *GenericPrivateKey) ([]byte, error) {
+	return self.marshal(CONTAINER_PRIVKEY, rowsToBytes(pri.ZeroHash, pri.OneHash))
+}
+
+// ParsePrivateKey reads a private key written by MarshalPrivateKey.
+func (self *ShakeScheme) ParsePrivateKey(data []byte) (*GenericPrivateKey, error) {
+	payload, err := self.parse(data, CONTAINER_PRIVKEY)
+	if err != nil {
+		return nil, err
+	}
+	rows := self.Params().bytesToRows(payload, 2)
+	return &GenericPrivateKey{ZeroHash: rows[0], OneHash: rows[1]}, nil
+}
+
+// MarshalSignature writes sig in a CONTAINER_SIGNATURE container with the
+// scheme's ID.
+func (self *ShakeScheme) MarshalSignature(sig *GenericSignature) ([]byte, error) {
+	return self.marshal(CONTAINER_SIGNATURE, rowsToBytes(sig.Preimage))
+}
+
+// ParseSignature reads a signature written by MarshalSignature.
+func (self *ShakeScheme) ParseSignature(data []byte) (*GenericSignature, error) {
+	payload, err := self.parse(data, CONTAINER_SIGNATURE)
+	if err != nil {
+		return nil, err
+	}
+	return &GenericSignature{Preimage: self.Params().bytesToRows(payload, 1)[0]}, nil
+}
+
+func (self *ShakeScheme) marshal(typ ContainerType, payload []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	err := writeContainerParams(&buf, typ, self.ID, payload)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (self *ShakeScheme) parse(data []byte, typ ContainerType) ([]byte, error) {
+	c, err := readContainer(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if c.Type != typ {
+		return nil, ContainerTypeError{Type: c.Type, Expect: typ}
+	}
+	if c.Params != self.ID {
+		return nil, SchemeMismatchError{Got: c.Params, Expect: self.ID}
+	}
+	return c.Payload, nil
+}
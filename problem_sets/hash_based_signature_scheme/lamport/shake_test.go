@@ -0,0 +1,180 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func testShakeScheme(t *testing.T, bits, blockBytes int) *ShakeScheme {
+	s, err := NewShakeScheme(bits, blockBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// TestShakeRoundTrip signs and verifies at block sizes 16, 20 and 32 with
+// both SHAKEs, and round trips every container, checking its length
+// follows the block size.
+func TestShakeRoundTrip(t *testing.T) {
+	for _, bits := range []int{128, 256} {
+		for _, size := range []int{16, 20, 32} {
+			s := testShakeScheme(t, bits, size)
+			pri, pub, err := s.GenerateKey(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(pub.ZeroHash[0]) != size {
+				t.Fatalf("%s: pubkey block %d bytes", s.Name, len(pub.ZeroHash[0]))
+			}
+			msg := s.Message([]byte("shake"))
+			sig, err := s.Sign(msg, pri)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := s.MarshalPublicKey(pub)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) != CONTAINER_HEADER_BYTES+2*MESSAGE_BITS*size {
+				t.Fatalf("%s: pubkey container %d bytes", s.Name, len(data))
+			}
+			pub2, err := s.ParsePublicKey(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err = s.MarshalSignature(sig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) != CONTAINER_HEADER_BYTES+MESSAGE_BITS*size {
+				t.Fatalf("%s: signature container %d bytes", s.Name, len(data))
+			}
+			sig2, err := s.ParseSignature(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err = s.MarshalPrivateKey(pri)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pri2, err := s.ParsePrivateKey(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = s.Verify(msg, pub2, sig2)
+			if err != nil {
+				t.Fatalf("%s: %v", s.Name, err)
+			}
+			sig3, err := s.Sign(msg, pri2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.Verify(msg, pub, sig3)
+			if err != nil {
+				t.Fatalf("%s: decoded private key: %v", s.Name, err)
+			}
+			msg[0] ^= 1
+			err = s.Verify(msg, pub, sig)
+			if !errors.Is(err, ErrInvalidSignature) {
+				t.Fatalf("%s: other message: got %v, expect ErrInvalidSignature",
+					s.Name, err)
+			}
+		}
+	}
+}
+
+// TestShakeCrossSize checks keys, signatures and containers from one block
+// size or SHAKE are refused by another, and by the 32 byte block schemes.
+func TestShakeCrossSize(t *testing.T) {
+	s16 := testShakeScheme(t, 256, 16)
+	s20 := testShakeScheme(t, 256, 20)
+	s20x := testShakeScheme(t, 128, 20)
+	if s16.ID == s20.ID || s20.ID == s20x.ID || SchemeByID(s20.ID) != nil {
+		t.Fatalf("params IDs aren't distinct: %d %d %d", s16.ID, s20.ID, s20x.ID)
+	}
+
+	pri, pub, err := s20.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := s20.Message([]byte("cross"))
+	sig, err := s20.Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paramsErr *ParamsError
+	err = s16.Verify(msg, pub, sig)
+	if !errors.As(err, &paramsErr) {
+		t.Fatalf("20 byte key under 16: got %v, expect *ParamsError", err)
+	}
+	_, pub16, err := s16.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s16.Verify(msg, pub16, sig)
+	if !errors.As(err, &paramsErr) {
+		t.Fatalf("20 byte signature under 16: got %v, expect *ParamsError", err)
+	}
+	// same block size, other SHAKE: the right shape, but the wrong hash
+	err = s20x.Verify(msg, pub, sig)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("shake256 signature under shake128: got %v, expect ErrInvalidSignature", err)
+	}
+
+	data, err := s20.MarshalPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mismatch SchemeMismatchError
+	for _, other := range []*ShakeScheme{s16, s20x} {
+		_, err = other.ParsePublicKey(data)
+		if !errors.As(err, &mismatch) {
+			t.Fatalf("%s reading %s: got %v, expect SchemeMismatchError",
+				other.Name, s20.Name, err)
+		}
+	}
+	if mismatch.Error() != "container written with shake256/20, expect shake128/20" {
+		t.Fatalf("mismatch error says %q", mismatch.Error())
+	}
+	_, err = SCHEME_SHA256.ParsePublicKey(data)
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("sha256 reading %s: got %v, expect SchemeMismatchError", s20.Name, err)
+	}
+	_, err = ReadContainer(bytes.NewReader(data))
+	var containerParams ContainerParamsError
+	if !errors.As(err, &containerParams) {
+		t.Fatalf("ReadContainer: got %v, expect ContainerParamsError", err)
+	}
+
+	// a 20 byte payload cut short, and a type SHAKE has no size for
+	_, err = s20.ParsePublicKey(data[:len(data)-1])
+	if !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("short pubkey: got %v, expect ErrWrongLength", err)
+	}
+	_, err = s20.marshal(CONTAINER_SALTED, make([]byte, SALTED_SIGNATURE_BYTES))
+	if !errors.As(err, &containerParams) {
+		t.Fatalf("salted under shake: got %v, expect ContainerParamsError", err)
+	}
+}
+
+// TestShakeSchemeParams checks the bounds on block size and SHAKE, and
+// that IDs name their schemes.
+func TestShakeSchemeParams(t *testing.T) {
+	for _, bad := range [][2]int{{256, 15}, {256, 65}, {512, 32}, {0, 20}} {
+		if _, err := NewShakeScheme(bad[0], bad[1]); err == nil {
+			t.Fatalf("NewShakeScheme(%d, %d) returned nil, expected an error",
+				bad[0], bad[1])
+		}
+	}
+	s := testShakeScheme(t, 128, 20)
+	if by := ShakeSchemeByID(s.ID); by == nil || by.Name != "shake128/20" {
+		t.Fatalf("ShakeSchemeByID(%#x) returned %v", s.ID, by)
+	}
+	if ShakeSchemeByID(PARAMS_SHA256) != nil || ShakeSchemeByID(PARAMS_SHAKE128|8) != nil {
+		t.Fatalf("ShakeSchemeByID found a scheme for a non-SHAKE ID")
+	}
+}
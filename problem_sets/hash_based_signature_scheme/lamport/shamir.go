@@ -0,0 +1,270 @@
+package lamport
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+Shamir secret sharing over GF(256), one byte of the secret at a time: each
+byte is the constant term of a random polynomial of degree k-1, and share x
+holds the polynomials' values at x, for x from 1 to n.  Any k shares pin
+the polynomials down and give back the secret; k-1 of them fit every
+possible secret equally well, so they say nothing about it.
+
+GF(256) is the AES field, polynomials mod x^8 + x^4 + x^3 + x + 1.
+
+A share encodes as
+
+    id         8 bytes, random, the same for every share of one split
+    threshold  1 byte
+    x          1 byte
+    y          the length of the secret
+    checksum   4 bytes, the first 4 of sha256 of everything before it
+
+The checksum catches a share that's been damaged on its own; the id catches
+shares from different splits being mixed.  With more than k shares
+CombineShares also checks the extra ones agree with the rest.
+*/
+
+const (
+	SHARE_ID_BYTES       = 8
+	SHARE_CHECKSUM_BYTES = 4
+	SHARE_OVERHEAD_BYTES = SHARE_ID_BYTES + 2 + SHARE_CHECKSUM_BYTES
+)
+
+var (
+	// Fewer shares than the threshold they were split with.
+	ErrNotEnoughShares = errors.New("shamir: not enough shares")
+	// Shares that don't belong to the same split, or don't agree on the
+	// secret.
+	ErrInconsistentShares = errors.New("shamir: shares are inconsistent")
+	// A share whose checksum doesn't match.
+	ErrShareChecksum = errors.New("shamir: share checksum mismatch")
+)
+
+// Share is one share of a secret split by SplitSecret.
+type Share struct {
+	ID        [SHARE_ID_BYTES]byte
+	Threshold int
+	X         byte
+	Y         []byte
+	Checksum  [SHARE_CHECKSUM_BYTES]byte
+}
+
+// gf256Exp and gf256Log are powers and logs of the generator 3.
+var gf256Exp, gf256Log = gf256Tables()
+
+func gf256Tables() (exp [510]byte, log [256]byte) {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i] = x
+		exp[i+255] = x
+		log[x] = byte(i)
+		// x *= 3, that is x ^ x*2
+		double := x << 1
+		if x&0x80 != 0 {
+			double ^= 0x1b
+		}
+		x ^= double
+	}
+	return exp, log
+}
+
+func gf256Mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gf256Exp[int(gf256Log[a])+int(gf256Log[b])]
+}
+
+func gf256Div(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gf256Exp[int(gf256Log[a])+255-int(gf256Log[b])]
+}
+
+// SplitSecret splits secret into n shares, any k of which recover it.  It
+// needs 1 <= k <= n <= 255.
+func SplitSecret(secret []byte, k, n int) ([]Share, error) {
+	return SplitSecretFrom(rand.Reader, secret, k, n)
+}
+
+// SplitSecretFrom is SplitSecret reading the id and the polynomials from r.
+func SplitSecretFrom(r io.Reader, secret []byte, k, n int) ([]Share, error) {
+	if k < 1 || k > n || n > 255 {
+		return nil, fmt.Errorf("shamir: %d of %d shares, need 1 <= k <= n <= 255", k, n)
+	}
+	if len(secret) == 0 {
+		return nil, errors.New("shamir: empty secret")
+	}
+	var id [SHARE_ID_BYTES]byte
+	coeffs := make([]byte, len(secret)*(k-1))
+	_, err := io.ReadFull(r, id[:])
+	if err == nil {
+		_, err = io.ReadFull(r, coeffs)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading shamir randomness: %w", err)
+	}
+	shares := make([]Share, n)
+	for i := range shares {
+		x := byte(i + 1)
+		share := Share{ID: id, Threshold: k, X: x, Y: make([]byte, len(secret))}
+		for j, s := range secret {
+			// Horner's rule, highest coefficient first
+			y := byte(0)
+			for c := k - 2; c >= 0; c-- {
+				y = gf256Mul(y, x) ^ coeffs[j*(k-1)+c]
+			}
+			share.Y[j] = gf256Mul(y, x) ^ s
+		}
+		share.Checksum = share.sum()
+		shares[i] = share
+	}
+	for i := range coeffs {
+		coeffs[i] = 0
+	}
+	return shares, nil
+}
+
+// sum computes the share's checksum.
+func (self Share) sum() [SHARE_CHECKSUM_BYTES]byte {
+	b := self.Bytes()
+	h := sha256.Sum256(b[:len(b)-SHARE_CHECKSUM_BYTES])
+	var sum [SHARE_CHECKSUM_BYTES]byte
+	copy(sum[:], h[:])
+	return sum
+}
+
+// Check reports whether the share's checksum matches, as ErrShareChecksum
+// if it doesn't.
+func (self Share) Check() error {
+	if self.sum() != self.Checksum {
+		return fmt.Errorf("%w: share %d", ErrShareChecksum, self.X)
+	}
+	return nil
+}
+
+// Bytes returns the share's encoding.
+func (self Share) Bytes() []byte {
+	b := make([]byte, 0, SHARE_OVERHEAD_BYTES+len(self.Y))
+	b = append(b, self.ID[:]...)
+	b = append(b, byte(self.Threshold), self.X)
+	b = append(b, self.Y...)
+	return append(b, self.Checksum[:]...)
+}
+
+// ShareFromBytes decodes a share from Bytes and checks its checksum.
+func ShareFromBytes(b []byte) (Share, error) {
+	if len(b) <= SHARE_OVERHEAD_BYTES {
+		return Share{}, fmt.Errorf("%w: share of %d bytes, expect more than %d",
+			ErrWrongLength, len(b), SHARE_OVERHEAD_BYTES)
+	}
+	var share Share
+	copy(share.ID[:], b)
+	share.Threshold = int(b[SHARE_ID_BYTES])
+	share.X = b[SHARE_ID_BYTES+1]
+	share.Y = append([]byte(nil), b[SHARE_ID_BYTES+2:len(b)-SHARE_CHECKSUM_BYTES]...)
+	copy(share.Checksum[:], b[len(b)-SHARE_CHECKSUM_BYTES:])
+	err := share.Check()
+	if err != nil {
+		return Share{}, err
+	}
+	return share, nil
+}
+
+// CombineShares recovers the secret from at least Threshold shares of one
+// split.  A damaged share is ErrShareChecksum; too few distinct shares is
+// ErrNotEnoughShares; shares from different splits, or extra shares that
+// don't agree with the first Threshold, are ErrInconsistentShares.
+func CombineShares(shares []Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, fmt.Errorf("%w: none given", ErrNotEnoughShares)
+	}
+	first := shares[0]
+	var distinct []Share
+	seen := make(map[byte]int)
+	for _, share := range shares {
+		err := share.Check()
+		if err != nil {
+			return nil, err
+		}
+		if share.ID != first.ID || share.Threshold != first.Threshold ||
+			len(share.Y) != len(first.Y) {
+			return nil, fmt.Errorf("%w: share %d is from another split",
+				ErrInconsistentShares, share.X)
+		}
+		if share.X == 0 || share.Threshold < 1 {
+			return nil, fmt.Errorf("%w: share %d of threshold %d",
+				ErrInconsistentShares, share.X, share.Threshold)
+		}
+		if i, ok := seen[share.X]; ok {
+			if string(distinct[i].Y) != string(share.Y) {
+				return nil, fmt.Errorf("%w: two different shares %d",
+					ErrInconsistentShares, share.X)
+			}
+			continue
+		}
+		seen[share.X] = len(distinct)
+		distinct = append(distinct, share)
+	}
+	k := first.Threshold
+	if len(distinct) < k {
+		return nil, fmt.Errorf("%w: %d of %d", ErrNotEnoughShares, len(distinct), k)
+	}
+
+	secret := interpolate(distinct[:k], 0)
+	for _, extra := range distinct[k:] {
+		if string(interpolate(distinct[:k], extra.X)) != string(extra.Y) {
+			return nil, fmt.Errorf("%w: share %d doesn't fit the others",
+				ErrInconsistentShares, extra.X)
+		}
+	}
+	return secret, nil
+}
+
+// interpolate evaluates at x the polynomials through shares, by Lagrange.
+func interpolate(shares []Share, x byte) []byte {
+	out := make([]byte, len(shares[0].Y))
+	for i, si := range shares {
+		// basis polynomial i at x: product of (x - xj) / (xi - xj)
+		basis := byte(1)
+		for j, sj := range shares {
+			if j != i {
+				basis = gf256Mul(basis, gf256Div(x^sj.X, si.X^sj.X))
+			}
+		}
+		for b := range out {
+			out[b] ^= gf256Mul(basis, si.Y[b])
+		}
+	}
+	return out
+}
+
+// SplitPrivateKeySeed splits a seed key's seed into n shares, any k of which
+// recover it with RecoverPrivateKey.  A raw PrivateKey can be split with
+// SplitSecret(pri.Bytes(), k, n), at 16KB a share.
+func SplitPrivateKeySeed(pri SeedPrivateKey, k, n int) ([]Share, error) {
+	return SplitSecret(pri.Seed[:], k, n)
+}
+
+// RecoverPrivateKey combines shares from SplitPrivateKeySeed back into the
+// seed key.
+func RecoverPrivateKey(shares []Share) (SeedPrivateKey, error) {
+	secret, err := CombineShares(shares)
+	if err != nil {
+		return SeedPrivateKey{}, err
+	}
+	if len(secret) != SEED_BYTES {
+		return SeedPrivateKey{}, fmt.Errorf("%w: %d byte secret, expect a %d byte seed",
+			ErrWrongLength, len(secret), SEED_BYTES)
+	}
+	var pri SeedPrivateKey
+	copy(pri.Seed[:], secret)
+	return pri, nil
+}
@@ -0,0 +1,228 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// TestGF256 checks multiplication against the shift and add definition, and
+// that division undoes it.
+func TestGF256(t *testing.T) {
+	slow := func(a, b byte) byte {
+		var p byte
+		for ; b != 0; b >>= 1 {
+			if b&1 != 0 {
+				p ^= a
+			}
+			carry := a & 0x80
+			a <<= 1
+			if carry != 0 {
+				a ^= 0x1b
+			}
+		}
+		return p
+	}
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			p := gf256Mul(byte(a), byte(b))
+			if p != slow(byte(a), byte(b)) {
+				t.Fatalf("%d * %d = %d, expect %d", a, b, p, slow(byte(a), byte(b)))
+			}
+			if b != 0 && gf256Div(p, byte(b)) != byte(a) {
+				t.Fatalf("%d * %d / %d != %d", a, b, b, a)
+			}
+		}
+	}
+}
+
+// subsets calls f with every subset of shares of size k.
+func subsets(shares []Share, k int, f func([]Share)) {
+	var pick func(start int, chosen []Share)
+	pick = func(start int, chosen []Share) {
+		if len(chosen) == k {
+			f(append([]Share(nil), chosen...))
+			return
+		}
+		for i := start; i < len(shares); i++ {
+			pick(i+1, append(chosen, shares[i]))
+		}
+	}
+	pick(0, nil)
+}
+
+// TestShamirAllSubsets splits with every k and n up to 5 and checks every
+// set of k shares recovers the secret and every set of k-1 doesn't.
+func TestShamirAllSubsets(t *testing.T) {
+	secret := []byte("the seed of an important key....")
+	for n := 1; n <= 5; n++ {
+		for k := 1; k <= n; k++ {
+			shares, err := SplitSecret(secret, k, n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(shares) != n {
+				t.Fatalf("%d of %d: got %d shares", k, n, len(shares))
+			}
+			for size := k; size <= n; size++ {
+				subsets(shares, size, func(some []Share) {
+					got, err := CombineShares(some)
+					if err != nil {
+						t.Fatalf("%d of %d with %d: %v", k, n, size, err)
+					}
+					if !bytes.Equal(got, secret) {
+						t.Fatalf("%d of %d with %d: got %q", k, n, size, got)
+					}
+				})
+			}
+			subsets(shares, k-1, func(some []Share) {
+				_, err := CombineShares(some)
+				if !errors.Is(err, ErrNotEnoughShares) {
+					t.Fatalf("%d of %d with %d: got %v, expect ErrNotEnoughShares",
+						k, n, k-1, err)
+				}
+			})
+		}
+	}
+}
+
+// TestShamirNoInformation checks a single share of a 2 of 3 split looks the
+// same whatever the secret is: over many splits each share byte value turns
+// up about equally often for an all zero secret and an all 0xff one.
+func TestShamirNoInformation(t *testing.T) {
+	r := rand.New(rand.NewSource(88))
+	const splits = 256
+	for _, fill := range []byte{0x00, 0xff} {
+		var counts [256]int
+		for i := 0; i < splits; i++ {
+			shares, err := SplitSecretFrom(r, bytes.Repeat([]byte{fill}, 64), 2, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, b := range shares[1].Y {
+				counts[b]++
+			}
+		}
+		// 64 per value expected; chi-squared with 255 degrees of freedom
+		chi := 0.0
+		for _, c := range counts {
+			chi += float64((c-64)*(c-64)) / 64
+		}
+		if chi > 350 {
+			t.Fatalf("secret of %#02x: share bytes far from uniform, chi-squared %.0f",
+				fill, chi)
+		}
+	}
+}
+
+// TestShamirCorrupted checks damaged and mixed up shares are refused rather
+// than combined into the wrong secret.
+func TestShamirCorrupted(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	shares, err := SplitSecret(secret, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bad := append([]Share(nil), shares...)
+	bad[1].Y = append([]byte(nil), bad[1].Y...)
+	bad[1].Y[7] ^= 0x01
+	_, err = CombineShares(bad[:3])
+	if !errors.Is(err, ErrShareChecksum) {
+		t.Fatalf("got %v, expect ErrShareChecksum", err)
+	}
+
+	// a damaged extra share with a fixed up checksum still doesn't fit
+	bad[1].Checksum = bad[1].sum()
+	_, err = CombineShares([]Share{shares[0], shares[2], shares[3], bad[1]})
+	if !errors.Is(err, ErrInconsistentShares) {
+		t.Fatalf("got %v, expect ErrInconsistentShares", err)
+	}
+
+	other, err := SplitSecret(secret, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = CombineShares([]Share{shares[0], shares[1], other[2]})
+	if !errors.Is(err, ErrInconsistentShares) {
+		t.Fatalf("got %v, expect ErrInconsistentShares", err)
+	}
+
+	// the same share twice only counts once
+	_, err = CombineShares([]Share{shares[0], shares[1], shares[1]})
+	if !errors.Is(err, ErrNotEnoughShares) {
+		t.Fatalf("got %v, expect ErrNotEnoughShares", err)
+	}
+	if _, err := CombineShares(nil); !errors.Is(err, ErrNotEnoughShares) {
+		t.Fatalf("got %v, expect ErrNotEnoughShares", err)
+	}
+
+	for _, kn := range [][2]int{{0, 3}, {4, 3}, {2, 256}} {
+		if _, err := SplitSecret(secret, kn[0], kn[1]); err == nil {
+			t.Fatalf("SplitSecret accepted %d of %d", kn[0], kn[1])
+		}
+	}
+}
+
+// TestShareBytes round trips shares through their encoding and checks a
+// flipped bit anywhere is caught.
+func TestShareBytes(t *testing.T) {
+	shares, err := SplitSecret([]byte("secret"), 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := shares[0].Bytes()
+	if len(b) != SHARE_OVERHEAD_BYTES+6 {
+		t.Fatalf("got %d bytes, expect %d", len(b), SHARE_OVERHEAD_BYTES+6)
+	}
+	share, err := ShareFromBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := CombineShares([]Share{share, shares[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "secret" {
+		t.Fatalf("got %q, expect \"secret\"", got)
+	}
+	for i := range b {
+		flipped := append([]byte(nil), b...)
+		flipped[i] ^= 0x10
+		if _, err := ShareFromBytes(flipped); !errors.Is(err, ErrShareChecksum) {
+			t.Fatalf("byte %d flipped: got %v, expect ErrShareChecksum", i, err)
+		}
+	}
+	if _, err := ShareFromBytes(b[:SHARE_OVERHEAD_BYTES]); !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+}
+
+// TestSplitPrivateKeySeed recovers a seed key from 2 of 3 shares and signs
+// with it.
+func TestSplitPrivateKeySeed(t *testing.T) {
+	pri, pub, err := GenerateSeedKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shares, err := SplitPrivateKeySeed(pri, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := RecoverPrivateKey([]Share{shares[2], shares[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("recovered")
+	if !Verify(msg, pub, got.Sign(msg)) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	short, err := SplitSecret([]byte("short"), 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RecoverPrivateKey(short); !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+}
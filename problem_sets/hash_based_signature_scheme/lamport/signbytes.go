@@ -0,0 +1,28 @@
+package lamport
+
+import (
+	"crypto/sha256"
+)
+
+// SignBytes hashes data with sha256 and signs the hash.  Sign itself takes a
+// Message, which has to be a digest already; passing it raw bytes copied into
+// a Message signs only their first 32 bytes, so use this instead.
+func SignBytes(data []byte, pri PrivateKey) (Signature, error) {
+	return Sign(sha256.Sum256(data), pri)
+}
+
+// VerifyBytes checks sig on the sha256 hash of data.
+func VerifyBytes(data []byte, pub PublicKey, sig Signature) bool {
+	return Verify(sha256.Sum256(data), pub, sig)
+}
+
+// SignString is SignBytes on s.  It's the same as
+// Sign(GetMessageFromString(s), pri).
+func SignString(s string, pri PrivateKey) (Signature, error) {
+	return SignBytes([]byte(s), pri)
+}
+
+// VerifyString is VerifyBytes on s.
+func VerifyString(s string, pub PublicKey, sig Signature) bool {
+	return VerifyBytes([]byte(s), pub, sig)
+}
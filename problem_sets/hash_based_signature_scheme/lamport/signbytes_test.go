@@ -0,0 +1,53 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// TestSignBytes checks SignBytes and SignString match signing the message
+// hash directly, and that the Verify versions agree.
+func TestSignBytes(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := mustSign(t, GetMessageFromString("test"), pri)
+
+	sig, err := SignBytes([]byte("test"), pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig != expected {
+		t.Fatalf("SignBytes differs from Sign on the hash")
+	}
+	sig, err = SignString("test", pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig != expected {
+		t.Fatalf("SignString differs from Sign on the hash")
+	}
+
+	if !VerifyBytes([]byte("test"), pub, sig) {
+		t.Fatalf("VerifyBytes returned false, expected true")
+	}
+	if !VerifyString("test", pub, sig) {
+		t.Fatalf("VerifyString returned false, expected true")
+	}
+	if VerifyString("tesT", pub, sig) {
+		t.Fatalf("VerifyString returned true for a different string")
+	}
+
+	// the provided signatures are on the hashes of "1" through "4"
+	pub1, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig1, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyString("1", pub1, sig1) {
+		t.Fatalf("VerifyString returned false on hexSignature1, expected true")
+	}
+}
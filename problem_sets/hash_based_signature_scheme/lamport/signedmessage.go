@@ -0,0 +1,88 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// SignedMessage bundles a message with its signature and the fingerprint of
+// the key that signed it, so the three don't get mixed up in transit.  The
+// message is kept as the original bytes; the signed Message is its sha256.
+type SignedMessage struct {
+	Payload     []byte
+	Signature   Signature
+	Fingerprint Fingerprint
+}
+
+// Seal signs the sha256 of msg with pri and returns the bundle.  pub must be
+// the pubkey for pri; it's only used for the fingerprint.
+func Seal(msg []byte, pri PrivateKey, pub PublicKey) (SignedMessage, error) {
+	sig, err := Sign(sha256.Sum256(msg), pri)
+	if err != nil {
+		return SignedMessage{}, err
+	}
+	return SignedMessage{
+		Payload:     append([]byte{}, msg...),
+		Signature:   sig,
+		Fingerprint: pub.Fingerprint(),
+	}, nil
+}
+
+// Open checks that sm was signed by pub and returns the payload.  A
+// fingerprint that doesn't match pub is ErrWrongKey, before any signature
+// checking happens, and a signature that doesn't verify is
+// ErrInvalidSignature.
+func Open(sm SignedMessage, pub PublicKey) ([]byte, error) {
+	if sm.Fingerprint != pub.Fingerprint() {
+		return nil, fmt.Errorf("%w: bundle %s, pubkey %s",
+			ErrWrongKey, sm.Fingerprint.Short(), pub.Fingerprint().Short())
+	}
+	if !Verify(sha256.Sum256(sm.Payload), pub, sm.Signature) {
+		return nil, ErrInvalidSignature
+	}
+	return sm.Payload, nil
+}
+
+// MarshalBinary encodes the bundle as a CONTAINER_SIGNED container, with a
+// payload of fingerprint (32 bytes), signature (SIGNATURE_BYTES), then the
+// message bytes to the end.
+func (self SignedMessage) MarshalBinary() ([]byte, error) {
+	payload := make([]byte, 0, len(self.Fingerprint)+SIGNATURE_BYTES+len(self.Payload))
+	payload = append(payload, self.Fingerprint[:]...)
+	payload = append(payload, self.Signature.Bytes()...)
+	payload = append(payload, self.Payload...)
+
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_SIGNED, payload)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a bundle from MarshalBinary.
+func (self *SignedMessage) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_SIGNED {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_SIGNED}
+	}
+	header := len(self.Fingerprint) + SIGNATURE_BYTES
+	if len(c.Payload) < header {
+		return ContainerLengthError{
+			Type: c.Type, Length: len(c.Payload), Expect: header}
+	}
+
+	var sm SignedMessage
+	copy(sm.Fingerprint[:], c.Payload)
+	sm.Signature, err = SignatureFromBytes(c.Payload[len(sm.Fingerprint):header])
+	if err != nil {
+		return err
+	}
+	sm.Payload = append([]byte{}, c.Payload[header:]...)
+	*self = sm
+	return nil
+}
@@ -0,0 +1,87 @@
+package lamport
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestSealOpen seals a message, serializes it, and opens it again.
+func TestSealOpen(t *testing.T) {
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("meet at the usual place")
+
+	sm, err := Seal(msg, sec, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := sm.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sm2 SignedMessage
+	err = sm2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Open(sm2, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, msg) {
+		t.Fatalf("opened %q, expect %q", out, msg)
+	}
+
+	// empty messages are fine too
+	sm, err = Seal(nil, sec, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Open(sm, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestOpenErrors checks the wrong key and tampered payloads are refused.
+func TestOpenErrors(t *testing.T) {
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, pub2, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sm, err := Seal([]byte("hello"), sec, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Open(sm, pub2)
+	if !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("got %v, expect ErrWrongKey", err)
+	}
+
+	sm.Payload[0] = 'j'
+	_, err = Open(sm, pub)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+
+	// truncated inside the signature
+	data, err := sm.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sm2 SignedMessage
+	err = sm2.UnmarshalBinary(data[:CONTAINER_HEADER_BYTES+100])
+	var lengthErr ContainerLengthError
+	if !errors.As(err, &lengthErr) {
+		t.Fatalf("got %v, expect ContainerLengthError", err)
+	}
+}
@@ -0,0 +1,131 @@
+package lamport
+
+import (
+	"sync"
+)
+
+// Signer holds a private key and signs at most one message with it, which is
+// how a Lamport key is supposed to be used; assignment.Forge shows what
+// happens otherwise.  It's safe to call from several goroutines, and only
+// one Sign can ever succeed.
+//
+// On its own Signer keeps its state in memory only.  To carry it across
+// restarts, set Store, or save UsedFor (or just Used) somewhere and call
+// MarkUsedFor or MarkUsed on the new Signer before signing.
+//
+// A Signer from NewEvolvingSigner has a key per period instead, and Evolve
+// moves it from one to the next; see SignPeriod.
+type Signer struct {
+	// AllowResign lets Sign be called again with the same message it
+	// already signed.  That reveals nothing new, since the signature is the
+	// same, but it's off by default.  Set it before the first Sign.
+	AllowResign bool
+
+	// WipeUnused zeroizes the half of the private key that the first
+	// signature didn't reveal, since a one-time key never needs it again.
+	// Re-signing the same message still works afterwards.
+	WipeUnused bool
+
+	// Store, if set, is told about the first signature before Sign returns
+	// it, and a key it already has a record for counts as used.  Set it
+	// before the first Sign.
+	Store StateStore
+
+	mu       sync.Mutex
+	pri      PrivateKey
+	used     bool
+	msgKnown bool
+	msg      Message
+	evolve   *evolution
+}
+
+// NewSigner returns an unused Signer for pri.
+func NewSigner(pri PrivateKey) *Signer {
+	return &Signer{pri: pri}
+}
+
+// Sign signs msg, unless the key has already been used, in which case it
+// returns ErrKeyAlreadyUsed.  With AllowResign, signing the exact message
+// signed before is allowed.  A key that's been wiped is ErrZeroKey.
+func (self *Signer) Sign(msg Message) (Signature, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.sign(msg)
+}
+
+// sign is Sign with the lock held.
+func (self *Signer) sign(msg Message) (Signature, error) {
+	if !self.used && self.Store != nil && !self.pri.IsZero() {
+		fp := self.pri.GetPublicKey().Fingerprint()
+		if recorded, ok := self.Store.Lookup(fp); ok {
+			self.used = true
+			self.msgKnown = true
+			self.msg = recorded
+		} else if err := self.Store.Record(fp, msg); err != nil {
+			return Signature{}, err
+		}
+	}
+	if self.used {
+		if !(self.AllowResign && self.msgKnown && self.msg == msg) {
+			return Signature{}, ErrKeyAlreadyUsed
+		}
+	}
+	sig, err := SignChecked(msg, &self.pri)
+	if err != nil {
+		return Signature{}, err
+	}
+	self.used = true
+	self.msgKnown = true
+	self.msg = msg
+	if self.WipeUnused {
+		zeroizeUnrevealed(&self.pri, msg)
+	}
+	return sig, nil
+}
+
+// Zeroize wipes the Signer's copy of the private key, and an evolving
+// Signer's seed, and marks it used.
+func (self *Signer) Zeroize() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.pri.Zeroize()
+	if self.evolve != nil {
+		self.evolve.seed.Zeroize()
+	}
+	self.used = true
+}
+
+// Used reports whether the key has signed anything or been marked used.
+func (self *Signer) Used() bool {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.used
+}
+
+// UsedFor returns the message the key signed.  ok is false if it hasn't
+// signed anything, or was marked used with MarkUsed and no message.
+func (self *Signer) UsedFor() (msg Message, ok bool) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.msg, self.msgKnown
+}
+
+// MarkUsed marks the key used without saying what it signed, so no further
+// Sign will succeed, even with AllowResign.
+func (self *Signer) MarkUsed() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.used = true
+	self.msgKnown = false
+	self.msg = Message{}
+}
+
+// MarkUsedFor marks the key as having signed msg, as if Sign(msg) had been
+// called.
+func (self *Signer) MarkUsedFor(msg Message) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.used = true
+	self.msgKnown = true
+	self.msg = msg
+}
@@ -0,0 +1,122 @@
+package lamport
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestSignerOnce signs once, then checks a second message is refused.
+func TestSignerOnce(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSigner(pri)
+	if s.Used() {
+		t.Fatalf("new Signer already used")
+	}
+
+	msg := GetMessageFromString("once")
+	sig, err := s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	if !s.Used() {
+		t.Fatalf("Signer not used after Sign")
+	}
+	got, ok := s.UsedFor()
+	if !ok || got != msg {
+		t.Fatalf("UsedFor doesn't return the signed message")
+	}
+
+	_, err = s.Sign(GetMessageFromString("twice"))
+	if err != ErrKeyAlreadyUsed {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+	_, err = s.Sign(msg)
+	if err != ErrKeyAlreadyUsed {
+		t.Fatalf("re-signed without AllowResign: got %v", err)
+	}
+}
+
+// TestSignerResign allows re-signing the same message, and checks state
+// restored with MarkUsed and MarkUsedFor.
+func TestSignerResign(t *testing.T) {
+	pri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("again")
+
+	s := NewSigner(pri)
+	s.AllowResign = true
+	sig1, err := s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig2, err := s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig1 != sig2 {
+		t.Fatalf("re-signed signature differs")
+	}
+	_, err = s.Sign(GetMessageFromString("different"))
+	if err != ErrKeyAlreadyUsed {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+
+	restored := NewSigner(pri)
+	restored.AllowResign = true
+	restored.MarkUsedFor(msg)
+	_, err = restored.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored.MarkUsed()
+	_, err = restored.Sign(msg)
+	if err != ErrKeyAlreadyUsed {
+		t.Fatalf("signed after MarkUsed: got %v", err)
+	}
+	if _, ok := restored.UsedFor(); ok {
+		t.Fatalf("UsedFor still knows the message after MarkUsed")
+	}
+}
+
+// TestSignerConcurrent races many goroutines to sign different messages;
+// exactly one should win.  Run with -race.
+func TestSignerConcurrent(t *testing.T) {
+	pri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSigner(pri)
+
+	const n = 32
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.Sign(GetMessageFromString(string(rune('a' + i))))
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else if err != ErrKeyAlreadyUsed {
+			t.Fatal(err)
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("%d concurrent signs succeeded, expect 1", succeeded)
+	}
+}
@@ -0,0 +1,44 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"io"
+)
+
+// SignReader hashes everything read from r with sha256, without holding it
+// all in memory, and signs the hash.  It returns the hash as well so the
+// caller can record what was signed.  An error from r is returned as is,
+// with no signature.
+func SignReader(r io.Reader, pri PrivateKey) (Signature, Message, error) {
+	msg, err := hashReader(r)
+	if err != nil {
+		return Signature{}, Message{}, err
+	}
+	sig, err := Sign(msg, pri)
+	if err != nil {
+		return Signature{}, Message{}, err
+	}
+	return sig, msg, nil
+}
+
+// VerifyReader checks sig on the sha256 hash of everything read from r.  An
+// error from r means no answer, so it's returned with false.
+func VerifyReader(r io.Reader, pub PublicKey, sig Signature) (bool, error) {
+	msg, err := hashReader(r)
+	if err != nil {
+		return false, err
+	}
+	return Verify(msg, pub, sig), nil
+}
+
+// hashReader is the sha256 of r read to EOF.
+func hashReader(r io.Reader) (Message, error) {
+	h := sha256.New()
+	_, err := io.Copy(h, r)
+	if err != nil {
+		return Message{}, err
+	}
+	var msg Message
+	h.Sum(msg[:0])
+	return msg, nil
+}
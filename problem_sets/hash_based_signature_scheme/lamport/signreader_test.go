@@ -0,0 +1,94 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"testing"
+)
+
+// zeroReader is an endless stream of zero bytes, like /dev/zero.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// brokenReader returns n zero bytes and then an error.
+type brokenReader struct {
+	n int
+}
+
+var errBrokenReader = errors.New("read failed")
+
+func (self *brokenReader) Read(p []byte) (int, error) {
+	if self.n == 0 {
+		return 0, errBrokenReader
+	}
+	if len(p) > self.n {
+		p = p[:self.n]
+	}
+	self.n -= len(p)
+	return zeroReader{}.Read(p)
+}
+
+// TestSignReader signs 16MB of zeros streamed through a LimitReader and
+// checks the hash and signature.
+func TestSignReader(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const size = 16 << 20
+
+	sig, msg, err := SignReader(io.LimitReader(zeroReader{}, size), pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg != sha256.Sum256(make([]byte, size)) {
+		t.Fatalf("SignReader hash isn't the sha256 of the input")
+	}
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+
+	ok, err := VerifyReader(io.LimitReader(zeroReader{}, size), pub, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatalf("VerifyReader returned false, expected true")
+	}
+	ok, err = VerifyReader(io.LimitReader(zeroReader{}, size-1), pub, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatalf("VerifyReader returned true for a shorter input")
+	}
+}
+
+// TestSignReaderError checks a reader failing part way through is an error
+// and not a signature on what was read so far.
+func TestSignReaderError(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = SignReader(&brokenReader{n: 100000}, pri)
+	if err != errBrokenReader {
+		t.Fatalf("got %v, expect the reader's error", err)
+	}
+
+	sig, err := SignBytes(make([]byte, 100000), pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := VerifyReader(&brokenReader{n: 100000}, pub, sig)
+	if err != errBrokenReader || ok {
+		t.Fatalf("got %v %v, expect false and the reader's error", ok, err)
+	}
+}
@@ -0,0 +1,105 @@
+package lamport
+
+import (
+	"math"
+	"math/bits"
+)
+
+// SignatureSet is a collection of signatures on different messages, all
+// checked against one pubkey.  It's for studying how quickly a one-time key
+// falls apart as it's reused: every Add reveals more of the private key, and
+// the methods here report how much is left to guess.
+type SignatureSet struct {
+	msgs []Message
+	sigs []Signature
+	rk   RevealedKey
+}
+
+// BitGap is a private key block no signature in a SignatureSet has
+// revealed: row Missing (0 or 1) of bit Index.
+type BitGap struct {
+	Index   int
+	Missing uint8
+}
+
+// NewSignatureSet returns an empty set for signatures by pub.
+func NewSignatureSet(pub PublicKey) *SignatureSet {
+	return &SignatureSet{rk: RevealedKey{pub: pub}}
+}
+
+// Add puts sig on msg in the set.  A signature that doesn't verify with the
+// set's pubkey is refused with ErrInvalidSignature.
+func (self *SignatureSet) Add(msg Message, sig Signature) error {
+	if err := self.rk.Add(self.rk.pub, msg, sig); err != nil {
+		return err
+	}
+	self.msgs = append(self.msgs, msg)
+	self.sigs = append(self.sigs, sig)
+	return nil
+}
+
+// Len returns the number of signatures in the set.
+func (self *SignatureSet) Len() int {
+	return len(self.sigs)
+}
+
+// At returns the i'th message and signature added.
+func (self *SignatureSet) At(i int) (Message, Signature) {
+	return self.msgs[i], self.sigs[i]
+}
+
+// PublicKey returns the pubkey the set's signatures are checked against.
+func (self *SignatureSet) PublicKey() PublicKey {
+	return self.rk.pub
+}
+
+// Revealed returns the private key blocks the set has given away.
+func (self *SignatureSet) Revealed() *RevealedKey {
+	return &self.rk
+}
+
+// CoverageFraction returns the fraction of the 512 private key blocks
+// revealed so far.  One signature reveals half; it can't reach 1 without
+// signing a message and its complement.
+func (self *SignatureSet) CoverageFraction() float64 {
+	zero, one := self.rk.Coverage()
+	known := 0
+	for i := range zero {
+		known += bits.OnesCount8(zero[i]) + bits.OnesCount8(one[i])
+	}
+	return float64(known) / (2 * MESSAGE_BITS)
+}
+
+// UncoveredPositions lists the blocks not yet revealed, in bit order with
+// row 0 before row 1.
+func (self *SignatureSet) UncoveredPositions() []BitGap {
+	zero, one := self.rk.Coverage()
+	var gaps []BitGap
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if zero.Bit(i) == 0 {
+			gaps = append(gaps, BitGap{Index: i, Missing: 0})
+		}
+		if one.Bit(i) == 0 {
+			gaps = append(gaps, BitGap{Index: i, Missing: 1})
+		}
+	}
+	return gaps
+}
+
+// Difficulty returns the number of positions with only one row revealed;
+// see RevealedKey.Difficulty.
+func (self *SignatureSet) Difficulty() int {
+	return self.rk.Difficulty()
+}
+
+// ExpectedForgeAttempts returns how many random messages a forger should
+// expect to hash before finding one the set lets them sign.  Each bit with
+// only one row revealed has to come out the right way, so that's 2 to the
+// power of their number; a bit with neither row revealed can never be
+// signed, which makes it +Inf.  With an empty set it's +Inf too.
+func (self *SignatureSet) ExpectedForgeAttempts() float64 {
+	if len(self.rk.MissingBits()) > 0 {
+		return math.Inf(1)
+	}
+	return math.Ldexp(1, self.rk.Difficulty())
+}
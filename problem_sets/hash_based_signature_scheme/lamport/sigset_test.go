@@ -0,0 +1,97 @@
+package lamport
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestSignatureSetProvided checks the numbers for the provided signatures,
+// one at a time, ending at the 1<<31 that Forge prints.
+func TestSignatureSetProvided(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := NewSignatureSet(pub)
+	if !math.IsInf(set.ExpectedForgeAttempts(), 1) {
+		t.Fatalf("got %v attempts for an empty set, expect +Inf",
+			set.ExpectedForgeAttempts())
+	}
+	if len(set.UncoveredPositions()) != 2*MESSAGE_BITS {
+		t.Fatalf("got %d gaps for an empty set, expect %d",
+			len(set.UncoveredPositions()), 2*MESSAGE_BITS)
+	}
+
+	last := 0.0
+	for i, s := range []string{hexSignature1, hexSignature2, hexSignature3, hexSignature4} {
+		sig, err := HexToSignature(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = set.Add(GetMessageFromString(fmt.Sprint(i+1)), sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		frac := set.CoverageFraction()
+		if frac <= last {
+			t.Fatalf("coverage %v after %d signatures isn't more than %v",
+				frac, i+1, last)
+		}
+		last = frac
+		gaps := len(set.UncoveredPositions())
+		if float64(gaps) != (1-frac)*2*MESSAGE_BITS {
+			t.Fatalf("got %d gaps, expect %v", gaps, (1-frac)*2*MESSAGE_BITS)
+		}
+	}
+	if set.Len() != 4 {
+		t.Fatalf("got %d signatures, expect 4", set.Len())
+	}
+	if n := set.ExpectedForgeAttempts(); n != 1<<31 {
+		t.Fatalf("got %v attempts, expect %v", n, 1<<31)
+	}
+	for _, gap := range set.UncoveredPositions() {
+		zero, one := set.Revealed().Coverage()
+		row := zero
+		if gap.Missing == 1 {
+			row = one
+		}
+		if row[gap.Index/8]>>(7-(gap.Index%8))&0x01 != 0 {
+			t.Fatalf("gap %+v is covered", gap)
+		}
+	}
+}
+
+// TestSignatureSetAdd checks a signature that doesn't verify is refused.
+func TestSignatureSetAdd(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := NewSignatureSet(pub)
+	msg := GetMessageFromString("set")
+	sig := mustSign(t, msg, pri)
+	err = set.Add(GetMessageFromString("other"), sig)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+	if set.Len() != 0 {
+		t.Fatalf("got %d signatures, expect 0", set.Len())
+	}
+
+	err = set.Add(msg, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.CoverageFraction() != 0.5 {
+		t.Fatalf("got coverage %v, expect 0.5", set.CoverageFraction())
+	}
+	if n := set.ExpectedForgeAttempts(); n != math.Ldexp(1, MESSAGE_BITS) {
+		t.Fatalf("got %v attempts, expect 2^256", n)
+	}
+	m, s := set.At(0)
+	if m != msg || s != sig {
+		t.Fatalf("At(0) doesn't return what was added")
+	}
+}
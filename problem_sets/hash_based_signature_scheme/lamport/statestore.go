@@ -0,0 +1,174 @@
+package lamport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+State files are text, one record per line:
+
+    <fingerprint hex> <message hex> <time, RFC 3339 UTC>
+
+Records are only ever appended.  A last line without a newline is what's left
+of an append that crashed before its fsync finished, so the signature it was
+for was never released; it's cut off when the file is opened.
+*/
+
+// StateStore records which one-time keys have signed, somewhere that
+// survives the process.  Signer and KeyRing call Record before they release
+// a signature, so a crash afterwards can't re-arm the key.
+type StateStore interface {
+	// Record durably notes that the key with fingerprint fp signs msg, and
+	// only returns once that will survive a crash.  If fp has already been
+	// recorded it returns ErrKeyAlreadyUsed and records nothing.
+	Record(fp Fingerprint, msg Message) error
+	// Lookup returns the message recorded for fp, if there is one.
+	Lookup(fp Fingerprint) (msg Message, ok bool)
+}
+
+// StateRecord is one line of a FileStateStore.
+type StateRecord struct {
+	Fingerprint Fingerprint
+	Message     Message
+	Time        time.Time
+}
+
+// FileStateStore is a StateStore kept in an append-only file.  It's safe to
+// call from several goroutines, but only one process should have a given
+// file open.
+type FileStateStore struct {
+	mu      sync.Mutex
+	f       *os.File
+	records []StateRecord
+	index   map[Fingerprint]int
+}
+
+// OpenStateStore opens the state file at path, creating it if it doesn't
+// exist, and loads every record in it.
+func OpenStateStore(path string) (*FileStateStore, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	store := &FileStateStore{f: f, index: make(map[Fingerprint]int)}
+	err = store.load()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return store, nil
+}
+
+// load reads the records in the file and, if the last line is torn, cuts it
+// off so the next record starts on a line of its own.
+func (self *FileStateStore) load() error {
+	data, err := io.ReadAll(self.f)
+	if err != nil {
+		return err
+	}
+	complete := data
+	if i := bytes.LastIndexByte(data, '\n'); i+1 < len(data) {
+		complete = data[:i+1]
+		err = self.f.Truncate(int64(len(complete)))
+		if err == nil {
+			err = self.f.Sync()
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(complete))
+	for line := 1; scanner.Scan(); line++ {
+		if scanner.Text() == "" {
+			continue
+		}
+		rec, err := parseStateRecord(scanner.Text())
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if _, ok := self.index[rec.Fingerprint]; !ok {
+			self.index[rec.Fingerprint] = len(self.records)
+		}
+		self.records = append(self.records, rec)
+	}
+	return scanner.Err()
+}
+
+func parseStateRecord(line string) (StateRecord, error) {
+	var rec StateRecord
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return rec, fmt.Errorf("%d fields, expect 3", len(fields))
+	}
+	fp, err := decodeHexString("fingerprint", fields[0], 2*len(rec.Fingerprint))
+	if err != nil {
+		return rec, err
+	}
+	msg, err := decodeHexString("message", fields[1], 2*len(rec.Message))
+	if err != nil {
+		return rec, err
+	}
+	rec.Time, err = time.Parse(time.RFC3339Nano, fields[2])
+	if err != nil {
+		return rec, err
+	}
+	copy(rec.Fingerprint[:], fp)
+	copy(rec.Message[:], msg)
+	return rec, nil
+}
+
+// Record appends a record for fp and msg and syncs the file.
+func (self *FileStateStore) Record(fp Fingerprint, msg Message) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if _, ok := self.index[fp]; ok {
+		return fmt.Errorf("%w: %s in state file", ErrKeyAlreadyUsed, fp.Short())
+	}
+	rec := StateRecord{Fingerprint: fp, Message: msg, Time: time.Now().UTC()}
+	// One write per record, so with O_APPEND a crash leaves at worst a torn
+	// last line.
+	line := fmt.Sprintf("%s %s %s\n", hex.EncodeToString(fp[:]),
+		hex.EncodeToString(msg[:]), rec.Time.Format(time.RFC3339Nano))
+	_, err := self.f.Write([]byte(line))
+	if err == nil {
+		err = self.f.Sync()
+	}
+	if err != nil {
+		return err
+	}
+	self.index[fp] = len(self.records)
+	self.records = append(self.records, rec)
+	return nil
+}
+
+// Lookup returns the message recorded for fp.
+func (self *FileStateStore) Lookup(fp Fingerprint) (Message, bool) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	i, ok := self.index[fp]
+	if !ok {
+		return Message{}, false
+	}
+	return self.records[i].Message, true
+}
+
+// Records returns every record in the file, oldest first.
+func (self *FileStateStore) Records() []StateRecord {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return append([]StateRecord(nil), self.records...)
+}
+
+// Close closes the state file.
+func (self *FileStateStore) Close() error {
+	return self.f.Close()
+}
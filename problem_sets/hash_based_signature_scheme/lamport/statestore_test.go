@@ -0,0 +1,165 @@
+package lamport
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestStateStoreCrash signs with a Signer backed by a state file, throws the
+// Signer away as a crash would, and checks a new Signer for the same key
+// refuses to sign something else.
+func TestStateStoreCrash(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := OpenStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSigner(pri)
+	s.Store = store
+	msg := GetMessageFromString("before crash")
+	_, err = s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+
+	store, err = OpenStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	got, ok := store.Lookup(pub.Fingerprint())
+	if !ok || got != msg {
+		t.Fatalf("state file lost the record")
+	}
+	s = NewSigner(pri)
+	s.Store = store
+	_, err = s.Sign(GetMessageFromString("after crash"))
+	if !errors.Is(err, ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+	if !s.Used() {
+		t.Fatalf("Signer not used after finding its record")
+	}
+
+	// Re-signing the recorded message is still up to AllowResign.
+	s = NewSigner(pri)
+	s.Store = store
+	s.AllowResign = true
+	sig, err := s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	if len(store.Records()) != 1 {
+		t.Fatalf("got %d records, expect 1", len(store.Records()))
+	}
+}
+
+// TestStateStoreKeyRing checks a reloaded ring skips keys the state file
+// says were used, even though the saved ring predates them.
+func TestStateStoreKeyRing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+	store, err := OpenStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ring, err := NewKeyRing(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved, err := ring.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ring.Store = store
+	for i := 0; i < 2; i++ {
+		_, _, err = ring.Sign(GetMessageFromString("ring"))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	store.Close()
+
+	store, err = OpenStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	var loaded KeyRing
+	err = loaded.UnmarshalBinary(saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded.Store = store
+	index, _, err := loaded.Sign(GetMessageFromString("after"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index != 2 {
+		t.Fatalf("got index %d, expect 2", index)
+	}
+	_, _, err = loaded.Sign(GetMessageFromString("more"))
+	if !errors.Is(err, ErrRingExhausted) {
+		t.Fatalf("got %v, expect ErrRingExhausted", err)
+	}
+}
+
+// TestStateStoreTorn checks a torn last line is dropped and the next record
+// goes on a line of its own.
+func TestStateStoreTorn(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+	store, err := OpenStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fp1, fp2 Fingerprint
+	fp1[0], fp2[0] = 1, 2
+	err = store.Record(fp1, Message{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.Record(fp1, Message{})
+	if !errors.Is(err, ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+	store.Close()
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("0200000000")
+	f.Close()
+
+	store, err = OpenStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.Records()) != 1 {
+		t.Fatalf("got %d records, expect 1", len(store.Records()))
+	}
+	err = store.Record(fp2, Message{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+
+	store, err = OpenStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	if _, ok := store.Lookup(fp2); !ok || len(store.Records()) != 2 {
+		t.Fatalf("record after a torn line was lost")
+	}
+}
@@ -0,0 +1,102 @@
+package lamport
+
+import (
+	"io"
+)
+
+// WriteTo implements io.WriterTo, writing the pubkey to w one block at a time
+// in the canonical order (row 0 then row 1) without building the whole
+// encoding in memory first.
+func (self PublicKey) WriteTo(w io.Writer) (int64, error) {
+	n, err := writeBlocks(w, self.ZeroHash[:], 0)
+	if err != nil {
+		return n, err
+	}
+	return writeBlocks(w, self.OneHash[:], n)
+}
+
+// ReadFrom implements io.ReaderFrom, reading exactly PUBKEY_BYTES from r.
+// If r runs out early the error is io.ErrUnexpectedEOF and the receiver is not
+// modified, so there's no half-filled key that looks valid.
+func (self *PublicKey) ReadFrom(r io.Reader) (int64, error) {
+	var p PublicKey
+	n, err := readBlocks(r, p.ZeroHash[:], 0)
+	if err != nil {
+		return n, err
+	}
+	n, err = readBlocks(r, p.OneHash[:], n)
+	if err != nil {
+		return n, err
+	}
+	*self = p
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo, with the same layout as PublicKey.
+func (self PrivateKey) WriteTo(w io.Writer) (int64, error) {
+	n, err := writeBlocks(w, self.ZeroHash[:], 0)
+	if err != nil {
+		return n, err
+	}
+	return writeBlocks(w, self.OneHash[:], n)
+}
+
+// ReadFrom implements io.ReaderFrom, reading exactly PRIVKEY_BYTES from r.
+func (self *PrivateKey) ReadFrom(r io.Reader) (int64, error) {
+	var p PrivateKey
+	n, err := readBlocks(r, p.ZeroHash[:], 0)
+	if err != nil {
+		return n, err
+	}
+	n, err = readBlocks(r, p.OneHash[:], n)
+	if err != nil {
+		return n, err
+	}
+	*self = p
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo, writing every block of the signature.
+func (self Signature) WriteTo(w io.Writer) (int64, error) {
+	return writeBlocks(w, self.Preimage[:], 0)
+}
+
+// ReadFrom implements io.ReaderFrom, reading exactly SIGNATURE_BYTES from r.
+func (self *Signature) ReadFrom(r io.Reader) (int64, error) {
+	var sig Signature
+	n, err := readBlocks(r, sig.Preimage[:], 0)
+	if err != nil {
+		return n, err
+	}
+	*self = sig
+	return n, nil
+}
+
+// writeBlocks writes each block to w, adding to the running count n.
+func writeBlocks(w io.Writer, blocks []Block, n int64) (int64, error) {
+	for i := range blocks {
+		m, err := w.Write(blocks[i][:])
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// readBlocks fills each block from r, adding to the running count n.  Any
+// short read, including hitting EOF right at the start, is reported as
+// io.ErrUnexpectedEOF.
+func readBlocks(r io.Reader, blocks []Block, n int64) (int64, error) {
+	for i := range blocks {
+		m, err := io.ReadFull(r, blocks[i][:])
+		n += int64(m)
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
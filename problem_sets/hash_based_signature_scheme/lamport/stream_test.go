@@ -0,0 +1,97 @@
+package lamport
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+// TestStreamRoundTrip writes a key pair and signature to a buffer and reads
+// them back one byte at a time.
+func TestStreamRoundTrip(t *testing.T) {
+	sec, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := mustSign(t, GetMessageFromString("stream"), sec)
+
+	var buf bytes.Buffer
+	n, err := pub.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != PUBKEY_BYTES {
+		t.Fatalf("pubkey WriteTo wrote %d bytes, expect %d", n, PUBKEY_BYTES)
+	}
+	if !bytes.Equal(buf.Bytes(), pub.Bytes()) {
+		t.Fatalf("pubkey WriteTo doesn't match Bytes()")
+	}
+	_, err = sec.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sig.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := iotest.OneByteReader(&buf)
+	var pub2 PublicKey
+	n, err = pub2.ReadFrom(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != PUBKEY_BYTES {
+		t.Fatalf("pubkey ReadFrom read %d bytes, expect %d", n, PUBKEY_BYTES)
+	}
+	var sec2 PrivateKey
+	_, err = sec2.ReadFrom(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig2 Signature
+	n, err = sig2.ReadFrom(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != SIGNATURE_BYTES {
+		t.Fatalf("signature ReadFrom read %d bytes, expect %d", n, SIGNATURE_BYTES)
+	}
+
+	if pub2 != pub || sec2 != sec || sig2 != sig {
+		t.Fatalf("stream round trip changed key or signature")
+	}
+}
+
+// TestStreamShortRead cuts the input off part way and checks that ReadFrom
+// returns io.ErrUnexpectedEOF and leaves the receiver alone.
+func TestStreamShortRead(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pub.Bytes()
+
+	for _, cut := range []int{0, 1, 31, PUBKEY_BYTES / 2, PUBKEY_BYTES - 1} {
+		var pub2 PublicKey
+		r := iotest.OneByteReader(bytes.NewReader(data[:cut]))
+		n, err := pub2.ReadFrom(r)
+		if err != io.ErrUnexpectedEOF {
+			t.Fatalf("cut at %d: got %v, expect io.ErrUnexpectedEOF", cut, err)
+		}
+		if n != int64(cut) {
+			t.Fatalf("cut at %d: ReadFrom reported %d bytes", cut, n)
+		}
+		if pub2 != (PublicKey{}) {
+			t.Fatalf("cut at %d: ReadFrom modified pubkey", cut)
+		}
+	}
+
+	// errors other than EOF come through as they are
+	var sig Signature
+	_, err = sig.ReadFrom(iotest.ErrReader(iotest.ErrTimeout))
+	if err != iotest.ErrTimeout {
+		t.Fatalf("got %v, expect iotest.ErrTimeout", err)
+	}
+}
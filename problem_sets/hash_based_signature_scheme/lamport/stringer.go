@@ -0,0 +1,178 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+/*
+Printing any of these types with fmt used to dump every byte: 16KB of
+pubkey, or worse, the whole private key into a log file.  Public types now
+print a short form, like
+
+    PublicKey(fp=9533eb365fed6195…)
+    Signature(id=0123456789abcdef…)
+    Block(ab12cd34…)
+
+while types holding secret blocks print REDACTED for every verb, %d and %x
+included, so there's no format string that gets key material out of them.
+Block and Message still print their bytes with %x and %X, since hex is the
+usual way to look at a hash.
+*/
+
+// String returns the pubkey's short fingerprint.
+func (self PublicKey) String() string {
+	return fmt.Sprintf("PublicKey(fp=%s…)", self.Fingerprint().Short())
+}
+
+// GoString keeps %#v from printing all 512 blocks.
+func (self PublicKey) GoString() string {
+	return fmt.Sprintf("lamport.PublicKey{ /* fp=%s… */ }", self.Fingerprint().Short())
+}
+
+// String identifies the signature by the first 8 bytes of the sha256 of its
+// encoding.
+func (self Signature) String() string {
+	return fmt.Sprintf("Signature(id=%s…)", self.id())
+}
+
+// GoString keeps %#v from printing all 256 blocks.
+func (self Signature) GoString() string {
+	return fmt.Sprintf("lamport.Signature{ /* id=%s… */ }", self.id())
+}
+
+func (self Signature) id() string {
+	h := sha256.Sum256(self.Bytes())
+	return hex.EncodeToString(h[:8])
+}
+
+// String shows the first 4 bytes of the block.
+func (self Block) String() string {
+	return fmt.Sprintf("Block(%x…)", self[:4])
+}
+
+// Format prints the block's bytes for %x and %X and String otherwise.
+func (self Block) Format(f fmt.State, verb rune) {
+	formatHash(f, verb, self[:], self.String())
+}
+
+// String shows the first 4 bytes of the message hash.
+func (self Message) String() string {
+	return fmt.Sprintf("Message(%x…)", self[:4])
+}
+
+// Format prints the message's bytes for %x and %X and String otherwise.
+func (self Message) Format(f fmt.State, verb rune) {
+	formatHash(f, verb, self[:], self.String())
+}
+
+// String never includes key material, only the fingerprint of the matching
+// pubkey.
+func (self PrivateKey) String() string {
+	if self.IsZero() {
+		return "PrivateKey(REDACTED zero)"
+	}
+	return fmt.Sprintf("PrivateKey(REDACTED fp=%s…)",
+		self.GetPublicKey().Fingerprint().Short())
+}
+
+// Format prints String for every verb.
+func (self PrivateKey) Format(f fmt.State, verb rune) {
+	io.WriteString(f, self.String())
+}
+
+// String never includes key material.
+func (self ZeroHalf) String() string {
+	return fmt.Sprintf("ZeroHalf(REDACTED fp=%s…)", self.Pub.Fingerprint().Short())
+}
+
+// Format prints String for every verb.
+func (self ZeroHalf) Format(f fmt.State, verb rune) {
+	io.WriteString(f, self.String())
+}
+
+// String never includes key material.
+func (self OneHalf) String() string {
+	return fmt.Sprintf("OneHalf(REDACTED fp=%s…)", self.Pub.Fingerprint().Short())
+}
+
+// Format prints String for every verb.
+func (self OneHalf) Format(f fmt.State, verb rune) {
+	io.WriteString(f, self.String())
+}
+
+// String never includes key material.
+func (self *KeyPair) String() string {
+	return fmt.Sprintf("KeyPair(REDACTED fp=%s…)", self.Public.Fingerprint().Short())
+}
+
+// Format prints String for every verb.
+func (self *KeyPair) Format(f fmt.State, verb rune) {
+	io.WriteString(f, self.String())
+}
+
+// String never includes key material.
+func (self *CryptoSigner) String() string {
+	return fmt.Sprintf("CryptoSigner(REDACTED fp=%s…)", self.pub.Fingerprint().Short())
+}
+
+// Format prints String for every verb.
+func (self *CryptoSigner) Format(f fmt.State, verb rune) {
+	io.WriteString(f, self.String())
+}
+
+// String never includes key material.
+func (self *Signer) String() string {
+	return fmt.Sprintf("Signer(REDACTED used=%v)", self.Used())
+}
+
+// Format prints String for every verb.
+func (self *Signer) Format(f fmt.State, verb rune) {
+	io.WriteString(f, self.String())
+}
+
+// String never includes key material.
+func (self *RevealedKey) String() string {
+	return fmt.Sprintf("RevealedKey(REDACTED fp=%s… sigs=%d difficulty=%d)",
+		self.pub.Fingerprint().Short(), self.count, self.Difficulty())
+}
+
+// Format prints String for every verb.
+func (self *RevealedKey) Format(f fmt.State, verb rune) {
+	io.WriteString(f, self.String())
+}
+
+// String never includes key material.
+func (self *SignatureSet) String() string {
+	return fmt.Sprintf("SignatureSet(REDACTED fp=%s… sigs=%d coverage=%.3f)",
+		self.rk.pub.Fingerprint().Short(), self.Len(), self.CoverageFraction())
+}
+
+// Format prints String for every verb.
+func (self *SignatureSet) Format(f fmt.State, verb rune) {
+	io.WriteString(f, self.String())
+}
+
+// String never includes key material.
+func (self *KeyRing) String() string {
+	return fmt.Sprintf("KeyRing(REDACTED keys=%d remaining=%d)",
+		self.Len(), self.Remaining())
+}
+
+// Format prints String for every verb.
+func (self *KeyRing) Format(f fmt.State, verb rune) {
+	io.WriteString(f, self.String())
+}
+
+// formatHash writes b in hex for %x and %X, with the flags given, and s for
+// any other verb.
+func formatHash(f fmt.State, verb rune, b []byte, s string) {
+	switch verb {
+	case 'x', 'X':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), b)
+	default:
+		io.WriteString(f, s)
+	}
+}
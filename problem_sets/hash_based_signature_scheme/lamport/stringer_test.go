@@ -0,0 +1,83 @@
+package lamport
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestPrivateKeyRedacted prints a private key, and things holding one, with
+// every verb we can think of and checks no secret block shows up.
+func TestPrivateKeyRedacted(t *testing.T) {
+	kp, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pri := kp.Private
+	z, o := SplitPrivateKey(pri)
+
+	var secrets []string
+	for i := 0; i < MESSAGE_BITS; i++ {
+		secrets = append(secrets,
+			hex.EncodeToString(pri.ZeroHash[i][:4]),
+			hex.EncodeToString(pri.OneHash[i][:4]))
+	}
+
+	values := []interface{}{pri, &pri, kp, *kp, z, o,
+		NewSigner(pri), NewCryptoSigner(pri)}
+	verbs := []string{"%v", "%+v", "%#v", "%s", "%x", "%X", "%d", "%q"}
+	for _, v := range values {
+		for _, verb := range verbs {
+			out := fmt.Sprintf(verb, v)
+			if !strings.Contains(out, "REDACTED") {
+				t.Fatalf("%s of %T isn't redacted: %.80s", verb, v, out)
+			}
+			for _, s := range secrets {
+				if strings.Contains(strings.ToLower(out), s) {
+					t.Fatalf("%s of %T contains secret bytes %s", verb, v, s)
+				}
+			}
+		}
+	}
+
+	fp := kp.Public.Fingerprint().Short()
+	if pri.String() != "PrivateKey(REDACTED fp="+fp+"…)" {
+		t.Fatalf("unexpected PrivateKey string %q", pri.String())
+	}
+}
+
+// TestStringShort checks the public types print briefly, and that Block and
+// Message still print all their bytes with %x.
+func TestStringShort(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s := fmt.Sprint(pub); s != "PublicKey(fp=9533eb365fed6195…)" {
+		t.Fatalf("unexpected pubkey string %q", s)
+	}
+	for _, s := range []string{fmt.Sprint(pub), fmt.Sprintf("%#v", pub),
+		fmt.Sprint(sig), fmt.Sprintf("%#v", sig)} {
+		if len(s) > 64 {
+			t.Fatalf("string %d characters long: %.80s", len(s), s)
+		}
+	}
+
+	block := pub.ZeroHash[0]
+	if s := fmt.Sprintf("%x", block); s != hex.EncodeToString(block[:]) {
+		t.Fatalf("%%x of block is %q", s)
+	}
+	if s := fmt.Sprint(block); s != "Block("+hexPubkey1[:8]+"…)" {
+		t.Fatalf("unexpected block string %q", s)
+	}
+	msg := GetMessageFromString("1")
+	if s := fmt.Sprintf("%X", msg); s != strings.ToUpper(hex.EncodeToString(msg[:])) {
+		t.Fatalf("%%X of message is %q", s)
+	}
+}
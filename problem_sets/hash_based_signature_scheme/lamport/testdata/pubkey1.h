@@ -0,0 +1,1027 @@
+/* Lamport public key 9533:eb36:5fed:6195:aa38:c9d5:e3aa:834f:486f:99c4:5764:d826:e569:70c9:9359:e03d */
+static const uint8_t lamport_pubkey1[16384] = {
+    0xb6, 0xf0, 0x16, 0xf0, 0xb9, 0x83, 0x94, 0x85, 0xef, 0x0f, 0x73, 0xf8, 0x94, 0x07, 0x22, 0x46,
+    0xc1, 0x71, 0xe9, 0x9d, 0x45, 0xf1, 0xc8, 0x33, 0x02, 0x34, 0x6d, 0x96, 0xe2, 0xee, 0xb6, 0xe0,
+    0x4b, 0xd8, 0xfc, 0x37, 0x26, 0xa5, 0xd4, 0x66, 0xfd, 0x98, 0x05, 0xe4, 0x27, 0xbc, 0xa7, 0x09,
+    0x31, 0x29, 0xfa, 0x8f, 0xb4, 0x86, 0xa4, 0xaf, 0xe8, 0x85, 0x6e, 0x4c, 0x80, 0x45, 0xa4, 0x67,
+    0x62, 0x8c, 0x37, 0x6d, 0x18, 0xd1, 0x01, 0x73, 0x7d, 0xd1, 0xf3, 0xf2, 0xb9, 0xb0, 0x1a, 0x15,
+    0x97, 0x2e, 0xee, 0x26, 0xcd, 0x1b, 0x81, 0x0d, 0xb7, 0xcb, 0x94, 0x00, 0x7d, 0xb6, 0xfd, 0xc5,
+    0xcf, 0xcd, 0x45, 0xbb, 0x2c, 0x06, 0x92, 0xe9, 0xb1, 0xfc, 0x7b, 0x84, 0x45, 0x34, 0x40, 0xf6,
+    0x37, 0x43, 0x01, 0x09, 0xc5, 0x7b, 0x40, 0x75, 0xd2, 0xc7, 0x70, 0x16, 0x01, 0xc6, 0x14, 0x18,
+    0x90, 0xa1, 0x38, 0x41, 0xc7, 0x29, 0x66, 0x0d, 0x8d, 0xa0, 0x05, 0xd7, 0xb3, 0x1b, 0x81, 0xe0,
+    0x5d, 0x7c, 0x31, 0x46, 0xd5, 0xb1, 0x06, 0x90, 0x89, 0xa8, 0xba, 0xc2, 0xe4, 0x05, 0x07, 0xbb,
+    0x95, 0x9e, 0x77, 0xa4, 0xeb, 0xe6, 0x4c, 0xde, 0x80, 0x16, 0x81, 0x04, 0x45, 0x1f, 0x45, 0xd4,
+    0x1d, 0x87, 0xfa, 0x80, 0x03, 0x3d, 0x77, 0x17, 0x77, 0x79, 0x9f, 0xb2, 0xac, 0x30, 0x81, 0xcf,
+    0x0d, 0x61, 0x31, 0x14, 0x3f, 0x9f, 0x1a, 0x92, 0xd5, 0xe5, 0x51, 0xaf, 0x68, 0xdf, 0x61, 0x90,
+    0x0f, 0x6e, 0xb7, 0xa4, 0xbc, 0xc6, 0xe2, 0x19, 0x3e, 0x75, 0xb3, 0x99, 0xd9, 0xa2, 0x6d, 0x08,
+    0x2a, 0x93, 0x84, 0x29, 0xf9, 0xba, 0x92, 0x32, 0x27, 0x2c, 0x50, 0xfa, 0xbb, 0x8f, 0x3a, 0x49,
+    0x79, 0x95, 0x81, 0x80, 0x43, 0xa1, 0x11, 0x89, 0x51, 0x73, 0x19, 0xa6, 0x37, 0x1f, 0x13, 0xfb,
+    0x09, 0x89, 0xad, 0xba, 0xba, 0xde, 0x29, 0x57, 0x32, 0xc0, 0x34, 0x44, 0x83, 0x6c, 0x97, 0xd1,
+    0x37, 0x9d, 0xea, 0x18, 0x83, 0x7f, 0xb0, 0x56, 0x0c, 0xf8, 0x48, 0x08, 0x45, 0x74, 0x47, 0x59,
+    0x9b, 0x25, 0x1e, 0x89, 0x2a, 0xc2, 0xec, 0xc6, 0x5e, 0x82, 0x5f, 0x55, 0xe4, 0x99, 0x22, 0xc1,
+    0x79, 0xab, 0xe4, 0xf0, 0x7c, 0x09, 0x44, 0x49, 0xc4, 0xb1, 0x01, 0x8c, 0x2f, 0x3f, 0x8c, 0x6c,
+    0xc5, 0x99, 0xea, 0x88, 0xb9, 0x99, 0xba, 0xd6, 0x03, 0xbe, 0x8e, 0x6b, 0xff, 0xb9, 0x90, 0xb9,
+    0x24, 0x92, 0xe3, 0xdf, 0x16, 0x5c, 0xa9, 0xb2, 0xca, 0xcf, 0x86, 0xf4, 0x81, 0xa7, 0x38, 0x17,
+    0x91, 0x54, 0x61, 0x07, 0x97, 0xb6, 0xf5, 0x50, 0x76, 0x2a, 0xc7, 0xb8, 0x0d, 0x77, 0x62, 0x5a,
+    0x0a, 0x82, 0xc3, 0x2a, 0xcc, 0x04, 0xf2, 0xbf, 0x4d, 0x66, 0xaa, 0xf9, 0x07, 0x5d, 0x66, 0xd2,
+    0x75, 0x2c, 0x65, 0x3a, 0x14, 0x1d, 0x9b, 0xe5, 0x1b, 0x63, 0x48, 0x84, 0x70, 0x83, 0xb1, 0xf2,
+    0x71, 0xa8, 0x03, 0x32, 0x79, 0x70, 0x4d, 0x34, 0xe1, 0x42, 0x09, 0x6e, 0xd6, 0x2f, 0x16, 0xd5,
+    0xf0, 0xfd, 0x43, 0xa7, 0xe4, 0xc0, 0x30, 0xe0, 0x8c, 0x8d, 0x35, 0x1c, 0x8b, 0x50, 0x0a, 0x9c,
+    0x54, 0x56, 0x2b, 0x1a, 0xdd, 0x15, 0x48, 0xd7, 0x2f, 0xc9, 0xd8, 0xc6, 0x7d, 0xb5, 0xec, 0xf7,
+    0xb0, 0x65, 0x7c, 0x4a, 0x9a, 0x66, 0x45, 0x1d, 0x9a, 0x7e, 0x30, 0x4f, 0x50, 0x2c, 0x9d, 0x0e,
+    0xf9, 0x9d, 0x22, 0xd7, 0x26, 0x9b, 0x88, 0x13, 0xf0, 0x29, 0x7d, 0xa1, 0x9a, 0xa5, 0x30, 0x97,
+    0xa6, 0x84, 0x0d, 0xb4, 0x1c, 0x61, 0x9f, 0x9b, 0xf5, 0x50, 0x6b, 0x68, 0x10, 0x76, 0x96, 0x9c,
+    0xe6, 0x5d, 0x84, 0x69, 0x41, 0x03, 0x03, 0xa2, 0x52, 0xd3, 0x59, 0x0a, 0xd3, 0x68, 0x3e, 0x1b,
+    0xc6, 0x0c, 0x63, 0x6c, 0xe9, 0xea, 0xee, 0x46, 0x52, 0xfa, 0x1f, 0xcb, 0x12, 0xe5, 0xb7, 0x3a,
+    0x0a, 0x90, 0x57, 0x17, 0x68, 0x9c, 0xcb, 0x44, 0x29, 0x2c, 0xbc, 0xb7, 0xc6, 0x11, 0xd2, 0x99,
+    0xc9, 0x46, 0x31, 0x1e, 0xc3, 0x30, 0x77, 0xf0, 0x27, 0x3d, 0x45, 0xc8, 0xcf, 0xce, 0x01, 0x63,
+    0xf7, 0x98, 0x9c, 0xe7, 0x6b, 0x83, 0x3a, 0xd3, 0xac, 0x1e, 0x36, 0xc8, 0x77, 0xea, 0x4c, 0x31,
+    0x36, 0x48, 0x4c, 0xba, 0x97, 0x5c, 0x74, 0x69, 0x43, 0x98, 0x34, 0xc0, 0x3a, 0xae, 0x50, 0x20,
+    0xa5, 0x42, 0x29, 0xbf, 0x52, 0xe7, 0xa6, 0xe2, 0xc8, 0xe0, 0xd3, 0x7c, 0x91, 0x97, 0xe7, 0x3c,
+    0x1a, 0xef, 0x3f, 0x18, 0x34, 0x3a, 0x76, 0x58, 0xa4, 0xe5, 0x60, 0xb1, 0x2b, 0x23, 0xd5, 0x97,
+    0xee, 0xe5, 0x86, 0xf8, 0x8f, 0xfc, 0xe1, 0x5e, 0x83, 0xa3, 0xad, 0x5f, 0x66, 0x4a, 0x84, 0xa2,
+    0x4e, 0x40, 0xb0, 0xf3, 0xd9, 0xf8, 0xdb, 0x30, 0xf3, 0xdc, 0x27, 0x1d, 0xa5, 0xa0, 0xea, 0x33,
+    0xda, 0xfe, 0xc4, 0x06, 0xc5, 0x90, 0x3d, 0x56, 0x9f, 0x4b, 0x56, 0x5b, 0xc6, 0x6f, 0x46, 0x45,
+    0xbe, 0x2d, 0x7f, 0x69, 0x0a, 0xf6, 0x64, 0xe8, 0x57, 0xc6, 0xa6, 0x63, 0x6f, 0x77, 0x9c, 0xc8,
+    0x33, 0x34, 0xdc, 0xf5, 0xa9, 0x25, 0xbe, 0xb7, 0x33, 0xb4, 0xcc, 0x63, 0x89, 0xcc, 0x78, 0x78,
+    0x03, 0x0b, 0xa9, 0xd3, 0xab, 0x2b, 0x04, 0x64, 0x0d, 0x7d, 0xa4, 0x5f, 0x11, 0xac, 0xe0, 0xb3,
+    0xa6, 0xcd, 0x18, 0x44, 0x85, 0x7f, 0xf9, 0x59, 0x95, 0x0f, 0x1a, 0xe6, 0x7f, 0x92, 0x70, 0xaf,
+    0xb2, 0x6c, 0xad, 0x1f, 0x7a, 0xa4, 0xd2, 0xb4, 0x1c, 0x73, 0x69, 0xae, 0xa6, 0xef, 0x32, 0xff,
+    0x2f, 0xc2, 0xd7, 0xeb, 0xa5, 0x99, 0xa6, 0x5c, 0x49, 0x1d, 0x34, 0x84, 0x3f, 0xdb, 0x1f, 0xdb,
+    0x78, 0x7b, 0x0c, 0x0e, 0x50, 0x24, 0x27, 0xa5, 0x42, 0xe8, 0x7c, 0xb8, 0xf3, 0x94, 0x71, 0x32,
+    0xf1, 0xa8, 0x81, 0x96, 0xaa, 0x91, 0x0e, 0x08, 0x93, 0x51, 0x2b, 0x47, 0xbc, 0xfa, 0x4a, 0x85,
+    0xa9, 0xc6, 0x40, 0xb8, 0x09, 0x33, 0x69, 0x83, 0x76, 0x92, 0xfb, 0x95, 0xe5, 0xd8, 0x6d, 0x22,
+    0x7d, 0x81, 0x2f, 0x55, 0x32, 0xa0, 0x06, 0xe9, 0xac, 0x3c, 0x53, 0xe7, 0xa4, 0xfb, 0xe1, 0x72,
+    0xfd, 0x8d, 0x3d, 0x9e, 0xa3, 0x25, 0xc9, 0xe0, 0x6d, 0x23, 0xe1, 0x43, 0x9f, 0x4f, 0xfd, 0xaf,
+    0xcd, 0xf0, 0x4f, 0x65, 0x3a, 0x54, 0x1f, 0x8a, 0x42, 0x75, 0x25, 0x53, 0x1a, 0x03, 0xc3, 0x7d,
+    0xe1, 0xcc, 0xb9, 0xad, 0xfa, 0x7f, 0xcd, 0xa2, 0x2f, 0x20, 0xe9, 0xa1, 0xd8, 0x43, 0x4c, 0x1f,
+    0xe5, 0x8e, 0x5e, 0x8c, 0xb7, 0x28, 0x45, 0xd3, 0xb2, 0x6c, 0x44, 0x93, 0xc7, 0x7c, 0x27, 0x46,
+    0x01, 0x7b, 0x5f, 0x37, 0xea, 0x8a, 0xd1, 0x9e, 0xd0, 0xbb, 0x11, 0xb6, 0x4f, 0x31, 0x1c, 0x57,
+    0x19, 0x04, 0xa0, 0x3f, 0x9c, 0x0f, 0x71, 0x34, 0x42, 0x14, 0x9f, 0x6a, 0x80, 0x50, 0xc1, 0x54,
+    0xd5, 0x16, 0xc1, 0xdf, 0xab, 0x98, 0x5f, 0xb9, 0x08, 0x3e, 0xc1, 0x05, 0x51, 0xe1, 0xf2, 0xca,
+    0x5d, 0xa3, 0x80, 0x3f, 0x3f, 0x60, 0x44, 0x13, 0xa9, 0x69, 0x54, 0x77, 0x18, 0xb7, 0x64, 0xeb,
+    0x25, 0x5e, 0x0c, 0xd2, 0xe0, 0x58, 0x69, 0xa1, 0xbb, 0xf7, 0x16, 0x79, 0x22, 0xa4, 0x97, 0x7b,
+    0xe9, 0x14, 0xfe, 0xf4, 0xd7, 0x68, 0x92, 0x13, 0x61, 0x69, 0xb5, 0x0d, 0x8d, 0x41, 0x4e, 0x01,
+    0x24, 0x3f, 0x1c, 0x6f, 0x43, 0x38, 0x1b, 0xce, 0x0c, 0x91, 0x46, 0xaa, 0x83, 0x6f, 0x9e, 0x60,
+    0xb2, 0x26, 0x90, 0x5e, 0x66, 0x79, 0x8a, 0x06, 0x97, 0x89, 0x21, 0xd1, 0x23, 0xfb, 0xd7, 0x65,
+    0xfe, 0x41, 0xa2, 0x2a, 0xa2, 0xeb, 0x0d, 0xbf, 0x9c, 0x9e, 0x29, 0xf8, 0x65, 0x48, 0x00, 0x2b,
+    0x1a, 0x1a, 0xfd, 0xf4, 0x78, 0xeb, 0x1b, 0x87, 0x13, 0x97, 0x22, 0x33, 0x36, 0x07, 0x7e, 0x50,
+    0x04, 0xe1, 0x59, 0x3a, 0x09, 0x1f, 0x26, 0xde, 0x12, 0x3c, 0x2d, 0xf5, 0xcf, 0x10, 0x5f, 0xec,
+    0x5b, 0x34, 0x3e, 0x66, 0xd4, 0x05, 0xd3, 0xe4, 0xa5, 0xb3, 0xd4, 0x5f, 0x92, 0xc3, 0x08, 0x1d,
+    0x3b, 0x65, 0x56, 0xaf, 0xc0, 0x52, 0x32, 0x7b, 0xaa, 0x1e, 0x5c, 0x6c, 0xd9, 0x64, 0x67, 0x30,
+    0xcd, 0xd7, 0x58, 0x78, 0xcf, 0x59, 0x14, 0xe8, 0x45, 0xba, 0x1a, 0x1f, 0x2b, 0x53, 0x70, 0x6a,
+    0x40, 0x0e, 0xab, 0x03, 0xd7, 0x5a, 0xa5, 0xbb, 0xf3, 0xce, 0xae, 0x93, 0x11, 0xb4, 0x5f, 0x20,
+    0xfa, 0xa5, 0xd0, 0xe1, 0x0a, 0x16, 0x12, 0xbc, 0x6f, 0x8a, 0x35, 0xa4, 0x3d, 0x43, 0x02, 0x85,
+    0xba, 0x73, 0x80, 0x53, 0x8f, 0x11, 0x2d, 0xbe, 0x30, 0x6e, 0x02, 0x4b, 0x5c, 0x3e, 0x96, 0xbf,
+    0xa6, 0x3b, 0x19, 0xc0, 0xb5, 0x23, 0xbd, 0xa1, 0xb1, 0x16, 0x84, 0x63, 0x53, 0x11, 0xbd, 0xe2,
+    0x3a, 0xfe, 0x18, 0xe8, 0xec, 0x1e, 0x60, 0x21, 0x0a, 0xdd, 0x36, 0x29, 0xd5, 0x76, 0x5d, 0x7b,
+    0x7d, 0x7c, 0xf9, 0xf7, 0x46, 0xab, 0x33, 0x0f, 0xe1, 0x19, 0xf5, 0xc6, 0xca, 0x5a, 0x12, 0x54,
+    0xab, 0x34, 0x77, 0xb4, 0x10, 0x07, 0x8c, 0xb6, 0xd5, 0x4f, 0xe6, 0x54, 0xa2, 0x13, 0x4a, 0xb3,
+    0xbe, 0xa4, 0x72, 0x9d, 0xf4, 0x66, 0x85, 0xcc, 0x9f, 0xcd, 0xb7, 0x75, 0x37, 0xeb, 0xbb, 0xc2,
+    0x5a, 0x8c, 0xc7, 0x90, 0x02, 0xdf, 0xc7, 0x63, 0x29, 0x5c, 0xe6, 0x26, 0x11, 0x04, 0x18, 0x0b,
+    0xc8, 0x81, 0x9f, 0x34, 0x5f, 0x67, 0xa7, 0x08, 0x73, 0xb4, 0xc0, 0xad, 0x2b, 0x5e, 0xc5, 0xf4,
+    0x12, 0xce, 0xed, 0xe5, 0x9e, 0x3f, 0x55, 0xbc, 0x01, 0x2a, 0x82, 0x28, 0x5a, 0xf4, 0xc4, 0x96,
+    0xc6, 0xc0, 0xe4, 0x44, 0xfd, 0xab, 0x5b, 0x3e, 0xbb, 0x9e, 0x09, 0x06, 0x2f, 0xfc, 0xd4, 0xd1,
+    0x3a, 0xeb, 0xf9, 0xd7, 0xb6, 0xb9, 0x44, 0x45, 0x34, 0x7a, 0x7e, 0xf2, 0x4c, 0x44, 0x2e, 0x2b,
+    0x7c, 0x88, 0x0c, 0xba, 0x94, 0xf3, 0xd2, 0xaa, 0xb3, 0xa2, 0x5a, 0xd0, 0x84, 0xe8, 0xa8, 0x61,
+    0x3e, 0x48, 0x84, 0x69, 0x7a, 0xd8, 0xa8, 0xbc, 0x3f, 0xec, 0x3c, 0x96, 0xfc, 0xcc, 0x4f, 0x92,
+    0xee, 0xdc, 0xed, 0xcc, 0xcb, 0xc6, 0x1a, 0x43, 0xd3, 0x3c, 0x86, 0x7c, 0xba, 0x6a, 0xbd, 0xc1,
+    0x3b, 0x83, 0xc6, 0xac, 0x47, 0x13, 0x56, 0x3d, 0x77, 0x83, 0x50, 0xe0, 0x8a, 0xdf, 0xe1, 0xec,
+    0x3e, 0x77, 0xdf, 0xb1, 0xaf, 0xf3, 0x57, 0x44, 0xd8, 0x4e, 0x3b, 0x00, 0x46, 0xd4, 0xf9, 0x4d,
+    0xf2, 0x98, 0x13, 0x91, 0x4f, 0x60, 0xbb, 0x80, 0x01, 0xc1, 0xa0, 0x8d, 0x91, 0x8a, 0xd8, 0x7b,
+    0x4a, 0x56, 0x27, 0x3d, 0xfa, 0x92, 0xa5, 0xc2, 0x11, 0x18, 0x91, 0x54, 0x1b, 0x1b, 0x79, 0x7c,
+    0x05, 0x13, 0x08, 0xde, 0x00, 0x78, 0x20, 0x34, 0xb3, 0x87, 0xd3, 0x4d, 0x76, 0x76, 0x18, 0xcd,
+    0xf0, 0x4b, 0x36, 0x6e, 0x3b, 0xb2, 0x9c, 0x2c, 0x1f, 0xa8, 0x3f, 0xab, 0x63, 0xe1, 0xd1, 0x8a,
+    0xa5, 0x4a, 0x72, 0xa6, 0x8a, 0x96, 0x84, 0x27, 0xaf, 0x63, 0xec, 0x40, 0x2f, 0x1d, 0x72, 0x6e,
+    0x42, 0x87, 0xc0, 0x61, 0xd5, 0x78, 0x1d, 0x33, 0x56, 0x42, 0xc0, 0xf6, 0x11, 0xac, 0xcd, 0xd2,
+    0x8b, 0x28, 0x8b, 0xa4, 0x74, 0xc0, 0x11, 0xfa, 0xfb, 0xc8, 0x30, 0x9d, 0x64, 0x35, 0xc2, 0x67,
+    0xf9, 0xb6, 0x71, 0x15, 0xee, 0xf2, 0x63, 0x11, 0xc0, 0x56, 0x10, 0x2a, 0x92, 0xd1, 0x59, 0xda,
+    0xc8, 0xd9, 0x85, 0x7a, 0x27, 0x1e, 0x1e, 0x79, 0x01, 0x60, 0x04, 0x46, 0xe6, 0x56, 0x01, 0xcb,
+    0xd3, 0xde, 0x47, 0x21, 0xc0, 0x4d, 0x5a, 0x5c, 0x10, 0xb4, 0x02, 0xac, 0x50, 0xcb, 0xdd, 0x3e,
+    0xde, 0xae, 0xe9, 0x9a, 0x08, 0x65, 0x53, 0x65, 0xe6, 0x6c, 0xac, 0x25, 0xa5, 0x98, 0x62, 0x9a,
+    0x2b, 0x9d, 0xac, 0xfb, 0xa5, 0x7d, 0x35, 0xe7, 0x0a, 0xd7, 0x9a, 0xbc, 0xf0, 0x44, 0x04, 0xc8,
+    0xb6, 0x1d, 0x8a, 0xe0, 0x5c, 0x30, 0x4c, 0x1d, 0x88, 0x0b, 0x1e, 0xf7, 0x51, 0x11, 0x10, 0x02,
+    0x9b, 0xfd, 0x73, 0xab, 0x9c, 0x6b, 0xa9, 0xdc, 0x7c, 0x6b, 0x96, 0x22, 0xe2, 0x91, 0xc8, 0x17,
+    0x97, 0xad, 0x5b, 0xf1, 0x09, 0x97, 0x2c, 0xa8, 0x83, 0x32, 0xbc, 0xd4, 0xb8, 0xdf, 0x9f, 0x8b,
+    0xa7, 0xa3, 0x96, 0x5e, 0x84, 0x29, 0xc0, 0xcb, 0x29, 0xc1, 0xa1, 0x7a, 0xb9, 0x7e, 0x2e, 0xfb,
+    0x89, 0x06, 0xa9, 0x4f, 0x50, 0x76, 0xfa, 0x04, 0x1c, 0x5c, 0x69, 0xee, 0x18, 0xf1, 0x4c, 0xf3,
+    0x69, 0x32, 0xfa, 0x62, 0xb7, 0x6a, 0x5e, 0xc2, 0x8c, 0x7b, 0x4d, 0x80, 0xba, 0x93, 0xfd, 0x4e,
+    0xc1, 0xe3, 0x46, 0x97, 0xbe, 0xf5, 0x95, 0x19, 0xda, 0xb4, 0xb1, 0xca, 0x32, 0xd2, 0x84, 0x20,
+    0xee, 0xba, 0x01, 0x51, 0x4a, 0xef, 0x61, 0xa7, 0x6e, 0xfc, 0x40, 0x33, 0xef, 0x81, 0x0d, 0xe2,
+    0x75, 0x07, 0x95, 0xf1, 0x29, 0x68, 0xde, 0x6a, 0xf6, 0x46, 0xe9, 0xbe, 0x42, 0x75, 0xd6, 0x3c,
+    0x40, 0xea, 0xac, 0xdf, 0xf2, 0x1a, 0xaa, 0x44, 0x23, 0xa4, 0x3a, 0x1c, 0xc6, 0xa5, 0x7a, 0xf6,
+    0xf7, 0x7e, 0x0b, 0xa6, 0x2f, 0x77, 0x3a, 0x50, 0x34, 0x3b, 0x37, 0x25, 0x40, 0x06, 0x78, 0xc8,
+    0x02, 0xc3, 0x9e, 0xb6, 0x77, 0xf5, 0x13, 0x7b, 0xa7, 0x3e, 0x87, 0xa9, 0xc1, 0x7e, 0xce, 0xcd,
+    0xfc, 0xa4, 0xaa, 0x55, 0x9b, 0xeb, 0x2b, 0xe2, 0xd4, 0xdc, 0x83, 0xa3, 0xdf, 0x02, 0xd8, 0x71,
+    0x23, 0x18, 0x6a, 0xf3, 0xbf, 0x94, 0x3e, 0xe1, 0x32, 0xd8, 0xf4, 0x6a, 0x88, 0x24, 0x58, 0x5c,
+    0xb9, 0xfb, 0x7c, 0x36, 0xd0, 0xdd, 0x13, 0x69, 0xd2, 0x27, 0xda, 0x30, 0xde, 0x92, 0x34, 0x7f,
+    0x4f, 0xb9, 0x89, 0x53, 0x8e, 0x81, 0xe2, 0x6a, 0xdc, 0x31, 0x9d, 0x0b, 0xe1, 0x76, 0x68, 0xff,
+    0x97, 0x1c, 0xbf, 0x95, 0xa8, 0x4b, 0x20, 0x6d, 0x4d, 0x6f, 0x6d, 0x33, 0x7b, 0xe5, 0x3b, 0x25,
+    0x10, 0xa4, 0x0a, 0xc1, 0x32, 0x81, 0x4d, 0x84, 0xa5, 0x16, 0x54, 0x5c, 0xe5, 0x15, 0x08, 0x94,
+    0x95, 0x28, 0xfa, 0x8c, 0x23, 0xe0, 0x8c, 0xb8, 0x07, 0x25, 0x08, 0x52, 0x15, 0x17, 0x33, 0x3c,
+    0xef, 0x74, 0xce, 0x3e, 0x7c, 0x76, 0x8c, 0x04, 0x8f, 0x08, 0x62, 0xa7, 0xa3, 0x0b, 0xbb, 0x4a,
+    0x9b, 0xa4, 0x93, 0x78, 0x70, 0x5b, 0xd0, 0x60, 0x2b, 0xa2, 0x32, 0x06, 0x8b, 0x67, 0xd8, 0xc1,
+    0x1b, 0x12, 0x98, 0x5e, 0xd8, 0x92, 0x26, 0x54, 0x4b, 0x5e, 0xea, 0x54, 0x16, 0xad, 0x3c, 0x3c,
+    0xee, 0x95, 0xe7, 0xeb, 0x3b, 0xb0, 0x75, 0xfa, 0x81, 0x28, 0xae, 0x99, 0x59, 0x71, 0x9f, 0x52,
+    0xe5, 0xe1, 0x84, 0x9e, 0xf3, 0x07, 0xfb, 0xee, 0xf4, 0x46, 0x48, 0xc1, 0x4e, 0x19, 0x4b, 0x6f,
+    0x94, 0xae, 0x9a, 0xd3, 0xc1, 0x06, 0x60, 0xe3, 0x49, 0xfd, 0x9b, 0x00, 0xdf, 0x4e, 0x38, 0x35,
+    0x17, 0xab, 0x53, 0x7b, 0xdd, 0xd5, 0x8e, 0x77, 0x88, 0x3a, 0x45, 0x72, 0x4f, 0x58, 0xb2, 0x05,
+    0x0a, 0x7b, 0x0f, 0x72, 0x3e, 0x5c, 0xe5, 0xea, 0x69, 0x84, 0x28, 0x5f, 0xc5, 0x2e, 0xe2, 0xc2,
+    0xe8, 0xda, 0xfa, 0x5c, 0x07, 0xa3, 0x40, 0xc2, 0x2a, 0xcd, 0xde, 0x11, 0xa1, 0x29, 0x7c, 0x92,
+    0x4d, 0x76, 0x4e, 0x4a, 0xf3, 0xf0, 0xec, 0x8c, 0x07, 0xad, 0x5b, 0xec, 0x64, 0x41, 0xfd, 0xd7,
+    0x00, 0x37, 0xf7, 0xfe, 0xe7, 0x05, 0xfa, 0xa0, 0xfb, 0xcd, 0xef, 0x8b, 0xbc, 0x24, 0xdd, 0xd7,
+    0x9a, 0x4c, 0xc9, 0xde, 0x5b, 0x53, 0x27, 0x9b, 0xc9, 0x0d, 0x87, 0xb5, 0x9f, 0xbe, 0xa6, 0x54,
+    0x42, 0xba, 0x0b, 0x17, 0x7f, 0x30, 0xb7, 0xfe, 0x20, 0x4d, 0x62, 0x4a, 0xcc, 0x8c, 0x42, 0x38,
+    0x08, 0x25, 0x93, 0x2e, 0xb1, 0x60, 0x0d, 0xf5, 0x33, 0x8f, 0x31, 0x9f, 0x99, 0x14, 0x43, 0xf3,
+    0x65, 0x82, 0xb6, 0x49, 0x33, 0xf2, 0xad, 0x95, 0x2c, 0x86, 0x29, 0x39, 0x60, 0x1f, 0xe6, 0x90,
+    0x26, 0x97, 0x79, 0xe6, 0x11, 0xa7, 0x38, 0xc3, 0x38, 0x9a, 0x0c, 0xd8, 0x4d, 0x77, 0x19, 0xa3,
+    0x8d, 0x93, 0x49, 0xba, 0x67, 0x20, 0x02, 0xe1, 0x71, 0x7c, 0xe6, 0xdd, 0x47, 0x66, 0x4c, 0xc8,
+    0x80, 0x2e, 0x40, 0xea, 0xfa, 0x00, 0xd7, 0xea, 0x2f, 0x59, 0x8b, 0x80, 0xca, 0xa1, 0x55, 0xbd,
+    0x11, 0x84, 0x7a, 0x27, 0x4d, 0x22, 0x94, 0x0c, 0x5a, 0xee, 0x60, 0x38, 0x01, 0x1b, 0x1d, 0x2a,
+    0x94, 0x28, 0x72, 0xbe, 0x0c, 0x5e, 0x03, 0x80, 0x2e, 0x13, 0xf4, 0x9c, 0x19, 0xd4, 0xb3, 0xf7,
+    0x50, 0xc1, 0x5a, 0x38, 0xbc, 0x64, 0x53, 0x56, 0x8a, 0xea, 0xf4, 0x1c, 0xa1, 0x98, 0x08, 0xe3,
+    0x25, 0x25, 0xc5, 0x40, 0xfa, 0xbf, 0xdb, 0x21, 0x53, 0xfa, 0x8c, 0xcb, 0xba, 0x0c, 0x83, 0x0a,
+    0xda, 0x26, 0xad, 0x51, 0x14, 0x95, 0xde, 0x2c, 0x91, 0x9b, 0xfc, 0xfd, 0x69, 0xf3, 0xa4, 0x20,
+    0xfe, 0xd0, 0xe3, 0xdb, 0x90, 0x1f, 0x81, 0x2f, 0x33, 0xa2, 0x41, 0x40, 0xff, 0xd9, 0x66, 0x61,
+    0x23, 0x40, 0x01, 0x9f, 0x2e, 0x1e, 0xa3, 0x9c, 0x4a, 0x12, 0xcf, 0x9e, 0xbc, 0xc8, 0xce, 0x51,
+    0x3c, 0xad, 0xec, 0x0e, 0x2a, 0x8b, 0x10, 0x98, 0x0c, 0x2f, 0xa0, 0x6f, 0xef, 0xa1, 0xaa, 0x75,
+    0x0d, 0x0d, 0x17, 0x46, 0x63, 0x5e, 0xba, 0xae, 0x8c, 0x3b, 0xfa, 0x9a, 0xe8, 0xc8, 0x88, 0x0a,
+    0x3b, 0x5e, 0x53, 0x2a, 0x9b, 0x7c, 0xba, 0xb8, 0xe8, 0x43, 0xab, 0xc3, 0xa2, 0x16, 0x4a, 0x4c,
+    0x77, 0x16, 0x09, 0x91, 0x92, 0x92, 0x19, 0x03, 0xd7, 0x8c, 0xd2, 0xaf, 0x4b, 0x92, 0x74, 0x0f,
+    0xad, 0x38, 0x41, 0x17, 0x9e, 0xf5, 0x10, 0xb8, 0x4e, 0x5c, 0xa6, 0x08, 0x3c, 0xf8, 0xf3, 0xea,
+    0xf7, 0xdc, 0x3f, 0x90, 0xec, 0xe2, 0x7e, 0xc0, 0x2e, 0xd7, 0xd8, 0xbe, 0x9c, 0x93, 0xcd, 0x25,
+    0xec, 0xac, 0x5b, 0xe1, 0xf4, 0xfc, 0x3b, 0xc5, 0x54, 0x15, 0x6e, 0xfc, 0x86, 0x4a, 0x9e, 0xf8,
+    0x29, 0x07, 0x09, 0x95, 0x77, 0x04, 0x89, 0xf9, 0x51, 0xc9, 0x38, 0x7f, 0x31, 0xe1, 0x6e, 0x47,
+    0x8a, 0x88, 0xf4, 0x98, 0xac, 0x21, 0xe3, 0x31, 0xd4, 0x76, 0x11, 0x1d, 0x47, 0x5e, 0x90, 0xc9,
+    0xf5, 0xd8, 0x00, 0xb5, 0x91, 0x8c, 0x98, 0xb6, 0x9e, 0x7b, 0x01, 0x58, 0x2a, 0x36, 0x7b, 0x19,
+    0xa5, 0x75, 0xad, 0x5a, 0xd3, 0x91, 0xc0, 0x56, 0xf2, 0x05, 0x3e, 0xcf, 0x62, 0xea, 0xe6, 0x04,
+    0x1f, 0x68, 0x46, 0x95, 0xf7, 0x51, 0xf0, 0xb4, 0xae, 0xb5, 0x89, 0x28, 0x52, 0x6b, 0x57, 0x50,
+    0x70, 0x7d, 0x7c, 0x0d, 0x39, 0x9c, 0x49, 0xbb, 0x46, 0xe1, 0xa9, 0x09, 0xee, 0x5c, 0x97, 0xa4,
+    0x1a, 0x68, 0x69, 0x1c, 0xd2, 0x63, 0xd7, 0x49, 0x02, 0xa1, 0xdc, 0xb6, 0xc5, 0x87, 0x64, 0x98,
+    0xe9, 0x7e, 0x14, 0xea, 0x99, 0x49, 0xd8, 0x26, 0xa6, 0x6e, 0xa1, 0xe5, 0x4c, 0x6c, 0x8c, 0x76,
+    0xfa, 0x33, 0x92, 0x26, 0xb1, 0x47, 0xa9, 0xe0, 0x6d, 0x16, 0x2f, 0xa2, 0xd9, 0x66, 0x53, 0x69,
+    0x06, 0x30, 0x8c, 0x77, 0xa9, 0x93, 0x8d, 0xe1, 0xaf, 0x3c, 0xc8, 0x5f, 0xf8, 0xbd, 0x44, 0x77,
+    0xa6, 0xaf, 0x85, 0xf5, 0xcd, 0xee, 0x12, 0x1c, 0x93, 0x1e, 0xc6, 0x57, 0x1e, 0xbc, 0x5c, 0x44,
+    0xb1, 0x73, 0x4d, 0x76, 0xaf, 0x70, 0xcb, 0x26, 0xa1, 0xb6, 0x8b, 0xa3, 0xc0, 0xdb, 0xd0, 0x9d,
+    0x05, 0x8b, 0x87, 0x76, 0xf2, 0x20, 0x85, 0x61, 0xf8, 0x6a, 0x8f, 0x3f, 0x63, 0x7b, 0x74, 0x00,
+    0x0c, 0x68, 0xeb, 0x68, 0x67, 0x8a, 0xca, 0x82, 0xbe, 0xe6, 0x71, 0xdb, 0xaf, 0x2d, 0x0e, 0xb4,
+    0xac, 0x81, 0x8e, 0xaf, 0xb5, 0xd8, 0x25, 0x7e, 0x50, 0x77, 0xb8, 0x40, 0xd7, 0x50, 0xff, 0xd0,
+    0x2d, 0x13, 0x05, 0x5b, 0x9c, 0x40, 0xc5, 0x47, 0x15, 0x69, 0x1d, 0x3c, 0x55, 0xce, 0xe9, 0x90,
+    0xbb, 0x0c, 0x80, 0xd7, 0x5f, 0x6d, 0xe9, 0xbd, 0xc5, 0xd4, 0x36, 0x78, 0x97, 0x6b, 0x00, 0x2a,
+    0xdc, 0x87, 0x1c, 0x5b, 0x54, 0xd6, 0x99, 0x3e, 0xc8, 0x29, 0x59, 0xb8, 0x4e, 0x9c, 0xa7, 0xc4,
+    0xce, 0xc3, 0x00, 0xde, 0xe2, 0x2b, 0x67, 0x66, 0xb0, 0xd6, 0x7d, 0x33, 0x46, 0x5f, 0x10, 0x54,
+    0x0f, 0x81, 0x3b, 0x8b, 0x77, 0x27, 0x46, 0xd1, 0x8c, 0x37, 0x12, 0x32, 0x08, 0x65, 0xea, 0x1d,
+    0xb8, 0xeb, 0xd5, 0x8b, 0xe5, 0xf3, 0xd5, 0x54, 0xa0, 0xcc, 0x28, 0x25, 0x81, 0x6b, 0x38, 0x1d,
+    0x4b, 0xc6, 0x50, 0x34, 0xda, 0xe5, 0x7a, 0x02, 0xbd, 0x53, 0x2f, 0x68, 0x98, 0xe0, 0x3c, 0xb5,
+    0x75, 0xe0, 0x6e, 0x8d, 0x79, 0x82, 0x8c, 0xbd, 0x02, 0x35, 0x27, 0x46, 0x62, 0x71, 0x9f, 0x74,
+    0x75, 0x13, 0x0e, 0xfa, 0xb0, 0xbd, 0xd7, 0xa4, 0xf7, 0xc7, 0x77, 0xc9, 0xf2, 0xf1, 0xf4, 0x81,
+    0x14, 0x05, 0x8f, 0x47, 0x5f, 0x07, 0xce, 0x4a, 0x53, 0x3c, 0x61, 0xba, 0x96, 0x8c, 0xad, 0x0c,
+    0x6c, 0xc5, 0xfa, 0xd9, 0xc1, 0x2e, 0xf0, 0x35, 0x0f, 0x29, 0x32, 0xfe, 0x23, 0xe7, 0xf6, 0xd3,
+    0xd3, 0x3c, 0xe8, 0x9e, 0x75, 0x9d, 0x28, 0x8b, 0x25, 0x24, 0x07, 0x07, 0xb9, 0xf3, 0x11, 0x32,
+    0x83, 0x3b, 0x2b, 0x93, 0x31, 0x24, 0xa7, 0x8d, 0x5b, 0x6c, 0x63, 0xaf, 0x99, 0xe1, 0xbf, 0xd0,
+    0xab, 0x15, 0x97, 0x47, 0x38, 0x2d, 0xed, 0x84, 0xdc, 0x74, 0x7e, 0x10, 0x52, 0x98, 0xfe, 0xea,
+    0xc3, 0x44, 0x86, 0x06, 0x06, 0x7f, 0xc0, 0x15, 0xdd, 0x3a, 0x29, 0x93, 0xd8, 0x26, 0x8c, 0xcf,
+    0xa2, 0xd1, 0x9b, 0xbd, 0x69, 0x02, 0x8d, 0xf5, 0x03, 0x5c, 0xbf, 0xe1, 0x1e, 0xd5, 0x7e, 0xbe,
+    0x10, 0x60, 0x63, 0xfd, 0xe4, 0x50, 0x85, 0x39, 0xf5, 0x7c, 0xfa, 0x38, 0x9c, 0xf7, 0x74, 0xfa,
+    0x12, 0xf9, 0x43, 0x34, 0x08, 0x6b, 0xcd, 0x4c, 0x50, 0xae, 0xe4, 0x88, 0xed, 0xef, 0xc4, 0x60,
+    0x60, 0x5d, 0x24, 0xb2, 0xca, 0xcf, 0x33, 0x37, 0x7c, 0x5c, 0xd6, 0x07, 0x68, 0x74, 0x4b, 0x45,
+    0xe6, 0xa2, 0x85, 0x50, 0x0b, 0xbc, 0x9f, 0x45, 0xea, 0x5b, 0xda, 0xfb, 0x3e, 0xd4, 0x8c, 0x46,
+    0x26, 0xb7, 0xc1, 0x33, 0xa6, 0xa8, 0x41, 0x2f, 0xba, 0x22, 0x80, 0x19, 0x23, 0xa3, 0xca, 0x1c,
+    0x25, 0xfc, 0x97, 0x03, 0x9e, 0x9f, 0xe7, 0x30, 0xf6, 0x26, 0xf6, 0x55, 0x40, 0x7a, 0x10, 0x2e,
+    0x46, 0xf7, 0x39, 0xb8, 0xbd, 0xa6, 0x1f, 0xde, 0x87, 0x21, 0x61, 0xeb, 0x83, 0x14, 0x2f, 0x2b,
+    0xb6, 0xa0, 0xb4, 0x66, 0xe7, 0xac, 0xdc, 0x30, 0x00, 0xba, 0x60, 0x2b, 0xcf, 0x9f, 0xd9, 0x10,
+    0xbc, 0x8a, 0xe0, 0x9b, 0xee, 0x67, 0xab, 0xe9, 0x19, 0xed, 0xdc, 0x04, 0xd6, 0x95, 0x8c, 0xf4,
+    0x63, 0xb7, 0xd9, 0x03, 0xc0, 0x3a, 0x9b, 0x43, 0xf2, 0x06, 0x9c, 0x94, 0xdc, 0x56, 0xef, 0x4c,
+    0x3c, 0x35, 0x75, 0xc6, 0x58, 0xd4, 0x27, 0xdb, 0xb1, 0x07, 0x7a, 0x2d, 0x06, 0x67, 0x42, 0xbd,
+    0x49, 0x8a, 0x07, 0x60, 0x7a, 0xe0, 0xb2, 0x64, 0xf6, 0xb9, 0xd0, 0xb7, 0x09, 0xb6, 0xe5, 0x0a,
+    0xb4, 0x1c, 0x8e, 0x8b, 0x8e, 0x39, 0x21, 0x7e, 0x03, 0x9b, 0x4b, 0xce, 0xeb, 0xca, 0x1e, 0x75,
+    0x6d, 0xf1, 0xea, 0x2b, 0xf9, 0xc9, 0x55, 0x1a, 0xea, 0x6c, 0x2f, 0xc1, 0x5d, 0x98, 0xd2, 0x0a,
+    0x7f, 0x60, 0xed, 0x58, 0x06, 0x97, 0x94, 0x8a, 0x23, 0xf7, 0xc5, 0xb6, 0x56, 0x88, 0xc1, 0xff,
+    0xee, 0xcf, 0x99, 0x31, 0xbd, 0x26, 0x10, 0xa2, 0x40, 0xc5, 0xf9, 0x00, 0xf5, 0xe1, 0x2a, 0x2c,
+    0x87, 0x15, 0x2c, 0xc4, 0x6e, 0xd7, 0x79, 0x2b, 0x3f, 0x6b, 0xfc, 0x94, 0xcf, 0x9b, 0xad, 0x80,
+    0x88, 0x7d, 0x70, 0x24, 0x49, 0x96, 0x97, 0x35, 0xfd, 0x33, 0xfd, 0x14, 0x4c, 0xd5, 0xa5, 0x3c,
+    0x86, 0xd8, 0x42, 0x98, 0xa9, 0x36, 0xce, 0x77, 0x04, 0x09, 0x19, 0x76, 0x04, 0xca, 0xc9, 0x20,
+    0xb7, 0xb1, 0xbb, 0x2c, 0x96, 0x30, 0xf4, 0xff, 0x18, 0x28, 0x44, 0xf0, 0x07, 0x42, 0x07, 0xc8,
+    0x63, 0xab, 0x82, 0x17, 0xca, 0x93, 0xc1, 0xd1, 0xa0, 0x9f, 0x5b, 0x58, 0x2f, 0x7c, 0x63, 0xeb,
+    0xff, 0xf8, 0x10, 0x96, 0x98, 0x3d, 0x70, 0xbe, 0x24, 0x9b, 0x95, 0x0a, 0xab, 0xf0, 0x46, 0xdb,
+    0x47, 0x4d, 0x99, 0xbe, 0xc7, 0x6e, 0x3c, 0xf6, 0x8e, 0x44, 0x4a, 0x32, 0xfd, 0xc9, 0xa8, 0xb1,
+    0xf2, 0xda, 0x70, 0xcb, 0x68, 0xcb, 0x6e, 0xba, 0x06, 0xbf, 0x0c, 0xa5, 0x3b, 0xa1, 0x05, 0x5c,
+    0xee, 0x27, 0x75, 0xa2, 0x23, 0x9a, 0x0c, 0x83, 0x4f, 0x1c, 0xc4, 0x16, 0x44, 0xae, 0x3c, 0x67,
+    0xbb, 0x28, 0x24, 0x30, 0x56, 0xbe, 0x65, 0x8e, 0x00, 0x1b, 0xc2, 0x2a, 0x6a, 0x88, 0xf0, 0x83,
+    0x49, 0xea, 0x02, 0x3e, 0x4b, 0x14, 0x19, 0x48, 0x71, 0xaf, 0xac, 0x0a, 0xec, 0xbf, 0x8b, 0x49,
+    0x52, 0xbb, 0x6a, 0x71, 0x3b, 0x9f, 0xe3, 0x4c, 0xaf, 0x78, 0xc9, 0x7b, 0x35, 0x1e, 0x80, 0x86,
+    0x67, 0x90, 0x48, 0x18, 0xde, 0x46, 0xb9, 0x99, 0x09, 0x0a, 0xd7, 0x85, 0x24, 0x84, 0x62, 0xfe,
+    0xa4, 0xc2, 0x89, 0x82, 0x35, 0x1b, 0x36, 0xb0, 0x53, 0xb4, 0xc2, 0x72, 0xf5, 0x18, 0x50, 0xf8,
+    0x15, 0x3d, 0x08, 0x22, 0xdf, 0x08, 0x3c, 0x05, 0xf2, 0x27, 0xa4, 0x1f, 0x5b, 0x86, 0x31, 0x14,
+    0xb1, 0x2a, 0xa7, 0xaa, 0xe1, 0xf0, 0x51, 0x6c, 0xd4, 0xab, 0x09, 0xe9, 0xa2, 0xd8, 0x46, 0xc0,
+    0xcf, 0x24, 0x08, 0xe1, 0x7d, 0xf9, 0xb0, 0xb6, 0x47, 0x84, 0xca, 0x1c, 0xc8, 0x55, 0x27, 0x6b,
+    0x8b, 0x0c, 0x23, 0x08, 0xd9, 0x9a, 0x38, 0x44, 0xb6, 0xc9, 0x29, 0xe0, 0xef, 0x0d, 0x16, 0xf1,
+    0x7b, 0xe2, 0xdf, 0xb4, 0xa5, 0x32, 0x07, 0x49, 0xf3, 0x7a, 0x00, 0xa1, 0x62, 0x59, 0xc7, 0x56,
+    0xdb, 0xbe, 0x5d, 0xb8, 0x6a, 0xca, 0xf6, 0x78, 0xb0, 0xe9, 0x94, 0x84, 0xfb, 0xe0, 0x1f, 0xa0,
+    0x5c, 0x07, 0x6e, 0x90, 0xa4, 0x5f, 0xaa, 0xec, 0xaf, 0x84, 0xb4, 0x0f, 0xb7, 0xb1, 0x13, 0x22,
+    0xea, 0xf2, 0xd4, 0xbb, 0xde, 0x32, 0xa9, 0xac, 0x59, 0xd2, 0x19, 0xea, 0x18, 0x1f, 0x30, 0xc5,
+    0x53, 0x43, 0x8d, 0x1d, 0x04, 0x18, 0xba, 0xb1, 0x0c, 0x8d, 0xa1, 0x40, 0x6d, 0x71, 0xab, 0x49,
+    0x20, 0x07, 0xb9, 0x2e, 0xf8, 0x8c, 0x97, 0xce, 0xad, 0x52, 0x72, 0x73, 0x06, 0xe7, 0x70, 0xed,
+    0xa5, 0x0b, 0xda, 0xd4, 0xc8, 0x02, 0x0c, 0x71, 0xd4, 0xfe, 0x2c, 0x08, 0x44, 0x5a, 0xb3, 0x07,
+    0xe0, 0xd2, 0x9f, 0x2a, 0xbb, 0xd4, 0x3f, 0xef, 0xcd, 0x4a, 0x78, 0x98, 0x86, 0x9a, 0x8e, 0xe0,
+    0xfe, 0x54, 0x92, 0xa4, 0x98, 0x81, 0xbe, 0xa5, 0xed, 0x77, 0x54, 0x39, 0x6e, 0xcf, 0x4e, 0x59,
+    0x19, 0x40, 0x4d, 0x34, 0x86, 0x2b, 0x60, 0x6b, 0xfb, 0xc6, 0x5a, 0x37, 0x34, 0xd9, 0xec, 0xc9,
+    0xa6, 0x10, 0x61, 0xfe, 0x3b, 0x4c, 0xa6, 0x7c, 0x2d, 0xc4, 0xf8, 0x43, 0xdc, 0x03, 0xa5, 0x9e,
+    0x11, 0x0a, 0x5e, 0xff, 0xa8, 0x0b, 0xca, 0xad, 0xba, 0x7a, 0x8b, 0x45, 0xe9, 0xcc, 0x41, 0x8b,
+    0xc0, 0xc5, 0x2b, 0x7d, 0xf3, 0x88, 0x5d, 0x30, 0x98, 0xd7, 0x61, 0x8f, 0xa6, 0xa4, 0x73, 0x7c,
+    0xf2, 0x76, 0xd8, 0x5b, 0x22, 0xab, 0x70, 0x45, 0x32, 0xde, 0x49, 0x2c, 0xdd, 0x04, 0xca, 0x90,
+    0xd9, 0x8c, 0x19, 0x8a, 0xa3, 0xe4, 0x02, 0xc4, 0x1e, 0x75, 0x58, 0xcf, 0x42, 0xc8, 0x58, 0xe6,
+    0x84, 0x0b, 0xa8, 0x21, 0xbe, 0x18, 0xb2, 0xef, 0x6d, 0x4b, 0x24, 0x0d, 0xe7, 0x95, 0xe2, 0xdb,
+    0xdf, 0x92, 0x6e, 0x22, 0x3d, 0xa6, 0x68, 0xd0, 0x4a, 0x52, 0x8c, 0xc6, 0xdc, 0xcd, 0x3a, 0xa7,
+    0x2d, 0xee, 0x64, 0x41, 0xfc, 0x3b, 0x39, 0xa9, 0x70, 0x0e, 0xb0, 0xf7, 0xe2, 0x9f, 0x20, 0x25,
+    0x73, 0xa2, 0x34, 0xe9, 0xa4, 0xa7, 0x3e, 0x18, 0x92, 0xb8, 0xb4, 0xaa, 0x25, 0x0f, 0x54, 0xb8,
+    0x14, 0x9e, 0x19, 0x59, 0xcd, 0xc5, 0xfb, 0xba, 0x34, 0x72, 0x82, 0x3c, 0xb1, 0xcf, 0x4f, 0xd5,
+    0x0f, 0x4c, 0xd8, 0x6b, 0x0b, 0xc1, 0xca, 0xbb, 0x38, 0x44, 0xed, 0xbe, 0x8f, 0x1d, 0x13, 0x88,
+    0xf1, 0xe2, 0xff, 0xd1, 0x07, 0x38, 0xc1, 0xc9, 0x16, 0x00, 0xa6, 0x10, 0x4b, 0x16, 0x9e, 0xcb,
+    0x1f, 0x0f, 0x11, 0x8f, 0x32, 0x75, 0x35, 0x96, 0xc8, 0x0c, 0xac, 0x67, 0x54, 0x85, 0x4e, 0xe4,
+    0xe3, 0x61, 0x2f, 0x74, 0xbb, 0xf3, 0xb4, 0xca, 0x20, 0x24, 0xd6, 0x34, 0x29, 0x82, 0x5b, 0xc8,
+    0xd0, 0xbc, 0x65, 0x54, 0x53, 0x9d, 0x1d, 0xb7, 0xba, 0xf4, 0x36, 0x1d, 0x17, 0x85, 0xb7, 0x5b,
+    0x85, 0xcb, 0xca, 0x3d, 0x9a, 0x19, 0x33, 0xfe, 0xb7, 0x25, 0x7b, 0xa0, 0xd0, 0xa7, 0x4c, 0x26,
+    0xd3, 0x28, 0xf5, 0x11, 0x0c, 0x3c, 0x60, 0xa7, 0xc9, 0x30, 0x74, 0xf3, 0xf0, 0xcc, 0x51, 0xc6,
+    0x65, 0xf9, 0xe7, 0xc0, 0x5a, 0x78, 0x29, 0x3c, 0x76, 0xe7, 0xa2, 0x82, 0x89, 0x0a, 0xe3, 0x4e,
+    0xcd, 0x20, 0x90, 0x1f, 0x08, 0x9b, 0xf6, 0x96, 0x9e, 0xc6, 0xd3, 0x2d, 0x13, 0x0d, 0xb1, 0xb7,
+    0xa4, 0xa8, 0x52, 0x30, 0xf4, 0x5f, 0xcb, 0x4c, 0x61, 0x8d, 0x8e, 0x31, 0x04, 0x83, 0x11, 0x19,
+    0x1d, 0xbc, 0x88, 0x9b, 0xf6, 0x89, 0xf0, 0xe4, 0xf4, 0x3f, 0x22, 0x69, 0x09, 0xaf, 0x8a, 0x2c,
+    0x3a, 0x56, 0x33, 0xd0, 0x88, 0x0c, 0x05, 0x1d, 0x46, 0xcb, 0x7f, 0xa4, 0x3b, 0x65, 0x0b, 0x2f,
+    0xe4, 0x42, 0x6c, 0xb0, 0x34, 0xe0, 0x75, 0xd7, 0xf3, 0xad, 0x27, 0x60, 0x9c, 0xee, 0xcf, 0xfe,
+    0x6a, 0x34, 0x1b, 0x15, 0xd6, 0x39, 0xa8, 0xdd, 0x15, 0xdd, 0xd2, 0xc0, 0x6e, 0x33, 0xa6, 0xe0,
+    0xb5, 0xf6, 0x93, 0xe3, 0xec, 0x21, 0x18, 0x56, 0x03, 0x36, 0xbf, 0x2c, 0x93, 0x6e, 0xea, 0xc6,
+    0xae, 0xfc, 0xa0, 0xa4, 0xc1, 0x8f, 0xa6, 0xbc, 0x6f, 0xc6, 0xd5, 0x94, 0xc7, 0x61, 0x5b, 0x84,
+    0xa0, 0x70, 0xec, 0x4e, 0x4d, 0xae, 0xac, 0x6f, 0xf7, 0x45, 0x37, 0xc6, 0x5d, 0x03, 0x20, 0xe7,
+    0xf7, 0x8e, 0xef, 0xc4, 0x49, 0xce, 0x53, 0x40, 0x8b, 0xfe, 0x7f, 0x0f, 0x7a, 0x93, 0x0d, 0xc8,
+    0xb5, 0xe7, 0x0c, 0xb7, 0xab, 0xdb, 0xd7, 0xfd, 0x22, 0x22, 0xae, 0xc2, 0xe8, 0x02, 0xb9, 0x02,
+    0x09, 0x1c, 0x58, 0xfd, 0x62, 0x00, 0x2d, 0x15, 0x50, 0xa1, 0x05, 0xf3, 0xff, 0x4f, 0x35, 0x7b,
+    0x95, 0x40, 0x49, 0x1f, 0xf8, 0x3e, 0x96, 0x82, 0xfa, 0x04, 0x55, 0x75, 0x10, 0x06, 0x6f, 0x17,
+    0x67, 0x97, 0x2a, 0xbd, 0x36, 0xb0, 0x2d, 0xc7, 0xe5, 0x6a, 0x4b, 0xe3, 0xc7, 0xcf, 0x87, 0x66,
+    0x49, 0xa1, 0xee, 0xe9, 0x6c, 0xc5, 0xd4, 0xb1, 0x03, 0x44, 0xf5, 0x37, 0x4c, 0x33, 0x66, 0x85,
+    0x87, 0xaa, 0xb6, 0x22, 0xcf, 0xeb, 0xb1, 0x96, 0x24, 0x07, 0x01, 0x03, 0x86, 0xac, 0x55, 0x71,
+    0x26, 0x68, 0x9b, 0x88, 0x4d, 0x17, 0xbb, 0x53, 0x66, 0x94, 0x12, 0x2d, 0xdd, 0xb3, 0x44, 0xf0,
+    0x45, 0x65, 0xe8, 0x2c, 0x58, 0xe4, 0x7d, 0xe0, 0xd3, 0x8e, 0x13, 0x56, 0x52, 0x90, 0x40, 0xad,
+    0x0e, 0x57, 0x80, 0x21, 0x9c, 0xe1, 0x5e, 0xad, 0x5b, 0xcf, 0xad, 0xb1, 0x43, 0x78, 0x7c, 0xf5,
+    0xf7, 0x12, 0xf5, 0x8e, 0x13, 0xca, 0x06, 0xb4, 0xa0, 0xde, 0x4d, 0x65, 0xb1, 0xb6, 0x66, 0x16,
+    0x37, 0x70, 0xc2, 0x83, 0xc5, 0xfa, 0xdd, 0x23, 0x7d, 0x30, 0x66, 0x94, 0xd2, 0x5d, 0x92, 0x3a,
+    0x05, 0x28, 0x82, 0x05, 0x50, 0x18, 0x1f, 0xfc, 0x7a, 0x46, 0x09, 0xe5, 0xfc, 0xd4, 0xe2, 0x06,
+    0x57, 0xae, 0x16, 0x57, 0x2e, 0x09, 0xe3, 0x1f, 0x93, 0xbc, 0x5e, 0x67, 0x0e, 0x58, 0x5a, 0xbb,
+    0x0d, 0x49, 0x85, 0x2f, 0x0d, 0x71, 0xa0, 0x16, 0xb3, 0xc6, 0x44, 0x62, 0x24, 0xf3, 0x30, 0x7c,
+    0x79, 0xfc, 0xd8, 0xd9, 0xeb, 0xac, 0xbd, 0x7b, 0xb5, 0x84, 0x0d, 0xf3, 0x3c, 0xab, 0x99, 0x8d,
+    0x11, 0x79, 0x8c, 0x6f, 0x04, 0x70, 0x2c, 0x99, 0x01, 0xce, 0xb8, 0x0d, 0x7a, 0x10, 0x62, 0x80,
+    0x92, 0x7c, 0x96, 0xa5, 0x2b, 0x81, 0x8c, 0x15, 0x8f, 0x78, 0x59, 0x4b, 0x7d, 0x96, 0x53, 0x12,
+    0xc0, 0x5b, 0x40, 0xe9, 0x7e, 0x48, 0xd1, 0x19, 0xa6, 0xfa, 0xc5, 0xd8, 0x86, 0xb3, 0x7e, 0xcc,
+    0x65, 0xf0, 0x5b, 0x74, 0xdf, 0x3a, 0x5a, 0x95, 0x56, 0x90, 0x1e, 0xc7, 0x18, 0xa3, 0x28, 0x1d,
+    0x74, 0xe4, 0x22, 0x8d, 0x46, 0x3a, 0x69, 0x0e, 0xa0, 0x1d, 0x4a, 0xf1, 0xb0, 0xa1, 0xe1, 0xcb,
+    0x5d, 0x22, 0x78, 0xea, 0x46, 0x58, 0xb8, 0xd7, 0x10, 0xe6, 0x52, 0xc8, 0xb0, 0x00, 0xa1, 0xad,
+    0x4b, 0xb4, 0x90, 0xdc, 0x7e, 0x12, 0x6a, 0x38, 0x9a, 0x45, 0x9b, 0xca, 0x29, 0x37, 0x0b, 0x7a,
+    0xcf, 0xc5, 0xdf, 0x1f, 0x8d, 0x3d, 0x90, 0xf2, 0xda, 0x72, 0x21, 0x70, 0x36, 0xd0, 0x7e, 0x8d,
+    0x45, 0x8d, 0x64, 0x6c, 0x46, 0x5a, 0xba, 0x83, 0xd8, 0xd8, 0x24, 0x7a, 0x52, 0xb9, 0x73, 0x46,
+    0x92, 0x23, 0x27, 0xa7, 0x1e, 0xc0, 0x8e, 0xf0, 0x61, 0xab, 0xd9, 0x81, 0x39, 0x70, 0xd1, 0x37,
+    0xb8, 0x1a, 0x9e, 0xae, 0xf7, 0x92, 0x3d, 0x7a, 0x23, 0xa9, 0xbf, 0xcf, 0xeb, 0x4d, 0x65, 0x5c,
+    0x3c, 0x68, 0x43, 0x86, 0xf0, 0xef, 0x91, 0x4c, 0x8b, 0x9d, 0x79, 0x1a, 0xd4, 0xa6, 0x6b, 0xac,
+    0xe1, 0x89, 0x41, 0x8d, 0x36, 0x37, 0x49, 0x80, 0xda, 0xc1, 0xa2, 0x05, 0x18, 0x9f, 0xe6, 0x0b,
+    0x2d, 0xd7, 0xe9, 0x8d, 0xcd, 0x61, 0xa4, 0x78, 0xb6, 0x0c, 0xc2, 0xd4, 0xd4, 0x2c, 0x37, 0xf2,
+    0x4d, 0xa5, 0x9c, 0x74, 0x88, 0x10, 0x1d, 0xc5, 0x85, 0x02, 0x39, 0xf9, 0x1d, 0x27, 0xf3, 0x13,
+    0xde, 0x8f, 0x4f, 0x38, 0xfc, 0x21, 0x35, 0x5e, 0x0a, 0xc0, 0x2e, 0xe9, 0xdf, 0xcc, 0x3a, 0x91,
+    0xb6, 0xa3, 0xfb, 0x77, 0x3c, 0xb0, 0x4a, 0x9f, 0xc0, 0x76, 0x1a, 0x7a, 0x76, 0x24, 0xee, 0xc0,
+    0x8c, 0xde, 0xa3, 0x2c, 0xfa, 0xac, 0xe2, 0xaa, 0x54, 0x91, 0x71, 0x59, 0x30, 0xde, 0xc9, 0x4b,
+    0xdb, 0x22, 0x79, 0x05, 0x1c, 0x9e, 0x98, 0xd8, 0xcb, 0x04, 0x9f, 0xf0, 0x1a, 0x7a, 0x11, 0x8a,
+    0xbb, 0xfc, 0xc9, 0x85, 0x87, 0xed, 0x3b, 0x31, 0x5f, 0xc4, 0x01, 0x48, 0xf1, 0x59, 0x77, 0xde,
+    0x5d, 0xd8, 0xb1, 0x7a, 0xaa, 0xd7, 0xeb, 0x24, 0x16, 0x69, 0x3c, 0x6c, 0x9f, 0xe2, 0xeb, 0xaa,
+    0x33, 0x7b, 0x3d, 0xf2, 0xb7, 0xbf, 0x8a, 0xf9, 0x2d, 0xfb, 0x78, 0x26, 0x8a, 0xac, 0x7f, 0xd4,
+    0x15, 0x25, 0xbc, 0xdc, 0x4e, 0x98, 0x34, 0x19, 0xfc, 0x22, 0xe1, 0x6d, 0xc0, 0x93, 0xca, 0x8f,
+    0x2b, 0x37, 0x8f, 0x6a, 0xb5, 0x54, 0x0d, 0xe3, 0xe4, 0xd9, 0x2a, 0x8e, 0x0d, 0x4e, 0x68, 0x47,
+    0x29, 0x39, 0xe4, 0x37, 0x2d, 0x8d, 0xc5, 0xa7, 0x90, 0xeb, 0x0d, 0xe9, 0x65, 0x21, 0x7b, 0x28,
+    0x06, 0x4b, 0xca, 0x71, 0xf2, 0x97, 0x70, 0xa7, 0xa9, 0xf9, 0x3e, 0x76, 0xd1, 0x84, 0x35, 0x71,
+    0x17, 0x7a, 0xa6, 0x6e, 0x2a, 0x52, 0x6f, 0x99, 0xc8, 0x26, 0xfc, 0x6f, 0x02, 0xe3, 0x1a, 0xc2,
+    0xed, 0x53, 0x67, 0x32, 0xd1, 0x65, 0xf7, 0x44, 0x8d, 0x9a, 0xf7, 0x49, 0xff, 0xb6, 0xd4, 0x51,
+    0x21, 0x10, 0x2a, 0x56, 0x13, 0x04, 0xab, 0x0b, 0x86, 0x30, 0xaf, 0xd7, 0x30, 0xec, 0x10, 0xe0,
+    0x92, 0x07, 0x17, 0xdb, 0x3f, 0x39, 0x73, 0x2f, 0xd4, 0x0f, 0x12, 0xad, 0x19, 0x26, 0x36, 0x2b,
+    0xb6, 0xdf, 0x00, 0x32, 0xdf, 0xa0, 0x31, 0xd9, 0x94, 0x7c, 0xa8, 0x39, 0x65, 0x39, 0x8c, 0x6c,
+    0x12, 0x3f, 0x54, 0x30, 0x6e, 0xef, 0x66, 0x48, 0x61, 0x6b, 0xe7, 0xca, 0xd6, 0xf5, 0x6e, 0x64,
+    0x01, 0xba, 0x2c, 0xac, 0x3c, 0x4b, 0x2b, 0x73, 0x87, 0xbb, 0xd2, 0x27, 0xd2, 0x9d, 0x4a, 0xcd,
+    0x4f, 0x03, 0x36, 0x1e, 0x50, 0x40, 0xb9, 0x70, 0xce, 0x41, 0xbc, 0x62, 0xbe, 0x6d, 0x69, 0xd9,
+    0x85, 0xb9, 0x42, 0x0e, 0xf9, 0x4b, 0xb2, 0x05, 0x9b, 0x94, 0xf7, 0xbc, 0x45, 0x30, 0x46, 0x7f,
+    0x47, 0x34, 0x52, 0x39, 0xe4, 0x0e, 0x07, 0x19, 0x77, 0xc5, 0x19, 0xb9, 0xca, 0x34, 0x49, 0x8e,
+    0xf7, 0x07, 0x93, 0x10, 0x6e, 0x44, 0x44, 0x4f, 0x70, 0x71, 0xde, 0x77, 0x29, 0x49, 0xd8, 0x09,
+    0x68, 0xd9, 0x23, 0xff, 0xad, 0x70, 0x17, 0xbb, 0xb0, 0x38, 0xa9, 0xb3, 0x30, 0x94, 0x15, 0x09,
+    0xa2, 0x02, 0x40, 0xab, 0xab, 0x02, 0xc9, 0x63, 0x5a, 0x86, 0x2e, 0xd2, 0xa8, 0x5f, 0x42, 0x97,
+    0x31, 0x12, 0x42, 0xcc, 0xf2, 0x47, 0xf3, 0xed, 0xba, 0xb2, 0x82, 0x18, 0x17, 0xff, 0xe1, 0x0e,
+    0x53, 0x24, 0x7d, 0x8c, 0x76, 0x0a, 0xdf, 0xf3, 0x63, 0x69, 0x56, 0x31, 0x06, 0x94, 0x66, 0x11,
+    0x4a, 0x1a, 0x32, 0x5d, 0x55, 0xcb, 0x5c, 0xe0, 0x58, 0x03, 0x58, 0x78, 0x11, 0x85, 0x96, 0xe7,
+    0xad, 0xba, 0x6b, 0xa1, 0x67, 0x76, 0xeb, 0x03, 0x5a, 0x79, 0xc8, 0xd1, 0xd4, 0x76, 0x48, 0x01,
+    0x68, 0x87, 0xd9, 0xb7, 0x05, 0xfe, 0xa7, 0xeb, 0x70, 0x67, 0xae, 0x17, 0x86, 0xf9, 0xe5, 0x21,
+    0x06, 0x7a, 0x89, 0x04, 0x35, 0x43, 0x45, 0x6e, 0x14, 0x1d, 0x06, 0xc9, 0xa9, 0x7e, 0x00, 0xed,
+    0x3f, 0x9a, 0xa8, 0xd3, 0x2b, 0x0d, 0xe0, 0x71, 0xa7, 0x53, 0x52, 0x13, 0xc8, 0xaa, 0xf3, 0x38,
+    0x8f, 0xcb, 0x3e, 0xf0, 0x6e, 0xe1, 0x83, 0xb2, 0x84, 0x58, 0x1e, 0x0b, 0x7e, 0x7b, 0x9f, 0x2e,
+    0x30, 0xb5, 0x3b, 0xcc, 0x09, 0x89, 0x02, 0xa8, 0x25, 0x4a, 0xee, 0xde, 0x8d, 0xe1, 0xec, 0x57,
+    0x74, 0x8f, 0x56, 0xf3, 0xae, 0x0c, 0xcd, 0xb3, 0x8a, 0x44, 0x0c, 0x4a, 0x89, 0x27, 0x9e, 0x01,
+    0xb3, 0x8a, 0x6d, 0x12, 0x53, 0x43, 0xe3, 0xe0, 0x64, 0x14, 0x61, 0xac, 0xdf, 0xd2, 0x1f, 0x49,
+    0x11, 0x54, 0x7e, 0x2a, 0x7d, 0x50, 0x5e, 0x1c, 0x3a, 0x38, 0x2d, 0x68, 0x38, 0x83, 0xd5, 0x44,
+    0x33, 0x5f, 0x7c, 0x78, 0x25, 0x81, 0x81, 0x93, 0x51, 0x79, 0x1f, 0x95, 0xd4, 0x7f, 0xef, 0x49,
+    0x58, 0x6a, 0xdc, 0xfb, 0x3d, 0xed, 0xe4, 0xb1, 0x36, 0x2b, 0xec, 0xd9, 0x6f, 0x54, 0x43, 0x5d,
+    0x42, 0x40, 0x84, 0xf1, 0x81, 0xd2, 0x19, 0x49, 0x3b, 0x29, 0x38, 0x6c, 0xa7, 0xb0, 0x2f, 0x2c,
+    0xb8, 0xf6, 0x86, 0xed, 0xd5, 0x72, 0x59, 0x7d, 0xb4, 0x6c, 0xe4, 0x3c, 0x3f, 0x8f, 0xfa, 0xac,
+    0x67, 0x09, 0x6b, 0x79, 0xb8, 0xfc, 0x64, 0x11, 0xa6, 0x1b, 0xee, 0x33, 0xb2, 0x56, 0xcb, 0xa7,
+    0x6b, 0x62, 0x27, 0xae, 0xee, 0xa9, 0x5a, 0x7b, 0x69, 0xb5, 0x11, 0x1c, 0x39, 0x1e, 0x29, 0x5b,
+    0x32, 0x7d, 0x2f, 0x54, 0x35, 0xd0, 0x97, 0x99, 0xbb, 0x66, 0xc1, 0xed, 0x87, 0x67, 0x06, 0xf8,
+    0x56, 0x38, 0xbd, 0xd8, 0xec, 0xa7, 0x87, 0x4c, 0x96, 0x12, 0x63, 0x1f, 0x55, 0x0b, 0x54, 0x97,
+    0xf0, 0x95, 0x05, 0xc2, 0x57, 0x00, 0x9e, 0x04, 0x55, 0x86, 0x15, 0xe3, 0x00, 0x25, 0x0a, 0x04,
+    0x17, 0x10, 0x44, 0xcf, 0xb5, 0xb9, 0xc5, 0x14, 0x4c, 0x27, 0x4c, 0xbf, 0x23, 0x29, 0xed, 0x0f,
+    0x29, 0x41, 0xa6, 0x9c, 0x34, 0xab, 0x02, 0x56, 0x7d, 0xfa, 0x41, 0xc9, 0xc7, 0xa2, 0x64, 0xe3,
+    0x79, 0x7e, 0x73, 0x03, 0x2f, 0x74, 0x24, 0xdc, 0x9e, 0xb8, 0x75, 0x60, 0x01, 0x3c, 0x31, 0x5d,
+    0x9b, 0xf7, 0x35, 0xae, 0x96, 0xfc, 0x36, 0x99, 0xad, 0xb9, 0xe2, 0xc9, 0x1d, 0x5e, 0xca, 0x71,
+    0xb4, 0x79, 0xf0, 0x3f, 0x3d, 0x9d, 0xd7, 0xdc, 0xd7, 0x27, 0x71, 0xc0, 0xda, 0xa4, 0x5d, 0x89,
+    0x82, 0xed, 0xda, 0xa0, 0x23, 0xf7, 0xd6, 0xc9, 0x23, 0x91, 0x30, 0x02, 0x87, 0xf0, 0x24, 0x33,
+    0x91, 0x7a, 0xf4, 0xcb, 0xa3, 0xb7, 0x38, 0xb4, 0x36, 0x12, 0xfd, 0xd8, 0x88, 0x07, 0x74, 0xd4,
+    0x84, 0x83, 0x27, 0x61, 0x2b, 0xb2, 0xbd, 0xe9, 0x89, 0xf1, 0x52, 0x9d, 0xb0, 0x26, 0xb7, 0x84,
+    0x62, 0x65, 0xad, 0x29, 0x8c, 0xc8, 0xbf, 0x1b, 0xf4, 0xfd, 0xa5, 0x2a, 0xdd, 0x2f, 0x26, 0x60,
+    0xfc, 0xbf, 0xac, 0x6b, 0x04, 0x7c, 0xfd, 0x74, 0xd0, 0x69, 0xe4, 0x00, 0x06, 0x98, 0xbf, 0x51,
+    0xe1, 0x02, 0x4c, 0xf5, 0xc2, 0x79, 0x51, 0x23, 0x02, 0x96, 0xb4, 0xd2, 0xb7, 0xdf, 0x06, 0x74,
+    0x22, 0x4e, 0xc8, 0xe9, 0xbf, 0x73, 0x62, 0x9c, 0xea, 0xdd, 0xfb, 0xc5, 0x45, 0xb5, 0xc3, 0x7d,
+    0xe8, 0x11, 0xc2, 0xe3, 0x44, 0x67, 0xe7, 0xe0, 0x92, 0x10, 0x44, 0xfc, 0x0a, 0x61, 0xfd, 0x5b,
+    0x66, 0xf6, 0xc5, 0x0c, 0x27, 0x4a, 0x97, 0x27, 0x80, 0x6f, 0xc8, 0xa4, 0x93, 0x62, 0x62, 0x6a,
+    0x8a, 0x4b, 0xb2, 0x17, 0x11, 0x69, 0x62, 0x3f, 0xf5, 0x66, 0xfa, 0x9a, 0xff, 0x8b, 0x41, 0xb0,
+    0x69, 0xad, 0x5f, 0x40, 0x05, 0x6a, 0xee, 0x52, 0x14, 0x6f, 0x95, 0xab, 0x65, 0x61, 0xa1, 0x6e,
+    0x8f, 0x57, 0xe0, 0x89, 0xa7, 0x9a, 0x45, 0xa1, 0x29, 0x42, 0xdb, 0x11, 0x7d, 0x43, 0xa5, 0x3f,
+    0xfa, 0x87, 0xce, 0x09, 0xa6, 0x04, 0x24, 0x40, 0x78, 0xcd, 0x49, 0xea, 0x93, 0x48, 0xa9, 0x30,
+    0xae, 0x39, 0x89, 0x72, 0xd7, 0xb6, 0xb8, 0x7f, 0x08, 0x51, 0xcc, 0x3b, 0x37, 0x32, 0x01, 0x03,
+    0x0a, 0x70, 0xa9, 0x34, 0x7d, 0x76, 0x2e, 0x6d, 0x57, 0x1b, 0x1c, 0xd4, 0x70, 0x18, 0x07, 0x96,
+    0xb2, 0xf7, 0x27, 0xca, 0x8a, 0x57, 0x62, 0xab, 0xca, 0x27, 0x03, 0x30, 0xed, 0x4d, 0x0e, 0xd9,
+    0xe2, 0x11, 0x17, 0xc0, 0xf2, 0xd2, 0xeb, 0x24, 0x38, 0x07, 0x4e, 0xcb, 0x90, 0xfc, 0xfd, 0x26,
+    0x21, 0xd8, 0x0e, 0x1c, 0xd3, 0xf7, 0x0e, 0x57, 0x8a, 0x03, 0x2d, 0xc9, 0x45, 0x33, 0x6d, 0x78,
+    0x4e, 0x61, 0x49, 0x3f, 0xd9, 0x5a, 0xd2, 0x33, 0x14, 0xe3, 0x01, 0xf2, 0xf5, 0x41, 0x92, 0x9b,
+    0xc7, 0x6a, 0xe7, 0xe9, 0x3b, 0x30, 0x2c, 0x4a, 0x18, 0x78, 0x8d, 0x2c, 0x4f, 0x34, 0x05, 0xb8,
+    0xdf, 0x32, 0x21, 0x66, 0x33, 0x3e, 0x88, 0x75, 0x90, 0x42, 0x47, 0x7b, 0x60, 0x68, 0xd4, 0x17,
+    0xa8, 0x43, 0xff, 0x28, 0xe7, 0x96, 0xac, 0xeb, 0x1e, 0x7e, 0x84, 0x7e, 0x46, 0x3b, 0xab, 0xe2,
+    0x5e, 0xe5, 0x4e, 0x76, 0x93, 0xaf, 0x71, 0x37, 0x15, 0x5e, 0xf1, 0x1b, 0xf3, 0x90, 0x82, 0xe3,
+    0xc3, 0x00, 0x7a, 0xb5, 0x99, 0x93, 0xb5, 0xc5, 0x18, 0x44, 0xbb, 0x2b, 0x75, 0x9e, 0x88, 0x25,
+    0x9d, 0x70, 0x50, 0xeb, 0x64, 0xcf, 0xaf, 0xa2, 0x66, 0x89, 0xc8, 0xf1, 0x67, 0x83, 0x9d, 0x1a,
+    0xb4, 0xfd, 0xf8, 0xb2, 0xe7, 0xbd, 0xe2, 0x92, 0xf6, 0x7e, 0x0f, 0xd7, 0x90, 0x86, 0x94, 0x76,
+    0x2f, 0xde, 0x42, 0x9d, 0x36, 0x42, 0x11, 0x24, 0x0d, 0x1c, 0x42, 0x28, 0x6b, 0x98, 0xbc, 0xfd,
+    0xd3, 0xde, 0x98, 0xc8, 0xb9, 0x3d, 0x70, 0xa3, 0x12, 0x4a, 0xac, 0xf6, 0x97, 0x71, 0xc8, 0x72,
+    0x5f, 0xaf, 0xce, 0x65, 0x7c, 0xee, 0x16, 0xa1, 0x35, 0xb6, 0x4f, 0xde, 0x87, 0xd2, 0xd2, 0xdb,
+    0x41, 0xd9, 0x1b, 0x50, 0x42, 0x6e, 0x0f, 0xc7, 0xb8, 0x17, 0xa5, 0xa7, 0x0e, 0x72, 0x8e, 0x88,
+    0xa8, 0xef, 0x59, 0x41, 0x50, 0x84, 0x40, 0x4c, 0xe0, 0xaf, 0x04, 0xfa, 0x16, 0x38, 0x15, 0xd2,
+    0x24, 0xf3, 0x3a, 0xcd, 0x37, 0xde, 0xe7, 0x54, 0x2f, 0x84, 0xa0, 0x18, 0x10, 0x0f, 0xd5, 0x6a,
+    0xae, 0x03, 0xbc, 0xbb, 0xbb, 0xfc, 0xa6, 0x44, 0x72, 0xc6, 0x52, 0x34, 0xa1, 0xe0, 0x4c, 0xca,
+    0xd8, 0xa7, 0x7a, 0x54, 0x96, 0x5d, 0x5d, 0xfe, 0x3d, 0x9d, 0xc1, 0x34, 0xa5, 0x43, 0x48, 0x4c,
+    0x85, 0x7f, 0x6d, 0x5d, 0xbf, 0xca, 0x28, 0x71, 0xa5, 0xd3, 0xa8, 0x58, 0x1e, 0xd7, 0x65, 0x7e,
+    0x28, 0xca, 0x1c, 0x00, 0xde, 0x99, 0x08, 0x44, 0x6e, 0x0d, 0x5f, 0x5e, 0x9b, 0x85, 0x94, 0xba,
+    0x49, 0xab, 0x52, 0xa0, 0xa4, 0x46, 0x2a, 0xb4, 0xe8, 0x35, 0xc4, 0xc2, 0xda, 0x21, 0xb7, 0xa1,
+    0x49, 0xc2, 0x38, 0x9e, 0xcb, 0x33, 0xb8, 0xc4, 0x99, 0x48, 0x31, 0xc2, 0x29, 0xaa, 0x09, 0xf4,
+    0x59, 0xa8, 0xae, 0x30, 0x20, 0x33, 0x21, 0x5a, 0xcf, 0xe6, 0x9e, 0x12, 0xa5, 0x4a, 0x91, 0xb0,
+    0xdb, 0x6b, 0x22, 0x77, 0x87, 0x48, 0x9e, 0x3d, 0x96, 0xc9, 0x08, 0xfd, 0x1c, 0x23, 0xbe, 0x45,
+    0x48, 0xb7, 0xa8, 0xa3, 0xc3, 0xf3, 0xd5, 0x4e, 0xd0, 0x6c, 0xec, 0x58, 0x1b, 0xea, 0x3d, 0xc2,
+    0x39, 0x58, 0x84, 0x35, 0xbc, 0xdf, 0x0a, 0xf7, 0x18, 0xe5, 0x18, 0xd1, 0xe5, 0x80, 0x11, 0x14,
+    0xee, 0x91, 0x76, 0x01, 0x8f, 0xb7, 0xd8, 0xa6, 0xb4, 0xaf, 0x9a, 0x9a, 0x21, 0x42, 0xa6, 0xfc,
+    0xa5, 0xeb, 0x57, 0xd9, 0x3f, 0x0c, 0xfa, 0x2e, 0xf0, 0x10, 0x76, 0x36, 0xc6, 0x7e, 0xd5, 0xc4,
+    0x71, 0x72, 0xb7, 0x13, 0x97, 0x11, 0xf2, 0x08, 0x68, 0x33, 0x49, 0xed, 0x35, 0x04, 0x29, 0xaf,
+    0xb1, 0x5b, 0xb6, 0xa5, 0xb3, 0x57, 0xe9, 0x50, 0x9e, 0xbb, 0x8d, 0xff, 0xb7, 0x76, 0xa8, 0xfc,
+    0xb9, 0x98, 0xde, 0x40, 0xce, 0x29, 0x3f, 0xda, 0xb3, 0x6c, 0x69, 0xb7, 0x15, 0x31, 0xad, 0x69,
+    0x9a, 0xe0, 0x2f, 0x1b, 0xee, 0xb4, 0x89, 0x96, 0x92, 0x43, 0xfc, 0xf8, 0xcd, 0x84, 0x1e, 0xd1,
+    0xe2, 0xc4, 0x8d, 0x7e, 0x73, 0xf3, 0xd4, 0x32, 0xab, 0xdc, 0x74, 0x25, 0xa6, 0xc5, 0x2d, 0x20,
+    0xda, 0x22, 0xca, 0xa5, 0x62, 0x21, 0x49, 0x0f, 0x9b, 0xd6, 0x0a, 0x8c, 0x63, 0x03, 0x2b, 0x34,
+    0xef, 0x6b, 0x22, 0x82, 0x24, 0x48, 0xf9, 0xb7, 0xb3, 0x22, 0x24, 0x0d, 0x96, 0x0c, 0xc7, 0xf3,
+    0x20, 0x3f, 0x6e, 0x5c, 0x53, 0x65, 0xee, 0x58, 0x0e, 0x3d, 0x47, 0x3c, 0x52, 0xa1, 0xc2, 0x0e,
+    0x31, 0x59, 0xee, 0xe3, 0xa0, 0x26, 0x90, 0xe9, 0x26, 0x88, 0x5a, 0xe7, 0x88, 0xb0, 0x59, 0x0b,
+    0xa0, 0x34, 0xf4, 0xa6, 0xbc, 0xff, 0x47, 0x39, 0x26, 0xba, 0x2c, 0x41, 0x81, 0xbb, 0x66, 0xa4,
+    0xd6, 0xe5, 0x97, 0x1b, 0x1f, 0xe3, 0x36, 0x35, 0xad, 0x34, 0xe9, 0x6b, 0x2b, 0xee, 0x23, 0x5c,
+    0x1e, 0x79, 0xd7, 0xe1, 0x9f, 0xac, 0xae, 0xdb, 0x32, 0x92, 0x1f, 0x6d, 0x1d, 0xe7, 0xd7, 0x0c,
+    0x0d, 0x75, 0x07, 0x51, 0x40, 0x53, 0xd8, 0x9d, 0x80, 0xdf, 0x1a, 0x61, 0x43, 0xbe, 0x5f, 0x96,
+    0x15, 0x8f, 0x68, 0xdb, 0xc2, 0xab, 0x73, 0x8f, 0x7d, 0x2d, 0xd0, 0x52, 0x3c, 0x44, 0xf9, 0x7e,
+    0x1f, 0xcd, 0xac, 0x38, 0xca, 0xad, 0x88, 0x95, 0x8e, 0x38, 0xd5, 0xe1, 0x6b, 0x56, 0x81, 0x81,
+    0x66, 0x84, 0x30, 0x32, 0x91, 0xa7, 0xbd, 0x85, 0x11, 0x37, 0x31, 0xc2, 0x6a, 0x03, 0x9e, 0x96,
+    0x62, 0x1e, 0x4d, 0x78, 0x8b, 0xd6, 0x84, 0x29, 0x8a, 0x52, 0x66, 0x78, 0xa4, 0x1c, 0xc2, 0xd5,
+    0x68, 0x59, 0x6e, 0x2f, 0xa9, 0x14, 0x90, 0xef, 0x86, 0xf7, 0xd7, 0xa6, 0x03, 0x90, 0x9b, 0x41,
+    0xf0, 0x34, 0x99, 0x78, 0x08, 0xc2, 0xb3, 0x68, 0x6a, 0x3c, 0x91, 0x00, 0xed, 0xb1, 0x0b, 0x5a,
+    0x0e, 0x71, 0x8a, 0xe6, 0x20, 0x68, 0x3c, 0x86, 0xdc, 0x94, 0x7d, 0xf3, 0xf7, 0xae, 0x78, 0x96,
+    0x1c, 0x17, 0x77, 0x11, 0x82, 0xd9, 0x49, 0x3a, 0x65, 0x59, 0x77, 0xca, 0x1d, 0x2e, 0xe8, 0x4f,
+    0xd0, 0x80, 0x6a, 0x45, 0xcf, 0xaf, 0xab, 0x5c, 0x4a, 0xb6, 0x7a, 0xcc, 0x44, 0x04, 0x47, 0xa9,
+    0x62, 0xf6, 0x06, 0xc3, 0x72, 0xda, 0xf3, 0xa1, 0x49, 0x59, 0x6e, 0x16, 0x1f, 0xc3, 0x1c, 0x10,
+    0xa4, 0xfe, 0x57, 0xc5, 0x1c, 0x92, 0x25, 0xcd, 0xf5, 0x5d, 0x73, 0x09, 0xb5, 0x37, 0x84, 0x61,
+    0x15, 0x11, 0xb2, 0x39, 0x09, 0x4f, 0xe8, 0x73, 0x2f, 0x70, 0xf9, 0x88, 0xbf, 0xe9, 0x08, 0x85,
+    0x9a, 0xdb, 0xaf, 0x46, 0xe7, 0xa0, 0x96, 0x00, 0x3c, 0x4a, 0x61, 0xf0, 0x1e, 0x17, 0xa5, 0xd8,
+    0xbc, 0x76, 0xd7, 0x74, 0x26, 0x62, 0x01, 0x9c, 0xd9, 0x8d, 0x82, 0xf9, 0xb4, 0xdb, 0x8b, 0x09,
+    0xb8, 0xf2, 0x87, 0xb5, 0xa9, 0xc2, 0x70, 0xaf, 0x7e, 0xbb, 0x09, 0xe9, 0xe0, 0x26, 0x04, 0xe1,
+    0x26, 0x06, 0xd9, 0xd9, 0x22, 0x96, 0xf7, 0xcd, 0xf2, 0xde, 0x28, 0x25, 0x5a, 0xd9, 0xc1, 0x55,
+    0x8b, 0xc0, 0x3b, 0xe4, 0x7b, 0xf6, 0xb9, 0xdc, 0x33, 0x44, 0x93, 0x92, 0x72, 0x2d, 0x1e, 0x7b,
+    0x08, 0x17, 0x84, 0x69, 0xec, 0xc6, 0x63, 0x58, 0x09, 0xb4, 0x65, 0x3d, 0x8a, 0x0a, 0x79, 0xfe,
+    0x31, 0x6b, 0xb1, 0x0d, 0xd0, 0x31, 0x07, 0x69, 0x85, 0x2d, 0xe5, 0xe7, 0x6b, 0x74, 0x70, 0x34,
+    0x5f, 0xf4, 0x45, 0x15, 0x58, 0x98, 0x18, 0x9d, 0xe3, 0x7d, 0x16, 0x8c, 0xf7, 0x38, 0x77, 0xfe,
+    0xfe, 0xd3, 0xe7, 0xd8, 0x3e, 0x54, 0x02, 0x26, 0x71, 0x79, 0xdf, 0x6c, 0xe5, 0x7e, 0xdf, 0x26,
+    0xe0, 0x8e, 0x55, 0xb5, 0xf5, 0xf7, 0x63, 0x70, 0x57, 0xf6, 0x15, 0x84, 0x0a, 0xc6, 0x86, 0x4a,
+    0x99, 0xb3, 0xc8, 0x77, 0x05, 0xe5, 0x15, 0x8f, 0xc4, 0x2a, 0x0a, 0x33, 0xf8, 0xd2, 0x5a, 0xc8,
+    0x99, 0xd8, 0x8a, 0x86, 0x08, 0xba, 0x3e, 0x4b, 0xff, 0x05, 0x1f, 0x4c, 0xe1, 0x7c, 0x5a, 0x62,
+    0x31, 0xbd, 0x73, 0x8f, 0xa4, 0x4b, 0x09, 0x9c, 0x24, 0x57, 0xac, 0xc0, 0xff, 0xe0, 0x24, 0x92,
+    0xa8, 0x12, 0x32, 0xd8, 0x3b, 0xab, 0x62, 0xbc, 0x64, 0xc1, 0xd5, 0xb1, 0xb2, 0xc9, 0x82, 0x23,
+    0xe8, 0x23, 0xcf, 0xfb, 0xf6, 0xaf, 0x9b, 0xcb, 0x9a, 0xdf, 0xb0, 0xdf, 0xb0, 0xc3, 0xdb, 0xce,
+    0x09, 0x89, 0xb5, 0xb4, 0x4f, 0x93, 0x62, 0x23, 0x71, 0xaf, 0x96, 0x76, 0x90, 0xc4, 0xfd, 0x6d,
+    0x47, 0x9f, 0x3c, 0xcc, 0x11, 0xab, 0x18, 0xc1, 0xb4, 0x6f, 0xe1, 0x52, 0x27, 0x31, 0x96, 0x58,
+    0xca, 0xda, 0x47, 0x1b, 0xa8, 0xc8, 0x25, 0x6c, 0x03, 0xd5, 0x90, 0x04, 0x19, 0x49, 0x76, 0x66,
+    0x52, 0x24, 0x8c, 0x70, 0x6b, 0x41, 0x4c, 0x7b, 0xad, 0xc4, 0x2e, 0xa2, 0x78, 0x85, 0x79, 0x36,
+    0xb6, 0x41, 0x1c, 0xb8, 0x4f, 0xfb, 0x43, 0x01, 0x3d, 0xc9, 0xfa, 0xc5, 0x19, 0x79, 0x50, 0xd5,
+    0xfd, 0x29, 0x31, 0x66, 0x13, 0x21, 0x4e, 0xe0, 0x0e, 0x8a, 0x49, 0x43, 0x35, 0x3b, 0x18, 0xba,
+    0x23, 0x40, 0x98, 0x86, 0xa3, 0x67, 0xce, 0x29, 0x63, 0xe8, 0xbc, 0xea, 0xcb, 0x14, 0x54, 0x99,
+    0xa5, 0x29, 0x7e, 0x1e, 0x0a, 0x3b, 0x77, 0xcf, 0xee, 0xb3, 0x73, 0x30, 0x37, 0xb6, 0xa7, 0xc4,
+    0xf9, 0x16, 0x08, 0x19, 0xb6, 0xe1, 0xb0, 0x7d, 0x1b, 0x08, 0x83, 0x53, 0x3c, 0xde, 0xe8, 0x0b,
+    0x94, 0x93, 0x0b, 0x71, 0x85, 0x69, 0x02, 0xb1, 0xe7, 0xa9, 0x32, 0x7d, 0x16, 0x28, 0xba, 0xf7,
+    0x0c, 0x8d, 0x96, 0x1e, 0x09, 0x5f, 0x1a, 0xc1, 0xbc, 0x89, 0x13, 0x74, 0xd0, 0xe9, 0x28, 0x72,
+    0x80, 0x08, 0xe8, 0x8a, 0x90, 0x03, 0x08, 0x05, 0x22, 0x62, 0xc7, 0x39, 0xe4, 0xa6, 0x56, 0xf4,
+    0xc7, 0x78, 0x9c, 0x74, 0x50, 0x0e, 0x70, 0x53, 0xdd, 0x75, 0xda, 0xd0, 0x45, 0xaa, 0x74, 0x4f,
+    0x05, 0x82, 0xd9, 0xab, 0xf0, 0x49, 0x5f, 0x17, 0x90, 0x3b, 0x62, 0x73, 0x61, 0xce, 0x91, 0x46,
+    0xe1, 0xad, 0xd2, 0xad, 0x6c, 0xf6, 0x04, 0x71, 0xdd, 0x26, 0xe5, 0xb5, 0xb5, 0xa9, 0x5c, 0x9f,
+    0x18, 0xf7, 0x3b, 0x5a, 0x76, 0x50, 0xe9, 0xeb, 0x8b, 0xa8, 0xc5, 0xb8, 0xb5, 0x37, 0x60, 0x11,
+    0xc1, 0x87, 0x0c, 0x6a, 0xb9, 0xff, 0x91, 0x3e, 0x21, 0xbd, 0x92, 0x84, 0x8c, 0x41, 0x57, 0xa7,
+    0x2d, 0x38, 0x3d, 0xb7, 0xb1, 0x37, 0x2b, 0x4c, 0x7a, 0xc4, 0xfc, 0x88, 0xcc, 0xbf, 0x53, 0x89,
+    0x4b, 0x71, 0xfd, 0x28, 0x5d, 0x32, 0x8c, 0x34, 0xfb, 0x72, 0xdf, 0x30, 0x5c, 0xa3, 0xe3, 0x5c,
+    0xee, 0x6d, 0x9c, 0x4f, 0x23, 0x38, 0x3b, 0x4d, 0xfb, 0x8b, 0x47, 0xc8, 0x7d, 0x72, 0x94, 0x42,
+    0xb8, 0xce, 0x11, 0xdd, 0xe7, 0x93, 0xee, 0xe5, 0xee, 0x65, 0xc5, 0x73, 0x44, 0x54, 0xcf, 0xb8,
+    0xd9, 0xe4, 0xce, 0xd7, 0x05, 0xa6, 0x6a, 0xbd, 0x55, 0x6f, 0x81, 0xaf, 0x34, 0x01, 0xb1, 0x7e,
+    0xe1, 0xbe, 0x62, 0xbb, 0x82, 0x4b, 0x95, 0xfc, 0x63, 0x53, 0x65, 0x81, 0xf3, 0xbc, 0x25, 0xf8,
+    0x6d, 0xf4, 0x88, 0x21, 0x7b, 0xf2, 0x1d, 0x0e, 0xfa, 0xb6, 0xc0, 0xa8, 0x02, 0x52, 0x06, 0x2a,
+    0xae, 0x8d, 0x54, 0x2f, 0x8a, 0x4a, 0x18, 0x44, 0xf1, 0xa4, 0x57, 0x60, 0xa1, 0x1e, 0xaa, 0x39,
+    0x47, 0xcb, 0xe0, 0xf8, 0x10, 0xb5, 0x08, 0x35, 0x55, 0x45, 0xb6, 0x95, 0xd4, 0x4c, 0xcf, 0xd1,
+    0xfc, 0x58, 0x95, 0xc0, 0xfd, 0x40, 0xd4, 0xbf, 0x9f, 0xb8, 0x30, 0xa1, 0x8c, 0x6b, 0x1c, 0x65,
+    0x8e, 0x8f, 0xc6, 0x83, 0xda, 0x89, 0xd1, 0xed, 0x3b, 0x6c, 0x48, 0xff, 0xfa, 0xde, 0x5d, 0xcd,
+    0x0b, 0xc9, 0xcd, 0x97, 0x24, 0x31, 0x27, 0x56, 0x5d, 0x83, 0x77, 0x6b, 0xc9, 0x51, 0xb3, 0x40,
+    0xbb, 0x5d, 0xc7, 0xda, 0xba, 0xc1, 0x9d, 0xdc, 0x27, 0xdc, 0x6d, 0xcc, 0x73, 0x82, 0xc2, 0xc9,
+    0x38, 0x7f, 0xf1, 0x4b, 0x3f, 0x6a, 0x33, 0xd7, 0x8d, 0x04, 0xc4, 0x09, 0x20, 0xd8, 0x2a, 0xf2,
+    0x43, 0x6e, 0x40, 0x51, 0xfc, 0x23, 0x77, 0x7a, 0x8f, 0xc2, 0x03, 0x1b, 0x21, 0x7d, 0x37, 0x36,
+    0x59, 0xb6, 0xbc, 0x00, 0x4e, 0x5f, 0x4f, 0x1c, 0xbd, 0x3c, 0x33, 0xe3, 0xfc, 0x6d, 0x71, 0x26,
+    0xae, 0xc4, 0x2e, 0xe1, 0x27, 0x88, 0x98, 0x84, 0xed, 0xe4, 0x5f, 0x65, 0x38, 0xd4, 0xc5, 0x1b,
+    0x08, 0xac, 0x96, 0x5c, 0x75, 0xf2, 0x3d, 0x9d, 0x03, 0x77, 0xcf, 0xae, 0xca, 0xb5, 0xd1, 0xc8,
+    0x97, 0xc7, 0xbd, 0x50, 0xf0, 0xf3, 0x19, 0x01, 0xe5, 0x26, 0x0c, 0xb1, 0xea, 0xdb, 0x5a, 0xbf,
+    0x5f, 0x3c, 0x89, 0x09, 0x8c, 0x4c, 0xeb, 0xed, 0x0b, 0xf4, 0x24, 0xa0, 0xba, 0x34, 0x4e, 0xea,
+    0x67, 0xd6, 0x97, 0x8b, 0x1b, 0xea, 0x41, 0x83, 0x21, 0x3e, 0x56, 0x1e, 0x69, 0x6f, 0xe4, 0xb1,
+    0x0e, 0x75, 0x43, 0xcd, 0xaa, 0xd7, 0x0e, 0xa1, 0xcd, 0x05, 0x40, 0x40, 0xae, 0xb0, 0x46, 0x20,
+    0xcb, 0x4e, 0x78, 0x79, 0xbe, 0xc9, 0x4d, 0x9f, 0xb3, 0xa9, 0xee, 0x7a, 0x16, 0xe3, 0x70, 0xc3,
+    0x49, 0x46, 0x02, 0x2f, 0xd0, 0x6f, 0x39, 0x1d, 0x66, 0xd0, 0xf1, 0x35, 0x12, 0x30, 0x6d, 0x73,
+    0xa8, 0xe5, 0x6c, 0xa4, 0xbc, 0x7b, 0x47, 0x01, 0xd6, 0x65, 0xf9, 0x14, 0xee, 0xff, 0x80, 0x48,
+    0xfa, 0xdb, 0xea, 0xfe, 0x46, 0xb1, 0x12, 0x4a, 0xcd, 0xe3, 0x72, 0x80, 0x8c, 0xb2, 0x27, 0x8c,
+    0x80, 0x61, 0x55, 0x5e, 0x54, 0x0b, 0x4d, 0x6c, 0x1c, 0xc3, 0x47, 0x6c, 0x8e, 0x41, 0x0d, 0x4c,
+    0xa3, 0x76, 0x2c, 0x5e, 0x85, 0x8c, 0x68, 0x2b, 0x63, 0x16, 0x6f, 0xa7, 0x47, 0x96, 0xc4, 0x54,
+    0x6a, 0xfd, 0x88, 0x1a, 0xb4, 0x53, 0xe9, 0xd5, 0x86, 0x8f, 0xc8, 0x87, 0x7f, 0x97, 0xf0, 0xaa,
+    0x2e, 0xdf, 0x0e, 0x6d, 0x95, 0x96, 0x57, 0xd1, 0x78, 0x59, 0x26, 0xbc, 0xa1, 0x21, 0x53, 0xdf,
+    0x61, 0x32, 0x55, 0x20, 0x6e, 0x89, 0xe5, 0x37, 0x96, 0xb1, 0xe5, 0x3d, 0x4c, 0xf8, 0x60, 0xd3,
+    0xbe, 0xd9, 0x77, 0xfc, 0xbc, 0x3d, 0xab, 0xaf, 0xa3, 0xb9, 0xce, 0x1e, 0xbc, 0xa0, 0xea, 0x28,
+    0xed, 0x55, 0xff, 0x48, 0xd7, 0x4b, 0x01, 0xea, 0x75, 0xd2, 0x21, 0x49, 0x12, 0x3f, 0x93, 0x80,
+    0x57, 0x9b, 0x2b, 0x19, 0x99, 0x2a, 0x86, 0xa3, 0x2a, 0x5c, 0x1d, 0x67, 0x9b, 0x75, 0x3c, 0xcc,
+    0x08, 0xd0, 0xa5, 0xb9, 0x49, 0xd2, 0xce, 0x43, 0x6d, 0x65, 0xf3, 0x5a, 0x03, 0x1d, 0x81, 0x71,
+    0x29, 0xec, 0x8b, 0xce, 0x20, 0x3b, 0x38, 0x17, 0x3c, 0x81, 0x18, 0x55, 0x16, 0xea, 0x82, 0x4c,
+    0x0c, 0x2e, 0xe0, 0x8c, 0xa7, 0xed, 0x85, 0x0b, 0xef, 0x73, 0xaf, 0xca, 0x49, 0xa0, 0x8d, 0x4d,
+    0x82, 0xd5, 0x5c, 0xcf, 0xee, 0xc2, 0x0e, 0xb6, 0x74, 0xe1, 0x7e, 0x56, 0x3a, 0xc7, 0x0e, 0xbc,
+    0x74, 0x07, 0x94, 0x74, 0x2f, 0x10, 0x0a, 0xdc, 0xc7, 0x00, 0x01, 0x08, 0xef, 0xba, 0x6a, 0xc0,
+    0x5f, 0x97, 0x6f, 0xa1, 0xde, 0xa1, 0x2e, 0xfc, 0x65, 0x12, 0x6d, 0xae, 0x58, 0xd6, 0x29, 0x15,
+    0x5b, 0x3d, 0x90, 0xaf, 0xb2, 0x9e, 0x71, 0x17, 0xd0, 0xe3, 0x0d, 0x4e, 0x5c, 0x77, 0x2b, 0x07,
+    0x02, 0x28, 0xc7, 0xc1, 0xd2, 0xb8, 0xea, 0xe2, 0x0c, 0x14, 0x08, 0x1f, 0x40, 0xd6, 0x37, 0x55,
+    0x93, 0x15, 0x59, 0x42, 0x7c, 0xea, 0xf5, 0x60, 0x00, 0x4f, 0x74, 0xac, 0x7d, 0xc3, 0x8f, 0x36,
+    0xe1, 0xd7, 0xe8, 0x7a, 0x60, 0xc6, 0x57, 0xe1, 0xd1, 0x2b, 0x24, 0x28, 0x4e, 0x4b, 0xf1, 0x07,
+    0xd7, 0x09, 0x83, 0x6b, 0xcb, 0x83, 0xa6, 0x25, 0x78, 0x94, 0x98, 0x52, 0x18, 0xd6, 0xbf, 0x50,
+    0xa6, 0xa4, 0x9c, 0x0c, 0x00, 0x20, 0x5d, 0x25, 0xab, 0x85, 0x5a, 0x27, 0xd4, 0xfc, 0x38, 0x18,
+    0x2e, 0x68, 0x64, 0x28, 0x4b, 0x21, 0x5d, 0xc4, 0x4b, 0xa0, 0xc2, 0x7b, 0x7e, 0x71, 0xdd, 0xc7,
+    0x8e, 0xcc, 0x17, 0xd1, 0xe3, 0x1d, 0x7c, 0x3b, 0x75, 0x64, 0x7b, 0x37, 0xb4, 0xc0, 0xf2, 0x65,
+    0x47, 0xc7, 0x41, 0xfb, 0x98, 0xb0, 0xe9, 0x6f, 0x7d, 0xb9, 0xf5, 0x37, 0x66, 0x49, 0x7a, 0xd8,
+    0x0d, 0x4f, 0xec, 0x72, 0x67, 0x37, 0xc7, 0x79, 0xcf, 0x07, 0x97, 0xfc, 0xcc, 0xee, 0x7f, 0x73,
+    0x86, 0x93, 0x31, 0x98, 0xc6, 0x49, 0x96, 0x27, 0x07, 0xe4, 0x38, 0xfd, 0x72, 0x49, 0xd7, 0x4a,
+    0x0b, 0x97, 0x24, 0x79, 0x3c, 0x3b, 0xcc, 0x74, 0xef, 0x21, 0xd7, 0x21, 0x71, 0x67, 0x38, 0x10,
+    0xbf, 0x82, 0x6b, 0x03, 0xc9, 0x88, 0xd6, 0x15, 0xb4, 0xf3, 0x72, 0xae, 0xfe, 0x2e, 0x23, 0x84,
+    0x84, 0x31, 0xa9, 0xaf, 0xc4, 0x75, 0x87, 0x13, 0xad, 0x54, 0x05, 0x92, 0x9a, 0xea, 0xaf, 0x86,
+    0x62, 0xf2, 0x8d, 0x0f, 0x38, 0x41, 0x25, 0x1d, 0xa1, 0xcc, 0xa2, 0x13, 0xf4, 0x49, 0x1b, 0x41,
+    0xd0, 0x97, 0xfb, 0x71, 0xa9, 0xdb, 0xcc, 0xcc, 0xe8, 0xe8, 0x00, 0x92, 0x4b, 0x7d, 0x22, 0xe7,
+    0xc0, 0x2e, 0xf2, 0xea, 0x9d, 0xa7, 0x24, 0x6e, 0xb5, 0x14, 0x4b, 0xb9, 0xcc, 0x53, 0x16, 0xb8,
+    0xf2, 0x63, 0x10, 0xbf, 0xf4, 0xeb, 0x23, 0x0a, 0x44, 0xe4, 0xb3, 0x02, 0xfb, 0x7f, 0x9d, 0xfa,
+    0x69, 0xe8, 0x05, 0xaf, 0xb2, 0x53, 0x67, 0xb7, 0x7b, 0x8a, 0x9e, 0xa0, 0x46, 0x3f, 0xe8, 0x3b,
+    0x16, 0x5b, 0xe7, 0xc1, 0x75, 0x64, 0x30, 0xd3, 0x2c, 0xd8, 0x07, 0x12, 0x89, 0xb8, 0x8f, 0x90,
+    0xc3, 0xfb, 0xee, 0x88, 0x0c, 0x2f, 0xb3, 0xd5, 0xd2, 0xcc, 0x74, 0x11, 0xb0, 0xa6, 0xad, 0xea,
+    0x4a, 0x69, 0xdf, 0x56, 0x36, 0x0b, 0xba, 0x82, 0x14, 0x22, 0xaf, 0x09, 0x36, 0x53, 0x21, 0x35,
+    0x6d, 0x1e, 0x6b, 0x4c, 0xc1, 0xd3, 0x44, 0x52, 0xcd, 0x13, 0x81, 0x1c, 0x76, 0x37, 0x54, 0xdc,
+    0x88, 0xe8, 0x08, 0x70, 0xa5, 0x0e, 0xb5, 0x2d, 0xb1, 0x80, 0x9d, 0x29, 0x86, 0xb2, 0x1c, 0xe6,
+    0xbd, 0xd5, 0x4d, 0xe3, 0xc6, 0x07, 0xdc, 0x04, 0x87, 0x33, 0x54, 0xf6, 0x6d, 0x21, 0x2c, 0xc5,
+    0x1e, 0x09, 0xb8, 0x31, 0xa5, 0x29, 0x25, 0x2f, 0x60, 0xd1, 0xf6, 0x08, 0x1f, 0x04, 0x1e, 0x46,
+    0xba, 0xe2, 0xf4, 0x34, 0x2c, 0x81, 0x2d, 0x05, 0xf7, 0xbd, 0x67, 0xe4, 0x09, 0x7e, 0x25, 0x27,
+    0xe5, 0x06, 0x0e, 0x62, 0xd8, 0xab, 0x34, 0x87, 0x3f, 0x1c, 0x65, 0x96, 0x61, 0x52, 0xc5, 0xe3,
+    0x90, 0x54, 0xb2, 0x11, 0x10, 0x87, 0xcd, 0xbc, 0x20, 0x7c, 0x88, 0xef, 0x5e, 0xdb, 0x1b, 0xfc,
+    0x4c, 0xe8, 0xa0, 0xc9, 0x5e, 0xcc, 0x90, 0x3f, 0x0b, 0x34, 0x00, 0x7d, 0x79, 0x31, 0xf6, 0xa9,
+    0x28, 0x3d, 0x57, 0x90, 0x20, 0xf5, 0x2e, 0x1f, 0xba, 0x54, 0x94, 0x10, 0x47, 0x70, 0x90, 0xd6,
+    0xda, 0x74, 0xf7, 0x8d, 0xde, 0xba, 0xdb, 0xf7, 0x2e, 0x60, 0x38, 0x55, 0x1a, 0xac, 0x63, 0x45,
+    0x0c, 0x96, 0x3f, 0xcd, 0x8a, 0x62, 0x13, 0x84, 0xff, 0xe7, 0x0a, 0x11, 0xa8, 0x62, 0xf0, 0xe3,
+    0xc1, 0x11, 0x32, 0xca, 0x6f, 0x16, 0x33, 0x32, 0x9e, 0x18, 0xf0, 0x2f, 0xb3, 0xf7, 0x02, 0x59,
+    0x28, 0x6b, 0x86, 0x55, 0xcc, 0x8d, 0x98, 0xaa, 0xfb, 0x6d, 0x26, 0x1a, 0xab, 0x62, 0xcc, 0xb3,
+    0x1e, 0x38, 0x98, 0xb2, 0xff, 0x26, 0xe4, 0xf4, 0xab, 0x2b, 0x84, 0xd0, 0xbd, 0x71, 0xe6, 0xad,
+    0x32, 0x0c, 0x55, 0xa7, 0xc5, 0xe7, 0xdc, 0x71, 0xcc, 0xd8, 0x2a, 0xa2, 0xa8, 0x09, 0x1b, 0x6f,
+    0x29, 0xc5, 0xdb, 0x55, 0x56, 0x90, 0x14, 0x4b, 0x99, 0x3f, 0x3c, 0xd3, 0x1b, 0xed, 0xa0, 0x60,
+    0xca, 0x1d, 0xe7, 0x63, 0xbf, 0xcb, 0x37, 0x58, 0xaa, 0xb8, 0x0c, 0xa7, 0x43, 0xfe, 0x0a, 0xf3,
+    0x86, 0x25, 0x4a, 0xca, 0x72, 0x9d, 0x37, 0xb5, 0xdd, 0xc8, 0xce, 0x06, 0x85, 0x35, 0xb6, 0x8f,
+    0xf2, 0x57, 0x45, 0x16, 0x90, 0x78, 0x29, 0x53, 0x98, 0xa9, 0xaa, 0xe2, 0x29, 0x79, 0xaf, 0xc8,
+    0xf5, 0xfa, 0xc3, 0x6a, 0xd0, 0xe3, 0x0e, 0x3d, 0xa0, 0x19, 0x09, 0xa9, 0xf4, 0xc6, 0xb2, 0x16,
+    0x61, 0xe5, 0x36, 0x63, 0x2a, 0xc3, 0x72, 0x53, 0xe2, 0xee, 0xbf, 0xdc, 0xab, 0x15, 0xc3, 0x7e,
+    0x77, 0xb7, 0x14, 0xdf, 0x56, 0x8e, 0xcc, 0xd8, 0x04, 0xa7, 0x5b, 0x75, 0xd4, 0x07, 0xb8, 0x22,
+    0x8d, 0x98, 0x09, 0x2a, 0x5f, 0x62, 0x6e, 0xf6, 0xd8, 0xf2, 0x1c, 0x8e, 0xe3, 0xaa, 0x31, 0xc5,
+    0x5b, 0xca, 0x7d, 0x55, 0xa4, 0xbe, 0x9f, 0x35, 0xfa, 0xf7, 0xf2, 0xbe, 0x0d, 0xe6, 0x18, 0x5c,
+    0x5a, 0x52, 0x4d, 0x01, 0x72, 0xa9, 0xa1, 0x3c, 0x16, 0xdd, 0xdb, 0x43, 0x8a, 0x52, 0xe5, 0xd3,
+    0x51, 0xec, 0x59, 0x76, 0x61, 0xb4, 0x5c, 0xf3, 0xc2, 0x71, 0x71, 0x08, 0x00, 0x88, 0x92, 0x36,
+    0x5b, 0x08, 0x42, 0x7c, 0xab, 0xf5, 0x71, 0x12, 0x31, 0x17, 0xc8, 0xd7, 0xc9, 0x23, 0xda, 0xbf,
+    0xab, 0x37, 0x0c, 0x63, 0x98, 0x38, 0x1e, 0x61, 0x5e, 0xb9, 0xfa, 0x53, 0x6c, 0x96, 0xfd, 0x96,
+    0xa4, 0x94, 0x0f, 0xba, 0xf5, 0xb6, 0x92, 0xe1, 0x1e, 0x6e, 0xec, 0x9a, 0x90, 0xe0, 0x57, 0xbe,
+    0xf3, 0x97, 0x19, 0x09, 0x4f, 0xe5, 0xf5, 0x9d, 0x75, 0xfa, 0x54, 0x96, 0xa2, 0x47, 0xa0, 0x9b,
+    0x00, 0x69, 0xce, 0x05, 0x40, 0x48, 0xdb, 0xd2, 0x23, 0xba, 0x5b, 0x87, 0x25, 0x61, 0x66, 0x3f,
+    0xb5, 0x63, 0xb7, 0x1c, 0xc9, 0xa6, 0x68, 0x8f, 0x05, 0x0d, 0x27, 0x06, 0xa9, 0x4d, 0x7e, 0x9a,
+    0xdf, 0x75, 0x84, 0x04, 0xa5, 0xc6, 0xb3, 0x9e, 0xc4, 0xb9, 0x54, 0xe9, 0x88, 0x6b, 0xef, 0x28,
+    0xb8, 0x3a, 0x75, 0x31, 0x9f, 0xad, 0xb2, 0xa1, 0x12, 0x11, 0x61, 0xc2, 0x90, 0x0e, 0xa1, 0x28,
+    0xc2, 0xe3, 0xce, 0x3a, 0x56, 0x2f, 0xa1, 0x49, 0xc6, 0x99, 0x89, 0x1c, 0x95, 0x3a, 0x8d, 0xe6,
+    0x9e, 0x34, 0xca, 0x48, 0xfc, 0x47, 0x75, 0x28, 0x99, 0x5d, 0x9f, 0xaa, 0xe3, 0x1d, 0x33, 0x52,
+    0xa0, 0x30, 0x90, 0x01, 0xeb, 0x2b, 0x9f, 0x85, 0x28, 0xbc, 0x3e, 0xfd, 0xbc, 0x80, 0xc2, 0x78,
+    0xb7, 0x63, 0xcd, 0x83, 0x13, 0x41, 0xa3, 0x43, 0x65, 0x0d, 0xd3, 0x8c, 0xc5, 0x19, 0x55, 0x7a,
+    0x32, 0xf0, 0xc6, 0xf4, 0x31, 0x08, 0xb0, 0xc6, 0x94, 0x7f, 0x50, 0xed, 0xd3, 0xb2, 0xea, 0x96,
+    0x31, 0xfb, 0x9c, 0x2e, 0xd5, 0x78, 0xc3, 0x03, 0xb0, 0x0b, 0x69, 0x75, 0xac, 0xdc, 0x3d, 0x83,
+    0xa0, 0x76, 0x0e, 0xb4, 0xf7, 0x01, 0x5e, 0x8f, 0x8c, 0x12, 0x6f, 0x85, 0xdb, 0xb3, 0x6d, 0x2f,
+    0x23, 0xca, 0xdd, 0xb4, 0xd4, 0xfb, 0x05, 0xa6, 0xd4, 0x87, 0x26, 0x2f, 0x19, 0xed, 0xae, 0x90,
+    0x95, 0x1b, 0xf5, 0x48, 0xfc, 0x76, 0x75, 0x8c, 0x15, 0x4b, 0xc4, 0x9d, 0x54, 0x9d, 0xd7, 0xa0,
+    0xa7, 0x5b, 0x54, 0x8f, 0x7c, 0xd8, 0xe7, 0x0d, 0x16, 0x95, 0x94, 0x38, 0x05, 0x47, 0x7b, 0x11,
+    0x52, 0xb3, 0x3c, 0xc6, 0xb7, 0x39, 0x1d, 0x60, 0x8b, 0x2a, 0xaf, 0xc5, 0xe1, 0xc4, 0x27, 0xa7,
+    0xc4, 0x1d, 0x2c, 0xb9, 0xb8, 0x1c, 0xe6, 0x01, 0x25, 0xf0, 0xb2, 0x50, 0xaf, 0x46, 0x8b, 0x80,
+    0xab, 0xa7, 0xcf, 0xee, 0xbc, 0x87, 0x57, 0x85, 0xf6, 0x39, 0x03, 0xa6, 0xdb, 0xa3, 0x51, 0x23,
+    0x8b, 0x91, 0x0a, 0x76, 0xbd, 0x7a, 0xd6, 0xb0, 0x54, 0xa2, 0xf6, 0x7d, 0x41, 0x74, 0x43, 0x7d,
+    0x97, 0x07, 0xc9, 0x1c, 0xc7, 0xb7, 0xd7, 0x70, 0xad, 0x08, 0x25, 0xc9, 0x34, 0x0c, 0xd5, 0xcc,
+    0x92, 0x0e, 0x64, 0x69, 0xe4, 0xe2, 0xcf, 0x52, 0xfd, 0xd0, 0x74, 0xbc, 0x2d, 0xc7, 0x97, 0xf8,
+    0xd2, 0xf0, 0xe7, 0xfc, 0xb3, 0x4a, 0xd9, 0x46, 0x42, 0x57, 0x94, 0xa1, 0x61, 0x29, 0x10, 0xea,
+    0xb0, 0x86, 0x57, 0x6d, 0xb8, 0xa2, 0xfd, 0x5f, 0x87, 0x32, 0x02, 0x50, 0x30, 0xae, 0x75, 0x21,
+    0x67, 0x0a, 0x82, 0xed, 0x93, 0x90, 0x9d, 0x10, 0xaf, 0x9f, 0x6c, 0x54, 0x6f, 0x75, 0xc9, 0x95,
+    0x23, 0x81, 0x84, 0xa2, 0x45, 0xd6, 0xbc, 0xdd, 0xcc, 0x94, 0x93, 0x35, 0xbd, 0x16, 0x9f, 0xb5,
+    0x7e, 0x79, 0xa7, 0xa2, 0xa9, 0xb4, 0x0f, 0x4a, 0x87, 0x3a, 0x15, 0xe4, 0x63, 0x9c, 0x2f, 0xed,
+    0x64, 0x14, 0xdd, 0x06, 0x96, 0xe1, 0x8c, 0x8a, 0x71, 0x8f, 0x66, 0x09, 0x32, 0x55, 0x35, 0xf4,
+    0x95, 0xcf, 0x98, 0x18, 0x5b, 0x68, 0x29, 0x14, 0xe2, 0xdf, 0xee, 0x50, 0x54, 0x51, 0x43, 0x2c,
+    0x31, 0x8f, 0x8f, 0x26, 0x7a, 0x65, 0xfa, 0x4a, 0x9d, 0x98, 0x3e, 0x82, 0x2b, 0x12, 0x51, 0x05,
+    0xab, 0x7b, 0x46, 0x05, 0x2d, 0x30, 0x99, 0xcc, 0x58, 0xb0, 0xe7, 0x30, 0x02, 0xa6, 0xc3, 0xc0,
+    0xd4, 0x38, 0x73, 0x5d, 0x37, 0x81, 0xe6, 0xec, 0x58, 0x23, 0xba, 0x55, 0x84, 0x99, 0x5f, 0x16,
+    0xb4, 0x74, 0x83, 0xfa, 0xde, 0xb3, 0x64, 0x01, 0xf7, 0x01, 0x09, 0x1e, 0x22, 0x7d, 0x1c, 0x98,
+    0x65, 0xb0, 0xaf, 0xab, 0xf2, 0xdd, 0xc0, 0xd8, 0xc3, 0x0f, 0x5e, 0x3f, 0xf9, 0xd0, 0xc2, 0xdb,
+    0x05, 0xd7, 0x00, 0x1a, 0x60, 0x44, 0x38, 0x8e, 0xc2, 0x38, 0x56, 0x45, 0x21, 0xb4, 0xe3, 0xd8,
+    0x36, 0xc4, 0x09, 0x0f, 0x5e, 0x4e, 0xb9, 0x85, 0x4d, 0xd4, 0x3e, 0xcb, 0x10, 0x0f, 0x09, 0x45,
+    0xca, 0x1d, 0x43, 0x69, 0x3e, 0xd5, 0x7d, 0x73, 0x18, 0x34, 0xea, 0x08, 0x92, 0x51, 0xc7, 0x48,
+    0xd2, 0xf4, 0x0a, 0x19, 0x11, 0xf5, 0x90, 0xb5, 0x69, 0xe9, 0xc3, 0x24, 0x29, 0x0b, 0x88, 0xe1,
+    0x01, 0x3d, 0xb9, 0x64, 0x9e, 0x18, 0x39, 0x1d, 0xae, 0xb1, 0x9b, 0x1d, 0x94, 0x9d, 0xed, 0xf7,
+    0x55, 0x08, 0xa3, 0x2f, 0x92, 0xb8, 0xfe, 0x54, 0xf2, 0x6f, 0xca, 0x5b, 0x97, 0xe7, 0x73, 0x03,
+    0xae, 0x8d, 0xc5, 0x29, 0xc9, 0x83, 0x45, 0xc5, 0xba, 0xe5, 0x4f, 0xdd, 0x43, 0x72, 0x55, 0xba,
+    0xd8, 0x01, 0xc1, 0x13, 0x91, 0xf0, 0x1f, 0x3f, 0xdd, 0x56, 0x75, 0x2c, 0x8c, 0x0e, 0x12, 0xa2,
+    0x23, 0x57, 0x95, 0xe7, 0xec, 0x32, 0xf2, 0x4d, 0x95, 0xc5, 0xf4, 0xbb, 0xe5, 0x7f, 0x1a, 0x12,
+    0x88, 0x4b, 0x6d, 0x7d, 0x6e, 0xe5, 0xb0, 0x65, 0x0c, 0xfb, 0x02, 0x0c, 0xeb, 0x98, 0xac, 0xc7,
+    0x1a, 0xd6, 0x90, 0x6d, 0xd2, 0x2b, 0xc9, 0xa9, 0x4b, 0x10, 0x77, 0x7e, 0x8a, 0x34, 0x8c, 0xee,
+    0x77, 0x4f, 0xc5, 0x82, 0x61, 0x17, 0xaf, 0x39, 0x27, 0x3c, 0x7d, 0x6b, 0xbc, 0x80, 0x4d, 0xfb,
+    0x31, 0x0f, 0x8f, 0xca, 0xbd, 0xf4, 0x9c, 0xbf, 0x20, 0x30, 0xe1, 0x33, 0xbb, 0xe6, 0xc6, 0xbf,
+    0x52, 0xb7, 0xec, 0x31, 0xf3, 0xa8, 0xd7, 0x1b, 0x51, 0xa3, 0x30, 0xd4, 0xdf, 0xcd, 0x1c, 0xbf,
+    0x02, 0x57, 0xb5, 0xd5, 0x28, 0xf0, 0x24, 0xaa, 0xde, 0xfd, 0xd9, 0xfc, 0x00, 0xb5, 0x8c, 0x9f,
+    0x54, 0xfe, 0x66, 0x1c, 0xc0, 0x54, 0x69, 0x95, 0x7b, 0x9b, 0x66, 0x74, 0x09, 0x4e, 0x81, 0xb0,
+    0xc2, 0xfb, 0x0d, 0xbc, 0xa2, 0x64, 0xd7, 0x7b, 0xc2, 0x5f, 0xfc, 0xa3, 0x2d, 0x14, 0x44, 0x66,
+    0xf9, 0xdf, 0x7b, 0x69, 0x4d, 0x93, 0x36, 0xe3, 0x37, 0x83, 0xb4, 0x21, 0x5d, 0xca, 0x6b, 0x0e,
+    0x3e, 0x07, 0x80, 0xfe, 0x4d, 0x7f, 0xb8, 0x81, 0x9e, 0xa9, 0x93, 0xa1, 0x96, 0xf7, 0x69, 0x89,
+    0xbe, 0x8d, 0x34, 0x50, 0xd9, 0x83, 0x55, 0x75, 0xc1, 0xb2, 0x35, 0x71, 0x0e, 0x91, 0xcf, 0xd2,
+    0x0a, 0xec, 0xf2, 0xcc, 0x89, 0x2d, 0x58, 0x39, 0x5a, 0xc9, 0x2a, 0x89, 0xf7, 0x18, 0x46, 0xe2,
+    0xe3, 0x52, 0x43, 0xdb, 0x76, 0x7a, 0x7c, 0xdb, 0x58, 0xf3, 0x65, 0x62, 0xa6, 0xf6, 0x24, 0x2d,
+    0xa9, 0xc9, 0xf9, 0xe8, 0xb5, 0x97, 0xbd, 0xbf, 0xa3, 0xa2, 0x82, 0x8b, 0x27, 0x03, 0x00, 0x62,
+    0x9b, 0xb1, 0x44, 0x10, 0xe9, 0x82, 0xc4, 0x44, 0xab, 0xf3, 0xab, 0xa7, 0x1e, 0x1b, 0xff, 0x4b,
+    0xf9, 0x85, 0xd1, 0xd8, 0x93, 0x77, 0xc8, 0xbd, 0xca, 0x42, 0xf7, 0x91, 0x30, 0xd2, 0x7e, 0x7e,
+    0xec, 0xa9, 0x8f, 0x6e, 0x0c, 0x2d, 0x31, 0x71, 0x0c, 0x2b, 0x6c, 0x83, 0x20, 0x3d, 0xf6, 0xd8,
+    0x04, 0xd6, 0x91, 0x02, 0xba, 0xcd, 0xa3, 0x5e, 0x71, 0x16, 0x58, 0x25, 0x80, 0x74, 0x87, 0xbe,
+    0x6f, 0x69, 0xf1, 0x77, 0x8a, 0xf2, 0x52, 0xb5, 0xba, 0xf6, 0x9e, 0x04, 0x60, 0xe0, 0xba, 0x2c,
+    0xd7, 0xc5, 0x15, 0xf4, 0x49, 0x49, 0xf4, 0xe6, 0x1e, 0xa6, 0x54, 0x17, 0xe1, 0x5b, 0x19, 0xb5,
+    0x20, 0xe9, 0x14, 0x9d, 0xab, 0xbf, 0xc4, 0x7b, 0x75, 0xae, 0x5b, 0x25, 0x20, 0x66, 0xe1, 0x65,
+    0x5e, 0xd3, 0xfb, 0xa1, 0x5c, 0x36, 0x03, 0x85, 0xf5, 0xde, 0x24, 0x9b, 0x70, 0xfd, 0x6a, 0x51,
+    0x15, 0xf5, 0xf3, 0x24, 0xf7, 0xf5, 0xee, 0xb9, 0x9c, 0xec, 0xd4, 0x92, 0x16, 0xd6, 0x34, 0xc3,
+    0xf1, 0x4a, 0xd2, 0xa9, 0x3c, 0x92, 0x58, 0x56, 0xad, 0x41, 0xad, 0x7a, 0x4f, 0xc4, 0xe4, 0xdb,
+    0xa7, 0x41, 0x56, 0xff, 0x66, 0x07, 0x79, 0x56, 0xbb, 0x37, 0x23, 0x3a, 0x1b, 0x89, 0x21, 0x2d,
+    0x6f, 0x05, 0x52, 0xce, 0xd1, 0x49, 0x36, 0xf8, 0xb7, 0xad, 0x19, 0xe3, 0xee, 0x83, 0xa1, 0x24,
+    0xbd, 0x40, 0xd7, 0xab, 0x3b, 0xab, 0xbf, 0xbc, 0xc1, 0x2c, 0x85, 0x22, 0x57, 0x35, 0xb1, 0x41,
+    0xe8, 0xd5, 0x91, 0x95, 0xc1, 0x56, 0x76, 0xb1, 0x17, 0xd9, 0x3b, 0xbe, 0x0f, 0xa7, 0x93, 0x3b,
+    0x65, 0x5e, 0x15, 0x93, 0xe0, 0x5f, 0x78, 0x8a, 0xb4, 0x71, 0x91, 0x57, 0x02, 0x6a, 0x0b, 0x3e,
+    0xca, 0x2d, 0x42, 0xd0, 0x93, 0xe3, 0x5b, 0xf0, 0x0e, 0xb4, 0x0b, 0x6d, 0x37, 0x60, 0x5d, 0x5a,
+    0x15, 0xef, 0x13, 0x21, 0x43, 0x98, 0xa3, 0x5a, 0x9f, 0x06, 0x32, 0xdf, 0x49, 0x0b, 0x90, 0xfe,
+    0xba, 0xf4, 0x46, 0x69, 0x65, 0x38, 0x2d, 0xfb, 0x02, 0x48, 0xfb, 0x31, 0x4b, 0x04, 0x48, 0x0b,
+    0xb9, 0x7e, 0xbc, 0xe2, 0xd0, 0x98, 0x2e, 0x66, 0x13, 0xda, 0xb0, 0x93, 0x4f, 0xc1, 0x16, 0x8f,
+    0x8c, 0xcf, 0x2f, 0x6e, 0x9a, 0xfa, 0x8b, 0x60, 0xa3, 0xaa, 0x51, 0x52, 0x84, 0x70, 0x20, 0xef,
+    0x65, 0x65, 0xb6, 0x1f, 0xc0, 0xd8, 0x81, 0xea, 0xac, 0xe4, 0xaa, 0x58, 0x62, 0xbd, 0xc2, 0x15,
+    0x98, 0x23, 0x1d, 0x79, 0xea, 0x69, 0x25, 0xe6, 0x6e, 0xfe, 0xd0, 0x29, 0xb5, 0xff, 0x65, 0xe1,
+    0x6b, 0x65, 0x39, 0x7f, 0x58, 0x73, 0x5e, 0xd0, 0xd6, 0x07, 0x39, 0xe6, 0xf0, 0x7a, 0x03, 0x0f,
+    0xcc, 0xaf, 0x8e, 0x35, 0x5b, 0xe7, 0xed, 0x74, 0x58, 0xfe, 0xc0, 0xd8, 0xf3, 0xc7, 0xe6, 0x84,
+    0x2f, 0xe2, 0x76, 0x17, 0x96, 0xdf, 0x3f, 0xc8, 0x98, 0xf9, 0x16, 0xa0, 0x4c, 0x83, 0xc5, 0x30,
+    0x99, 0xfc, 0x7e, 0xcd, 0x44, 0x60, 0x0d, 0x62, 0xf8, 0x96, 0xf7, 0xae, 0x8b, 0xba, 0x96, 0xbb,
+    0xa9, 0x65, 0x22, 0xb4, 0x0f, 0x06, 0x5a, 0x6b, 0x4f, 0x45, 0xfe, 0x86, 0x0b, 0x11, 0x10, 0x54,
+    0x7f, 0x24, 0x2e, 0xe7, 0xd3, 0x40, 0xac, 0xb9, 0x04, 0x11, 0x15, 0x5e, 0xb5, 0xbc, 0x09, 0x44,
+    0x43, 0xf5, 0x4f, 0x00, 0x25, 0xa3, 0xb1, 0xd6, 0x1b, 0x87, 0x33, 0xf8, 0xbf, 0xbc, 0x93, 0x2d,
+    0x6d, 0x01, 0x71, 0xda, 0x46, 0x00, 0xdf, 0xa9, 0x32, 0xbf, 0x31, 0x33, 0xc2, 0xb9, 0xe5, 0xc7,
+    0xbb, 0x4b, 0x16, 0x5d, 0x29, 0xeb, 0x95, 0x9f, 0x26, 0x6d, 0xc5, 0x5a, 0x2e, 0x25, 0xd3, 0xc1,
+    0x3e, 0xa6, 0x7b, 0x12, 0xd4, 0x36, 0xc2, 0x40, 0xc9, 0x5a, 0x9f, 0xde, 0xee, 0x6b, 0xe8, 0xec,
+    0x62, 0x72, 0x78, 0x7a, 0x35, 0xa7, 0x1e, 0xc0, 0x70, 0xc2, 0x36, 0x1c, 0xd7, 0x94, 0x60, 0xb5,
+    0x15, 0x6f, 0xac, 0x4f, 0x81, 0x12, 0x32, 0x2b, 0x7e, 0x93, 0x66, 0x58, 0xfb, 0x1a, 0x4b, 0xa6,
+    0x33, 0x01, 0x0b, 0xb8, 0x8e, 0x2b, 0x7a, 0xfb, 0x9b, 0xda, 0xb9, 0xa3, 0xd9, 0x3b, 0x75, 0x7a,
+    0x88, 0x31, 0xfb, 0xe7, 0x36, 0x7f, 0x00, 0x58, 0xb3, 0xe6, 0x95, 0x66, 0xc8, 0xbb, 0x12, 0x43,
+    0xb4, 0x9e, 0x0f, 0x84, 0x90, 0x68, 0xd3, 0x5d, 0x12, 0xf4, 0x22, 0xed, 0x87, 0xef, 0x59, 0x9e,
+    0x2c, 0x51, 0xa9, 0x10, 0x06, 0x26, 0xe1, 0x46, 0x06, 0xa4, 0xb8, 0xa1, 0x4c, 0x85, 0x58, 0x29,
+    0x7f, 0xf8, 0xa9, 0xc4, 0x56, 0x4e, 0x31, 0x89, 0x88, 0x5e, 0xe6, 0xa2, 0x82, 0x12, 0xab, 0x82,
+    0x4a, 0x04, 0xb3, 0xac, 0x3a, 0xa3, 0x14, 0xda, 0x56, 0x5c, 0x13, 0x29, 0xc2, 0x7c, 0xf8, 0x1d,
+    0xd3, 0xf9, 0x3c, 0xdc, 0xa2, 0xb3, 0xb3, 0x78, 0x23, 0x55, 0x22, 0x04, 0xc5, 0x42, 0xfc, 0x80,
+    0xa0, 0xfc, 0x3e, 0x75, 0x19, 0xe2, 0xda, 0xef, 0x8e, 0xb0, 0x00, 0x6f, 0xd9, 0xf9, 0xa0, 0xd8,
+    0xfe, 0x88, 0x6d, 0x58, 0x87, 0x3d, 0x2e, 0x67, 0xb8, 0xaf, 0x75, 0x85, 0x80, 0x24, 0x24, 0xa7,
+    0xc5, 0x8f, 0x6c, 0xbd, 0xa1, 0x86, 0x80, 0x8e, 0x00, 0x3d, 0x72, 0x0e, 0x64, 0x05, 0xef, 0x82,
+    0x09, 0x5c, 0x82, 0xee, 0xd0, 0x87, 0xf1, 0x28, 0x70, 0x46, 0x24, 0xd0, 0x6f, 0x2b, 0xea, 0x72,
+    0xf6, 0x1f, 0xa2, 0xc4, 0x77, 0x43, 0xa7, 0xac, 0xb6, 0x3a, 0xe0, 0x34, 0x4b, 0xd9, 0xc5, 0x1f,
+    0xdc, 0xc6, 0x8e, 0xf6, 0xf8, 0xca, 0x3b, 0x84, 0x37, 0xcc, 0x1e, 0x83, 0xb1, 0x2c, 0x20, 0x2b,
+    0xf3, 0x64, 0x8c, 0xaf, 0x75, 0x2a, 0x32, 0x49, 0xde, 0x9f, 0x19, 0x47, 0x29, 0xbc, 0xc8, 0x90,
+    0x93, 0xd9, 0x5c, 0x5f, 0x07, 0x49, 0x82, 0xfd, 0x1e, 0x72, 0x0e, 0xad, 0x94, 0x01, 0xcb, 0x6e,
+    0x2e, 0x53, 0xea, 0xa3, 0xe5, 0x22, 0xab, 0x37, 0xe3, 0x36, 0xda, 0xac, 0xbd, 0x64, 0xca, 0x4b,
+    0xf6, 0xa5, 0x00, 0x03, 0x76, 0x92, 0x51, 0x96, 0xe0, 0xbe, 0x47, 0x7f, 0x52, 0x02, 0xb8, 0x6b,
+    0x24, 0x6c, 0x34, 0xfe, 0xf5, 0x25, 0x06, 0xe7, 0x67, 0x92, 0xa9, 0x85, 0x92, 0x52, 0x7e, 0x47,
+    0xf3, 0x2b, 0x26, 0x45, 0x4e, 0xac, 0x9a, 0x52, 0x9f, 0x28, 0x17, 0x70, 0x52, 0x22, 0x0d, 0x33,
+    0xef, 0xf5, 0xfd, 0xe9, 0xa3, 0x5d, 0xc4, 0x0a, 0x52, 0x19, 0x97, 0xe1, 0x9d, 0xa4, 0xee, 0xe1,
+    0xa6, 0x16, 0xff, 0x96, 0x9a, 0x5e, 0x16, 0xa0, 0xd8, 0xa0, 0xab, 0x17, 0x3a, 0xcb, 0xa3, 0x3a,
+    0x60, 0x67, 0x02, 0xc5, 0x81, 0xc4, 0x21, 0x09, 0x22, 0x7a, 0x8f, 0x87, 0xdf, 0x07, 0x15, 0x6b,
+    0x8d, 0xe7, 0x76, 0x89, 0x3d, 0xdc, 0x7e, 0x59, 0x54, 0x15, 0x45, 0x8b, 0x1f, 0x39, 0xc6, 0x9e,
+    0x74, 0xf6, 0x86, 0xf7, 0x56, 0x65, 0x85, 0x69, 0xee, 0x7e, 0x6b, 0x44, 0x31, 0x28, 0xb8, 0xb8,
+    0xa5, 0x5a, 0x99, 0xe1, 0x50, 0xe7, 0xa8, 0x79, 0x72, 0x37, 0x64, 0xce, 0x2e, 0xe0, 0x7c, 0x70,
+    0x64, 0x1f, 0x76, 0x09, 0x70, 0x40, 0xff, 0xf8, 0xa4, 0xa0, 0x14, 0x69, 0xeb, 0xd6, 0x80, 0x13,
+    0x53, 0xf0, 0x45, 0x79, 0xea, 0x0d, 0x85, 0xac, 0xc6, 0x73, 0x1d, 0x2b, 0x6a, 0x49, 0x33, 0x49,
+    0x8d, 0xe0, 0xd6, 0xca, 0x72, 0x48, 0xfa, 0x4e, 0xbc, 0xa3, 0x26, 0x56, 0x88, 0x36, 0xda, 0x44,
+    0x15, 0xb5, 0x0e, 0xfc, 0x07, 0x38, 0x9f, 0x47, 0x42, 0x50, 0x74, 0x1f, 0xf6, 0x84, 0x3d, 0xb1,
+    0xcf, 0x3f, 0x11, 0x78, 0x53, 0x4f, 0xce, 0xe1, 0xec, 0x11, 0xcc, 0x57, 0x44, 0xfc, 0xab, 0x7f,
+    0x9e, 0x3f, 0xfe, 0xc1, 0xe2, 0x29, 0x04, 0xf4, 0xbd, 0x4d, 0x0a, 0x50, 0xa3, 0x88, 0x03, 0x7d,
+    0x9a, 0x43, 0x91, 0x48, 0x48, 0xdc, 0x1d, 0x05, 0xe6, 0xb3, 0x06, 0xdc, 0xd3, 0xcf, 0xd8, 0xfe,
+    0x16, 0xc0, 0x52, 0x8e, 0xfd, 0x36, 0xc2, 0x4e, 0xdc, 0xb2, 0xd6, 0xc2, 0xb1, 0xf6, 0xbe, 0x5d,
+    0x76, 0x17, 0x54, 0xcb, 0x09, 0x31, 0x91, 0x63, 0xe0, 0xc7, 0xb2, 0xaf, 0x97, 0x85, 0x08, 0x79,
+    0x13, 0x11, 0x03, 0xcd, 0x35, 0x1c, 0xab, 0xa6, 0xb0, 0xef, 0xdd, 0xec, 0xff, 0xa0, 0x7e, 0xbf,
+    0x0e, 0xce, 0x5c, 0x8c, 0xd3, 0xaf, 0x76, 0xd7, 0x5c, 0xc8, 0x4d, 0x71, 0x57, 0xe5, 0x3b, 0x22,
+    0x2c, 0xbb, 0xb3, 0x8c, 0x3b, 0x01, 0x2d, 0x41, 0xec, 0x42, 0x6f, 0x4a, 0xb4, 0x6c, 0x5c, 0xa6,
+    0xcd, 0x50, 0xf6, 0x3f, 0xb0, 0x69, 0xb8, 0x09, 0x24, 0x61, 0x2a, 0xda, 0x32, 0xb2, 0xd0, 0x34,
+    0x51, 0xc5, 0x54, 0x86, 0x79, 0x91, 0x22, 0x43, 0xca, 0x8a, 0xa9, 0x81, 0x88, 0x4c, 0x84, 0x2d,
+    0xf6, 0xc9, 0x79, 0xeb, 0x2f, 0x1a, 0x4a, 0x05, 0xc7, 0x5f, 0xbe, 0x5b, 0x34, 0xd7, 0xc1, 0xfa,
+    0xcc, 0x4a, 0xa2, 0x51, 0x86, 0xb6, 0x4f, 0x5d, 0xf9, 0x9f, 0x5e, 0xe6, 0x02, 0x43, 0x54, 0xa5,
+    0xb3, 0x80, 0xf9, 0x25, 0x4d, 0xc0, 0x4a, 0x5e, 0xec, 0xda, 0x6b, 0xf2, 0x4d, 0x2f, 0x92, 0x73,
+    0x28, 0x8c, 0xef, 0xb9, 0xad, 0x93, 0x50, 0x42, 0xa7, 0xa5, 0x20, 0xd4, 0x28, 0x7a, 0xa2, 0xb0,
+    0x8a, 0xb4, 0x25, 0x99, 0x63, 0xcd, 0x6b, 0xd6, 0xba, 0x79, 0x6d, 0x12, 0x41, 0xd1, 0x71, 0xb3,
+    0x19, 0xf6, 0xdf, 0x4e, 0x70, 0x53, 0xa0, 0x43, 0x26, 0x2c, 0x7c, 0x14, 0x87, 0xfa, 0x67, 0xef,
+    0x2d, 0xf5, 0x4c, 0xaa, 0xef, 0x8f, 0x79, 0x7f, 0xad, 0x64, 0x63, 0xd5, 0x11, 0xe1, 0x12, 0x4d,
+    0x77, 0xf3, 0x61, 0xcd, 0x94, 0x80, 0x81, 0xc4, 0xde, 0xe6, 0x30, 0xf8, 0x17, 0x6b, 0x5a, 0x66,
+    0xbc, 0x5c, 0xbd, 0xa8, 0xc7, 0x37, 0x65, 0xf4, 0x73, 0xe5, 0x32, 0x55, 0x63, 0x1f, 0xb6, 0x70,
+    0xd2, 0xcc, 0x1f, 0xd4, 0xfb, 0x3c, 0xfd, 0x9e, 0x43, 0x45, 0x9d, 0xd0, 0x41, 0xf3, 0x8e, 0x57,
+    0xb2, 0x74, 0x7a, 0x1b, 0xd6, 0x3f, 0xa8, 0x04, 0xb8, 0xf0, 0x22, 0x8f, 0x69, 0x64, 0x43, 0x34,
+    0x3e, 0xa2, 0xff, 0x2a, 0xe7, 0x04, 0x4a, 0x08, 0xff, 0x90, 0x2c, 0x60, 0xcf, 0x99, 0x12, 0xde,
+    0xe8, 0x53, 0x6c, 0xcb, 0xe5, 0xc9, 0xed, 0x70, 0x40, 0x21, 0x49, 0x6c, 0xcc, 0x59, 0x9f, 0x8d,
+    0xd9, 0x37, 0xe2, 0x7f, 0xd8, 0x11, 0x77, 0x22, 0xe9, 0x6f, 0x74, 0x83, 0x13, 0xd8, 0x64, 0x50,
+    0x72, 0x57, 0x0e, 0xe8, 0x19, 0x0a, 0xf7, 0x60, 0x9d, 0x61, 0x38, 0xb8, 0x98, 0x89, 0xef, 0xa4,
+    0xce, 0x9a, 0xc4, 0x73, 0xea, 0xf3, 0x99, 0xb1, 0x58, 0x6b, 0x87, 0x08, 0xb9, 0x74, 0x55, 0x86,
+    0xd8, 0xaf, 0x61, 0x98, 0xf5, 0xeb, 0x80, 0x2d, 0x26, 0x02, 0x91, 0x65, 0x6b, 0xde, 0x1b, 0x9f,
+    0x45, 0xd0, 0x4e, 0x58, 0x62, 0xb0, 0x68, 0x60, 0x4b, 0xca, 0x88, 0x96, 0x84, 0xcd, 0x5b, 0xe6,
+    0x24, 0x71, 0xc7, 0x20, 0xf7, 0x1c, 0x2a, 0xe4, 0x29, 0xc2, 0x1f, 0x0b, 0x9f, 0xac, 0x63, 0x1f,
+    0x0d, 0x13, 0xfc, 0x4f, 0x99, 0x4c, 0x7b, 0x39, 0x45, 0xe4, 0xef, 0x27, 0x32, 0x9b, 0x43, 0xd5,
+    0x60, 0xd6, 0xe2, 0x67, 0xef, 0xa3, 0x70, 0xd1, 0x6a, 0xaa, 0x25, 0xc4, 0x34, 0xda, 0x35, 0x19,
+    0xea, 0xd9, 0x4f, 0x6a, 0x34, 0xa0, 0x74, 0x85, 0x7e, 0x04, 0x85, 0x3d, 0xb4, 0xe2, 0x93, 0xf6,
+    0x7f, 0x43, 0xa9, 0x87, 0xc2, 0x27, 0x54, 0xcd, 0xa2, 0x75, 0xaf, 0xe7, 0xc2, 0x4b, 0xf7, 0xeb,
+    0x2d, 0x91, 0x5f, 0xbf, 0xf0, 0x9a, 0xaf, 0xdb, 0x04, 0x67, 0xc2, 0xc1, 0xca, 0xad, 0x22, 0x65,
+    0xa9, 0x5b, 0xbb, 0xd5, 0x95, 0x01, 0xa3, 0x4f, 0x5f, 0x32, 0x08, 0xaa, 0x50, 0x73, 0x6b, 0x92,
+    0x83, 0x3c, 0xba, 0xfb, 0x33, 0x4a, 0x59, 0x93, 0xa6, 0xf6, 0xb4, 0x81, 0x45, 0xa5, 0x80, 0x3e,
+    0x61, 0x40, 0x5d, 0x71, 0x29, 0xd3, 0x4c, 0xb3, 0xd7, 0x5a, 0x56, 0x01, 0x72, 0x21, 0x0a, 0x9f,
+    0x4c, 0x75, 0x7d, 0x94, 0xe3, 0xca, 0xd3, 0xa5, 0xdc, 0x35, 0xc1, 0x2f, 0x26, 0xc6, 0xcb, 0x52,
+    0xee, 0x1e, 0xe1, 0x76, 0xf0, 0x02, 0xf8, 0x2a, 0xde, 0x16, 0x45, 0x00, 0x84, 0xc4, 0xa9, 0x25,
+    0xd3, 0x2c, 0x5a, 0xc7, 0x78, 0x32, 0x53, 0x65, 0x3d, 0x9b, 0x09, 0x01, 0x3a, 0xd9, 0xa2, 0x82,
+    0x0a, 0x8e, 0x10, 0xc5, 0x76, 0xb8, 0xc6, 0x71, 0x6c, 0x62, 0x67, 0x88, 0xb7, 0x50, 0x56, 0x7e,
+    0x39, 0x45, 0x2d, 0x09, 0x10, 0x7a, 0x50, 0x33, 0x39, 0x31, 0x73, 0x15, 0x41, 0x68, 0xb0, 0xa8,
+    0x40, 0xa4, 0x71, 0x5d, 0x6e, 0xf4, 0x2b, 0xaa, 0xd5, 0x68, 0x74, 0x55, 0x68, 0x0b, 0x26, 0x97,
+    0x2a, 0x5d, 0x7c, 0xc0, 0x71, 0xc5, 0xbe, 0x63, 0x4a, 0x84, 0x92, 0xae, 0x2a, 0xa5, 0x6f, 0xd0,
+    0x3f, 0x6c, 0x59, 0x4e, 0x66, 0x00, 0x20, 0x50, 0x7b, 0x92, 0xf8, 0x15, 0x5c, 0xec, 0x3b, 0xac,
+    0xbe, 0xff, 0x2e, 0x99, 0x5b, 0x14, 0xdb, 0x78, 0x08, 0xa1, 0x23, 0xd7, 0xb4, 0x2c, 0x6e, 0xf6,
+    0x75, 0x2a, 0xf3, 0xa5, 0x99, 0x2f, 0x97, 0x2b, 0xeb, 0x2e, 0x25, 0x51, 0x38, 0xa4, 0x2a, 0x19,
+    0x76, 0xc8, 0xf0, 0xb1, 0x00, 0xbf, 0xaa, 0xf9, 0xe5, 0x6b, 0x70, 0x1f, 0x91, 0x2a, 0x4c, 0x36,
+    0xe6, 0x17, 0x3c, 0xff, 0xc7, 0x3b, 0x60, 0x57, 0x5d, 0x00, 0x8c, 0xff, 0xa0, 0xe6, 0x18, 0x8d,
+    0x18, 0xcf, 0x2d, 0xf2, 0xe5, 0xa9, 0x95, 0xb1, 0x04, 0x92, 0x72, 0xe8, 0x49, 0x5b, 0x8f, 0xf0,
+    0x92, 0x1d, 0x44, 0x1b, 0x80, 0xd3, 0x45, 0xb7, 0xda, 0xd2, 0x92, 0x27, 0x83, 0x21, 0xec, 0xc3,
+    0x4a, 0x26, 0x66, 0x93, 0x2c, 0xd4, 0xc5, 0x73, 0xdb, 0x3d, 0xf7, 0xb1, 0x64, 0x21, 0x56, 0x41,
+    0xab, 0x11, 0x99, 0x25, 0xdc, 0x10, 0x6e, 0x76, 0xa8, 0x75, 0xf0, 0x8c, 0x85, 0x5c, 0x4d, 0x06,
+    0x8e, 0xfc, 0xc1, 0xe8, 0xd2, 0x73, 0x27, 0x58, 0x40, 0xc1, 0x84, 0xd1, 0x1c, 0xcb, 0xf9, 0x7a,
+    0xf5, 0xae, 0xae, 0x0d, 0x5f, 0x54, 0x38, 0x0f, 0x3b, 0x2b, 0xdd, 0x9e, 0x10, 0xd6, 0x59, 0xdb,
+    0x41, 0xf7, 0x51, 0x25, 0xe3, 0x14, 0x74, 0xec, 0x4a, 0x39, 0x74, 0xa6, 0x88, 0x4a, 0x06, 0x19,
+    0x4a, 0x59, 0x86, 0x9c, 0x6b, 0xd5, 0x96, 0x37, 0x5d, 0x8c, 0xa5, 0xbf, 0x14, 0x71, 0xfa, 0x2b,
+    0x0e, 0x1e, 0x85, 0x33, 0x3b, 0x8b, 0xe5, 0x67, 0x62, 0xd5, 0x0d, 0xb7, 0x3c, 0x98, 0x29, 0xf1,
+    0xa7, 0x4c, 0xc9, 0x4a, 0x81, 0x8e, 0xee, 0x32, 0xf5, 0xc2, 0xb8, 0x2b, 0xf4, 0xb7, 0xc5, 0xa4,
+    0xf5, 0x40, 0xf7, 0x44, 0x8f, 0x30, 0xe8, 0x73, 0xe6, 0x15, 0xd2, 0xf9, 0x45, 0xf0, 0x58, 0x1c,
+    0x64, 0x37, 0x6a, 0x73, 0x66, 0xe8, 0x16, 0xe7, 0xc7, 0x29, 0x13, 0x0b, 0x0a, 0xe7, 0xc3, 0x19,
+    0x1a, 0x22, 0xea, 0x66, 0xda, 0x9b, 0x99, 0x68, 0x92, 0xf2, 0xa5, 0xd5, 0x76, 0x7c, 0x1f, 0xc1,
+    0xc0, 0x4a, 0x6a, 0x35, 0xff, 0x49, 0xa0, 0x11, 0x8b, 0xb6, 0x3d, 0x9a, 0x58, 0xb5, 0xda, 0xa2,
+    0xf7, 0xff, 0x93, 0xf7, 0x75, 0x9d, 0xc2, 0xd6, 0xeb, 0xf0, 0x7d, 0x8f, 0xdb, 0xf4, 0x82, 0x35,
+    0x8c, 0x9a, 0x22, 0x92, 0x4c, 0x30, 0x69, 0x9e, 0xad, 0x34, 0xfa, 0xbb, 0xd2, 0xcf, 0x10, 0xbc,
+    0x86, 0xd5, 0x64, 0x14, 0x06, 0x3e, 0x5e, 0x01, 0x39, 0xc1, 0x0d, 0xbe, 0xa0, 0x0c, 0x2a, 0x7a,
+    0x70, 0xf5, 0xac, 0xeb, 0xf8, 0xe6, 0x88, 0x7a, 0xb9, 0x45, 0x82, 0x62, 0xc4, 0x0b, 0x33, 0xce,
+    0x0d, 0xf0, 0xf0, 0x62, 0x5c, 0x54, 0x6e, 0x9f, 0xb7, 0x17, 0xb1, 0x15, 0x52, 0x1e, 0x7b, 0x95,
+    0xe0, 0x3b, 0x34, 0xd0, 0xd9, 0xad, 0x61, 0x2f, 0x3d, 0xee, 0x54, 0x46, 0x92, 0x25, 0x57, 0x30,
+    0x89, 0xbf, 0xf8, 0x02, 0xb4, 0xd2, 0xb9, 0x12, 0x42, 0xf3, 0xf4, 0x47, 0x5d, 0xaf, 0x44, 0xc2,
+    0x6a, 0xc6, 0x39, 0xb7, 0xc5, 0xb9, 0xcb, 0x4e, 0x6f, 0x28, 0xfe, 0x85, 0x25, 0x78, 0xd9, 0x96,
+    0x59, 0xb4, 0x59, 0x1d, 0x10, 0x1c, 0xbd, 0x77, 0xf1, 0x12, 0x6b, 0x03, 0x28, 0xd9, 0x11, 0x7c,
+    0x12, 0x2c, 0x8e, 0xab, 0xa5, 0xfe, 0x18, 0xe9, 0xaf, 0x45, 0x22, 0x61, 0x20, 0x12, 0x6c, 0x22,
+    0xd1, 0x2a, 0x31, 0x7b, 0x10, 0x8c, 0x14, 0x38, 0xdf, 0x61, 0xef, 0xaa, 0x1e, 0xe7, 0x5c, 0x0f,
+    0x5d, 0x30, 0x90, 0x2d, 0xf4, 0x58, 0x0a, 0x56, 0x73, 0x18, 0x12, 0xd5, 0x77, 0x70, 0x6d, 0x80,
+    0x6b, 0xf5, 0x1a, 0xb0, 0x98, 0x99, 0x44, 0x85, 0x60, 0x08, 0x35, 0xb8, 0x5d, 0xe4, 0xd2, 0x55,
+    0xee, 0x9d, 0x3b, 0x74, 0x15, 0x00, 0x39, 0x3a, 0x9b, 0x80, 0x3e, 0x8c, 0xfd, 0xa5, 0xbe, 0x51,
+    0xfc, 0x67, 0x30, 0x23, 0x52, 0x2d, 0xbe, 0xa2, 0x58, 0xa3, 0xbd, 0x03, 0xd8, 0xe1, 0xd3, 0xb9,
+    0xc0, 0x3e, 0xa4, 0x62, 0x1d, 0xeb, 0x20, 0x3b, 0x53, 0xb6, 0x4e, 0x35, 0x83, 0x32, 0xca, 0xfc,
+    0x06, 0xde, 0x88, 0x2d, 0xee, 0x49, 0x30, 0x4e, 0x1f, 0xb5, 0xdd, 0x14, 0xa5, 0x1e, 0xec, 0x05,
+    0x91, 0xa8, 0x10, 0x49, 0x43, 0xa7, 0x17, 0xfe, 0x86, 0x80, 0x05, 0x4e, 0xf3, 0x8e, 0xda, 0x5b,
+    0xef, 0xaa, 0xee, 0x3d, 0x90, 0xe9, 0xa6, 0xf6, 0x6c, 0x5a, 0x0b, 0x78, 0x5c, 0xd1, 0x19, 0xaf,
+    0x7c, 0x90, 0xb1, 0x47, 0x7b, 0xd1, 0x1c, 0x92, 0x45, 0xe9, 0x56, 0x88, 0xb8, 0x70, 0x2d, 0x0a,
+    0x36, 0x17, 0x93, 0x8a, 0x9f, 0x6f, 0x8e, 0x10, 0x28, 0xed, 0xbb, 0x19, 0x3c, 0xbe, 0x66, 0x80,
+    0xb8, 0x70, 0xe0, 0xf7, 0x8e, 0x9f, 0x60, 0x65, 0x82, 0x55, 0x9b, 0xdd, 0x74, 0xbf, 0x6e, 0xd6,
+    0x8e, 0xd3, 0x9c, 0x32, 0x05, 0x93, 0x77, 0x10, 0xf3, 0xa5, 0xd7, 0x10, 0x02, 0x28, 0x13, 0xe1,
+    0xd1, 0xeb, 0xbe, 0xeb, 0x8a, 0x86, 0xb0, 0x5c, 0x06, 0x7c, 0x7d, 0x92, 0xba, 0xd8, 0x2d, 0x51,
+    0x75, 0x84, 0x7c, 0x15, 0x73, 0x2c, 0xe2, 0x81, 0xaa, 0xdb, 0xda, 0x87, 0x63, 0x9d, 0xf5, 0xc5,
+    0xc9, 0xaf, 0x7a, 0xa3, 0x58, 0xc8, 0x70, 0x1b, 0xe6, 0x6a, 0x32, 0xb2, 0x68, 0x27, 0x6f, 0x35,
+    0x86, 0x15, 0x51, 0xcc, 0x1c, 0xa5, 0x18, 0xf5, 0xef, 0xfb, 0x1f, 0xaa, 0x75, 0x4e, 0xca, 0x9f,
+    0xf2, 0xb9, 0xa8, 0xcf, 0x0d, 0x9c, 0x35, 0x9a, 0xf9, 0xa2, 0xec, 0xce, 0xaf, 0xb1, 0x07, 0xf0,
+    0x99, 0xe8, 0xc3, 0x4e, 0xbf, 0xdd, 0x96, 0xaf, 0x4f, 0xf7, 0x2d, 0x53, 0xc6, 0x0b, 0xef, 0xcb,
+    0x83, 0x48, 0xd2, 0xf4, 0x34, 0x56, 0x39, 0xe7, 0x81, 0xe1, 0x3a, 0xb6, 0x90, 0x6a, 0x47, 0xdf,
+    0x66, 0x09, 0x0a, 0xf9, 0xf4, 0x8d, 0xd6, 0x25, 0xcc, 0x90, 0x60, 0x3c, 0x3f, 0x88, 0xd0, 0x92,
+    0xea, 0xda, 0xf1, 0x93, 0x27, 0x28, 0xcc, 0x42, 0x6b, 0x81, 0x38, 0xba, 0x7a, 0x22, 0xd0, 0x26,
+    0x9b, 0x92, 0xad, 0xf3, 0xf6, 0x01, 0xde, 0x5a, 0xd2, 0x77, 0x5f, 0x2d, 0x31, 0xce, 0x48, 0xb0,
+    0x27, 0x26, 0x61, 0x11, 0x1e, 0xfd, 0x3d, 0xbc, 0xb9, 0x07, 0x20, 0x07, 0x74, 0x07, 0xed, 0x52,
+    0x35, 0x19, 0x04, 0x5a, 0xf2, 0x14, 0xf7, 0x65, 0xba, 0x23, 0xcf, 0x2d, 0xe6, 0xf7, 0x89, 0xaa,
+    0x3d, 0xf2, 0x19, 0x35, 0x79, 0xda, 0x75, 0xf8, 0x4e, 0x53, 0x31, 0x43, 0xba, 0x40, 0xa9, 0x4a,
+    0xcb, 0xad, 0x97, 0x8a, 0xb6, 0x38, 0x9a, 0x33, 0xca, 0xbe, 0xf8, 0xb3, 0x28, 0xa1, 0x73, 0x28,
+    0x3f, 0x6b, 0x55, 0xd8, 0xd3, 0xda, 0xdd, 0x05, 0x08, 0x7a, 0x72, 0x86, 0xa8, 0x4a, 0x74, 0x8f,
+    0xb2, 0x59, 0xa2, 0x1b, 0x63, 0x83, 0x60, 0x97, 0xba, 0xfc, 0x22, 0x04, 0xa3, 0x17, 0x9d, 0x85,
+    0x1f, 0x7a, 0x8d, 0x2d, 0x9d, 0xb7, 0xe6, 0xfd, 0xdf, 0x0e, 0xe8, 0x09, 0x55, 0x0d, 0x88, 0x1d,
+    0x8c, 0x3d, 0xcd, 0x25, 0xa4, 0x35, 0x1d, 0x2f, 0xaa, 0x58, 0xfd, 0x5a, 0x36, 0x1b, 0x92, 0x7f,
+    0xed, 0x99, 0x5d, 0x83, 0x16, 0xfd, 0x44, 0xd8, 0x76, 0xb9, 0x82, 0x8d, 0x74, 0xb1, 0xd7, 0x63,
+    0xed, 0x5b, 0x4d, 0xb1, 0xf0, 0x77, 0xcd, 0xe1, 0xb4, 0xb9, 0xb1, 0x27, 0x4b, 0x51, 0x7a, 0x81,
+    0x65, 0x9d, 0xa2, 0x1a, 0xb2, 0x00, 0xac, 0xbd, 0x84, 0x9b, 0x20, 0xa2, 0x25, 0x1b, 0x10, 0xa1,
+    0x1b, 0x63, 0xbb, 0x01, 0x2f, 0xd0, 0xc4, 0x95, 0xed, 0x24, 0x3c, 0xb8, 0x75, 0xae, 0x7f, 0x5f,
+    0x32, 0x01, 0x83, 0x12, 0x0f, 0xc5, 0x02, 0xf7, 0x96, 0x1f, 0xe0, 0xd8, 0xeb, 0x13, 0xfa, 0x33,
+    0x5a, 0x23, 0x70, 0x77, 0x74, 0x17, 0x37, 0x23, 0xe9, 0xa8, 0xf4, 0x2a, 0xcd, 0xaf, 0x60, 0x56,
+    0x11, 0x6b, 0xdb, 0x59, 0xdb, 0x0c, 0x92, 0xb2, 0x4a, 0x1d, 0x26, 0x89, 0xdf, 0x15, 0xcd, 0x23,
+    0x1c, 0xd0, 0x1c, 0xb9, 0x9e, 0x7f, 0x27, 0x66, 0xc9, 0x98, 0x1b, 0x59, 0x89, 0x08, 0xf0, 0x10,
+    0xbf, 0xf0, 0x85, 0x7f, 0xcd, 0xe6, 0x8a, 0x93, 0x02, 0xcc, 0xc5, 0x09, 0x14, 0xe3, 0xd0, 0x95,
+    0x1c, 0xf8, 0x1d, 0x01, 0xd1, 0x2b, 0xa3, 0xb5, 0x69, 0x56, 0x35, 0xc2, 0xe8, 0xbb, 0xa7, 0x97,
+    0x1d, 0x47, 0x23, 0x74, 0xbc, 0x04, 0xab, 0x3c, 0x3f, 0x9b, 0xc1, 0x43, 0xe9, 0x96, 0x39, 0xb4,
+    0x04, 0x77, 0x29, 0x71, 0x6a, 0xb3, 0xa5, 0x84, 0xce, 0xab, 0x3d, 0x3a, 0x44, 0xa2, 0x01, 0xb1,
+    0xd7, 0x5c, 0xb5, 0x85, 0x44, 0x00, 0x82, 0x49, 0x50, 0xda, 0x8f, 0xb9, 0xe8, 0xad, 0x21, 0xb6,
+    0x82, 0x72, 0xfc, 0xb5, 0xb4, 0x8b, 0x84, 0x38, 0x02, 0xa8, 0x02, 0x25, 0x6c, 0xbb, 0xd3, 0xe5,
+    0xd1, 0x90, 0x72, 0x29, 0xfe, 0xe2, 0x8e, 0xd6, 0x33, 0x2b, 0x0a, 0x20, 0x48, 0x5c, 0x7b, 0xcb,
+    0x40, 0xcf, 0xd5, 0xc6, 0x4a, 0x25, 0x79, 0x2b, 0x55, 0xb7, 0x7d, 0xf6, 0x2e, 0x0f, 0xb8, 0x43,
+    0xdb, 0x74, 0x7b, 0x6a, 0x7e, 0x1f, 0xd8, 0x9c, 0x75, 0x1b, 0x7b, 0x03, 0xac, 0xcd, 0xcb, 0x0a,
+    0x22, 0x6d, 0xb0, 0xd7, 0xc0, 0xf3, 0x4c, 0x2c, 0x1e, 0xea, 0x2c, 0xa6, 0xf4, 0xfe, 0x1e, 0xf9,
+    0xab, 0xe6, 0xc5, 0xa8, 0x2e, 0xa9, 0x70, 0x22, 0x27, 0x19, 0x97, 0x9b, 0x06, 0xf4, 0xeb, 0x2a,
+    0x1c, 0x94, 0xfb, 0xf8, 0xc1, 0x29, 0x0f, 0xec, 0x0b, 0xb7, 0xaf, 0x04, 0x84, 0xf9, 0xe0, 0x17,
+    0x15, 0xbc, 0x47, 0xfd, 0x01, 0x9c, 0x88, 0x42, 0xa4, 0x35, 0xb0, 0xb3, 0x43, 0x37, 0x77, 0x3e,
+    0xbf, 0xb0, 0xd2, 0x83, 0xcf, 0x16, 0xc8, 0x01, 0xa2, 0x10, 0x27, 0x4c, 0x69, 0x4b, 0x2c, 0xeb,
+    0xa3, 0xed, 0x51, 0xa7, 0x87, 0x85, 0x0b, 0x1f, 0xa8, 0x11, 0x6e, 0x39, 0x28, 0x1d, 0xf8, 0x46,
+    0x44, 0x79, 0x3a, 0x9b, 0x73, 0x33, 0xc9, 0x41, 0x9b, 0xf3, 0xca, 0x06, 0x3c, 0x14, 0x4f, 0x72,
+    0xc4, 0xd4, 0x65, 0xdf, 0xf5, 0x35, 0x5f, 0x6e, 0x50, 0xc6, 0x10, 0xb5, 0xc3, 0xe0, 0xe0, 0xfd,
+    0xbf, 0xe5, 0x5d, 0xca, 0x2f, 0x42, 0xbe, 0xc8, 0x58, 0x91, 0x7b, 0x18, 0x50, 0x0b, 0x43, 0x26,
+    0x95, 0x78, 0x9c, 0x21, 0x24, 0x05, 0xa4, 0xe8, 0xe0, 0xb4, 0x0b, 0x42, 0xd4, 0x5f, 0xd6, 0xe7,
+    0xba, 0xbd, 0x21, 0x8b, 0x6c, 0xba, 0x15, 0xcc, 0x8a, 0xed, 0xaa, 0x15, 0x31, 0xb5, 0x9a, 0xe0,
+    0x98, 0x78, 0x17, 0xde, 0x09, 0x1a, 0xa9, 0xc5, 0xb3, 0x41, 0x30, 0xef, 0x60, 0xff, 0xd9, 0x40,
+    0xcf, 0xf3, 0xb5, 0xcf, 0xd9, 0xba, 0x51, 0xb4, 0x60, 0x8a, 0xf9, 0x3d, 0x0a, 0xa4, 0x4e, 0xa9,
+    0x03, 0x35, 0x4f, 0x2f, 0x28, 0xfe, 0x3d, 0xc2, 0x33, 0x20, 0x12, 0x55, 0xf6, 0xbf, 0xec, 0x85,
+    0x4e, 0xba, 0x07, 0x48, 0x8d, 0xf5, 0xa3, 0x6c, 0x05, 0xaf, 0xee, 0x92, 0xf9, 0x0d, 0x83, 0x8f,
+    0xc4, 0xf8, 0x6b, 0xff, 0x81, 0xb7, 0xe9, 0x70, 0x85, 0x9d, 0xf9, 0xe7, 0x28, 0x11, 0x1b, 0x98,
+    0xa0, 0x7d, 0x8f, 0xfb, 0x19, 0x09, 0x35, 0x96, 0x9e, 0x3a, 0x5d, 0xa1, 0x4a, 0xe5, 0xcb, 0x7a,
+    0x0c, 0x08, 0xa9, 0xe1, 0xe9, 0x2c, 0x5e, 0xd0, 0xb6, 0x43, 0xf7, 0x7d, 0x1e, 0x96, 0x39, 0x32,
+    0x1a, 0x38, 0x22, 0xa2, 0x85, 0xaf, 0x5a, 0x51, 0x2e, 0x6e, 0xfe, 0xae, 0x76, 0x3a, 0xf9, 0x82,
+    0x9d, 0xd3, 0x2b, 0x8a, 0xe8, 0x9a, 0x12, 0x4e, 0xeb, 0x32, 0xdc, 0xde, 0xfa, 0x14, 0x63, 0x78,
+    0x94, 0x63, 0x53, 0x21, 0xa6, 0xf9, 0xb4, 0xae, 0x80, 0xae, 0xfb, 0x14, 0x3a, 0xf6, 0x39, 0xf3,
+    0x90, 0xb6, 0x4c, 0x74, 0x51, 0x03, 0xdb, 0x05, 0xad, 0x4d, 0x08, 0x12, 0x35, 0xe8, 0x4f, 0xab,
+    0xa2, 0xd2, 0x23, 0xfd, 0x27, 0x5f, 0xb5, 0xf6, 0x30, 0x84, 0x73, 0xee, 0x16, 0x8b, 0xdd, 0x36,
+    0x8a, 0x9a, 0x9b, 0x2f, 0x55, 0x9e, 0x0c, 0x1f, 0x11, 0x42, 0xf2, 0x84, 0xb5, 0x7c, 0x18, 0x24,
+    0xec, 0x87, 0x13, 0xb3, 0x42, 0xc3, 0x5a, 0xcf, 0xf2, 0x83, 0xbe, 0xf8, 0x4f, 0xff, 0x19, 0x1f,
+    0x2d, 0xee, 0xb9, 0x62, 0x6b, 0xf7, 0x9a, 0x3b, 0x1b, 0x9e, 0x54, 0x20, 0x47, 0xc5, 0x27, 0xff,
+    0x76, 0xf4, 0xa9, 0xb6, 0x99, 0xb4, 0xd4, 0xef, 0x53, 0x33, 0x04, 0x9c, 0x80, 0x36, 0x04, 0xb7,
+    0x11, 0x62, 0x5a, 0x57, 0xfb, 0x77, 0x09, 0x93, 0xc0, 0x22, 0x2b, 0xf1, 0xde, 0x08, 0x87, 0xd6,
+    0x7a, 0x55, 0xc6, 0xdb, 0x5d, 0xd9, 0xbd, 0x00, 0xcd, 0x3e, 0x50, 0x13, 0xf1, 0x16, 0x67, 0xe2,
+    0x15, 0xb2, 0x21, 0xc9, 0x09, 0xf8, 0x63, 0xc6, 0xf6, 0x1e, 0x1b, 0x0e, 0x45, 0x7d, 0x32, 0x93,
+    0xac, 0x98, 0xaf, 0x91, 0x2f, 0x0a, 0x71, 0xb8, 0x8d, 0xf2, 0x28, 0x84, 0x81, 0xd4, 0xe4, 0x20,
+    0xa4, 0x8a, 0x44, 0xbc, 0x30, 0x94, 0xbe, 0x67, 0xfd, 0x7e, 0x16, 0x31, 0x2d, 0xfb, 0x71, 0xce,
+    0xf3, 0x95, 0x58, 0x8f, 0xfd, 0xdb, 0xa7, 0xc6, 0x4e, 0xe1, 0x10, 0xaa, 0xe4, 0x25, 0x86, 0x4f,
+    0x45, 0x60, 0x5b, 0xa4, 0x43, 0xa6, 0x9d, 0x89, 0xb9, 0x77, 0x3f, 0x61, 0x54, 0x9f, 0x19, 0x5b,
+    0x72, 0x09, 0x13, 0xf4, 0x4a, 0xec, 0xe8, 0xaa, 0xca, 0xa0, 0xbb, 0x0e, 0x65, 0xf7, 0xcc, 0x24,
+    0x87, 0x10, 0x26, 0x04, 0xfe, 0x19, 0x01, 0xf9, 0xba, 0x67, 0xe8, 0x7a, 0x51, 0x65, 0xc1, 0x34,
+    0xf5, 0xf7, 0xe1, 0x44, 0x71, 0x98, 0x51, 0x9e, 0xae, 0x8e, 0xdf, 0x0d, 0x57, 0x99, 0xd3, 0x3d,
+    0xbd, 0x58, 0xfd, 0x66, 0xa1, 0xfb, 0x3d, 0x53, 0x3c, 0x90, 0x8e, 0xa3, 0x2f, 0x31, 0x9f, 0x77,
+    0xeb, 0x95, 0xfe, 0x09, 0xfb, 0xe9, 0x68, 0x95, 0xbe, 0x8f, 0x87, 0x74, 0x72, 0xbf, 0x6d, 0xc3,
+    0x05, 0xea, 0xf1, 0x53, 0x28, 0x53, 0xff, 0x2e, 0xf4, 0x6e, 0x30, 0xb7, 0x2c, 0x71, 0xa4, 0x65,
+    0xf3, 0xc4, 0x74, 0x5f, 0x27, 0x5a, 0x98, 0x48, 0x86, 0xca, 0xb0, 0xbf, 0x64, 0xe8, 0x7e, 0xd5,
+    0x5a, 0x58, 0x12, 0x24, 0xde, 0x59, 0x7f, 0xcf, 0x4b, 0x02, 0x39, 0x65, 0x70, 0xd3, 0x47, 0x83,
+    0x73, 0xd7, 0x7d, 0x49, 0x9e, 0x79, 0x72, 0xf7, 0xb0, 0xd6, 0xce, 0xf3, 0x5f, 0xfd, 0x3b, 0x3f,
+    0x6c, 0x8d, 0xd3, 0xe3, 0x2b, 0xbc, 0x22, 0x97, 0x96, 0x10, 0xa1, 0x98, 0x1d, 0x35, 0x76, 0x08,
+    0x4a, 0x52, 0x63, 0x2a, 0x3d, 0xf6, 0x6f, 0xde, 0x61, 0xa7, 0x1b, 0xca, 0x36, 0xf9, 0x48, 0xd9,
+    0x0b, 0x14, 0xc2, 0x22, 0x2a, 0x87, 0x6d, 0x30, 0x15, 0x4e, 0x91, 0x86, 0x47, 0xdf, 0xbb, 0x2d,
+    0x0d, 0xfd, 0x3c, 0x1b, 0x8f, 0xde, 0xc8, 0xa2, 0xf2, 0xff, 0xf3, 0x64, 0x84, 0xf4, 0x61, 0x43,
+    0x6c, 0x91, 0x8e, 0x9f, 0x72, 0x79, 0x71, 0x1d, 0xd5, 0xf2, 0x8d, 0xd3, 0x0e, 0x25, 0x77, 0xc0,
+    0xb1, 0x11, 0xf3, 0xf3, 0xb1, 0x81, 0x81, 0xc4, 0xa1, 0xa2, 0x9f, 0x3b, 0x2c, 0x27, 0xde, 0xa1,
+    0xac, 0xd1, 0x91, 0x14, 0xe4, 0x34, 0x51, 0x72, 0x54, 0x7b, 0x37, 0xa8, 0xdf, 0x37, 0x93, 0x2a,
+    0x6f, 0xf0, 0x85, 0xd8, 0x17, 0x21, 0xd2, 0x36, 0x5e, 0xca, 0x72, 0xea, 0x4b, 0x4f, 0x12, 0x25,
+    0x3e, 0xbe, 0xcb, 0x09, 0xd8, 0x87, 0x98, 0x51, 0x78, 0x6f, 0xd1, 0x60, 0x34, 0x81, 0x16, 0xa6,
+    0xfd, 0x23, 0x16, 0x78, 0x13, 0xe6, 0xe6, 0x3b, 0x8e, 0x2e, 0x08, 0x01, 0x65, 0x31, 0x69, 0x76,
+    0x27, 0xa8, 0x82, 0xb4, 0x4f, 0x93, 0x14, 0xad, 0xf2, 0x00, 0xd6, 0xd0, 0x04, 0x7e, 0xb5, 0xd2,
+    0x25, 0xf1, 0x85, 0x5d, 0x72, 0x3f, 0x29, 0xf4, 0x27, 0x62, 0x22, 0x95, 0xcf, 0x78, 0xd2, 0xc5,
+    0xe1, 0x22, 0x34, 0xcf, 0x94, 0xf4, 0xe0, 0x54, 0x0e, 0x32, 0xec, 0x36, 0x41, 0xda, 0x4d, 0x1e,
+    0x20, 0x25, 0xa3, 0xcf, 0x9a, 0x18, 0x78, 0x85, 0x70, 0x7a, 0xef, 0x24, 0x8e, 0x65, 0x68, 0x25,
+    0x25, 0xea, 0x89, 0x63, 0xa6, 0x14, 0x41, 0xd1, 0x58, 0x34, 0x51, 0x05, 0xb6, 0xdf, 0x7c, 0x05,
+    0x87, 0x81, 0x9f, 0x21, 0x5a, 0xfb, 0x12, 0x23, 0x18, 0x11, 0x46, 0x17, 0xa3, 0xf2, 0x53, 0xe6,
+    0x34, 0xb8, 0xb0, 0x82, 0xc0, 0xbd, 0x82, 0xb0, 0x80, 0xc7, 0x4e, 0x75, 0x5d, 0x3c, 0xbe, 0x9b,
+    0x7a, 0x51, 0x57, 0x1a, 0xa5, 0xd6, 0xd2, 0xc5, 0x09, 0x71, 0x88, 0x96, 0xfc, 0x1d, 0x42, 0xe6,
+    0xdf, 0x6e, 0x66, 0x76, 0xb7, 0xcf, 0x74, 0x5d, 0x6c, 0x91, 0xa3, 0x80, 0xf3, 0x60, 0xb0, 0x75,
+    0x6e, 0xc9, 0xed, 0x3c, 0x52, 0x45, 0x06, 0x42, 0xd9, 0xe8, 0xe2, 0x10, 0x4e, 0xd6, 0xd6, 0xaf,
+    0x13, 0x24, 0x57, 0x56, 0x41, 0x88, 0x54, 0xae, 0xdc, 0x51, 0xca, 0x6e, 0x2e, 0x26, 0x57, 0x6d,
+    0xb7, 0x2b, 0x7e, 0xf7, 0xdb, 0x59, 0x8f, 0xb7, 0x2b, 0x86, 0xf3, 0xa5, 0x2c, 0x85, 0xe0, 0xb3,
+    0x3b, 0x91, 0x0b, 0x2b, 0xfe, 0x04, 0x15, 0x28, 0xe9, 0x8c, 0x91, 0xdf, 0x04, 0x41, 0x35, 0x8f,
+    0xa2, 0x8b, 0x1d, 0xe7, 0xcd, 0xfc, 0xbf, 0xe5, 0xed, 0xbc, 0x75, 0x7d, 0xb5, 0x7e, 0xd9, 0x7b,
+    0x17, 0x0a, 0x8e, 0x0c, 0x66, 0x86, 0xa6, 0xb1, 0x7f, 0x09, 0x86, 0x5e, 0x8e, 0xa3, 0x09, 0x9f,
+    0xba, 0x61, 0xf8, 0xad, 0x2a, 0x7e, 0x03, 0xb9, 0x65, 0x11, 0x03, 0xd8, 0xce, 0x22, 0xfa, 0x25,
+    0x85, 0x15, 0xec, 0x66, 0x59, 0x9a, 0x1f, 0x25, 0xa8, 0x5c, 0xd2, 0x3e, 0x82, 0x6a, 0x6c, 0x6d,
+    0xe6, 0x4f, 0x68, 0x57, 0xa9, 0x31, 0x60, 0x25, 0x4d, 0xfa, 0x2c, 0x00, 0x4d, 0xee, 0x60, 0xb7,
+    0x44, 0x2e, 0xbc, 0xe2, 0x56, 0x7d, 0x76, 0x05, 0x8e, 0xd2, 0xb1, 0xdd, 0x3b, 0x1e, 0x96, 0xa1,
+    0x56, 0xbf, 0x52, 0xc8, 0x39, 0x2a, 0xa6, 0x82, 0x84, 0xb3, 0x8a, 0x89, 0x57, 0x34, 0xf4, 0x87,
+    0xca, 0x58, 0x85, 0x6e, 0xf5, 0xbf, 0x1e, 0x07, 0x20, 0x8a, 0xbe, 0x0f, 0xdb, 0x33, 0xe0, 0x76,
+    0x4c, 0x86, 0xf2, 0x2d, 0x67, 0x5a, 0x0c, 0xf8, 0x03, 0x48, 0x25, 0xb0, 0x10, 0x39, 0x5c, 0x91,
+    0x44, 0x9f, 0xe2, 0x9d, 0x08, 0x0d, 0xf4, 0x32, 0x14, 0x3a, 0xa1, 0x39, 0x42, 0x23, 0xb1, 0x8d,
+    0xa6, 0xc3, 0xdf, 0xb5, 0x4b, 0xc0, 0xb9, 0xa2, 0x4e, 0x45, 0x24, 0x6c, 0x26, 0x08, 0xf8, 0x58,
+    0x32, 0x24, 0xc8, 0x57, 0xbb, 0xfc, 0x5c, 0x1d, 0x79, 0x6d, 0x7d, 0x70, 0x53, 0x1d, 0x2d, 0x61,
+    0x02, 0x7f, 0xdb, 0x17, 0xa4, 0x76, 0x29, 0x0a, 0x21, 0x95, 0x47, 0x8b, 0xfe, 0x00, 0x1e, 0x21,
+    0x92, 0x39, 0x2d, 0xdc, 0x6a, 0x44, 0x4e, 0x58, 0x37, 0x9c, 0xf2, 0x50, 0x8c, 0xd6, 0xd4, 0x51,
+    0x0d, 0x90, 0xaa, 0xbb, 0x5d, 0x7e, 0x09, 0xe3, 0xb4, 0x21, 0x5f, 0xf5, 0xa7, 0x07, 0xa2, 0x0c,
+    0x57, 0x1d, 0x61, 0xc0, 0x6c, 0xca, 0x40, 0xe9, 0xfc, 0x83, 0x34, 0xdc, 0x35, 0x77, 0x81, 0x35,
+    0x0c, 0x37, 0xb7, 0x94, 0x10, 0xe3, 0x59, 0x84, 0x52, 0xbb, 0xc7, 0x4f, 0x54, 0xe5, 0x49, 0x20,
+    0xcd, 0xb1, 0xbd, 0x01, 0x2c, 0xcd, 0x27, 0xae, 0x4b, 0x79, 0x79, 0x7a, 0x89, 0x89, 0xcd, 0x74,
+    0xce, 0xe9, 0x73, 0x23, 0x67, 0xff, 0xdb, 0xeb, 0x61, 0x32, 0x28, 0x21, 0x35, 0x30, 0xbf, 0x74,
+    0xec, 0xda, 0xd8, 0x51, 0x4a, 0x7b, 0x90, 0x32, 0x8e, 0x4f, 0x90, 0xad, 0x40, 0x98, 0x9b, 0xaa,
+    0xad, 0xe7, 0x8e, 0x04, 0x19, 0x71, 0xba, 0xab, 0x8f, 0x93, 0x2e, 0xad, 0x6c, 0x0e, 0x8c, 0xb1,
+    0x5c, 0xf0, 0xf6, 0x00, 0x2f, 0xd6, 0x6c, 0x93, 0x1b, 0xa4, 0x5f, 0x39, 0x3f, 0x82, 0xc8, 0x3d,
+    0xb1, 0xf0, 0x8e, 0x8b, 0x9b, 0x53, 0xef, 0x12, 0xcb, 0x3d, 0x86, 0xfe, 0x1e, 0xcd, 0x28, 0x2d,
+    0x96, 0x78, 0xaf, 0x67, 0x4b, 0x4b, 0xf1, 0xa2, 0xf2, 0xd4, 0x83, 0xee, 0x11, 0x73, 0x66, 0xd2,
+    0x41, 0xd6, 0x22, 0xe0, 0x34, 0x76, 0xbb, 0xbe, 0x00, 0x51, 0x5c, 0x91, 0xc0, 0xb5, 0x1d, 0x7c,
+    0x87, 0xf4, 0x33, 0xf8, 0xa8, 0x39, 0xde, 0x49, 0xcc, 0xfd, 0x7d, 0x1b, 0x89, 0x3c, 0xf0, 0x59,
+    0xc7, 0x37, 0x4e, 0xb9, 0xa2, 0xf9, 0x00, 0x9d, 0xed, 0x57, 0xa0, 0xf2, 0xca, 0x0a, 0x88, 0x1a,
+    0x5f, 0x71, 0x5d, 0xfc, 0x01, 0x83, 0x3c, 0xd5, 0xfd, 0x35, 0x21, 0x70, 0x9a, 0x2b, 0x8f, 0xc1,
+    0x77, 0xde, 0xee, 0x59, 0x5b, 0x3c, 0xcc, 0x5d, 0xbc, 0xb0, 0xcd, 0xa8, 0x7d, 0xac, 0x92, 0x72,
+    0x17, 0x65, 0x73, 0x4d, 0x51, 0x5a, 0x88, 0xa1, 0x00, 0xee, 0x68, 0x94, 0x38, 0xcf, 0x95, 0x87,
+    0x67, 0xfb, 0x0d, 0xeb, 0x50, 0x09, 0xb6, 0xbf, 0x18, 0x12, 0x62, 0x49, 0x8c, 0x4f, 0xf0, 0x27,
+    0x5d, 0x71, 0x5d, 0x21, 0xa1, 0x8f, 0x88, 0x39, 0x66, 0x61, 0xd5, 0xf6, 0x5e, 0x04, 0x65, 0x18,
+    0x5b, 0x1c, 0x25, 0x7c, 0x03, 0x3b, 0xaf, 0x55, 0x86, 0x05, 0x24, 0x98, 0x94, 0x06, 0x0e, 0x12,
+    0x1a, 0xf5, 0x0f, 0x08, 0x9b, 0x70, 0x3e, 0x5c, 0xb0, 0x46, 0x25, 0x66, 0x7d, 0x74, 0xd9, 0x99,
+    0x0d, 0xe7, 0xf0, 0x48, 0xe3, 0x36, 0xde, 0x01, 0xf1, 0x43, 0xfd, 0x77, 0x70, 0xbb, 0x6b, 0xcb,
+    0x4d, 0x64, 0x44, 0x0b, 0xea, 0xfd, 0xb0, 0x52, 0xcf, 0x15, 0x8d, 0x8d, 0xbf, 0xd9, 0xb2, 0xf5,
+    0x59, 0xb6, 0x5c, 0xb4, 0x11, 0xca, 0x27, 0x52, 0x1b, 0x1e, 0xbc, 0x53, 0x21, 0xf4, 0x8c, 0x86,
+    0x44, 0x11, 0x1d, 0x9f, 0x8b, 0x0a, 0x8d, 0x1e, 0xe6, 0x92, 0x96, 0xd8, 0xee, 0xf8, 0xe5, 0x6d,
+    0x6a, 0xd7, 0x43, 0x69, 0x38, 0x2b, 0xf4, 0x45, 0xcc, 0xf8, 0xe8, 0xf6, 0x36, 0xd9, 0xb5, 0xc8,
+    0x4b, 0x3a, 0x96, 0x0b, 0x6a, 0x2d, 0x60, 0x53, 0x19, 0xdc, 0xf5, 0xfb, 0x88, 0xcc, 0x6f, 0xf1,
+    0x89, 0x36, 0xd6, 0x48, 0x21, 0xae, 0xd7, 0x5c, 0xde, 0xb3, 0x03, 0x05, 0xc9, 0x55, 0x1b, 0x5d,
+    0x8a, 0xab, 0x48, 0x7b, 0xd9, 0x49, 0xdb, 0xad, 0xd5, 0xb4, 0x9e, 0xe2, 0x79, 0x0a, 0x8c, 0x0d,
+    0x2b, 0xa4, 0x1b, 0x64, 0xaa, 0xda, 0xee, 0xd6, 0xaf, 0x48, 0x4c, 0x15, 0xdb, 0x39, 0x81, 0x00,
+    0x69, 0x94, 0xa0, 0xf7, 0xa3, 0x5e, 0x4f, 0x13, 0x0a, 0xb5, 0xa6, 0xa4, 0x7d, 0x31, 0xc3, 0x02,
+    0xc1, 0xbb, 0x6c, 0x9f, 0xfe, 0x32, 0xf8, 0x44, 0x05, 0xd2, 0xc1, 0x19, 0x6d, 0xf8, 0x55, 0xd5,
+    0x4f, 0xbc, 0x65, 0xed, 0x3b, 0x3f, 0x55, 0x6f, 0xaf, 0x26, 0x49, 0xb5, 0xf8, 0x74, 0x91, 0xd5,
+    0x57, 0x0d, 0xf0, 0x73, 0xde, 0x32, 0x88, 0x75, 0x44, 0x33, 0x2b, 0x80, 0x05, 0x2e, 0x2b, 0xc3,
+    0x32, 0xcb, 0x88, 0xc2, 0x51, 0xf8, 0xc2, 0xd8, 0x58, 0x75, 0x71, 0x75, 0x30, 0x2c, 0x76, 0xa1,
+    0xa4, 0xc9, 0xa2, 0x04, 0xe7, 0x4f, 0xb2, 0x0a, 0x05, 0x8c, 0xb8, 0x74, 0xb0, 0xcc, 0x68, 0x8e,
+    0x52, 0xd3, 0x51, 0x76, 0xb5, 0x5a, 0x1e, 0xb3, 0xb8, 0xde, 0x03, 0x86, 0x5c, 0x51, 0x27, 0x57,
+    0xfb, 0x04, 0xa3, 0xd9, 0x40, 0x07, 0x7f, 0x2d, 0x82, 0x0e, 0xe9, 0xe9, 0x3e, 0x84, 0xc4, 0x65,
+    0x1d, 0x23, 0x53, 0x7b, 0xd3, 0x61, 0x75, 0x61, 0x93, 0x17, 0x65, 0xdb, 0xef, 0x61, 0x00, 0x89,
+    0x05, 0x22, 0xd2, 0x67, 0xed, 0xc0, 0xb5, 0x66, 0x8a, 0x02, 0x91, 0xed, 0xf1, 0x12, 0x79, 0x92,
+    0x0d, 0xea, 0x52, 0xbf, 0x3b, 0x12, 0xae, 0x4f, 0x4c, 0x63, 0xc0, 0xca, 0x21, 0x4c, 0x7c, 0x51,
+    0x3e, 0xc4, 0x57, 0x89, 0x78, 0x85, 0x6d, 0xfb, 0x42, 0xd5, 0xb0, 0x6b, 0xb4, 0x1d, 0xe8, 0xcc,
+    0x2f, 0x8a, 0x3a, 0x12, 0xa2, 0x97, 0x6a, 0xad, 0xbf, 0xeb, 0x91, 0x5b, 0xd8, 0x18, 0x06, 0x89,
+    0x4b, 0x74, 0x33, 0xb9, 0xdf, 0x18, 0x74, 0xef, 0xcc, 0x07, 0x82, 0xbf, 0x2a, 0xb9, 0xdd, 0x17,
+    0x40, 0xee, 0x61, 0x8b, 0x70, 0x9a, 0x89, 0x92, 0x75, 0x5c, 0xa7, 0x0b, 0x5a, 0x01, 0x82, 0x0c,
+    0x92, 0x55, 0xa9, 0x4a, 0xdf, 0x56, 0x75, 0x81, 0x47, 0xe7, 0xa4, 0x71, 0x15, 0xde, 0xd9, 0x89,
+    0xce, 0x40, 0x94, 0x55, 0x1b, 0xa2, 0x4f, 0x6b, 0xba, 0xa4, 0x3e, 0x67, 0x5c, 0x4b, 0x4a, 0x3e,
+    0xec, 0x58, 0xa7, 0x4f, 0xcb, 0x82, 0xce, 0xe7, 0xeb, 0x39, 0x1d, 0xb0, 0x16, 0x3c, 0x5d, 0xf7,
+    0x86, 0x65, 0xbc, 0xdd, 0x7e, 0x3c, 0x6a, 0xb4, 0x40, 0x71, 0x98, 0x11, 0xec, 0x2d, 0x39, 0x27,
+    0x14, 0xc2, 0x6f, 0x68, 0x83, 0x30, 0x63, 0x2f, 0x57, 0xc2, 0x86, 0x92, 0x77, 0x42, 0x76, 0x43,
+    0x36, 0x1e, 0x70, 0x37, 0xea, 0xb7, 0x26, 0x81, 0x15, 0xcb, 0x30, 0xcd, 0xc4, 0x7c, 0x4d, 0x68,
+    0x28, 0x31, 0x7e, 0xab, 0x20, 0x7b, 0x5a, 0xb6, 0x50, 0x75, 0x49, 0x33, 0x6e, 0x83, 0x85, 0xc5,
+    0x65, 0xa7, 0xe5, 0xef, 0x49, 0xea, 0x17, 0x60, 0x74, 0x89, 0xd9, 0x4c, 0x16, 0xac, 0x3b, 0x4e,
+    0x2a, 0xd7, 0xe2, 0xc0, 0x5e, 0x84, 0x7b, 0x64, 0xd4, 0xd0, 0x05, 0x76, 0x82, 0xc8, 0x2d, 0x87,
+    0xf6, 0xbf, 0xf6, 0x82, 0x15, 0xf8, 0x0a, 0xdd, 0x18, 0xa7, 0xd3, 0x75, 0xf9, 0x26, 0x55, 0xff,
+    0x36, 0x85, 0x01, 0x63, 0xa7, 0xd0, 0xf1, 0x1c, 0x13, 0x2c, 0x39, 0xe9, 0x68, 0xbe, 0x42, 0x2d,
+    0x11, 0x25, 0x07, 0x29, 0x8a, 0xd1, 0x75, 0x77, 0xa1, 0x23, 0x90, 0x2f, 0x02, 0xb3, 0xe1, 0xd3,
+    0xf0, 0x7d, 0x7c, 0x21, 0x20, 0x25, 0x7e, 0x89, 0x5e, 0x92, 0xf9, 0x2c, 0x56, 0xa0, 0xb4, 0x88,
+    0x58, 0xb3, 0x3a, 0xd4, 0x7d, 0x07, 0x4e, 0xcb, 0x07, 0xe4, 0xa9, 0xd1, 0xc9, 0x6d, 0x88, 0xef,
+    0xf4, 0x17, 0x65, 0xf9, 0xfe, 0x51, 0x7f, 0xc5, 0x87, 0x1d, 0x6e, 0xde, 0x06, 0x5b, 0x2a, 0xae,
+    0x2e, 0x1e, 0xe0, 0xf3, 0xff, 0x79, 0x94, 0x30, 0x75, 0x8c, 0xf5, 0x2a, 0xfe, 0xc2, 0x59, 0xb8,
+    0x77, 0xea, 0xca, 0x37, 0x34, 0x77, 0xdd, 0x65, 0xba, 0x94, 0x8f, 0x26, 0xb8, 0x88, 0x08, 0x9f,
+    0xff, 0x8f, 0xb5, 0x23, 0x7d, 0xa1, 0xa6, 0x23, 0x2b, 0x54, 0xe6, 0x59, 0x90, 0xd1, 0x6d, 0x72,
+    0x75, 0x2a, 0x0d, 0x3d, 0xb9, 0x00, 0xf2, 0xb6, 0xae, 0x93, 0x59, 0x81, 0xce, 0x60, 0xa3, 0x7f,
+    0xe7, 0x84, 0xc2, 0x50, 0x71, 0xef, 0x6b, 0x6e, 0x1c, 0xb7, 0x0f, 0x86, 0x8a, 0xef, 0x1b, 0x5d,
+    0xb9, 0x3b, 0xa4, 0xd3, 0xda, 0x76, 0x27, 0xa7, 0xd5, 0x98, 0xe6, 0xc0, 0x38, 0x21, 0x36, 0x00,
+    0x8b, 0xd2, 0xb2, 0x81, 0xbc, 0xae, 0xee, 0x2f, 0x1c, 0xa3, 0xe8, 0x62, 0xda, 0xfc, 0x70, 0x41,
+    0x2c, 0x84, 0xab, 0xac, 0x80, 0x5a, 0x22, 0xc5, 0x36, 0xd1, 0x69, 0x69, 0x40, 0xe6, 0xe4, 0x61,
+    0x04, 0x8f, 0x1c, 0xec, 0x0e, 0x75, 0x20, 0xaa, 0xcd, 0xf2, 0x95, 0xa3, 0x31, 0x4d, 0xad, 0x7c,
+    0x01, 0xff, 0x7f, 0x98, 0x4a, 0x63, 0xb3, 0xdb, 0xaf, 0xf6, 0x7d, 0x27, 0x08, 0xae, 0xc1, 0x07,
+    0x01, 0x47, 0x6d, 0x38, 0xfc, 0xd0, 0x64, 0x3a, 0xd8, 0x2b, 0x43, 0xe7, 0xfe, 0xcf, 0x30, 0xe1,
+    0x6a, 0x1d, 0xf8, 0x45, 0x77, 0x24, 0x89, 0x4c, 0xb1, 0xfa, 0xcf, 0x54, 0xfa, 0x61, 0xcb, 0x75,
+    0x08, 0xbb, 0x47, 0xe8, 0x82, 0x9d, 0x9b, 0x48, 0xe3, 0x3e, 0x50, 0x2a, 0x4d, 0x77, 0x84, 0x66,
+    0x30, 0xdc, 0x16, 0xbb, 0x41, 0xd0, 0x75, 0xcb, 0x9f, 0x88, 0x49, 0x3b, 0xe4, 0xfc, 0xf4, 0x68,
+    0x5b, 0x92, 0x27, 0x35, 0x79, 0x0e, 0x73, 0xd4, 0x42, 0x76, 0xa5, 0x68, 0xef, 0x13, 0x49, 0x96,
+    0xda, 0x2c, 0x33, 0xdb, 0x84, 0x55, 0x45, 0x1d, 0x75, 0x14, 0x4c, 0x9b, 0x62, 0xe4, 0x94, 0x01,
+    0x84, 0xfd, 0x97, 0x74, 0xc3, 0x31, 0xbd, 0x11, 0x17, 0xed, 0x0a, 0x24, 0xe4, 0x79, 0xfb, 0x23,
+    0xcd, 0x0a, 0xc2, 0x8e, 0x6b, 0x8c, 0x1f, 0x3d, 0x71, 0x15, 0x7d, 0x3e, 0xeb, 0x10, 0x0c, 0x4e,
+    0xf6, 0xf2, 0x4b, 0x6c, 0x46, 0x08, 0xb2, 0x5b, 0x8f, 0x6b, 0xfd, 0xc3, 0xf2, 0x3a, 0x6f, 0x7f,
+    0xf0, 0x6e, 0x84, 0x6a, 0xe7, 0x94, 0x6f, 0xea, 0x47, 0x59, 0x96, 0x20, 0x38, 0xf3, 0x27, 0xd5,
+    0xda, 0xb0, 0x91, 0x74, 0x39, 0x9e, 0x0b, 0x6b, 0x10, 0xd2, 0x45, 0x27, 0x29, 0x80, 0x64, 0xd2,
+    0x4f, 0x17, 0x92, 0x44, 0x2f, 0x8b, 0x2f, 0x16, 0x16, 0x52, 0x2b, 0xea, 0x05, 0x8d, 0xba, 0x08,
+    0xe3, 0x9f, 0x5f, 0xca, 0x41, 0x9e, 0x63, 0xed, 0x97, 0x5d, 0x6b, 0xdd, 0x31, 0x63, 0xfa, 0x38,
+    0xf0, 0xb8, 0x73, 0xdd, 0x01, 0x77, 0x70, 0x49, 0x21, 0x48, 0x1a, 0xfc, 0xcd, 0xfd, 0xdf, 0xed,
+    0x52, 0xe2, 0x02, 0x09, 0xbb, 0x94, 0x7a, 0x7c, 0x5b, 0x5e, 0xb1, 0xed, 0x2e, 0xfd, 0xb8, 0xff,
+    0x5f, 0x9d, 0x97, 0x96, 0x9c, 0x67, 0xf1, 0x8f, 0x88, 0xba, 0x19, 0x85, 0xcd, 0xe4, 0xd7, 0xf2,
+    0x71, 0x56, 0xd5, 0xe1, 0xa8, 0xa1, 0x18, 0x0a, 0x95, 0x91, 0x2d, 0x4f, 0xd3, 0xdb, 0x96, 0xef,
+    0xcc, 0x9b, 0x26, 0xa5, 0xa4, 0xab, 0x4a, 0x70, 0x29, 0x0b, 0x2f, 0x76, 0xec, 0x03, 0x0c, 0xd4,
+    0xdb, 0x9f, 0xf9, 0x3a, 0x2a, 0x4a, 0xad, 0x7d, 0xd9, 0x06, 0x1e, 0x03, 0x24, 0x13, 0x46, 0x02,
+    0x0e, 0x52, 0xdf, 0xaf, 0xcc, 0x72, 0x48, 0x3a, 0xda, 0x76, 0xcd, 0x8e, 0xad, 0x98, 0x5d, 0xea,
+    0x3a, 0xa7, 0xdb, 0x21, 0xa5, 0xa3, 0x0a, 0xde, 0x6b, 0xda, 0x02, 0xc2, 0x55, 0xa0, 0x37, 0xbb,
+    0xbf, 0xd8, 0xfd, 0x07, 0x13, 0xbf, 0x68, 0x74, 0x26, 0x20, 0x35, 0xd1, 0xeb, 0x6d, 0xca, 0x44,
+    0x19, 0x75, 0x0e, 0x21, 0x4e, 0x84, 0x8f, 0x02, 0x96, 0x5c, 0xe7, 0x45, 0xd8, 0x93, 0x73, 0x4f,
+    0xaa, 0x3b, 0xdd, 0x26, 0x40, 0xf8, 0xe1, 0x60, 0x3d, 0x94, 0x6b, 0xeb, 0x2e, 0xa9, 0xa0, 0x36,
+    0x8b, 0xc5, 0xc2, 0x96, 0x70, 0x7f, 0xbe, 0x4f, 0xb4, 0x82, 0x84, 0x3b, 0x13, 0x92, 0xc1, 0x29,
+    0x33, 0x17, 0xdb, 0x7c, 0xb9, 0x02, 0x09, 0xb8, 0xfa, 0x70, 0x96, 0x27, 0x4a, 0x8b, 0xa0, 0x04,
+    0x37, 0xb5, 0x23, 0x02, 0x48, 0xe9, 0xc9, 0x0a, 0xe3, 0xd7, 0xa1, 0xec, 0xe4, 0x60, 0x69, 0xe9,
+    0x23, 0xb1, 0x51, 0xe7, 0x47, 0x7a, 0x99, 0xc8, 0xe6, 0x44, 0x33, 0x35, 0xa7, 0xd9, 0xf8, 0xdf,
+    0x10, 0x89, 0x74, 0xdf, 0x68, 0x76, 0x7d, 0x4c, 0x42, 0x7e, 0x0a, 0x7c, 0x15, 0x8e, 0xde, 0x29,
+    0x86, 0x82, 0x4e, 0x69, 0x20, 0x7d, 0x46, 0xb1, 0xbf, 0xba, 0xd1, 0x52, 0xb5, 0xc8, 0xd4, 0x79,
+    0x58, 0x71, 0x2c, 0x30, 0x2b, 0xbe, 0x86, 0x80, 0x98, 0x0c, 0x72, 0xcb, 0x7d, 0x16, 0xce, 0x6e,
+    0x5d, 0x75, 0x03, 0x38, 0xda, 0x06, 0x1d, 0x1d, 0xdf, 0x93, 0xff, 0x75, 0x25, 0x37, 0xe2, 0xa4,
+    0xd9, 0x15, 0xba, 0x68, 0x3c, 0x3a, 0xcf, 0x55, 0x55, 0xa1, 0x74, 0x8f, 0x41, 0xdc, 0x03, 0x83,
+    0x5d, 0xe5, 0x2c, 0xf3, 0xf1, 0x9a, 0xf5, 0x4c, 0xf1, 0x41, 0x65, 0xfc, 0x77, 0xdb, 0x3f, 0xe9,
+    0xe6, 0x0f, 0x17, 0xe7, 0x2a, 0x71, 0xbd, 0x86, 0xa5, 0x84, 0x0a, 0x8e, 0x64, 0xd6, 0xca, 0x47,
+    0x15, 0x80, 0x95, 0x79, 0x54, 0x42, 0xa8, 0xdf, 0x29, 0x1e, 0x71, 0xb0, 0xde, 0x84, 0xa4, 0x38,
+    0x54, 0x09, 0xa6, 0x1a, 0x6e, 0x67, 0xd9, 0x8f, 0x1e, 0x10, 0x83, 0xca, 0x34, 0xf4, 0xa0, 0x02,
+    0x30, 0x06, 0x97, 0x0c, 0x0c, 0xdf, 0x13, 0x3d, 0xf1, 0x47, 0xb2, 0x0d, 0x52, 0x6e, 0x85, 0xd5,
+    0x75, 0x4c, 0x54, 0xc2, 0xf4, 0x6e, 0x0d, 0x53, 0x9c, 0xb4, 0x0c, 0x7c, 0xfd, 0x43, 0x89, 0xa9,
+    0x23, 0xfb, 0xe3, 0xd0, 0x9c, 0x69, 0x30, 0x08, 0xf2, 0xce, 0x22, 0x6b, 0x1f, 0x71, 0x46, 0x02,
+    0x45, 0xca, 0x9c, 0x7d, 0x00, 0xe4, 0x94, 0xd5, 0xb2, 0xea, 0xdf, 0xd0, 0xb9, 0x9a, 0x5f, 0x55,
+    0xb2, 0x5b, 0x59, 0xd7, 0x94, 0x82, 0x00, 0x5c, 0x07, 0x49, 0xf6, 0x1d, 0xf3, 0x53, 0xcc, 0x1e,
+    0xee, 0x96, 0xf7, 0x7c, 0xbc, 0xca, 0xa8, 0xfb, 0x78, 0x68, 0x41, 0xcf, 0xe7, 0x8b, 0x15, 0xb9,
+    0x58, 0xcb, 0x76, 0x02, 0xb0, 0x05, 0xb9, 0x55, 0xda, 0xee, 0x80, 0x6b, 0xef, 0x2c, 0x91, 0x98,
+    0x81, 0xbd, 0x57, 0x10, 0x7d, 0xc7, 0x27, 0xb1, 0xdb, 0x98, 0xe9, 0x42, 0x13, 0xbb, 0x31, 0x92,
+    0x5f, 0xb5, 0x9b, 0x9c, 0x41, 0x4a, 0x45, 0x0b, 0x30, 0x9a, 0x09, 0xd2, 0xd5, 0x15, 0xb0, 0xa5,
+    0x4d, 0xda, 0x5c, 0x96, 0x4d, 0x5a, 0xa0, 0x27, 0x28, 0xf7, 0x74, 0xbf, 0xb6, 0x58, 0x0b, 0x42,
+    0x83, 0x5d, 0x16, 0x20, 0x4a, 0x46, 0xba, 0x6f, 0xdb, 0xaf, 0x01, 0x51, 0xbf, 0xe4, 0x42, 0x7a,
+    0x9e, 0x1d, 0x33, 0xb9, 0xec, 0x5a, 0x3c, 0x67, 0x56, 0x49, 0x83, 0x63, 0x92, 0xfd, 0x69, 0x02,
+    0x8d, 0x2d, 0xfd, 0xaf, 0xe9, 0x20, 0x04, 0x3c, 0x80, 0x9e, 0x36, 0xe7, 0xb7, 0x9c, 0xec, 0x0d,
+    0x19, 0x3c, 0xf2, 0x7b, 0x3e, 0x45, 0xe3, 0x1a, 0x79, 0xc0, 0x3e, 0xa0, 0xca, 0xac, 0xdf, 0xa3,
+    0xe4, 0xe1, 0x3b, 0x77, 0xb4, 0x5c, 0x44, 0x83, 0xdb, 0x87, 0x82, 0x82, 0x46, 0x3e, 0xb4, 0x53,
+    0x0d, 0x84, 0x2a, 0x2c, 0x46, 0xcf, 0x86, 0x48, 0x9c, 0x13, 0x45, 0xfd, 0x85, 0xee, 0x4f, 0xc1,
+    0x96, 0xb9, 0x6e, 0x4a, 0x38, 0xde, 0xad, 0x00, 0x09, 0xe0, 0xe9, 0x9e, 0x57, 0x94, 0x7d, 0xcc,
+    0xdd, 0x02, 0x02, 0xa7, 0x3f, 0xc7, 0x41, 0xf5, 0x92, 0x78, 0x58, 0x2d, 0x78, 0xfc, 0xfa, 0x5d,
+    0x4a, 0xba, 0xf4, 0xbb, 0x3f, 0x8a, 0x3b, 0x7d, 0x62, 0x30, 0x37, 0x59, 0xc0, 0xa2, 0xed, 0xab,
+    0x50, 0xa2, 0x87, 0x1a, 0x80, 0x8f, 0xda, 0xa0, 0xc7, 0xe3, 0x37, 0x1f, 0xc3, 0xb7, 0xba, 0x8d,
+    0xb8, 0x7e, 0x9f, 0x4c, 0xce, 0x88, 0x69, 0xe5, 0x06, 0x4a, 0x8f, 0x0a, 0xa9, 0xbf, 0x66, 0xe4,
+    0xda, 0x4e, 0x88, 0x74, 0xce, 0xf5, 0xee, 0x16, 0x03, 0xdc, 0xa6, 0xf2, 0x4d, 0x5a, 0x5a, 0x5c,
+    0x1d, 0x88, 0x77, 0xe7, 0x6e, 0x9e, 0x26, 0x53, 0x9e, 0x2a, 0xa6, 0xb7, 0x63, 0x1f, 0x25, 0x6c,
+    0xc3, 0x67, 0x7e, 0x42, 0x83, 0xd9, 0xfd, 0xee, 0x71, 0x54, 0x09, 0x3a, 0x62, 0xdb, 0xb9, 0x53,
+    0xb5, 0xd7, 0x51, 0xcb, 0x7e, 0x48, 0x36, 0x34, 0x8b, 0x25, 0x89, 0xd0, 0x9a, 0x23, 0xd6, 0xa4,
+    0x87, 0xa1, 0xd7, 0x67, 0x9a, 0x72, 0x29, 0x22, 0x57, 0x7f, 0xde, 0x5c, 0x03, 0x42, 0xc6, 0x10,
+    0x5e, 0xc4, 0xf5, 0x79, 0xcf, 0x5f, 0xf1, 0x73, 0x40, 0xe8, 0x4a, 0x50, 0x6f, 0xc6, 0x34, 0x35,
+    0x06, 0xae, 0x6d, 0x61, 0x8b, 0x6e, 0xac, 0x04, 0xe9, 0x52, 0x7b, 0x1a, 0x0b, 0x2c, 0xcd, 0xae,
+    0xa0, 0x4a, 0xe4, 0xe6, 0xa3, 0x92, 0x02, 0x20, 0x10, 0xbe, 0x60, 0xef, 0x6d, 0x29, 0xeb, 0x45,
+    0x06, 0xa7, 0xb4, 0x6f, 0x13, 0xdd, 0x6d, 0xeb, 0xcb, 0x69, 0xd9, 0x55, 0x42, 0xe0, 0xd4, 0xee,
+    0xd9, 0xf0, 0x84, 0x51, 0x2d, 0x41, 0x31, 0x49, 0xd2, 0xe3, 0x16, 0x31, 0x04, 0xa5, 0x7a, 0x65,
+    0x16, 0xe3, 0x06, 0xfa, 0xff, 0x7c, 0x76, 0xad, 0x5f, 0xd6, 0x7f, 0x79, 0x99, 0xf7, 0xec, 0x22,
+    0xd1, 0xee, 0x0e, 0xf0, 0x6c, 0x3d, 0x15, 0x9b, 0x67, 0x5b, 0xe9, 0xfc, 0x6f, 0xee, 0xde, 0x9a,
+    0x14, 0xec, 0x9f, 0x74, 0x5b, 0xdd, 0x88, 0x7c, 0xfe, 0x99, 0x23, 0x45, 0x22, 0x77, 0xc1, 0xf0,
+    0x23, 0x8f, 0x7a, 0x99, 0xfa, 0x61, 0x71, 0x5e, 0x1d, 0xab, 0x8a, 0x3f, 0x39, 0xa4, 0xcb, 0x65,
+    0xa7, 0x5a, 0xfa, 0xd6, 0x50, 0x18, 0xad, 0x8f, 0xb4, 0x93, 0xa9, 0x77, 0x61, 0x7e, 0x4d, 0x3b,
+    0x34, 0xf6, 0x64, 0x9d, 0xc7, 0x17, 0x22, 0xf5, 0xca, 0x45, 0x8c, 0x57, 0xdf, 0xad, 0xc5, 0xd5,
+    0xac, 0x42, 0x6a, 0xef, 0xa5, 0x97, 0x6d, 0x2c, 0x5d, 0xa5, 0x09, 0x2a, 0x41, 0x9d, 0x43, 0x8b,
+    0x43, 0x5c, 0x5a, 0xa0, 0x32, 0xd5, 0x59, 0xfc, 0xec, 0xda, 0xba, 0xbe, 0x0d, 0x28, 0xaf, 0x06,
+    0xb1, 0xf8, 0x4a, 0xb8, 0xc2, 0xf0, 0xb5, 0xe2, 0xe5, 0x93, 0x10, 0xc6, 0xdf, 0x57, 0xdc, 0x07,
+    0x48, 0x11, 0x33, 0x99, 0x58, 0xc2, 0xc5, 0x19, 0x6a, 0x23, 0x40, 0x83, 0xed, 0xb4, 0x62, 0xf7,
+    0x82, 0x45, 0x69, 0xec, 0x75, 0x25, 0xf9, 0x72, 0xc2, 0x35, 0xa5, 0x48, 0x45, 0x3a, 0x24, 0xe0,
+    0x12, 0xe2, 0x93, 0x7b, 0xd2, 0xdf, 0xf4, 0xa3, 0x43, 0xcc, 0xd8, 0xd8, 0x2a, 0x5b, 0x5a, 0x48,
+    0x65, 0x48, 0x80, 0xfc, 0x3c, 0xf1, 0xe2, 0x25, 0x2e, 0xf0, 0xf8, 0x09, 0xbe, 0x28, 0x2d, 0x14,
+    0x31, 0xcd, 0x1e, 0xa8, 0x32, 0x90, 0x53, 0xfd, 0xaa, 0x13, 0x11, 0x33, 0xf2, 0x07, 0x9b, 0x0e,
+    0x2f, 0x9f, 0xc6, 0xab, 0xdb, 0x11, 0x4d, 0xaa, 0x63, 0x7a, 0x05, 0x5b, 0xed, 0xcc, 0x95, 0x9a,
+    0xfb, 0xe6, 0xd3, 0xd2, 0xab, 0xf6, 0x09, 0xec, 0xcc, 0xc1, 0xc5, 0x93, 0x8e, 0xc1, 0x0d, 0x71,
+    0xc2, 0x40, 0x91, 0xe5, 0xab, 0x8a, 0x1c, 0xbe, 0x83, 0x0a, 0xc7, 0xc2, 0x85, 0x32, 0xd5, 0xee,
+    0xf0, 0x59, 0x19, 0x2f, 0x6d, 0x04, 0xda, 0x7c, 0xf3, 0x80, 0x04, 0x36, 0x05, 0x01, 0x75, 0x9e,
+    0x6c, 0x3c, 0x94, 0x80, 0xec, 0xd9, 0x55, 0xf8, 0xf1, 0x90, 0xc7, 0x70, 0xe8, 0xd5, 0x71, 0x03,
+    0xc5, 0xdc, 0x71, 0x8d, 0x98, 0x97, 0x64, 0xaa, 0x07, 0xd8, 0x5f, 0x4b, 0x99, 0x5a, 0x01, 0x31,
+    0xb6, 0x47, 0xba, 0xef, 0x2a, 0xae, 0xd5, 0xe8, 0x33, 0xa6, 0x58, 0xc3, 0x11, 0xb8, 0x8b, 0x20,
+    0x33, 0xe7, 0x7b, 0x2b, 0x7a, 0xa7, 0x13, 0x1a, 0xde, 0x2f, 0xf5, 0x19, 0xfb, 0x4b, 0x41, 0x1c,
+    0x43, 0x9f, 0x86, 0x02, 0x9e, 0x21, 0x51, 0x7b, 0xfb, 0x81, 0x16, 0x98, 0x79, 0x6b, 0x21, 0x9b,
+    0x8f, 0x91, 0x81, 0x9e, 0x67, 0x45, 0xdb, 0x5f, 0x09, 0xf1, 0x20, 0x5c, 0xac, 0xa2, 0xd6, 0xa7,
+    0x5d, 0xa5, 0xe4, 0x1e, 0xbf, 0xdf, 0x28, 0xb3, 0x63, 0xcf, 0x4f, 0x2e, 0xaa, 0xb1, 0x2c, 0xb2,
+    0xd6, 0x7f, 0xb8, 0x3e, 0xb2, 0x21, 0xb4, 0x55, 0xa3, 0x78, 0x5d, 0x32, 0xb5, 0x56, 0x74, 0x20,
+    0x71, 0xf1, 0x79, 0xe0, 0x8c, 0x39, 0xaa, 0x0b, 0xa7, 0xe9, 0xbe, 0x8b, 0x12, 0xdc, 0x30, 0x91,
+    0x0e, 0x3e, 0xbb, 0xdc, 0x0e, 0x1e, 0x40, 0x1c, 0x6c, 0x71, 0x77, 0xb8, 0xe2, 0xb7, 0x41, 0x2d,
+    0xae, 0x3a, 0xb2, 0xa2, 0xc4, 0x2f, 0x71, 0x61, 0x88, 0x1b, 0x34, 0x52, 0xa3, 0xe7, 0x4a, 0x48,
+    0x92, 0x4c, 0x74, 0x74, 0xb0, 0x04, 0x2d, 0xf0, 0x0a, 0x91, 0xdf, 0x8a, 0x56, 0x20, 0x1d, 0xe8,
+    0xb4, 0xb0, 0x2e, 0xbd, 0x1e, 0x38, 0x95, 0x26, 0xb0, 0x85, 0x57, 0xcf, 0x8b, 0xce, 0xd9, 0x6f,
+    0x5a, 0x46, 0xfb, 0x2d, 0x54, 0x15, 0x01, 0x73, 0xc1, 0xac, 0x5e, 0xb6, 0xa3, 0xea, 0xe6, 0x36,
+    0x4f, 0x51, 0xd2, 0x19, 0x76, 0x12, 0x0f, 0x7c, 0x9e, 0x73, 0x03, 0x01, 0x12, 0x4f, 0x9b, 0xc5,
+    0x02, 0xdf, 0x8b, 0x4c, 0xe2, 0xeb, 0xed, 0x9b, 0x0d, 0x3c, 0x1f, 0xc7, 0xed, 0x70, 0xd3, 0xc0,
+    0x8c, 0x13, 0xbb, 0x60, 0x69, 0x4e, 0x56, 0x7d, 0xec, 0xae, 0xcb, 0x72, 0x18, 0x37, 0xcf, 0x41,
+    0xff, 0x3b, 0x8a, 0x5d, 0x01, 0xf7, 0xd9, 0x56, 0x70, 0xdd, 0x3d, 0xee, 0xc2, 0xbb, 0x5f, 0xec,
+    0x9d, 0xf8, 0xbb, 0x7b, 0xb6, 0x1b, 0x8f, 0x51, 0xf9, 0x31, 0xa5, 0x98, 0x1f, 0xed, 0x69, 0x52,
+    0x1f, 0xfa, 0xee, 0x66, 0xee, 0x36, 0x20, 0x3d, 0xcb, 0x6c, 0x12, 0x5d, 0x25, 0xd4, 0x73, 0x7e,
+    0xe0, 0x61, 0x88, 0xe5, 0xdd, 0x6b, 0x06, 0x7b, 0xdf, 0x40, 0x56, 0x81, 0xe2, 0x6a, 0xc3, 0x50,
+    0xf5, 0x90, 0xa0, 0x1b, 0x51, 0x5a, 0xb9, 0x55, 0xfc, 0x91, 0xaf, 0xac, 0x05, 0xcf, 0x0b, 0x3a,
+    0x6c, 0x16, 0x91, 0xb3, 0x8d, 0x55, 0xde, 0xad, 0x2f, 0x83, 0x15, 0x8c, 0x2f, 0xf2, 0x71, 0x1d,
+};
@@ -0,0 +1,515 @@
+/* Lamport signature by key 9533:eb36:5fed:6195:aa38:c9d5:e3aa:834f:486f:99c4:5764:d826:e569:70c9:9359:e03d */
+static const uint8_t lamport_sig1[8192] = {
+    0x0e, 0x49, 0xfe, 0x17, 0x06, 0xd9, 0xe9, 0x3b, 0x37, 0x93, 0xe1, 0x05, 0x7f, 0x32, 0x8c, 0x42,
+    0x06, 0x63, 0x2d, 0xa5, 0x8c, 0x13, 0xb1, 0xaa, 0x3c, 0x95, 0x15, 0x8c, 0x1a, 0x68, 0xb8, 0xb5,
+    0xd9, 0xb4, 0xfb, 0x00, 0x11, 0x00, 0xe1, 0x3c, 0xda, 0xc7, 0x60, 0xfe, 0xbf, 0x75, 0x5f, 0xae,
+    0xef, 0xc4, 0x46, 0x67, 0xdb, 0x49, 0x83, 0x22, 0x5c, 0x5d, 0xb7, 0x14, 0x51, 0x6b, 0x86, 0x7a,
+    0x80, 0x6f, 0x8f, 0x88, 0x33, 0x30, 0xd5, 0xac, 0xff, 0x28, 0xb0, 0x97, 0xf4, 0x40, 0xb7, 0x5d,
+    0x91, 0x4b, 0x65, 0x45, 0x41, 0x69, 0x12, 0x6a, 0x78, 0x05, 0xb6, 0x11, 0x8a, 0xd1, 0xc7, 0x67,
+    0x1e, 0x28, 0xd8, 0x8a, 0xa5, 0x9d, 0x77, 0x61, 0xc0, 0xf9, 0x2c, 0xad, 0x83, 0xef, 0x4f, 0xc2,
+    0x1e, 0xe9, 0x7c, 0x09, 0xa8, 0x1e, 0x5a, 0xe2, 0x0a, 0x44, 0x75, 0xb6, 0x89, 0x35, 0xc3, 0xec,
+    0x4d, 0x06, 0xc1, 0xd2, 0xa2, 0x16, 0x55, 0x05, 0xb6, 0x67, 0xb2, 0x74, 0x1a, 0x53, 0x11, 0xd2,
+    0xa7, 0x8d, 0x99, 0xcb, 0x46, 0x16, 0x3b, 0x8b, 0x6d, 0x65, 0xd0, 0x4e, 0xe4, 0x0c, 0x2d, 0x3e,
+    0x22, 0x89, 0xb0, 0xd9, 0x81, 0xa5, 0x81, 0x3f, 0xcb, 0x4b, 0x3f, 0x3f, 0xc6, 0xb1, 0x12, 0x30,
+    0xfd, 0x4c, 0xff, 0xd3, 0xb9, 0x10, 0xd7, 0xf7, 0x66, 0xdd, 0xc0, 0x9e, 0x95, 0xd3, 0xdd, 0x6f,
+    0xe7, 0x23, 0x2b, 0x5e, 0x9c, 0xd5, 0x2d, 0x09, 0xb8, 0x7a, 0x80, 0x25, 0xe9, 0x84, 0x1d, 0x2f,
+    0xc2, 0xb8, 0x90, 0xfe, 0x0a, 0x0f, 0x3c, 0x4c, 0x2c, 0x8e, 0xe7, 0xb4, 0x64, 0xf4, 0xe1, 0xfa,
+    0x0c, 0x0b, 0x8f, 0x4c, 0x57, 0xd0, 0xa3, 0xc9, 0x46, 0x04, 0xba, 0x22, 0xfa, 0x6c, 0xba, 0x18,
+    0x8d, 0x77, 0x6b, 0xf9, 0xe9, 0xe9, 0xe6, 0x47, 0x1c, 0x61, 0xb8, 0xcc, 0xfb, 0xa1, 0x4f, 0xd9,
+    0x48, 0x9f, 0xe7, 0x95, 0xc8, 0xaf, 0xde, 0x07, 0xec, 0xaa, 0x80, 0x2f, 0x05, 0xf1, 0xcb, 0x69,
+    0x45, 0x55, 0x8d, 0x88, 0x40, 0x32, 0x13, 0x02, 0xff, 0x4d, 0x53, 0x6e, 0x6e, 0xb5, 0xcf, 0xc8,
+    0x9d, 0xbb, 0xf5, 0xc9, 0xc8, 0x83, 0x58, 0x7a, 0x3a, 0xd4, 0x37, 0x70, 0x22, 0x2c, 0xbe, 0xe2,
+    0xdc, 0x5e, 0xe2, 0x2f, 0x00, 0x50, 0xd9, 0x49, 0x0a, 0x4b, 0x1a, 0x27, 0x82, 0x06, 0xca, 0xc7,
+    0x07, 0x54, 0xd4, 0x19, 0x6e, 0xa1, 0x98, 0x2e, 0xb7, 0x2d, 0xf2, 0xd2, 0x85, 0xf2, 0x7d, 0x77,
+    0x49, 0x47, 0xa0, 0x57, 0x08, 0x00, 0x3f, 0x44, 0x9c, 0xdf, 0x19, 0xd8, 0xaa, 0xf8, 0xe6, 0x3d,
+    0x43, 0x39, 0xb9, 0xec, 0xa5, 0x8b, 0x2c, 0xf9, 0x16, 0x8f, 0xbb, 0xc2, 0x7e, 0xa6, 0xf9, 0xb6,
+    0x5a, 0xf1, 0x65, 0x11, 0x93, 0x37, 0x0f, 0x70, 0xe3, 0xaf, 0x4d, 0x1c, 0xaf, 0xa7, 0xa2, 0xdb,
+    0x7f, 0xd1, 0x22, 0xcc, 0x34, 0xfc, 0x07, 0xfe, 0xa2, 0xec, 0xde, 0xb1, 0x68, 0x94, 0x9e, 0x83,
+    0xa3, 0x38, 0x9b, 0xb5, 0x8b, 0xce, 0x50, 0xaf, 0xf3, 0x80, 0xc7, 0x07, 0xa2, 0xb6, 0xf6, 0x64,
+    0x92, 0x25, 0x8e, 0x6a, 0x81, 0x08, 0x63, 0x0e, 0x68, 0x3a, 0x51, 0xd9, 0xbb, 0xd1, 0x5b, 0x78,
+    0xc3, 0x13, 0xe5, 0xf3, 0xb8, 0x08, 0xff, 0xd9, 0x04, 0xae, 0x27, 0xa5, 0x5f, 0xc8, 0xae, 0x8a,
+    0x21, 0xc4, 0xe1, 0x51, 0x82, 0x67, 0x74, 0x5f, 0x33, 0x58, 0x43, 0x0e, 0x3d, 0xf1, 0x13, 0x91,
+    0x87, 0x5e, 0x30, 0xfb, 0xf1, 0x91, 0x64, 0x8d, 0xb2, 0x83, 0xdb, 0xd9, 0x8e, 0xc0, 0x45, 0x1f,
+    0xab, 0x47, 0xd9, 0x40, 0x79, 0xe2, 0x09, 0x2a, 0x0a, 0x18, 0x79, 0xa6, 0x51, 0x6d, 0xd0, 0xa5,
+    0x0c, 0x1e, 0xc4, 0xf4, 0xe8, 0x55, 0x45, 0xc5, 0x95, 0x26, 0xe0, 0x49, 0xa8, 0xd5, 0x43, 0x11,
+    0x98, 0xa0, 0x14, 0x40, 0xcd, 0x22, 0x8c, 0x3c, 0x1e, 0x61, 0x20, 0xdc, 0x73, 0xac, 0xce, 0xc5,
+    0xcf, 0xf2, 0xa0, 0x83, 0xca, 0xab, 0x20, 0x72, 0x9a, 0xc2, 0xf0, 0x00, 0x82, 0x76, 0xbb, 0x42,
+    0x4c, 0x79, 0x6a, 0xd7, 0xd4, 0xff, 0xb9, 0xd4, 0xb5, 0xd2, 0x13, 0x45, 0x0e, 0x81, 0xd0, 0xa5,
+    0xcc, 0x25, 0xcb, 0x65, 0xfb, 0x46, 0x98, 0x53, 0xba, 0x75, 0xf4, 0x00, 0xae, 0xa5, 0xb7, 0xb9,
+    0x58, 0x82, 0xad, 0xd5, 0x3b, 0x72, 0xcf, 0x21, 0xb2, 0x7c, 0x01, 0x89, 0x38, 0xfe, 0x70, 0x5b,
+    0x74, 0xa8, 0xae, 0x2b, 0xcc, 0x2c, 0x80, 0xb0, 0x90, 0x4d, 0xbe, 0x44, 0x79, 0x68, 0xe6, 0x6c,
+    0x05, 0x22, 0xc8, 0x66, 0xc3, 0x11, 0x09, 0xe6, 0xa0, 0x55, 0xfc, 0x03, 0xa1, 0x69, 0x6e, 0x35,
+    0x0d, 0xa0, 0xd2, 0x1b, 0xa5, 0x9f, 0xd7, 0xd5, 0x30, 0x62, 0x8f, 0xe6, 0xff, 0x8e, 0xbb, 0x9e,
+    0x40, 0x22, 0x16, 0xce, 0x5f, 0xf3, 0x55, 0x0d, 0xb5, 0xeb, 0x38, 0xb5, 0xe0, 0xf7, 0x96, 0x4a,
+    0xfc, 0xf2, 0xc0, 0xa9, 0xf4, 0xb7, 0xe4, 0x69, 0x3f, 0x90, 0xdf, 0x9c, 0x58, 0xbc, 0xf2, 0x17,
+    0x0a, 0x24, 0x04, 0x89, 0xf6, 0x2e, 0xf7, 0xef, 0x81, 0x96, 0xbb, 0xfd, 0x16, 0x2e, 0x13, 0xd8,
+    0xfe, 0xc5, 0x7d, 0x81, 0xed, 0x81, 0x55, 0x69, 0x64, 0x0c, 0x9b, 0x2f, 0x09, 0xf9, 0x43, 0x5f,
+    0x1b, 0x01, 0x38, 0x0d, 0xd6, 0xe5, 0x44, 0x08, 0xfe, 0xf2, 0xa6, 0x64, 0x44, 0x35, 0xaf, 0x3a,
+    0xbf, 0xd8, 0x54, 0xf4, 0xcf, 0xc5, 0x80, 0x31, 0xe9, 0x04, 0x80, 0xab, 0x3d, 0x0a, 0xa4, 0x06,
+    0x13, 0x98, 0x12, 0xbf, 0x64, 0xe0, 0x75, 0xb3, 0x96, 0x74, 0xd0, 0x9b, 0xc5, 0x44, 0xf1, 0x48,
+    0x17, 0x69, 0xbd, 0x20, 0x88, 0xbc, 0x04, 0xee, 0x2e, 0x7e, 0x8a, 0x7a, 0xb9, 0x10, 0xc8, 0x3c,
+    0xcf, 0x40, 0x30, 0xad, 0xff, 0x9a, 0x05, 0x01, 0xd2, 0x70, 0x42, 0x56, 0xc2, 0xc1, 0x20, 0x01,
+    0xd2, 0x77, 0x3f, 0xef, 0xa7, 0xb5, 0x80, 0x47, 0x2e, 0xea, 0x8b, 0x3c, 0xd1, 0xb2, 0x89, 0x09,
+    0x26, 0x45, 0x29, 0x3e, 0x7c, 0x27, 0xa5, 0xaa, 0x0e, 0x4d, 0xe5, 0xb9, 0x5f, 0x50, 0xcf, 0x94,
+    0x3b, 0xf5, 0xb7, 0x1b, 0x9c, 0x4a, 0x81, 0x90, 0x64, 0x07, 0xb5, 0xd3, 0xb6, 0xfe, 0x59, 0xca,
+    0xda, 0x69, 0x8b, 0xad, 0x79, 0x30, 0xb1, 0xd9, 0x3e, 0x98, 0xea, 0xd0, 0xb5, 0xd4, 0x67, 0xf8,
+    0xa4, 0x1c, 0x23, 0x9c, 0x05, 0xf7, 0x15, 0x17, 0x2d, 0xab, 0xda, 0xcc, 0xe8, 0xce, 0x9a, 0x66,
+    0x38, 0x15, 0xa5, 0x3f, 0xfb, 0x1b, 0xa4, 0x77, 0x0b, 0x98, 0x13, 0x6a, 0xdf, 0xae, 0xa6, 0x85,
+    0xd5, 0xab, 0x01, 0xb6, 0x0a, 0xcb, 0x0a, 0xce, 0xb2, 0x15, 0x37, 0xb7, 0x01, 0xd1, 0x04, 0xf9,
+    0x6e, 0xdc, 0x2a, 0x60, 0xca, 0xb5, 0xe8, 0x66, 0xc7, 0x68, 0x6c, 0x57, 0xe1, 0xba, 0xc8, 0x63,
+    0x2c, 0xac, 0x62, 0x83, 0x28, 0x94, 0xef, 0xe0, 0xc0, 0x75, 0xe2, 0xae, 0x68, 0x9a, 0x86, 0xb8,
+    0x7e, 0xad, 0x44, 0x32, 0xce, 0xde, 0x9f, 0xb6, 0x05, 0x8f, 0x80, 0xbb, 0x6c, 0x9d, 0x52, 0xb2,
+    0xbe, 0x42, 0x0d, 0x09, 0xa2, 0xc8, 0x47, 0xfa, 0x69, 0x5a, 0x8c, 0x8d, 0x37, 0x04, 0x7d, 0x4d,
+    0xf7, 0xbc, 0x15, 0x3c, 0xa9, 0xc9, 0x55, 0xe0, 0x48, 0x5a, 0xee, 0x8d, 0xda, 0x93, 0xfd, 0x2f,
+    0x4e, 0xb7, 0x91, 0x0b, 0x48, 0x4f, 0xec, 0xb1, 0x48, 0x1c, 0x82, 0xf6, 0x51, 0xd4, 0x1e, 0x43,
+    0x74, 0xc1, 0x28, 0x26, 0x82, 0x18, 0xfd, 0xb5, 0x22, 0x58, 0xf2, 0x57, 0xc4, 0xca, 0xf2, 0x2f,
+    0x23, 0x37, 0xc6, 0xc6, 0x2e, 0xc1, 0xf6, 0x7c, 0x42, 0x3e, 0x19, 0xa6, 0x16, 0x87, 0x32, 0x58,
+    0x97, 0xf2, 0x5e, 0x24, 0x9a, 0x39, 0x73, 0x45, 0x61, 0x98, 0xed, 0x51, 0x6c, 0x52, 0xf1, 0x7d,
+    0x30, 0x61, 0x46, 0xc2, 0xfb, 0xb6, 0x82, 0x2e, 0x56, 0x50, 0xe6, 0x21, 0x2a, 0x19, 0x98, 0x4d,
+    0x38, 0x87, 0x18, 0xa7, 0x45, 0xfe, 0x87, 0xbb, 0x12, 0x7c, 0x0a, 0x4b, 0x39, 0x0b, 0xb8, 0xc8,
+    0x65, 0x51, 0x3d, 0x2c, 0xfc, 0x00, 0x63, 0xc3, 0x8a, 0x41, 0xf4, 0xaa, 0x01, 0x59, 0x19, 0x01,
+    0x00, 0x9a, 0x6c, 0x0b, 0x03, 0x54, 0xc4, 0x2e, 0xd5, 0x5a, 0x6d, 0xa1, 0x66, 0xd7, 0xc1, 0x09,
+    0xc6, 0x76, 0x77, 0x9e, 0x83, 0xa2, 0xdf, 0xab, 0x81, 0x39, 0x2c, 0xd5, 0x5c, 0xf1, 0x26, 0x2b,
+    0x77, 0x82, 0x56, 0x2a, 0x45, 0x56, 0x06, 0x71, 0x3d, 0xf9, 0xec, 0x9a, 0xf4, 0xba, 0xc2, 0x5c,
+    0x9b, 0x88, 0x54, 0xc1, 0x5a, 0x44, 0x23, 0x06, 0x28, 0x47, 0x69, 0x1c, 0x69, 0x1b, 0xb6, 0x30,
+    0x82, 0xc0, 0xac, 0x79, 0xc9, 0xe7, 0xe0, 0x0a, 0x0e, 0x06, 0xe8, 0x50, 0x12, 0x89, 0x96, 0xbf,
+    0x24, 0xce, 0x74, 0x2c, 0x8c, 0x85, 0xc9, 0x39, 0xae, 0x49, 0x7a, 0xd0, 0xd1, 0xd4, 0xa9, 0xf7,
+    0xe5, 0x1d, 0xb8, 0x5b, 0x22, 0x85, 0x7f, 0x56, 0x90, 0xc4, 0xb8, 0x33, 0x43, 0x91, 0x09, 0xd4,
+    0xef, 0xfc, 0x16, 0x6d, 0xd5, 0x22, 0xdc, 0x0d, 0x2b, 0xce, 0xa1, 0x0d, 0x40, 0x12, 0x48, 0xd7,
+    0x64, 0xa3, 0x7c, 0xf3, 0x9c, 0xf0, 0x8e, 0x86, 0xbd, 0x71, 0xac, 0xcc, 0xe4, 0x9b, 0x23, 0x37,
+    0x89, 0xf5, 0x4b, 0x4b, 0x89, 0x1c, 0x22, 0x14, 0xda, 0x4d, 0x17, 0x78, 0xd4, 0xd2, 0x69, 0x55,
+    0xdc, 0x75, 0x35, 0xaa, 0x3b, 0x5c, 0xf4, 0xf7, 0x1c, 0x63, 0xf6, 0x54, 0xc4, 0xa1, 0x6e, 0x80,
+    0x09, 0x46, 0x59, 0x0a, 0xad, 0xde, 0x41, 0x35, 0x6f, 0x98, 0xb9, 0xc6, 0xb9, 0x89, 0x40, 0xb5,
+    0x6b, 0x29, 0x33, 0x83, 0x0e, 0x05, 0x56, 0xda, 0x48, 0xde, 0x6f, 0x71, 0x76, 0x6a, 0x4a, 0x77,
+    0x22, 0x77, 0x63, 0x42, 0xaf, 0x68, 0xed, 0xfc, 0x09, 0x38, 0xd8, 0xeb, 0x82, 0x5a, 0x8f, 0x82,
+    0x04, 0x2c, 0xd0, 0xf9, 0x15, 0x35, 0x7e, 0x4c, 0x8c, 0x1b, 0x86, 0xfe, 0x92, 0x39, 0x83, 0x82,
+    0x63, 0x4d, 0x54, 0xd2, 0xdc, 0x93, 0xc4, 0x28, 0x91, 0xdc, 0x8b, 0x19, 0x3f, 0x97, 0x90, 0xfe,
+    0x54, 0xee, 0x58, 0x53, 0x71, 0x57, 0xd2, 0x9a, 0xf2, 0xbf, 0x1c, 0x1c, 0x6b, 0x66, 0x7c, 0x36,
+    0x5b, 0x17, 0x68, 0x07, 0x90, 0x53, 0xdd, 0x1a, 0x9a, 0x46, 0x17, 0xa5, 0x11, 0xab, 0x7f, 0x40,
+    0x08, 0x54, 0x80, 0x22, 0x54, 0x2f, 0xfd, 0xf6, 0x97, 0xe7, 0x8d, 0x93, 0x24, 0x0b, 0xd6, 0x3d,
+    0x13, 0x97, 0x7c, 0xdf, 0x39, 0xef, 0x93, 0x6b, 0xf0, 0xf8, 0xa4, 0x64, 0xdc, 0x7f, 0x9f, 0xb2,
+    0xb7, 0x42, 0xf6, 0x1f, 0x1a, 0x7d, 0xdc, 0xc8, 0x8a, 0x83, 0xd9, 0x6c, 0xa7, 0xc6, 0x5b, 0x09,
+    0x4c, 0x49, 0xaf, 0x70, 0x99, 0xc0, 0x84, 0xbc, 0x53, 0xa2, 0xf2, 0x0f, 0x88, 0xf9, 0xcf, 0x10,
+    0x56, 0x58, 0x39, 0xf8, 0xec, 0xee, 0x01, 0xad, 0xe8, 0x55, 0x08, 0x63, 0x4a, 0x27, 0xe5, 0x8b,
+    0xca, 0x4c, 0x4d, 0x22, 0x4b, 0x26, 0xa4, 0xc9, 0xb8, 0x97, 0x51, 0x42, 0x54, 0xab, 0x0f, 0x1e,
+    0x29, 0x7f, 0x48, 0xd0, 0x63, 0x80, 0x4d, 0x4d, 0xd6, 0x0f, 0x63, 0xd0, 0x8d, 0xb9, 0x85, 0xc0,
+    0xbf, 0x88, 0x52, 0xf8, 0xd8, 0x29, 0x6e, 0xb9, 0x2f, 0x2a, 0xdb, 0x3e, 0x60, 0x7b, 0xde, 0x0a,
+    0x9d, 0x9e, 0xde, 0x0d, 0x83, 0x28, 0x76, 0x9e, 0xd2, 0xb6, 0xfb, 0x3c, 0x00, 0xdb, 0x50, 0xc2,
+    0x53, 0x9a, 0x03, 0x4b, 0x7c, 0x96, 0x0d, 0xa3, 0x7e, 0xb6, 0x71, 0x7f, 0xb3, 0xc7, 0x1c, 0xc4,
+    0x7a, 0xa2, 0x5f, 0xdd, 0x9b, 0x27, 0xc8, 0xab, 0x24, 0x45, 0x5c, 0x9d, 0xfa, 0x93, 0x42, 0x67,
+    0xd1, 0x01, 0xde, 0x01, 0x97, 0x48, 0x0a, 0x28, 0x20, 0xb5, 0xc5, 0x95, 0x97, 0x93, 0x06, 0x75,
+    0xa1, 0xd3, 0x26, 0x9f, 0x7c, 0x3e, 0x03, 0x3c, 0xb4, 0x1d, 0xc0, 0x80, 0x01, 0xde, 0xc6, 0x62,
+    0x24, 0xa3, 0xd2, 0x36, 0x4f, 0x6f, 0x14, 0xb3, 0x99, 0x1c, 0xa4, 0x72, 0x69, 0x07, 0xa5, 0xa1,
+    0x2f, 0x8f, 0x57, 0x01, 0xb0, 0x8c, 0x9e, 0x91, 0x65, 0x23, 0xdc, 0xcd, 0x74, 0xc0, 0xe6, 0xbf,
+    0xaf, 0x55, 0xfc, 0xf5, 0x44, 0xc3, 0xa2, 0x2e, 0xee, 0x94, 0x15, 0xc1, 0x51, 0x13, 0xd1, 0xdb,
+    0x15, 0xc9, 0x37, 0x9f, 0x9f, 0x48, 0x1e, 0x4a, 0x42, 0x7d, 0x5b, 0x9b, 0xdc, 0x3f, 0x2e, 0x0a,
+    0xb5, 0x28, 0x8a, 0x1f, 0x0b, 0x46, 0xe8, 0xe4, 0xc4, 0xaf, 0x02, 0x3f, 0x65, 0xc4, 0xd7, 0xca,
+    0x13, 0xf0, 0xec, 0x4b, 0xdd, 0x50, 0x82, 0x1f, 0x90, 0xab, 0x88, 0x06, 0xf5, 0xd3, 0x36, 0x89,
+    0xde, 0x6a, 0xad, 0x54, 0xc4, 0xd9, 0xa8, 0x23, 0x60, 0x23, 0x63, 0x51, 0x64, 0xb6, 0x40, 0x01,
+    0x99, 0x21, 0x8e, 0x3d, 0xfb, 0x4e, 0xe7, 0xaa, 0x17, 0x99, 0x87, 0xb1, 0xd4, 0xe4, 0x89, 0x3a,
+    0x19, 0x09, 0x62, 0xa8, 0x81, 0xdb, 0x6b, 0x91, 0xa0, 0xc3, 0x26, 0x8f, 0x63, 0x54, 0xe9, 0xa0,
+    0xae, 0x04, 0x92, 0x46, 0x67, 0xf6, 0x5b, 0x83, 0x4c, 0xe3, 0x00, 0x67, 0xc9, 0x88, 0x01, 0x9f,
+    0xf8, 0x69, 0x84, 0xbb, 0xdd, 0xeb, 0x21, 0x1b, 0x3d, 0x94, 0x2f, 0x1e, 0xaa, 0xb6, 0xd2, 0x13,
+    0xfe, 0xc0, 0x8c, 0x7a, 0xb3, 0x08, 0x42, 0xba, 0x11, 0x22, 0x1f, 0xf2, 0x90, 0x44, 0xe4, 0xe9,
+    0x66, 0x0f, 0x07, 0x96, 0xb4, 0x37, 0xd3, 0xd0, 0xd8, 0xfe, 0x9e, 0x2f, 0xc6, 0x0e, 0x67, 0xdb,
+    0x18, 0x71, 0x09, 0xb7, 0xaa, 0x35, 0xb3, 0x0e, 0xb3, 0x8f, 0xd6, 0x25, 0x2b, 0x35, 0xd2, 0xf5,
+    0xc3, 0xcd, 0xaf, 0x7e, 0x1f, 0x23, 0x11, 0xea, 0x6c, 0xe6, 0xa6, 0xde, 0x6d, 0x1b, 0x68, 0x1e,
+    0xde, 0x35, 0x07, 0x8a, 0xc0, 0xa0, 0x8c, 0xb7, 0x9f, 0x74, 0xba, 0x2f, 0xad, 0xd7, 0x68, 0xb3,
+    0x5a, 0xb7, 0x89, 0xf8, 0x3b, 0x9f, 0x9a, 0x50, 0x59, 0xe5, 0x00, 0xde, 0x88, 0xa4, 0x01, 0xd8,
+    0xa6, 0x12, 0xe7, 0x74, 0x0f, 0xf9, 0xae, 0xc5, 0xd1, 0xbf, 0x78, 0xe1, 0xcd, 0xd4, 0x85, 0xe0,
+    0x1b, 0x5f, 0x45, 0xda, 0x6e, 0x40, 0x9b, 0x13, 0x72, 0xfa, 0x0b, 0x52, 0xe3, 0x91, 0x8c, 0x69,
+    0x52, 0xc0, 0xf4, 0x05, 0x83, 0x57, 0xae, 0xee, 0x77, 0x6b, 0x1e, 0xb0, 0xfe, 0x31, 0x61, 0x5a,
+    0xe1, 0xa9, 0x95, 0xda, 0xc7, 0x3f, 0xc8, 0x40, 0x68, 0x23, 0x3b, 0x5b, 0x3d, 0x4d, 0x2c, 0x04,
+    0xb4, 0x75, 0xa2, 0x93, 0xc7, 0xcc, 0xfb, 0x6a, 0x2a, 0x6e, 0xb6, 0xe0, 0x80, 0xa9, 0x5e, 0x3f,
+    0xf8, 0x8d, 0xb3, 0x8b, 0x60, 0xba, 0x81, 0x27, 0xcd, 0x04, 0xab, 0xdd, 0x90, 0x55, 0xba, 0x27,
+    0xcc, 0x8e, 0x87, 0x8a, 0xd0, 0x5d, 0xf4, 0xb2, 0x2f, 0x92, 0xcd, 0x58, 0x58, 0x86, 0x4f, 0xbb,
+    0x7e, 0xdb, 0x84, 0x42, 0xd1, 0x04, 0x5b, 0x53, 0x37, 0x4f, 0x24, 0xa6, 0xa2, 0x33, 0xa1, 0xb9,
+    0x19, 0x3c, 0x55, 0x2c, 0x3c, 0x84, 0x54, 0x57, 0x73, 0x4f, 0x84, 0x86, 0x35, 0x2a, 0x3b, 0x9b,
+    0x11, 0xb5, 0x13, 0xac, 0x23, 0x2f, 0x66, 0x05, 0x0b, 0x87, 0x20, 0xaf, 0x60, 0xaa, 0xdf, 0xf5,
+    0xb2, 0xed, 0xd1, 0xab, 0x0a, 0xc5, 0x1e, 0x5f, 0x46, 0xeb, 0x1c, 0xbc, 0xec, 0x86, 0xe0, 0xa3,
+    0xac, 0xab, 0x54, 0xfd, 0xa2, 0xea, 0xf2, 0x44, 0x59, 0x03, 0x90, 0xc4, 0xa1, 0x63, 0x67, 0x27,
+    0x4b, 0xcd, 0xf1, 0xed, 0xec, 0xcc, 0xd7, 0x1a, 0xf0, 0xbd, 0xf5, 0x68, 0xbc, 0x6a, 0x2a, 0x14,
+    0x3c, 0x03, 0x23, 0xf6, 0xe1, 0x6d, 0x24, 0xe4, 0xe4, 0xef, 0x64, 0x74, 0x83, 0xa7, 0x3c, 0x23,
+    0x58, 0x57, 0x9e, 0x1e, 0xcd, 0xad, 0x38, 0xce, 0x68, 0xed, 0x42, 0xf2, 0xf8, 0x6d, 0x2f, 0xbe,
+    0x8f, 0x3c, 0xdd, 0xd6, 0x0b, 0x07, 0xa2, 0x1d, 0x90, 0x99, 0xc6, 0xb0, 0x8f, 0x22, 0xb9, 0x7e,
+    0x1a, 0x02, 0x2a, 0xf2, 0x85, 0x74, 0x09, 0x32, 0x92, 0x7b, 0xc8, 0x8c, 0x83, 0x4d, 0xfc, 0x33,
+    0x03, 0x6c, 0xe8, 0x6f, 0x09, 0x5f, 0x5b, 0xba, 0x0b, 0xc4, 0xcb, 0x37, 0xd3, 0x30, 0xac, 0xb5,
+    0x9d, 0x65, 0x70, 0xa8, 0xe2, 0x82, 0x97, 0x95, 0x2c, 0xf4, 0xed, 0x05, 0x13, 0x46, 0x78, 0x60,
+    0x5e, 0x42, 0x0d, 0xb3, 0x14, 0x71, 0xb0, 0x8c, 0xd6, 0xe4, 0x47, 0x16, 0x37, 0xb8, 0x43, 0x7f,
+    0x88, 0x80, 0x26, 0x6f, 0x3d, 0xcf, 0xab, 0x80, 0xc2, 0x2d, 0xc9, 0x3b, 0x84, 0x2a, 0xbc, 0x92,
+    0x3f, 0xbb, 0x12, 0x64, 0x3f, 0x3a, 0xd3, 0xc6, 0x8e, 0xc2, 0xde, 0xd3, 0x7c, 0x1d, 0xba, 0x3e,
+    0xff, 0x45, 0xc9, 0x10, 0x6d, 0xb1, 0x15, 0x35, 0xb1, 0x4d, 0xa3, 0x9f, 0xc2, 0x68, 0xf1, 0x27,
+    0xd0, 0x6a, 0x6a, 0x4d, 0xa2, 0xc2, 0x00, 0x90, 0xdb, 0x3f, 0xb4, 0xcf, 0x35, 0xbc, 0xc7, 0x4a,
+    0xa8, 0x62, 0x72, 0x40, 0xb9, 0xfd, 0x99, 0x64, 0x93, 0x9f, 0x98, 0x43, 0x32, 0x21, 0x7b, 0xcc,
+    0x00, 0xb5, 0x79, 0xe8, 0x7c, 0x91, 0x21, 0x14, 0xec, 0xaa, 0x21, 0x80, 0xb6, 0xd3, 0xc3, 0xa4,
+    0x2c, 0x3b, 0xfe, 0x24, 0xfe, 0x4e, 0x07, 0x95, 0xfc, 0x16, 0xe1, 0x66, 0xfe, 0xd9, 0x4f, 0x36,
+    0x55, 0x93, 0x2a, 0x6c, 0x3e, 0x2f, 0xa1, 0xd4, 0x4e, 0x2f, 0x9a, 0x95, 0xe1, 0x6a, 0x74, 0x4f,
+    0xc5, 0xc1, 0x5c, 0xe8, 0x4a, 0xbe, 0xa3, 0xd0, 0x40, 0xf7, 0x74, 0x04, 0xc9, 0xed, 0x16, 0x30,
+    0xf2, 0x7c, 0xc1, 0x1d, 0x1f, 0x88, 0x5e, 0x56, 0xbd, 0x94, 0x70, 0x38, 0x69, 0x98, 0x9b, 0xfb,
+    0xd5, 0x24, 0x7b, 0x0c, 0xb6, 0x72, 0x43, 0x06, 0x1a, 0x82, 0x7a, 0x57, 0x5b, 0x69, 0x58, 0xba,
+    0x2e, 0xd8, 0x09, 0xc5, 0x5d, 0x75, 0xf8, 0x98, 0xeb, 0x30, 0x74, 0xde, 0x5e, 0x49, 0x0f, 0x43,
+    0xdc, 0xea, 0x4b, 0xd1, 0xe8, 0x01, 0x96, 0x83, 0xb0, 0x7f, 0x72, 0x70, 0xb3, 0x61, 0x0e, 0x57,
+    0x83, 0x7d, 0x57, 0xfb, 0x6a, 0x84, 0xe0, 0x6f, 0xfe, 0xdf, 0x58, 0x97, 0x44, 0xb8, 0x4e, 0x19,
+    0x0a, 0x8e, 0x64, 0x07, 0x44, 0x0d, 0x53, 0xc9, 0xef, 0x7a, 0x50, 0xd1, 0x79, 0xe2, 0xe2, 0x73,
+    0xd3, 0x5e, 0x37, 0x52, 0x9b, 0x8e, 0xe1, 0xb3, 0xf0, 0x22, 0x7f, 0x91, 0xcb, 0x18, 0x89, 0xcd,
+    0x25, 0x60, 0x1f, 0xe6, 0x85, 0xb8, 0x58, 0x71, 0x0d, 0xa0, 0x59, 0xcd, 0xfa, 0x4e, 0x84, 0xbd,
+    0x12, 0x55, 0x29, 0xf8, 0x66, 0xa3, 0x49, 0xa2, 0x2d, 0xed, 0xa0, 0x60, 0xd4, 0xa1, 0xeb, 0x59,
+    0x10, 0x0f, 0xdf, 0x22, 0xa1, 0xff, 0xc1, 0x32, 0x44, 0x73, 0x59, 0x6f, 0x98, 0x8a, 0x37, 0x22,
+    0x73, 0xd8, 0xf5, 0x93, 0xed, 0x34, 0x77, 0xf2, 0xcf, 0x4d, 0x03, 0x5f, 0x8e, 0x5c, 0x46, 0xe9,
+    0x1a, 0x60, 0x67, 0xe2, 0x70, 0xd8, 0x4d, 0x62, 0x46, 0xdf, 0x36, 0xb5, 0x16, 0xbf, 0x49, 0x97,
+    0x9c, 0x37, 0xf8, 0xe7, 0xea, 0xf8, 0xf3, 0x8b, 0xc1, 0x73, 0x68, 0x5c, 0x76, 0xdb, 0x01, 0x5a,
+    0xf8, 0x6b, 0x5c, 0xd3, 0x48, 0x25, 0xdc, 0xf7, 0x0d, 0x3b, 0x54, 0xef, 0xa5, 0x5e, 0x91, 0x63,
+    0xf2, 0x5c, 0x1d, 0xe2, 0x9c, 0x1f, 0xf2, 0xa2, 0xa8, 0x4e, 0xd0, 0x08, 0x72, 0x2c, 0x91, 0x33,
+    0x43, 0xe7, 0x27, 0xb6, 0x81, 0x22, 0x16, 0xf4, 0xd0, 0xe0, 0x21, 0x23, 0x59, 0xd5, 0xdf, 0x37,
+    0x61, 0x12, 0x9b, 0x4a, 0x95, 0xd8, 0xd7, 0x40, 0x5e, 0x34, 0x01, 0x7f, 0x60, 0x51, 0x6d, 0x4c,
+    0x67, 0x5d, 0xf3, 0xf0, 0x8a, 0x00, 0xe6, 0x2f, 0x03, 0x01, 0x0f, 0xc9, 0xd0, 0x49, 0xcc, 0xf8,
+    0xb0, 0xa6, 0x76, 0xc6, 0xf1, 0x60, 0x39, 0x59, 0xe1, 0x20, 0x2a, 0x63, 0x19, 0x70, 0x6f, 0xcd,
+    0x28, 0xea, 0x42, 0xd6, 0x91, 0x28, 0x3c, 0xaf, 0xd9, 0x25, 0xfe, 0x7c, 0xad, 0x83, 0xde, 0xd6,
+    0xf8, 0xe7, 0x9f, 0xfe, 0xa0, 0x70, 0x4e, 0x84, 0x85, 0x0d, 0x6f, 0x1d, 0x69, 0x5e, 0x1c, 0xdb,
+    0xd3, 0x35, 0xe1, 0x1a, 0xc7, 0x5a, 0xbf, 0xc6, 0xce, 0x97, 0x7b, 0x28, 0x68, 0x82, 0x42, 0xfe,
+    0xf2, 0x7d, 0xe9, 0x31, 0xc5, 0x27, 0x32, 0xb6, 0xeb, 0x1d, 0x39, 0x02, 0x0f, 0x49, 0xf5, 0xc5,
+    0xcf, 0x48, 0x87, 0x88, 0x96, 0xde, 0x5d, 0x41, 0x24, 0x35, 0xc7, 0x9a, 0x81, 0x0a, 0x5c, 0xcf,
+    0xf0, 0x84, 0xc9, 0x7e, 0x44, 0x1f, 0xfa, 0x15, 0x91, 0x70, 0x34, 0x7b, 0x72, 0xd6, 0x7a, 0xbb,
+    0x6f, 0x15, 0xb1, 0x4d, 0xef, 0x09, 0x2b, 0x67, 0x08, 0x3b, 0x0a, 0x9a, 0xb5, 0xba, 0x31, 0xc9,
+    0x49, 0x0e, 0x19, 0x26, 0x6c, 0xd0, 0x29, 0xc4, 0xb9, 0x1f, 0x8c, 0x49, 0x36, 0x5a, 0xe0, 0x3a,
+    0x94, 0x94, 0x46, 0x3a, 0x23, 0xa9, 0xef, 0xfb, 0xed, 0xaf, 0x2c, 0x11, 0x59, 0x27, 0x24, 0x09,
+    0x8e, 0x35, 0x7e, 0x50, 0xa2, 0xcb, 0xe3, 0xa1, 0x52, 0x3f, 0xae, 0x9a, 0x7a, 0x04, 0x5c, 0x77,
+    0x3a, 0x57, 0x8f, 0xd5, 0x9c, 0x41, 0x3d, 0x66, 0x4d, 0x66, 0xa9, 0xcb, 0xb5, 0x92, 0x7a, 0x23,
+    0x73, 0x60, 0x18, 0x4a, 0x0e, 0x0e, 0x36, 0xf0, 0xc2, 0x74, 0x94, 0x8a, 0x12, 0xc2, 0xae, 0xbf,
+    0xca, 0x64, 0x8d, 0xdc, 0x0d, 0xee, 0x41, 0x64, 0x97, 0x5b, 0x4c, 0xb0, 0xe5, 0xcf, 0xe6, 0x86,
+    0x08, 0x61, 0x97, 0xaa, 0xbc, 0x88, 0xd6, 0xda, 0xa7, 0x0f, 0xe9, 0x92, 0xd4, 0x8b, 0x86, 0xb2,
+    0x24, 0xa8, 0xb1, 0x23, 0xda, 0xec, 0x21, 0x35, 0x2c, 0x2b, 0xf4, 0xb0, 0x86, 0x64, 0xe5, 0xb9,
+    0x0f, 0xe2, 0x0d, 0xc7, 0xb3, 0x78, 0x76, 0x73, 0x06, 0xe9, 0xe8, 0x6f, 0x57, 0x0c, 0x82, 0xd5,
+    0x3d, 0x02, 0x5b, 0xab, 0xbc, 0x54, 0x6f, 0xc7, 0x09, 0x4c, 0x06, 0x7d, 0xa9, 0x83, 0x6e, 0x18,
+    0x91, 0x40, 0xbd, 0x43, 0x73, 0x4e, 0xf9, 0x83, 0x4b, 0xe3, 0xe2, 0x5e, 0x23, 0x5d, 0xd9, 0xa3,
+    0xa3, 0x06, 0x04, 0x5a, 0xc9, 0x8a, 0x24, 0x4c, 0x25, 0xc4, 0x72, 0xdb, 0xc2, 0x26, 0x50, 0x5c,
+    0x8c, 0xd8, 0xda, 0x89, 0xf6, 0x86, 0x41, 0xec, 0x39, 0x32, 0xa1, 0x8b, 0x72, 0x0b, 0xbd, 0xa0,
+    0xd0, 0x72, 0xbb, 0xae, 0x4a, 0xe4, 0x04, 0xcf, 0x4b, 0x8b, 0xb1, 0xf1, 0x7f, 0x2e, 0xee, 0xe0,
+    0xa1, 0x5c, 0xd6, 0x1d, 0x53, 0x77, 0x59, 0x09, 0xb0, 0xd1, 0xeb, 0x87, 0x94, 0x22, 0xb8, 0x70,
+    0x69, 0xaf, 0xa9, 0x6b, 0xbf, 0x82, 0xb9, 0x38, 0x31, 0x2b, 0x82, 0x31, 0xb9, 0xf2, 0x91, 0x91,
+    0xc4, 0xc5, 0x98, 0x3e, 0x3f, 0x59, 0x69, 0x84, 0x49, 0x4f, 0x3a, 0xc9, 0xc8, 0xbd, 0xf1, 0x73,
+    0x16, 0x96, 0x7b, 0xe9, 0xba, 0x0a, 0x9e, 0xbb, 0x29, 0x9c, 0x17, 0xa9, 0xf4, 0x11, 0xd9, 0xfb,
+    0x0f, 0x89, 0x38, 0x5b, 0x0f, 0x5f, 0x1a, 0x2f, 0xa8, 0x63, 0xb0, 0x7a, 0x9a, 0x55, 0x6c, 0x34,
+    0x85, 0x60, 0x1a, 0xb9, 0xe7, 0x75, 0x63, 0x8c, 0x09, 0xee, 0x62, 0xac, 0x56, 0x32, 0x1b, 0x21,
+    0x3c, 0xca, 0x53, 0x76, 0xcb, 0x77, 0xd2, 0xae, 0x0e, 0xe9, 0xd1, 0x88, 0xc7, 0x7d, 0x77, 0x32,
+    0xfa, 0xdb, 0x72, 0x69, 0x65, 0x92, 0x12, 0x12, 0xb4, 0x7b, 0xe2, 0x61, 0x9a, 0x78, 0x00, 0xfd,
+    0x24, 0x31, 0xad, 0x10, 0x4b, 0xab, 0x4b, 0x0f, 0xfe, 0xf4, 0xb6, 0x57, 0xc0, 0x3e, 0x0c, 0xb9,
+    0x6a, 0x39, 0x31, 0x44, 0x0a, 0xb2, 0x0c, 0x97, 0x7e, 0x8b, 0xe1, 0x18, 0x6b, 0x09, 0x0d, 0xfc,
+    0xd0, 0x54, 0xab, 0xb0, 0x10, 0x18, 0xae, 0xf8, 0x04, 0x7d, 0xd1, 0xd5, 0xb1, 0xfb, 0x04, 0x62,
+    0x40, 0xcc, 0x7c, 0x8d, 0x03, 0xf8, 0xcd, 0xf1, 0x24, 0x67, 0x08, 0xb0, 0x3b, 0x4d, 0x0f, 0x4b,
+    0x4b, 0x1f, 0x2f, 0xa5, 0xc4, 0x0c, 0x7b, 0x9c, 0x8d, 0x68, 0x0b, 0x65, 0x72, 0x70, 0x59, 0x50,
+    0x37, 0x54, 0xc1, 0x36, 0xe4, 0x49, 0x1c, 0x40, 0x43, 0xba, 0xbe, 0x09, 0xc5, 0xd0, 0xa5, 0x4c,
+    0xd5, 0xca, 0x94, 0x55, 0x31, 0x03, 0xeb, 0xdc, 0xe7, 0x36, 0x50, 0x67, 0x70, 0x96, 0x27, 0x1f,
+    0x57, 0x3e, 0x7c, 0xa3, 0xd9, 0xab, 0x71, 0x89, 0xed, 0x95, 0x80, 0x8e, 0x93, 0x4e, 0x94, 0xb7,
+    0x04, 0x7a, 0x22, 0xc8, 0xd0, 0x70, 0xce, 0xd1, 0xa2, 0x74, 0x81, 0x76, 0xb4, 0xd1, 0xeb, 0xa8,
+    0x48, 0x6e, 0xef, 0x5b, 0x2f, 0xfc, 0xdf, 0x76, 0xc9, 0x44, 0xce, 0xe8, 0xa9, 0xaf, 0xdd, 0x7d,
+    0xc7, 0xc9, 0x7a, 0xa3, 0x78, 0x2c, 0xf8, 0xe8, 0x70, 0x19, 0xc2, 0x7b, 0x6d, 0x69, 0xe7, 0xca,
+    0xbd, 0xa0, 0xaf, 0xbc, 0x60, 0xd1, 0x6d, 0xf6, 0xc4, 0x46, 0xbc, 0x84, 0xda, 0xd5, 0x48, 0xc6,
+    0x98, 0xca, 0xf8, 0x67, 0x1c, 0x30, 0xe1, 0x48, 0x0a, 0xd5, 0xac, 0x40, 0xc1, 0xbc, 0xfe, 0x9a,
+    0x16, 0xb7, 0x24, 0x0b, 0x59, 0xdc, 0x34, 0xb5, 0xa5, 0x6b, 0x4a, 0x61, 0x9f, 0x34, 0x87, 0x27,
+    0xb1, 0xf2, 0x15, 0xee, 0x09, 0x43, 0xb9, 0x02, 0x57, 0xcf, 0x77, 0x6b, 0x1e, 0xd1, 0xed, 0xe3,
+    0xda, 0xf2, 0xbd, 0x1c, 0x1a, 0x70, 0xbd, 0x62, 0x36, 0xca, 0xf4, 0x50, 0xb5, 0x17, 0x25, 0x42,
+    0xfb, 0x1d, 0xfa, 0x9a, 0x0f, 0x15, 0xe7, 0xea, 0x9d, 0xe6, 0x3c, 0x51, 0x6a, 0x5e, 0xd9, 0x37,
+    0xb2, 0xa6, 0xcf, 0x53, 0xdb, 0x75, 0x81, 0x71, 0x27, 0xc8, 0x93, 0x18, 0x4b, 0x7e, 0x2f, 0x2e,
+    0x12, 0xba, 0xd7, 0xb7, 0x26, 0xce, 0x8f, 0x06, 0x49, 0x3e, 0x3c, 0x63, 0x24, 0x60, 0x2c, 0x14,
+    0x92, 0x28, 0xc9, 0x3c, 0x99, 0xb6, 0x33, 0x35, 0x21, 0x05, 0x59, 0x08, 0xe9, 0x1d, 0x31, 0x1b,
+    0x84, 0xcb, 0xe4, 0xbc, 0xdc, 0x33, 0x3d, 0xab, 0xd3, 0x59, 0x2e, 0xc8, 0xb9, 0x88, 0xa3, 0x4b,
+    0x6b, 0xd7, 0xcc, 0x92, 0xda, 0xe7, 0x91, 0x50, 0x96, 0xe9, 0x64, 0x41, 0x76, 0xc8, 0x4c, 0x5e,
+    0x67, 0x09, 0xed, 0xfe, 0xc3, 0x40, 0x43, 0x37, 0xe3, 0x7f, 0xe1, 0x1c, 0xe6, 0x76, 0xbd, 0x2a,
+    0xa1, 0xa4, 0xe9, 0xa5, 0x8d, 0x02, 0x51, 0x42, 0x87, 0x5b, 0xd5, 0x2f, 0x83, 0x59, 0x43, 0x4f,
+    0x6c, 0xdd, 0xf0, 0x54, 0x50, 0xce, 0x87, 0x09, 0x97, 0xf0, 0x38, 0xa8, 0x0b, 0x3e, 0xb1, 0xc0,
+    0xd8, 0xf0, 0xe4, 0x69, 0xe2, 0xd0, 0x8e, 0x9a, 0xe6, 0xf9, 0xcf, 0x0b, 0xb6, 0x1c, 0xfd, 0xf1,
+    0x8f, 0x33, 0x2b, 0xc0, 0x35, 0xce, 0x84, 0x52, 0x43, 0x56, 0x49, 0xf5, 0x4f, 0xc8, 0x3b, 0xd9,
+    0x12, 0xbe, 0x06, 0x51, 0x67, 0x0d, 0xc0, 0xce, 0x4b, 0xaa, 0xfd, 0x1a, 0xd4, 0xfc, 0xc9, 0xf5,
+    0x29, 0x0b, 0x8f, 0x2b, 0xa0, 0xff, 0x44, 0x69, 0x6c, 0x91, 0x32, 0xdd, 0x52, 0xea, 0xc2, 0x6b,
+    0x95, 0x93, 0x1c, 0x06, 0xb2, 0xbe, 0x6a, 0xfa, 0x51, 0xe1, 0xd7, 0x60, 0x2a, 0x38, 0x27, 0xa8,
+    0xa6, 0xf0, 0x74, 0xfb, 0xbc, 0x24, 0x92, 0xf7, 0x52, 0xeb, 0x8a, 0x59, 0xba, 0x96, 0x36, 0x5e,
+    0x39, 0xf2, 0x81, 0xa2, 0x2a, 0x59, 0x09, 0x9e, 0x25, 0xa2, 0xfe, 0x6e, 0x33, 0x71, 0x74, 0x7d,
+    0x73, 0x3f, 0x98, 0x65, 0xa6, 0x50, 0x90, 0x78, 0xab, 0x25, 0x28, 0xbf, 0x74, 0xb1, 0x96, 0x65,
+    0x2e, 0x3f, 0xc5, 0xd8, 0x84, 0xa0, 0xd1, 0x98, 0xd0, 0x2d, 0x51, 0xa6, 0x0f, 0x70, 0x56, 0x15,
+    0x67, 0x9e, 0xdc, 0x6c, 0xda, 0x6d, 0xdb, 0xa4, 0x1a, 0xfa, 0xcf, 0xf5, 0xa7, 0x76, 0xdb, 0x11,
+    0xf7, 0x2f, 0x6a, 0xb8, 0xad, 0x6d, 0x9a, 0x9c, 0x93, 0x66, 0x15, 0xcf, 0x37, 0xc3, 0x49, 0xe6,
+    0x27, 0xdc, 0x3e, 0x91, 0xbf, 0x35, 0x4a, 0x08, 0xb8, 0x44, 0x48, 0x23, 0x4f, 0xa2, 0xec, 0x9c,
+    0x6d, 0x54, 0xcd, 0x97, 0x38, 0xd9, 0xdc, 0xbf, 0x1c, 0x8b, 0x91, 0xa3, 0xc2, 0xc2, 0xd9, 0x12,
+    0x57, 0x6e, 0x4e, 0x6e, 0x35, 0xcd, 0xf3, 0x5c, 0x09, 0x22, 0x02, 0xf7, 0x72, 0xe7, 0xc3, 0x38,
+    0x95, 0xf6, 0x4f, 0xa8, 0x99, 0xd2, 0x28, 0x0f, 0x52, 0x06, 0x75, 0x90, 0x29, 0xdf, 0x5c, 0xba,
+    0xef, 0xc2, 0xb6, 0x1e, 0x40, 0xbd, 0x14, 0x8c, 0x61, 0x1a, 0x6a, 0x21, 0x72, 0x83, 0x45, 0xb4,
+    0x0b, 0xf6, 0xa2, 0x47, 0x5b, 0xce, 0xd2, 0x35, 0xe4, 0x8a, 0x34, 0x90, 0x7f, 0xa4, 0x7e, 0xdb,
+    0xca, 0x39, 0xe8, 0xf9, 0xa0, 0xe0, 0xec, 0xe5, 0x7b, 0x6b, 0xea, 0x14, 0xef, 0xe7, 0x96, 0x49,
+    0xd6, 0xa5, 0x38, 0xfd, 0x51, 0x14, 0x16, 0x3b, 0x5d, 0xb4, 0x4f, 0xf9, 0x0e, 0x7f, 0xbd, 0x49,
+    0x3e, 0xe9, 0x02, 0xb6, 0xc2, 0x75, 0xa9, 0xcd, 0xdc, 0x12, 0x9b, 0xf8, 0xe9, 0x9b, 0x74, 0xc7,
+    0xf2, 0x6a, 0xa8, 0x11, 0x4d, 0x0f, 0x2c, 0x65, 0x18, 0x8c, 0x2e, 0x91, 0x3c, 0x00, 0x36, 0x69,
+    0xb2, 0x6a, 0xb3, 0xf2, 0x2d, 0x40, 0x82, 0x19, 0x8f, 0x94, 0x84, 0x77, 0x2a, 0x4f, 0x3e, 0xb8,
+    0xf9, 0xd9, 0xd2, 0x93, 0x69, 0x26, 0x64, 0x2a, 0x41, 0x35, 0x77, 0xc8, 0xa4, 0x72, 0xc6, 0x11,
+    0xa6, 0x0d, 0x55, 0xbc, 0x30, 0xd2, 0xa7, 0xb7, 0x4e, 0xb5, 0x7f, 0x95, 0xed, 0x83, 0x65, 0x85,
+    0x84, 0x5b, 0x68, 0xfd, 0x5b, 0xb1, 0x45, 0xea, 0x20, 0x35, 0x0d, 0x6e, 0x06, 0x53, 0x57, 0x78,
+    0xaa, 0x7d, 0x60, 0x6a, 0x92, 0x4a, 0x3c, 0x96, 0x19, 0xa4, 0x50, 0x10, 0x15, 0xf7, 0xbf, 0xc7,
+    0x20, 0xf0, 0xea, 0xc1, 0xb6, 0x05, 0x92, 0xf1, 0xde, 0xeb, 0xf4, 0x7d, 0x5a, 0xc1, 0x43, 0x86,
+    0x53, 0x23, 0x93, 0x1c, 0xc4, 0x70, 0xfe, 0xa2, 0xe0, 0x60, 0x37, 0x96, 0x29, 0xbc, 0x34, 0x1c,
+    0x59, 0xe0, 0x71, 0xe3, 0xae, 0x14, 0x5a, 0x93, 0xa4, 0x98, 0x38, 0xe4, 0x93, 0x8f, 0x4f, 0x60,
+    0x67, 0x38, 0x93, 0xda, 0x07, 0xa5, 0x24, 0x07, 0xeb, 0x4d, 0xe5, 0x38, 0xbf, 0xee, 0x99, 0xde,
+    0x63, 0xa8, 0x5c, 0xe0, 0x6d, 0xb2, 0x31, 0xc7, 0x7d, 0xfd, 0x03, 0x3b, 0x97, 0x45, 0x13, 0x93,
+    0x86, 0x44, 0xb7, 0xfd, 0x19, 0xf2, 0xbe, 0xd7, 0x5a, 0x4d, 0xa4, 0xa5, 0xf3, 0xce, 0x71, 0x83,
+    0x46, 0x24, 0xb2, 0xb3, 0x07, 0x49, 0x45, 0x98, 0xb3, 0x9d, 0xca, 0xc8, 0x6b, 0x76, 0x42, 0xf0,
+    0x2b, 0xf2, 0x55, 0x59, 0x91, 0x5c, 0xd4, 0x2e, 0xd5, 0x71, 0x80, 0xbe, 0xe8, 0xcc, 0x43, 0x23,
+    0xee, 0xdd, 0x3a, 0x4c, 0x40, 0x72, 0x0c, 0x1a, 0x07, 0xc9, 0x40, 0x47, 0x2d, 0x38, 0x6e, 0x63,
+    0x7f, 0x60, 0x03, 0xe2, 0x25, 0x2d, 0x13, 0x10, 0xa7, 0x1f, 0x0e, 0xc0, 0xd3, 0xd4, 0x30, 0x16,
+    0xba, 0x2e, 0x1a, 0x10, 0x2a, 0xbc, 0xac, 0x42, 0x38, 0xae, 0xa9, 0xec, 0x27, 0x2c, 0x8d, 0x8b,
+    0x91, 0xaa, 0xcd, 0x87, 0x80, 0x28, 0x74, 0x79, 0x51, 0x64, 0xc0, 0xd1, 0x4b, 0xc5, 0xbb, 0x0b,
+    0xf1, 0xc6, 0x71, 0x79, 0x62, 0x0d, 0x45, 0xf1, 0x11, 0x51, 0xc7, 0x4a, 0x6b, 0x3c, 0x87, 0x2a,
+    0x5f, 0x26, 0x90, 0xf6, 0x34, 0x4c, 0x02, 0x14, 0xf2, 0xe0, 0x56, 0x0f, 0xfc, 0x7a, 0xa7, 0xf7,
+    0xdd, 0x06, 0x7f, 0x4d, 0x7b, 0x44, 0x78, 0xa2, 0xdb, 0x34, 0x30, 0xaa, 0xdb, 0x8e, 0xbd, 0xe0,
+    0x65, 0xc0, 0xde, 0x92, 0x8d, 0x57, 0x50, 0x93, 0x68, 0xef, 0x3a, 0xc3, 0x3a, 0x11, 0x6b, 0xf3,
+    0x4f, 0xe3, 0x64, 0xb1, 0x88, 0x15, 0x9f, 0xf9, 0xc7, 0x21, 0x77, 0xf6, 0x18, 0x44, 0x80, 0x7c,
+    0x33, 0x1d, 0xa9, 0x2f, 0xa7, 0xeb, 0x0b, 0x63, 0x08, 0xc1, 0x70, 0x5b, 0x60, 0x10, 0x62, 0x35,
+    0xc2, 0x7c, 0x49, 0x38, 0x5e, 0x6e, 0x75, 0x0a, 0xe9, 0x32, 0x3c, 0x39, 0xef, 0x2e, 0xf9, 0xf0,
+    0xee, 0xf7, 0xa6, 0x53, 0xe8, 0x11, 0x53, 0x67, 0xd9, 0x76, 0x95, 0x18, 0x83, 0x75, 0x38, 0xa8,
+    0xb8, 0x09, 0xef, 0x42, 0xb0, 0x4b, 0x9d, 0xce, 0xba, 0x2a, 0x88, 0xd5, 0xa2, 0x0e, 0x2a, 0x2b,
+    0x23, 0xb8, 0x28, 0x80, 0x57, 0xe9, 0xe7, 0x03, 0x4e, 0xe3, 0x4f, 0x1e, 0x8b, 0xc8, 0xf9, 0x0b,
+    0x3e, 0xdd, 0x5c, 0x0e, 0x73, 0xf9, 0x14, 0x0d, 0x18, 0x02, 0xac, 0x19, 0xcb, 0x0e, 0xc0, 0x60,
+    0xd2, 0xc0, 0x67, 0x0e, 0x11, 0x68, 0x86, 0xb4, 0xbf, 0xd0, 0xbf, 0xbd, 0x8e, 0xc2, 0xb5, 0x25,
+    0xab, 0xd0, 0x1e, 0x44, 0x46, 0x09, 0x3f, 0x9c, 0x20, 0x64, 0x58, 0x1b, 0x07, 0x36, 0xcf, 0x28,
+    0xc9, 0x3d, 0x5c, 0xd1, 0x1d, 0x1f, 0x80, 0xab, 0x7d, 0x64, 0xfa, 0xed, 0x8a, 0x99, 0xf5, 0xad,
+    0x80, 0x38, 0xc0, 0x86, 0xdf, 0x5a, 0x3c, 0x34, 0xf9, 0x90, 0x9f, 0x4b, 0x07, 0x40, 0xbb, 0xfb,
+    0xe4, 0x46, 0xcb, 0x84, 0xdf, 0x3b, 0x5b, 0xd0, 0xba, 0x2e, 0xc5, 0x90, 0x1a, 0x74, 0xf2, 0x0f,
+    0x22, 0x89, 0x31, 0x67, 0xbe, 0x22, 0x0a, 0x7a, 0x30, 0x4c, 0x1c, 0xff, 0x62, 0x2d, 0x8b, 0x60,
+    0x6b, 0xd3, 0x06, 0x8f, 0x7c, 0x01, 0xad, 0xc3, 0x9f, 0xd1, 0x27, 0xd9, 0x7a, 0xcd, 0x64, 0x74,
+    0xbf, 0xbd, 0x4f, 0x0d, 0xc8, 0x7e, 0x71, 0xa3, 0xec, 0xed, 0xf7, 0x29, 0x00, 0x41, 0xf0, 0xc5,
+    0x91, 0x54, 0x7a, 0x63, 0xba, 0x0b, 0x42, 0x67, 0xc1, 0xe6, 0xef, 0x7c, 0x67, 0x9d, 0x07, 0x7d,
+    0xac, 0x78, 0x2f, 0x23, 0x5d, 0x04, 0x60, 0xba, 0x8d, 0xa6, 0x68, 0x83, 0x70, 0x08, 0xeb, 0x70,
+    0x06, 0x86, 0x5a, 0x4c, 0x50, 0xa0, 0x91, 0x30, 0xe2, 0x7d, 0x26, 0xaa, 0xb9, 0x7c, 0x9c, 0x49,
+    0x97, 0x57, 0x60, 0x83, 0xcd, 0xcc, 0x1c, 0x52, 0x4a, 0xc4, 0xfc, 0x97, 0x43, 0xe7, 0x39, 0xc2,
+    0xdd, 0x6b, 0x8e, 0xb4, 0x1d, 0x3e, 0x6a, 0x80, 0x1f, 0xb4, 0xc6, 0x11, 0x3e, 0xe3, 0x2e, 0x0d,
+    0xa6, 0xdc, 0x2a, 0xa9, 0x4c, 0xdc, 0x4b, 0xad, 0x2f, 0x32, 0x89, 0x2f, 0x33, 0x50, 0x5c, 0xff,
+    0xef, 0x95, 0x97, 0xb2, 0xca, 0x37, 0x20, 0xc9, 0x83, 0xcc, 0x4c, 0xe2, 0x1d, 0xf1, 0xc9, 0x25,
+    0x0d, 0x3b, 0xb3, 0xa4, 0xca, 0xb2, 0x13, 0x1a, 0x83, 0x6b, 0xe7, 0xae, 0x46, 0xf8, 0x9c, 0xa2,
+    0x74, 0x9b, 0xe1, 0xa2, 0x3c, 0xab, 0xac, 0x7f, 0xae, 0x8c, 0x70, 0x65, 0x5d, 0x95, 0x5f, 0x9d,
+    0x2b, 0xfc, 0x62, 0x15, 0x6e, 0xcc, 0xe5, 0x1e, 0x3a, 0x54, 0xcb, 0xb5, 0x66, 0x73, 0x87, 0xcb,
+    0xf8, 0xba, 0x3b, 0x4b, 0x15, 0xe4, 0xf8, 0x03, 0x29, 0x44, 0xba, 0x06, 0x38, 0xdf, 0x2e, 0x0d,
+    0xda, 0x67, 0x13, 0x82, 0x42, 0x82, 0xfc, 0xaf, 0x46, 0x84, 0xc4, 0x9b, 0x20, 0x81, 0xfd, 0xc8,
+    0x2a, 0x70, 0x7a, 0x93, 0xbd, 0x62, 0xa1, 0xc6, 0x79, 0xc2, 0x21, 0x99, 0xe5, 0xc1, 0x25, 0x3d,
+    0x95, 0x42, 0xc7, 0x76, 0x14, 0x67, 0xbc, 0x81, 0x5e, 0x8f, 0x72, 0xc4, 0xfd, 0x87, 0x03, 0x41,
+    0xfd, 0x02, 0x8e, 0x0a, 0x55, 0x8d, 0xf0, 0xfd, 0x49, 0xa8, 0x84, 0x1a, 0xec, 0x82, 0x55, 0xc6,
+    0xde, 0x16, 0x08, 0x8a, 0x74, 0x01, 0x8a, 0x8c, 0xe2, 0xe5, 0x52, 0x1f, 0x26, 0x21, 0x17, 0x17,
+    0x9c, 0x9e, 0x71, 0x30, 0x0f, 0x78, 0x91, 0x64, 0x58, 0x1f, 0x2b, 0xf3, 0x3f, 0x9a, 0x61, 0x8d,
+    0x92, 0x45, 0xb5, 0xaf, 0xbf, 0xc2, 0xe0, 0xbe, 0x97, 0xa2, 0xfb, 0x86, 0xe2, 0xf2, 0x28, 0x28,
+    0x9d, 0x19, 0x15, 0x44, 0xc2, 0x86, 0xf2, 0xcc, 0xae, 0xff, 0xe5, 0x42, 0x63, 0xc4, 0x1a, 0xf8,
+    0x56, 0x69, 0x96, 0x43, 0xb2, 0x25, 0xb0, 0x57, 0x4a, 0x6a, 0xdd, 0xb6, 0x3c, 0xc6, 0xc9, 0xd6,
+    0x60, 0x7f, 0xc4, 0xdf, 0xe4, 0xaf, 0x97, 0x63, 0x08, 0x73, 0xb6, 0xbd, 0xe6, 0x3c, 0x2a, 0xc3,
+    0x9c, 0x0f, 0x2a, 0x82, 0xa1, 0x04, 0x0e, 0xd6, 0x9c, 0xc7, 0x59, 0x41, 0x4b, 0xfa, 0x67, 0x79,
+    0x1b, 0xa6, 0xb4, 0x77, 0xb2, 0xe9, 0xe9, 0x11, 0x4a, 0xc2, 0x3c, 0x5a, 0xa9, 0x21, 0xf2, 0x7d,
+    0x32, 0x70, 0x89, 0xe1, 0x83, 0x47, 0x7d, 0xfd, 0x0f, 0xe8, 0x9c, 0x61, 0x20, 0x4b, 0x42, 0x3e,
+    0x95, 0xdb, 0x80, 0x11, 0xc4, 0x3b, 0x4e, 0x51, 0x7d, 0xc8, 0x4d, 0x11, 0x69, 0x1c, 0xb8, 0x15,
+    0x53, 0x0b, 0x97, 0x2c, 0xb8, 0xd6, 0x84, 0x6d, 0x0e, 0xbd, 0xd0, 0x4d, 0xd7, 0x4b, 0xec, 0x66,
+    0x40, 0x48, 0x54, 0xb2, 0x1d, 0x95, 0xca, 0x50, 0x62, 0x40, 0xca, 0xf0, 0x63, 0xb2, 0x0b, 0x3c,
+    0x17, 0x8a, 0x87, 0x45, 0xd4, 0x2f, 0x9d, 0xbd, 0xd5, 0xab, 0x8a, 0xf3, 0xc7, 0x11, 0x35, 0x0f,
+    0x70, 0xbb, 0xc3, 0x30, 0x67, 0x47, 0x2e, 0x24, 0x22, 0x00, 0x71, 0xd0, 0x5a, 0xea, 0x31, 0xdb,
+    0x66, 0x5c, 0xb4, 0xc3, 0xba, 0x0f, 0x26, 0xea, 0xe3, 0x76, 0x34, 0xf9, 0xb1, 0x9e, 0x91, 0x85,
+    0x46, 0x87, 0xe9, 0x26, 0x2e, 0xe8, 0x6f, 0x4a, 0x06, 0x62, 0x71, 0x6f, 0x4d, 0x4c, 0xe0, 0x7f,
+    0xd5, 0x7c, 0x0b, 0xd7, 0x93, 0x13, 0xeb, 0x8e, 0xeb, 0x1a, 0x8d, 0xb8, 0x9a, 0x54, 0x5b, 0x29,
+    0xf8, 0x62, 0xab, 0xdf, 0xf0, 0x51, 0x75, 0xdf, 0x46, 0x01, 0xc0, 0xce, 0x84, 0xf4, 0xe3, 0x3e,
+    0x85, 0xaf, 0xea, 0x12, 0xc9, 0x5d, 0xe5, 0x43, 0xee, 0xce, 0x73, 0x68, 0xd4, 0x45, 0xf0, 0xf4,
+    0x81, 0x65, 0xc4, 0x42, 0x0e, 0x76, 0x0f, 0x8c, 0xd1, 0xe8, 0x4e, 0x25, 0x71, 0x0c, 0x77, 0x17,
+    0xe6, 0x57, 0xbc, 0x13, 0x8f, 0x43, 0x2c, 0x3b, 0xa8, 0x56, 0xc9, 0xa2, 0x43, 0xf6, 0x38, 0x8b,
+    0xd0, 0xca, 0x6b, 0xf3, 0x24, 0xce, 0x41, 0xfe, 0x16, 0x1f, 0x05, 0xa9, 0x3e, 0x0b, 0xff, 0xc2,
+    0xcd, 0x35, 0x3f, 0xa4, 0xed, 0xd4, 0xf1, 0x00, 0xfa, 0xb0, 0x4e, 0x60, 0x54, 0x68, 0x7e, 0xa1,
+    0x62, 0x38, 0x59, 0xb5, 0x23, 0x8f, 0x16, 0xb0, 0x45, 0x6f, 0x03, 0xb0, 0x6d, 0x91, 0x1b, 0x12,
+    0x0b, 0x84, 0x99, 0xfb, 0x65, 0xdc, 0x57, 0xca, 0x7e, 0x25, 0x3f, 0x41, 0xee, 0x91, 0x47, 0xf9,
+    0x6c, 0x35, 0xd3, 0x18, 0xdc, 0xc5, 0x0c, 0xc7, 0x66, 0xb6, 0xd6, 0xe2, 0xa4, 0x8e, 0x08, 0x49,
+    0x05, 0x1b, 0xcc, 0x5b, 0x2b, 0xdf, 0x65, 0xf0, 0x60, 0x21, 0x8a, 0x0f, 0xd8, 0xaa, 0x36, 0x34,
+    0x31, 0x5f, 0xfd, 0x1f, 0x49, 0x47, 0x3d, 0x06, 0xe6, 0x39, 0x1a, 0xa3, 0x58, 0x27, 0x9a, 0x55,
+    0x99, 0x35, 0xb5, 0x8a, 0xed, 0x7c, 0x03, 0x03, 0x73, 0x49, 0xaf, 0x45, 0x2f, 0xcf, 0x23, 0x0d,
+    0xc2, 0xc3, 0x85, 0xc4, 0x8c, 0xf6, 0x67, 0xd6, 0x68, 0x11, 0x41, 0xdb, 0xe5, 0x84, 0xa7, 0x09,
+    0x27, 0x45, 0x69, 0x9c, 0xfa, 0x49, 0x9d, 0xe7, 0x55, 0x38, 0xdd, 0x0f, 0x74, 0x2e, 0x97, 0xb9,
+    0xec, 0x0e, 0x1f, 0xe7, 0xf6, 0xde, 0x18, 0x72, 0x16, 0x70, 0x78, 0x65, 0x23, 0x9c, 0xd7, 0xf9,
+    0xb1, 0x75, 0xfd, 0xee, 0xcf, 0x5a, 0x49, 0xd9, 0x7e, 0x3e, 0x9f, 0xa8, 0x20, 0x9a, 0x3b, 0xba,
+    0x26, 0xe5, 0x9f, 0xd8, 0x60, 0x54, 0x02, 0xab, 0x6b, 0x21, 0xcc, 0x5a, 0xa6, 0x8b, 0x2a, 0xd6,
+    0x9b, 0xe8, 0x04, 0x19, 0xc5, 0x3e, 0x81, 0x54, 0x2c, 0x12, 0xa3, 0x45, 0x8b, 0x6f, 0x90, 0xc6,
+    0x0c, 0x2e, 0x25, 0xd0, 0xec, 0x44, 0xb8, 0x7e, 0xcf, 0x7e, 0x10, 0xda, 0x4b, 0xbb, 0xe6, 0xa1,
+    0x15, 0xdb, 0x4e, 0xe5, 0x2a, 0xbb, 0xc8, 0x1e, 0x54, 0x20, 0x4c, 0x5b, 0xf2, 0xff, 0x9c, 0x52,
+    0xdb, 0x92, 0xc9, 0x08, 0xcd, 0x41, 0x55, 0x1c, 0x4b, 0xa8, 0x82, 0xc8, 0x64, 0xf9, 0xd4, 0x12,
+    0x89, 0x63, 0xb3, 0xcf, 0x41, 0x50, 0x40, 0x23, 0x73, 0x53, 0x48, 0xfc, 0x23, 0x24, 0x95, 0xd2,
+    0x4e, 0xa2, 0x3c, 0x53, 0x9b, 0x63, 0x5f, 0x53, 0x6e, 0xd2, 0x97, 0x3e, 0xf9, 0x85, 0x4d, 0x19,
+    0xb7, 0x3c, 0xf8, 0x3c, 0x0e, 0x5b, 0xc3, 0x5c, 0xaf, 0xdd, 0xfd, 0xca, 0x42, 0x59, 0x0a, 0x8d,
+    0x32, 0x68, 0xb1, 0x1e, 0x12, 0xd7, 0x4f, 0xd1, 0x06, 0x5e, 0x90, 0x1f, 0x86, 0x78, 0x9f, 0xa6,
+    0xfb, 0x73, 0xc1, 0xd4, 0x57, 0x55, 0x20, 0x65, 0x6e, 0xb7, 0xbb, 0xf5, 0xca, 0x80, 0xb2, 0x7f,
+    0xed, 0x31, 0xdd, 0x52, 0x6e, 0x61, 0xec, 0x0e, 0x40, 0x81, 0x03, 0x58, 0xb7, 0x0b, 0x29, 0x5a,
+    0xed, 0x8d, 0x08, 0xa0, 0x3b, 0xcc, 0xdd, 0x84, 0xff, 0x5c, 0x34, 0x09, 0xa1, 0xf2, 0x3a, 0x92,
+    0x78, 0x60, 0x0f, 0xee, 0x17, 0x8a, 0x97, 0xac, 0xf5, 0xc0, 0xec, 0xb8, 0x1f, 0xf6, 0x8d, 0x27,
+    0xab, 0x3f, 0xf0, 0x67, 0x6d, 0xff, 0x99, 0xab, 0x77, 0x31, 0x34, 0x57, 0xe4, 0x47, 0x92, 0xde,
+    0x59, 0x7e, 0x7d, 0x6d, 0xf6, 0x2a, 0xdd, 0xff, 0xf4, 0xa3, 0x41, 0xea, 0xbf, 0x99, 0x26, 0xeb,
+    0xbf, 0xb5, 0x5b, 0x5a, 0x7b, 0x31, 0x18, 0xaa, 0x37, 0x30, 0xd4, 0x83, 0x34, 0xeb, 0xec, 0x98,
+    0xdc, 0xf8, 0xc2, 0x43, 0xc2, 0x83, 0xd1, 0xa7, 0x26, 0x40, 0x7f, 0xc7, 0x0f, 0xfc, 0x0e, 0xb2,
+    0x68, 0xfe, 0x44, 0x09, 0x16, 0x7d, 0xa6, 0x9d, 0xf4, 0x98, 0xea, 0x17, 0xc9, 0xe3, 0x5c, 0xde,
+    0xaa, 0xad, 0x00, 0x33, 0xcb, 0x18, 0xed, 0x62, 0xcc, 0x1d, 0xc5, 0xcb, 0xcd, 0x13, 0xd0, 0x76,
+    0xaa, 0xd2, 0x01, 0x10, 0xb6, 0x36, 0x03, 0x2c, 0xcb, 0x57, 0x51, 0x34, 0xfe, 0xda, 0x04, 0x40,
+    0x1f, 0x09, 0xb0, 0x5f, 0x68, 0x6a, 0xa3, 0xbf, 0xad, 0x99, 0x49, 0xcb, 0x77, 0x5e, 0xd4, 0xc2,
+    0x3e, 0x60, 0x65, 0x04, 0x4c, 0xad, 0x62, 0x0c, 0x50, 0xd3, 0xd5, 0xd6, 0x22, 0x9a, 0x56, 0x9e,
+    0xa1, 0x00, 0x43, 0x91, 0xc9, 0xc6, 0x28, 0x82, 0xc3, 0x3e, 0x44, 0x80, 0x77, 0x26, 0x6f, 0xa9,
+    0xd4, 0x61, 0xd7, 0x10, 0x40, 0xab, 0xf7, 0x78, 0xa0, 0x34, 0x5d, 0x22, 0xc9, 0x74, 0x5b, 0x4b,
+    0x26, 0x6e, 0x9f, 0xae, 0x69, 0x30, 0xc9, 0x22, 0x17, 0xbd, 0x41, 0xd9, 0x66, 0xd1, 0xd3, 0x91,
+    0xa0, 0x00, 0x9d, 0x33, 0x6a, 0xb8, 0x86, 0x4a, 0xd4, 0xec, 0x5c, 0x72, 0x7f, 0x05, 0xe4, 0x4d,
+    0x03, 0x9e, 0x7e, 0x4f, 0xa2, 0x9e, 0x5c, 0x6e, 0x46, 0x34, 0x0c, 0xc3, 0x7b, 0xd3, 0x80, 0xb5,
+    0x64, 0x33, 0x3c, 0xd4, 0x89, 0x1a, 0x00, 0xc5, 0x99, 0x0b, 0x37, 0x82, 0xc8, 0x6a, 0xc7, 0x45,
+    0xb6, 0x29, 0x2f, 0x66, 0x23, 0xdb, 0xd2, 0xfb, 0x73, 0x0b, 0xb7, 0xaa, 0x51, 0x85, 0x64, 0x12,
+    0x5c, 0x56, 0xee, 0x12, 0x6e, 0x83, 0xf3, 0x53, 0x8c, 0x98, 0xc4, 0x53, 0xb8, 0xa8, 0x7c, 0x79,
+    0xcf, 0x42, 0x85, 0xf8, 0x37, 0x7e, 0xa8, 0x46, 0x98, 0x06, 0xc3, 0xf9, 0x9f, 0xde, 0x2d, 0x42,
+    0x25, 0x51, 0x07, 0x24, 0xd9, 0xfd, 0xbc, 0x6d, 0x9d, 0x41, 0xb0, 0x1f, 0xf7, 0x08, 0x04, 0xe5,
+    0xb8, 0xe6, 0x28, 0x6d, 0x5a, 0x01, 0x35, 0xe2, 0x56, 0xd8, 0x72, 0x5e, 0xb3, 0x2b, 0x95, 0xc1,
+    0x58, 0x2f, 0x0f, 0x29, 0x6f, 0xe3, 0x29, 0x64, 0x53, 0xe4, 0x40, 0x7d, 0x72, 0xd4, 0x74, 0xd4,
+    0x59, 0xbf, 0xa4, 0xd3, 0xa2, 0x85, 0x2e, 0x12, 0x89, 0xf8, 0xa7, 0xd1, 0xa0, 0x4e, 0xf4, 0x2c,
+    0x14, 0x70, 0x4b, 0x57, 0x7b, 0xe4, 0x44, 0x84, 0x54, 0xdf, 0xb0, 0x8e, 0xc6, 0x45, 0xce, 0xf6,
+    0x75, 0xf8, 0x1b, 0x1d, 0x35, 0x47, 0x49, 0x76, 0x15, 0x50, 0x81, 0x21, 0xfe, 0xa6, 0x90, 0x85,
+    0x01, 0x7f, 0x43, 0x87, 0x4e, 0xce, 0x7c, 0x54, 0xb6, 0xe5, 0xbf, 0xc6, 0x30, 0x7a, 0x52, 0x31,
+    0xca, 0xfe, 0x2f, 0x51, 0x91, 0xae, 0x5c, 0x13, 0x68, 0x76, 0x42, 0x75, 0x42, 0xf7, 0x34, 0x39,
+    0xcf, 0x2a, 0xa6, 0x9f, 0x52, 0x1c, 0x69, 0xca, 0xb2, 0xec, 0x89, 0xbc, 0xd6, 0xa2, 0x90, 0xc5,
+    0x94, 0xde, 0x1d, 0x95, 0x13, 0x96, 0xd3, 0x6f, 0x91, 0x62, 0xda, 0xab, 0xd3, 0xc7, 0x89, 0xff,
+    0xaf, 0x85, 0x27, 0xc3, 0x9d, 0xd6, 0x66, 0x97, 0xa1, 0x59, 0x2a, 0xb8, 0xaf, 0x20, 0xdd, 0x9b,
+    0x0d, 0xa3, 0x72, 0x95, 0x61, 0x5b, 0xc0, 0xec, 0x7f, 0xec, 0x67, 0x82, 0x58, 0x78, 0xab, 0xbe,
+    0xf5, 0x53, 0xc0, 0x4c, 0x38, 0x4b, 0xa6, 0x75, 0x08, 0xe4, 0x44, 0xd6, 0x0a, 0x95, 0x96, 0x9a,
+    0x13, 0xb9, 0x52, 0xb6, 0xa7, 0xe8, 0x90, 0xf9, 0xbe, 0xcb, 0x83, 0xa0, 0x9f, 0x09, 0x7c, 0x40,
+    0xcc, 0x88, 0x13, 0xad, 0xcc, 0x05, 0x52, 0x64, 0x02, 0x4b, 0x32, 0x73, 0x86, 0xe3, 0xff, 0x6d,
+    0x94, 0x2c, 0x2f, 0xc4, 0x33, 0x25, 0xda, 0x26, 0x11, 0x0a, 0xf2, 0x7c, 0xb8, 0xe7, 0x01, 0xbb,
+    0x94, 0x39, 0x20, 0xda, 0x93, 0x45, 0xd0, 0xf2, 0xc8, 0x04, 0x64, 0x15, 0x2a, 0x32, 0xea, 0x04,
+    0x22, 0xf6, 0x64, 0x7e, 0xe4, 0x8a, 0x0e, 0x75, 0x22, 0xbf, 0xd3, 0x24, 0x71, 0xf6, 0xe6, 0xf4,
+    0x55, 0x07, 0x84, 0x28, 0xea, 0xb0, 0xf9, 0xef, 0xf4, 0x3a, 0xfa, 0x68, 0xd9, 0x2b, 0xce, 0x3a,
+    0xfd, 0x5d, 0xd0, 0xec, 0x87, 0xff, 0xa6, 0x3d, 0x7d, 0x07, 0x92, 0x13, 0xf8, 0xad, 0xe4, 0x53,
+    0x84, 0x54, 0xa9, 0x36, 0x3f, 0xe9, 0x08, 0x2d, 0xc4, 0xee, 0x98, 0xfb, 0xc2, 0xca, 0xea, 0x6f,
+    0x68, 0x67, 0x75, 0xef, 0x35, 0xf4, 0x50, 0xe8, 0xe0, 0xb3, 0x35, 0xf9, 0x3b, 0xdb, 0xdf, 0xa0,
+    0xee, 0x2b, 0x24, 0x81, 0xad, 0x33, 0xc3, 0xe1, 0xd5, 0x14, 0xee, 0x02, 0x9b, 0xa6, 0xba, 0x9e,
+    0x5e, 0x8b, 0xdb, 0x74, 0x6b, 0xd3, 0xbc, 0x37, 0x6e, 0x20, 0x1b, 0xdd, 0x6d, 0x1b, 0x3a, 0x9b,
+    0x6c, 0xb5, 0x3d, 0xa2, 0x32, 0x62, 0x08, 0x7a, 0x7e, 0xd6, 0x4f, 0x6b, 0xde, 0x2b, 0x73, 0xc2,
+    0xdc, 0x3c, 0x53, 0x8a, 0x94, 0xc3, 0xb9, 0xa3, 0xa1, 0x63, 0xac, 0x85, 0xa1, 0x81, 0xc7, 0xe8,
+    0x86, 0x6f, 0xc1, 0x90, 0x52, 0x47, 0x40, 0x76, 0xa8, 0xa6, 0xa6, 0xaf, 0x50, 0xfe, 0xd2, 0x06,
+    0x55, 0x54, 0x61, 0x9f, 0x2f, 0xc0, 0xa3, 0x86, 0x53, 0xd9, 0x41, 0xd9, 0xfb, 0x4d, 0xf3, 0xb7,
+    0x47, 0x68, 0xbf, 0xe3, 0xd5, 0xa4, 0xbe, 0x24, 0x5a, 0xd7, 0xa8, 0xa0, 0x02, 0xa6, 0x2e, 0xdb,
+    0x0d, 0x51, 0x4e, 0x08, 0xaa, 0x3a, 0xf1, 0x62, 0x6e, 0x96, 0x3e, 0x02, 0x17, 0xc1, 0xf2, 0x65,
+    0x7c, 0x49, 0x08, 0xa4, 0x9d, 0xb4, 0x41, 0x4c, 0x3e, 0x07, 0xc0, 0x86, 0x30, 0x9a, 0x2e, 0x46,
+    0x76, 0xba, 0x79, 0xd3, 0x10, 0xf2, 0x83, 0xa3, 0x8c, 0x1f, 0x14, 0x53, 0x4f, 0xf3, 0x9e, 0x6c,
+    0x74, 0x4e, 0xa7, 0x2d, 0xde, 0xc4, 0x3b, 0x01, 0x07, 0xa8, 0x60, 0x4b, 0x5f, 0x34, 0xbc, 0x9a,
+    0x31, 0xf2, 0x00, 0xbe, 0xfd, 0x27, 0xa9, 0xbe, 0xe1, 0x09, 0x1e, 0x2d, 0xeb, 0x2f, 0x8f, 0x08,
+    0x9a, 0xa1, 0xbc, 0x76, 0xf4, 0xb0, 0x9f, 0xd2, 0x18, 0x86, 0x06, 0x29, 0x59, 0xdb, 0x55, 0x4d,
+    0x28, 0xf3, 0x28, 0xcc, 0x5e, 0x1d, 0x2c, 0xe2, 0x2f, 0x05, 0x5b, 0x1d, 0x10, 0x5a, 0x52, 0x33,
+    0x6d, 0x0e, 0x7b, 0x8e, 0x6e, 0x26, 0xc3, 0xd8, 0x72, 0xd9, 0xca, 0x71, 0x3f, 0x51, 0x19, 0x6e,
+    0x2e, 0x0c, 0xd1, 0xd1, 0xc3, 0x3d, 0xd9, 0x33, 0x11, 0x55, 0x79, 0xc4, 0x84, 0x09, 0x7d, 0xd4,
+    0x35, 0x16, 0x45, 0xaa, 0x1f, 0xe0, 0x04, 0xa9, 0xc8, 0xb4, 0x2a, 0x2a, 0x3f, 0xe4, 0xa4, 0x14,
+    0x0c, 0x0a, 0xf8, 0x68, 0x6e, 0xaa, 0x68, 0xf5, 0xdb, 0xcb, 0x5a, 0x14, 0xa3, 0x5c, 0xb9, 0x12,
+    0x13, 0xc3, 0x05, 0x31, 0xf6, 0x11, 0x3a, 0xdc, 0x5b, 0x5b, 0x48, 0x48, 0x3f, 0x92, 0x39, 0xa8,
+    0xf8, 0x68, 0x6d, 0x87, 0x5b, 0xb0, 0x6d, 0x19, 0x02, 0x37, 0xfa, 0x3d, 0x92, 0x92, 0x8c, 0x94,
+    0x55, 0xd2, 0x1b, 0x15, 0xc0, 0xdd, 0x80, 0x1e, 0x07, 0x14, 0x1e, 0x30, 0x0b, 0x6e, 0x11, 0x6d,
+    0x39, 0xa4, 0xad, 0x00, 0xb6, 0x98, 0xf3, 0x90, 0x92, 0xe3, 0x52, 0x0f, 0x81, 0x3a, 0x68, 0xb5,
+    0x62, 0x63, 0xcc, 0x70, 0x12, 0x17, 0x5c, 0x1d, 0x1c, 0xe7, 0x08, 0xd8, 0x96, 0x97, 0x73, 0xac,
+    0x35, 0xa1, 0x75, 0xf1, 0x67, 0x9f, 0x03, 0xb2, 0x48, 0xa3, 0x8e, 0xd8, 0x51, 0x9d, 0x1b, 0x48,
+    0xd8, 0xea, 0x55, 0xca, 0x82, 0xde, 0xc8, 0x6b, 0x0c, 0x32, 0x23, 0x5c, 0x68, 0x97, 0x48, 0xe2,
+    0x9a, 0xdf, 0x0f, 0xfe, 0x8e, 0x04, 0xdc, 0x82, 0x3a, 0x67, 0xa1, 0xbd, 0x22, 0x0b, 0x98, 0x49,
+    0x3e, 0x91, 0xbd, 0x61, 0x46, 0x61, 0xb3, 0x67, 0x3f, 0x14, 0x6c, 0x68, 0xb3, 0x06, 0x2c, 0x78,
+    0xe9, 0xc8, 0x39, 0x70, 0x13, 0x19, 0xf1, 0x79, 0xf3, 0x6d, 0x68, 0x26, 0x10, 0xef, 0x7f, 0xd9,
+    0x6d, 0xf5, 0x32, 0xb4, 0x8d, 0xfd, 0x93, 0xae, 0x2d, 0xf0, 0x1b, 0xb8, 0xe6, 0x73, 0x60, 0xe1,
+    0xc3, 0xb0, 0x8c, 0xaa, 0x86, 0x74, 0xaf, 0x3d, 0x01, 0xcc, 0x04, 0x4a, 0x8d, 0xa8, 0x21, 0xff,
+    0x2e, 0x3b, 0x8e, 0x59, 0x5e, 0x7a, 0xc4, 0xc1, 0x63, 0x30, 0xe0, 0x0d, 0xd0, 0xeb, 0xac, 0x86,
+    0x7f, 0xee, 0xb4, 0x0c, 0x80, 0x80, 0xe2, 0xa0, 0x2a, 0x42, 0x51, 0x7f, 0xde, 0x15, 0xfa, 0xe3,
+    0x6c, 0x6f, 0xee, 0x9e, 0x3a, 0xfd, 0xd4, 0xff, 0x3d, 0xd9, 0xb8, 0xe0, 0xc8, 0x92, 0x7b, 0x0c,
+    0xcf, 0x47, 0xfd, 0x2c, 0xd4, 0xa4, 0x21, 0xd2, 0x39, 0x14, 0x3e, 0x0f, 0x0b, 0x00, 0x58, 0x15,
+    0x91, 0x75, 0x23, 0x07, 0x20, 0xd8, 0xe9, 0x54, 0xe4, 0x5b, 0x91, 0xc8, 0x3f, 0xf4, 0x82, 0x69,
+    0x29, 0x1e, 0x67, 0x8f, 0xe7, 0x05, 0xfa, 0x95, 0xe6, 0xf4, 0x9d, 0x5e, 0xed, 0x7b, 0x32, 0xf6,
+    0x0a, 0x66, 0xbd, 0x2a, 0x59, 0x61, 0xaf, 0xe8, 0x62, 0xe4, 0x0a, 0xcf, 0xaf, 0x45, 0xc5, 0x6e,
+    0xd3, 0x92, 0x50, 0xb4, 0x9c, 0xc1, 0x52, 0xc3, 0x3e, 0x90, 0xba, 0xd4, 0xbb, 0x9d, 0x3c, 0xad,
+    0xdd, 0x0b, 0xc9, 0xcb, 0x0d, 0x39, 0xca, 0xbe, 0xa9, 0x62, 0x76, 0x91, 0x05, 0x5c, 0x1f, 0x22,
+    0x6a, 0x90, 0x59, 0x22, 0xef, 0x23, 0xee, 0x14, 0x50, 0x29, 0x25, 0x8a, 0x2c, 0x18, 0xd5, 0x4b,
+    0x77, 0xb9, 0x1e, 0xfe, 0x9e, 0x56, 0x0b, 0x38, 0xd3, 0x54, 0x48, 0x12, 0xb8, 0xa4, 0x09, 0x09,
+    0xdb, 0xa0, 0xd1, 0x8c, 0xe6, 0x62, 0xdc, 0xf3, 0xdb, 0x27, 0xe9, 0xfb, 0x38, 0xb0, 0x56, 0x97,
+    0x6e, 0xfa, 0xd1, 0x22, 0x11, 0x0b, 0x45, 0xab, 0x87, 0xc0, 0xce, 0x91, 0x30, 0x58, 0x1c, 0xc2,
+    0x02, 0x6f, 0xb8, 0x71, 0x3d, 0x08, 0xba, 0x96, 0x05, 0xa9, 0x7c, 0x2a, 0x69, 0x56, 0xd9, 0x06,
+    0xaf, 0x1b, 0x41, 0xd5, 0xbf, 0x77, 0x58, 0x18, 0x15, 0xea, 0x61, 0x87, 0x36, 0x92, 0xd3, 0x2d,
+    0x2f, 0x35, 0xa5, 0xfc, 0x1d, 0xb5, 0x51, 0x65, 0x9d, 0xfd, 0x24, 0x1c, 0xdb, 0x75, 0xd8, 0x16,
+    0x76, 0x58, 0xd8, 0x66, 0x35, 0x19, 0xc0, 0x5d, 0x34, 0x46, 0x28, 0x0c, 0x05, 0x9b, 0x9f, 0xe8,
+    0x98, 0xec, 0xba, 0xdb, 0xf7, 0x63, 0xe3, 0x46, 0xb4, 0x69, 0xa2, 0x2a, 0xd9, 0x5f, 0xcb, 0xd6,
+    0x7c, 0xd5, 0x0f, 0xae, 0xca, 0xcc, 0xcb, 0x34, 0x6a, 0xa5, 0xeb, 0xeb, 0x05, 0xd7, 0x9a, 0x91,
+    0xf7, 0x96, 0xa2, 0xe1, 0xb9, 0x35, 0x39, 0xfa, 0x7a, 0x45, 0xae, 0xa2, 0x43, 0x5f, 0xc3, 0x01,
+    0xff, 0xeb, 0x0c, 0xa2, 0x12, 0x20, 0xb0, 0xfc, 0xb6, 0x2c, 0x0c, 0xb4, 0x8f, 0x1d, 0x11, 0x30,
+    0x34, 0xa4, 0x71, 0xa8, 0xba, 0xd1, 0x31, 0xe3, 0x70, 0x11, 0x76, 0x95, 0x7f, 0x82, 0x8a, 0xec,
+    0x5a, 0x0e, 0xb0, 0xfc, 0xd9, 0x60, 0x28, 0xea, 0x3f, 0x39, 0xd4, 0x64, 0x56, 0x0a, 0x89, 0x1f,
+    0xc0, 0x30, 0x3c, 0x2f, 0x0f, 0xfb, 0x17, 0xc3, 0x84, 0xe6, 0x40, 0xc5, 0xac, 0x02, 0x86, 0xad,
+    0x10, 0xed, 0x83, 0xf8, 0xec, 0x31, 0x0a, 0x88, 0x6e, 0x50, 0x1d, 0x8a, 0x88, 0xf1, 0x2b, 0x2a,
+    0xaa, 0x3e, 0x12, 0x06, 0x3a, 0x98, 0x01, 0xca, 0x75, 0x27, 0x87, 0x56, 0xf3, 0x16, 0xa1, 0xcb,
+    0xd2, 0x1b, 0x1e, 0x41, 0x99, 0x4f, 0x7e, 0xd5, 0xdc, 0xe2, 0x43, 0x88, 0xf2, 0x10, 0xa2, 0x88,
+    0x7d, 0x10, 0x3a, 0xbb, 0x1a, 0x13, 0x45, 0x6b, 0xd8, 0x66, 0x8b, 0xd9, 0x24, 0xa3, 0xb3, 0x0a,
+    0xbb, 0x67, 0x8b, 0x98, 0x16, 0xb8, 0x2a, 0xf7, 0xe9, 0x56, 0xb6, 0x4f, 0x55, 0x56, 0xb7, 0x2c,
+    0x38, 0xb3, 0xcb, 0x1c, 0xe5, 0xa8, 0x3e, 0x59, 0x52, 0xb1, 0x8f, 0x42, 0xe3, 0x4e, 0x56, 0x1a,
+    0x90, 0xda, 0xa9, 0x07, 0x2a, 0x90, 0x8c, 0xea, 0x03, 0x4b, 0xbc, 0x87, 0x08, 0xc5, 0x7d, 0x7c,
+    0xab, 0x14, 0xfb, 0x53, 0xea, 0x24, 0xdf, 0xa1, 0x6f, 0x89, 0x5c, 0x3b, 0x34, 0xb4, 0x68, 0x75,
+    0xf8, 0x74, 0x4b, 0x15, 0x9d, 0x77, 0xac, 0x59, 0x58, 0x8c, 0xb8, 0x5d, 0x55, 0xa0, 0x71, 0x14,
+    0xec, 0x00, 0x2d, 0x14, 0xe5, 0xa9, 0xe7, 0x76, 0x9d, 0xd7, 0x5f, 0xbd, 0x52, 0xb3, 0x6d, 0xed,
+    0x9d, 0x63, 0x91, 0x11, 0x1e, 0xe2, 0x4c, 0x8a, 0xea, 0x92, 0xb1, 0xd6, 0x93, 0x4e, 0xfe, 0x84,
+    0x0c, 0xf6, 0x52, 0x58, 0x65, 0x2e, 0xe4, 0x71, 0xad, 0x64, 0xa6, 0x48, 0xc2, 0x86, 0x77, 0x5c,
+    0xb5, 0xd1, 0xbc, 0xce, 0xa2, 0x7d, 0xcd, 0x5c, 0x01, 0xc3, 0x1e, 0xae, 0x56, 0xcc, 0xc1, 0x8b,
+    0x19, 0x7a, 0xa4, 0x09, 0x81, 0x8c, 0x49, 0xb9, 0x61, 0xc8, 0x92, 0x00, 0x94, 0x52, 0x8f, 0x49,
+    0xb8, 0xf6, 0x2d, 0x12, 0xc1, 0xca, 0x37, 0xfe, 0xd2, 0xb6, 0x9b, 0x47, 0xd9, 0x82, 0x9d, 0x18,
+    0x2c, 0x1a, 0xb6, 0xbf, 0xa0, 0x1a, 0xe4, 0x20, 0x92, 0x18, 0x73, 0xda, 0x71, 0xf1, 0x3e, 0x3f,
+    0x07, 0x40, 0x48, 0xe7, 0x9c, 0x0f, 0xb0, 0xe3, 0x64, 0x75, 0x2d, 0x7c, 0xa5, 0x9d, 0x98, 0xa8,
+    0xdd, 0xe8, 0x6d, 0xf0, 0xbc, 0x38, 0x8b, 0x6c, 0xfa, 0x85, 0x5a, 0xc3, 0x19, 0xf8, 0xf8, 0x01,
+    0x67, 0x7b, 0xf8, 0x9c, 0x06, 0x03, 0x62, 0xec, 0x61, 0x58, 0x2d, 0x12, 0x1f, 0x4d, 0xad, 0xfe,
+    0x9c, 0x8d, 0xa6, 0x3f, 0x89, 0xa9, 0x94, 0x93, 0xf3, 0x80, 0xa6, 0x11, 0x71, 0x88, 0x35, 0xb6,
+    0xd3, 0xb0, 0x76, 0x63, 0xa3, 0x5a, 0x46, 0x7a, 0x4b, 0x8c, 0x9c, 0x20, 0x32, 0x75, 0x7d, 0xfc,
+    0xd1, 0x69, 0xb0, 0xe1, 0xb5, 0x90, 0x96, 0x28, 0x6a, 0x42, 0x6e, 0x6d, 0x1e, 0x35, 0xf4, 0x37,
+    0x1d, 0xdd, 0x41, 0x5e, 0x1d, 0x7d, 0x1c, 0xf1, 0x01, 0xe5, 0xd9, 0xea, 0xa1, 0xbe, 0x9d, 0x07,
+    0x27, 0xc7, 0x64, 0xb0, 0x61, 0xf6, 0x7a, 0x30, 0x4a, 0x12, 0x79, 0xaa, 0xc6, 0xa8, 0xdc, 0x66,
+    0x13, 0xd6, 0x19, 0x5e, 0x52, 0xe4, 0x7d, 0xd4, 0x7a, 0xe6, 0x4a, 0x56, 0x53, 0x7c, 0x8e, 0x15,
+    0x51, 0xc9, 0x2c, 0x9d, 0xd7, 0xd1, 0x3a, 0x39, 0xb4, 0xa3, 0x58, 0xa4, 0x30, 0xf5, 0x7c, 0x91,
+    0xf1, 0x4c, 0x80, 0x69, 0x71, 0x7e, 0xdd, 0x89, 0xed, 0x3a, 0x39, 0xa0, 0xda, 0x23, 0xe4, 0x65,
+    0x00, 0x16, 0xec, 0x25, 0xe0, 0x05, 0x63, 0x6e, 0xbd, 0xe0, 0x4e, 0x9a, 0x23, 0x38, 0x18, 0x7b,
+    0xf0, 0x7f, 0x7f, 0x20, 0x42, 0x32, 0xaa, 0xfb, 0x81, 0xc9, 0xd4, 0x78, 0x09, 0x38, 0xb0, 0xd9,
+    0x69, 0xbf, 0x87, 0x20, 0x05, 0x4a, 0xbc, 0xb8, 0xd4, 0x45, 0xd4, 0x4e, 0xc8, 0xc2, 0x24, 0x3c,
+    0x4c, 0x51, 0xcc, 0xe7, 0xa6, 0x68, 0xb7, 0xc9, 0xad, 0xeb, 0x4b, 0xb3, 0x06, 0xc4, 0xcd, 0x94,
+    0xcb, 0x3f, 0xc1, 0x87, 0xca, 0xf1, 0x36, 0xa1, 0xab, 0x64, 0x64, 0x42, 0x8a, 0x88, 0x31, 0x85,
+    0xf0, 0x5e, 0x60, 0x5e, 0x4c, 0xcd, 0xfe, 0x04, 0xc9, 0x26, 0x2f, 0x60, 0xe9, 0xfb, 0x0e, 0xef,
+    0xf7, 0x58, 0x81, 0xf6, 0x87, 0xe2, 0x97, 0x4e, 0x7b, 0x97, 0x8d, 0x61, 0xaa, 0x06, 0xb0, 0x4f,
+    0xf6, 0x8a, 0xff, 0x6b, 0x59, 0x1e, 0xea, 0xa1, 0xf2, 0xa8, 0xbc, 0x49, 0x96, 0x96, 0x81, 0xd6,
+    0xe9, 0xd1, 0x0d, 0x25, 0x93, 0x3c, 0x50, 0x7a, 0x9d, 0x93, 0xa5, 0x35, 0xc3, 0xa5, 0xe3, 0x06,
+    0x70, 0x5b, 0x60, 0x74, 0x8c, 0x07, 0xe0, 0x7f, 0x10, 0x93, 0x35, 0x72, 0x55, 0xcc, 0x18, 0x84,
+    0x63, 0xe4, 0xfb, 0x4c, 0x34, 0xcf, 0x28, 0x5e, 0xf6, 0x2e, 0x96, 0x97, 0x53, 0x22, 0x6e, 0x95,
+    0x25, 0x08, 0x28, 0xc2, 0x1b, 0x8d, 0xc0, 0x15, 0xcb, 0x11, 0xec, 0xd0, 0x26, 0xe5, 0xe3, 0x23,
+    0x75, 0xe0, 0x31, 0x28, 0xdf, 0x4f, 0xf3, 0x27, 0x89, 0x00, 0x3e, 0x16, 0xf8, 0x85, 0x13, 0xa3,
+    0xc6, 0x8a, 0xbe, 0xe1, 0x11, 0xbf, 0xac, 0xb4, 0x70, 0xca, 0xaa, 0xa3, 0x14, 0x30, 0xe0, 0xbb,
+    0x5b, 0xac, 0x07, 0xcd, 0xe0, 0x29, 0xa2, 0x1f, 0xa6, 0x7e, 0x98, 0x42, 0xd4, 0xa6, 0xd7, 0x96,
+    0x4b, 0x6d, 0x90, 0x37, 0xf6, 0xc0, 0x00, 0xb2, 0xc4, 0x80, 0xdc, 0x68, 0x66, 0x7c, 0x64, 0x46,
+    0xd1, 0xe0, 0x50, 0xf9, 0xe7, 0xa4, 0x3f, 0x9d, 0xf9, 0x58, 0xc2, 0x84, 0x71, 0xed, 0x0e, 0x33,
+    0x70, 0x9d, 0xee, 0xb2, 0x64, 0x09, 0x1b, 0xb8, 0x3b, 0xc7, 0xf2, 0x7c, 0x15, 0xb2, 0x31, 0xca,
+    0xb7, 0xee, 0x92, 0x96, 0x8f, 0x26, 0x9d, 0xe7, 0x83, 0x8e, 0xec, 0x01, 0xac, 0x9e, 0x3e, 0x03,
+    0xbc, 0x2d, 0x20, 0x10, 0x4e, 0x36, 0x84, 0xaa, 0xef, 0xe2, 0x25, 0x18, 0x91, 0xb2, 0xda, 0x54,
+    0xf3, 0x35, 0x4d, 0xd0, 0x8f, 0xbc, 0x2b, 0xa5, 0x37, 0xf3, 0x5c, 0x85, 0xe2, 0xe5, 0x21, 0x7a,
+    0x6b, 0xe1, 0x9b, 0xa8, 0xaa, 0x17, 0xcd, 0xd2, 0xae, 0x81, 0xcf, 0x20, 0x20, 0x86, 0xca, 0x2f,
+    0x06, 0x76, 0x7e, 0xaf, 0x5c, 0xf8, 0x5f, 0xc5, 0x06, 0x0b, 0x52, 0x8b, 0x5b, 0x6c, 0xe6, 0xe3,
+    0x59, 0xae, 0x8e, 0x9c, 0xe8, 0x9d, 0xa3, 0x20, 0xcc, 0x8e, 0x99, 0xcf, 0x83, 0x77, 0x6e, 0xdf,
+    0x3a, 0xdd, 0x4b, 0xa0, 0x0c, 0xe4, 0x9c, 0xbf, 0xbc, 0x6d, 0x07, 0x75, 0xbf, 0xe7, 0x28, 0xe4,
+    0x89, 0x34, 0xba, 0x99, 0xc7, 0xd7, 0x51, 0x27, 0x99, 0xa9, 0xfc, 0x6b, 0x24, 0xfb, 0xa7, 0x16,
+    0x61, 0x6a, 0x6f, 0xca, 0x70, 0xea, 0x5b, 0x03, 0x9c, 0x37, 0xd5, 0x1f, 0x1f, 0x43, 0xa8, 0x32,
+    0x19, 0xc6, 0xf1, 0xa6, 0xa4, 0x04, 0xa3, 0x40, 0x1f, 0xdb, 0x77, 0x8d, 0xc3, 0x36, 0xb8, 0x91,
+    0x3c, 0x2d, 0x89, 0xcd, 0x1a, 0x5b, 0x80, 0x6c, 0xf6, 0x79, 0xb9, 0x15, 0x60, 0x8f, 0x4d, 0x4d,
+    0xec, 0x8d, 0xe7, 0xc3, 0x45, 0x75, 0x33, 0xf0, 0x23, 0x22, 0x24, 0x40, 0xcb, 0x7c, 0x7b, 0x1c,
+    0x3a, 0x3a, 0x22, 0xfe, 0xb2, 0xc6, 0x39, 0xb9, 0x54, 0x23, 0x80, 0x74, 0xde, 0xa7, 0xc9, 0xda,
+    0x9f, 0xd4, 0x3b, 0x85, 0xa3, 0xc3, 0xa8, 0x06, 0x35, 0xda, 0x52, 0x0a, 0xb8, 0x81, 0x02, 0x51,
+    0xa8, 0x40, 0x14, 0x3d, 0x9a, 0x31, 0x56, 0x49, 0x12, 0x40, 0x8e, 0xfe, 0x5f, 0xaa, 0x2d, 0xb3,
+    0xdf, 0x74, 0x4d, 0x00, 0xcb, 0x9c, 0xd5, 0x0e, 0xfc, 0xab, 0xb6, 0x09, 0xe0, 0x68, 0x30, 0x6e,
+    0xa9, 0xf4, 0xb6, 0x8f, 0x1c, 0x7b, 0xa5, 0x9d, 0x6c, 0x4c, 0xb6, 0x5f, 0x90, 0xa9, 0x08, 0x50,
+    0xdc, 0x3d, 0xfa, 0x09, 0x15, 0x0f, 0x1f, 0x24, 0xf2, 0x68, 0x78, 0x29, 0x2c, 0x60, 0x15, 0xba,
+    0xe8, 0xa2, 0x4f, 0xbd, 0xf2, 0xe4, 0xa0, 0x12, 0x73, 0x6f, 0x7d, 0x02, 0xa0, 0xfe, 0x11, 0xba,
+    0xc9, 0x82, 0x6f, 0xb2, 0x6e, 0x01, 0x24, 0x85, 0xb4, 0x76, 0xcb, 0x00, 0x6d, 0xe9, 0x68, 0x01,
+    0x76, 0xd1, 0x6f, 0x3e, 0xa9, 0xc5, 0xe7, 0x3e, 0xbe, 0x86, 0x07, 0xf0, 0xf7, 0x7b, 0xc4, 0x06,
+    0x88, 0x77, 0x46, 0x98, 0xf9, 0xbe, 0x59, 0x09, 0x79, 0x67, 0x88, 0x08, 0xbd, 0xab, 0xc8, 0x54,
+    0x9b, 0xe9, 0xb4, 0xbe, 0xb3, 0x1a, 0x25, 0x14, 0x90, 0x80, 0xe1, 0xb3, 0x8d, 0x7a, 0x4d, 0xc3,
+    0x40, 0x8c, 0xec, 0x58, 0x59, 0x75, 0x6e, 0x93, 0x25, 0x28, 0x3e, 0x8b, 0x31, 0x25, 0x41, 0xa7,
+    0xe7, 0x03, 0xc7, 0x5b, 0x73, 0xe4, 0x0c, 0x10, 0x8f, 0xec, 0x13, 0x41, 0x3a, 0x25, 0x9f, 0x0e,
+    0x3a, 0x0f, 0x83, 0xbc, 0xd7, 0x51, 0xbf, 0x00, 0x63, 0x28, 0xc0, 0x61, 0xce, 0x8e, 0xfd, 0xa3,
+    0xbe, 0xd6, 0xa5, 0xfe, 0xbd, 0x16, 0xbe, 0x95, 0xcf, 0x95, 0x5b, 0x14, 0x63, 0x17, 0x72, 0x64,
+    0xaa, 0x14, 0xc2, 0xef, 0x20, 0xe2, 0xa9, 0x8b, 0x17, 0x84, 0xa3, 0xd8, 0x2a, 0x2b, 0x65, 0xb5,
+    0xfb, 0x09, 0x60, 0x55, 0x8b, 0x94, 0x2c, 0x7a, 0xbe, 0xe3, 0x3b, 0x4c, 0x1e, 0xae, 0x8e, 0xc3,
+    0x72, 0x54, 0x22, 0x4e, 0x61, 0x18, 0xc9, 0x43, 0xe7, 0x24, 0xdb, 0x9b, 0x41, 0x43, 0x12, 0x24,
+    0x27, 0x52, 0xde, 0x2d, 0x9d, 0xcb, 0xd8, 0x47, 0x5e, 0x2d, 0x8c, 0x39, 0x14, 0x96, 0x4c, 0x73,
+    0xdc, 0x4c, 0xce, 0xab, 0xce, 0xb3, 0x57, 0xa2, 0xd9, 0x67, 0x90, 0xe6, 0x78, 0x45, 0x13, 0x64,
+    0x23, 0x04, 0x25, 0x29, 0x5b, 0x7c, 0xc3, 0xb1, 0xb2, 0x0f, 0x3d, 0xc9, 0x2d, 0xaf, 0x42, 0x03,
+    0xf1, 0xb5, 0xbb, 0x58, 0xe1, 0x15, 0xd2, 0xc9, 0x7f, 0x15, 0x08, 0xdb, 0x72, 0x4e, 0x4e, 0xbb,
+    0x62, 0x9e, 0xbb, 0x73, 0xdd, 0x5e, 0x09, 0x13, 0xa7, 0x6f, 0x2d, 0xc1, 0x62, 0x74, 0x54, 0x6d,
+    0x70, 0xc1, 0xb6, 0x91, 0xa3, 0xfa, 0xe0, 0x3b, 0xc6, 0xdb, 0x9f, 0x11, 0xcb, 0xb7, 0xe3, 0x97,
+    0x56, 0xd1, 0x12, 0x44, 0x38, 0x48, 0xd5, 0x1b, 0x69, 0xc1, 0x43, 0x13, 0xcf, 0xe2, 0x76, 0xd3,
+};
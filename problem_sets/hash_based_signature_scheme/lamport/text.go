@@ -0,0 +1,149 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+Labeled text format, for looking at keys by eye and editing single blocks by
+hand.  A header line, then one block per line:
+
+    lamport public key
+    zero[0]: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+    ...
+    zero[255]: ...
+    one[0]: ...
+    ...
+    one[255]: ...
+
+Blank lines are ignored when parsing, but every index of both rows has to
+appear exactly once.
+*/
+
+const TEXT_PUBKEY_HEADER = "lamport public key"
+const TEXT_PRIVKEY_HEADER = "lamport private key"
+
+// MarshalText implements encoding.TextMarshaler.
+func (self PublicKey) MarshalText() ([]byte, error) {
+	return marshalRows(TEXT_PUBKEY_HEADER, &self.ZeroHash, &self.OneHash), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.  The receiver is only
+// modified if the whole text parses.
+func (self *PublicKey) UnmarshalText(text []byte) error {
+	var p PublicKey
+	err := unmarshalRows(text, TEXT_PUBKEY_HEADER, &p.ZeroHash, &p.OneHash)
+	if err != nil {
+		return err
+	}
+	*self = p
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.  The output is the secret
+// key in the clear, so be careful where it goes.
+func (self PrivateKey) MarshalText() ([]byte, error) {
+	return marshalRows(TEXT_PRIVKEY_HEADER, &self.ZeroHash, &self.OneHash), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (self *PrivateKey) UnmarshalText(text []byte) error {
+	var p PrivateKey
+	err := unmarshalRows(text, TEXT_PRIVKEY_HEADER, &p.ZeroHash, &p.OneHash)
+	if err != nil {
+		return err
+	}
+	*self = p
+	return nil
+}
+
+// marshalRows writes the header and both rows in the labeled format.
+func marshalRows(header string, zero, one *[MESSAGE_BITS]Block) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(header + "\n")
+	for i := range zero {
+		fmt.Fprintf(&buf, "zero[%d]: %x\n", i, zero[i])
+	}
+	for i := range one {
+		fmt.Fprintf(&buf, "one[%d]: %x\n", i, one[i])
+	}
+	return buf.Bytes()
+}
+
+// unmarshalRows parses the labeled format into zero and one, checking the
+// header and that each index of each row is seen exactly once.
+func unmarshalRows(text []byte, header string, zero, one *[MESSAGE_BITS]Block) error {
+	lines := strings.Split(string(text), "\n")
+	var seen [2][MESSAGE_BITS]bool
+
+	first := true
+	for n, line := range lines {
+		lineNum := n + 1
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if first {
+			if line != header {
+				return fmt.Errorf("line %d: header %q, expect %q", lineNum, line, header)
+			}
+			first = false
+			continue
+		}
+
+		label, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return fmt.Errorf("line %d: missing ':'", lineNum)
+		}
+		name, index, ok := strings.Cut(strings.TrimSuffix(label, "]"), "[")
+		if !ok || !strings.HasSuffix(label, "]") {
+			return fmt.Errorf("line %d: label %q, expect zero[i] or one[i]", lineNum, label)
+		}
+
+		var row int
+		var blocks *[MESSAGE_BITS]Block
+		switch name {
+		case "zero":
+			row, blocks = 0, zero
+		case "one":
+			row, blocks = 1, one
+		default:
+			return fmt.Errorf("line %d: unknown row %q", lineNum, name)
+		}
+
+		i, err := strconv.Atoi(index)
+		if err != nil || i < 0 || i >= MESSAGE_BITS {
+			return fmt.Errorf("line %d: index %q out of range 0-%d",
+				lineNum, index, MESSAGE_BITS-1)
+		}
+		if seen[row][i] {
+			return fmt.Errorf("line %d: duplicate %s[%d]", lineNum, name, i)
+		}
+		seen[row][i] = true
+
+		b, err := hex.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("line %d: %w: %v", lineNum, ErrInvalidHex, err)
+		}
+		blocks[i], err = BlockFromByteSliceStrict(b)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", lineNum, err)
+		}
+	}
+
+	if first {
+		return fmt.Errorf("missing header %q", header)
+	}
+	for row, name := range []string{"zero", "one"} {
+		for i := range seen[row] {
+			if !seen[row][i] {
+				return fmt.Errorf("missing %s[%d]", name, i)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package lamport
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestTextRoundTrip marshals the provided pubkey and a new private key to
+// text and back.
+func TestTextRoundTrip(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := pub.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(text)), "\n")
+	if len(lines) != 1+2*MESSAGE_BITS {
+		t.Fatalf("text pubkey %d lines, expect %d", len(lines), 1+2*MESSAGE_BITS)
+	}
+	if lines[1] != "zero[0]: "+hexPubkey1[:64] {
+		t.Fatalf("first block line %q", lines[1])
+	}
+	var pub2 PublicKey
+	err = pub2.UnmarshalText(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != pub {
+		t.Fatalf("pubkey changed after text round trip")
+	}
+
+	sec, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err = sec.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sec2 PrivateKey
+	err = sec2.UnmarshalText(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sec2 != sec {
+		t.Fatalf("privkey changed after text round trip")
+	}
+
+	// a pubkey isn't a private key
+	err = sec2.UnmarshalText([]byte(strings.Join(lines, "\n")))
+	if err == nil {
+		t.Fatalf("pubkey text parsed as privkey")
+	}
+}
+
+// TestTextEditBreaksVerify hand edits one line of the pubkey, the way a
+// student might, and checks that a signature using that block now fails.
+func TestTextEditBreaksVerify(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := HexToSignature(hexSignature1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("1")
+
+	text, err := pub.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(text), "\n")
+	// bit 0 of sha256("1") is 0 (first byte 0x6b), so zero[0] is used
+	lines[1] = "zero[0]: " + strings.Repeat("00", MESSAGE_BYTES)
+
+	var pub2 PublicKey
+	err = pub2.UnmarshalText([]byte(strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	if Verify(msg, pub2, sig) {
+		t.Fatalf("Verify with edited pubkey returned true, expected false")
+	}
+}
+
+// TestTextErrors checks missing, duplicate, and out of range lines.
+func TestTextErrors(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := pub.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(text), "\n")
+	block := strings.Repeat("ab", MESSAGE_BYTES)
+
+	edit := func(i int, line string) []string {
+		l := append([]string{}, lines...)
+		l[i] = line
+		return l
+	}
+	cases := []struct {
+		lines []string
+		msg   string
+	}{
+		{edit(5, ""), "missing zero[4]"},
+		{edit(300, "one[0]: "+block), "duplicate one[0]"},
+		{edit(300, "one[256]: "+block), "out of range"},
+		{edit(300, "one[-1]: "+block), "out of range"},
+		{edit(300, "two[43]: "+block), "unknown row"},
+		{edit(300, "one[43]: "+block[:62]), "block 31 bytes"},
+		{edit(0, "lamport signature"), "header"},
+	}
+	for _, c := range cases {
+		var p PublicKey
+		err = p.UnmarshalText([]byte(strings.Join(c.lines, "\n")))
+		if err == nil || !strings.Contains(err.Error(), c.msg) {
+			t.Fatalf("got %v, expect error containing %q", err, c.msg)
+		}
+	}
+}
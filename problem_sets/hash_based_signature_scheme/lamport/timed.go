@@ -0,0 +1,105 @@
+package lamport
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+/*
+Timed messages are signed as GetMessage(TIMED_DOMAIN, env.Bytes()), where
+Bytes is the canonical encoding
+
+    payload hash  32 bytes, sha256 of the data
+    issued at     8 bytes, big endian unix seconds
+    expires at    8 bytes, big endian unix seconds
+*/
+
+const TIMED_DOMAIN = "lamport timed message v1"
+const TIMED_MESSAGE_BYTES = MESSAGE_BYTES + 8 + 8 // 48
+
+var (
+	ErrExpired     = errors.New("timed message has expired")
+	ErrNotYetValid = errors.New("timed message isn't valid yet")
+)
+
+// TimedMessage wraps the hash of some data with the window it's valid in.
+// Signing it signs all three, so the window can't be stretched later.
+type TimedMessage struct {
+	PayloadHash Message
+	IssuedAt    int64
+	ExpiresAt   int64
+}
+
+// NewTimedMessage returns an envelope for data, valid from issued for ttl.
+// Times are kept to the second.
+func NewTimedMessage(data []byte, issued time.Time, ttl time.Duration) (TimedMessage, error) {
+	if ttl <= 0 {
+		return TimedMessage{}, fmt.Errorf("timed message: ttl %v, expect more than 0", ttl)
+	}
+	return TimedMessage{
+		PayloadHash: sha256.Sum256(data),
+		IssuedAt:    issued.Unix(),
+		ExpiresAt:   issued.Add(ttl).Unix(),
+	}, nil
+}
+
+// Bytes returns the envelope's canonical encoding.
+func (self TimedMessage) Bytes() []byte {
+	b := make([]byte, TIMED_MESSAGE_BYTES)
+	copy(b, self.PayloadHash[:])
+	binary.BigEndian.PutUint64(b[MESSAGE_BYTES:], uint64(self.IssuedAt))
+	binary.BigEndian.PutUint64(b[MESSAGE_BYTES+8:], uint64(self.ExpiresAt))
+	return b
+}
+
+// TimedMessageFromBytes is the inverse of TimedMessage.Bytes.
+func TimedMessageFromBytes(b []byte) (TimedMessage, error) {
+	if len(b) != TIMED_MESSAGE_BYTES {
+		return TimedMessage{}, fmt.Errorf("%w: TimedMessage %d bytes, expect %d",
+			ErrWrongLength, len(b), TIMED_MESSAGE_BYTES)
+	}
+	var env TimedMessage
+	copy(env.PayloadHash[:], b)
+	env.IssuedAt = int64(binary.BigEndian.Uint64(b[MESSAGE_BYTES:]))
+	env.ExpiresAt = int64(binary.BigEndian.Uint64(b[MESSAGE_BYTES+8:]))
+	return env, nil
+}
+
+// Message returns what gets signed for the envelope.
+func (self TimedMessage) Message() Message {
+	return GetMessage(TIMED_DOMAIN, self.Bytes())
+}
+
+// Covers reports whether the envelope is for data.
+func (self TimedMessage) Covers(data []byte) bool {
+	return self.PayloadHash == sha256.Sum256(data)
+}
+
+// SignTimed signs the envelope with pri.
+func SignTimed(env TimedMessage, pri PrivateKey) (Signature, error) {
+	return Sign(env.Message(), pri)
+}
+
+// VerifyTimed checks sig on env against pub, then that now is in the
+// envelope's window, give or take skew for clocks that disagree.  A bad
+// signature is ErrInvalidSignature whatever the time; otherwise it's
+// ErrNotYetValid before IssuedAt-skew and ErrExpired from ExpiresAt+skew on.
+func VerifyTimed(env TimedMessage, pub PublicKey, sig Signature, now time.Time, skew time.Duration) error {
+	if !Verify(env.Message(), pub, sig) {
+		return ErrInvalidSignature
+	}
+	issued := time.Unix(env.IssuedAt, 0)
+	expires := time.Unix(env.ExpiresAt, 0)
+	if now.Before(issued.Add(-skew)) {
+		return fmt.Errorf("%w: issued at %v, now %v", ErrNotYetValid,
+			issued.UTC(), now.UTC())
+	}
+	if !now.Before(expires.Add(skew)) {
+		return fmt.Errorf("%w: expired at %v, now %v", ErrExpired,
+			expires.UTC(), now.UTC())
+	}
+	return nil
+}
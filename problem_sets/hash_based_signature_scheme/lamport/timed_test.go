@@ -0,0 +1,89 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestVerifyTimed walks a fake clock through the window and checks each
+// failure mode.
+func TestVerifyTimed(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("valid for an hour")
+	issued := time.Date(2018, 2, 14, 12, 0, 0, 0, time.UTC)
+	env, err := NewTimedMessage(data, issued, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !env.Covers(data) || env.Covers([]byte("something else")) {
+		t.Fatalf("Covers doesn't match the data")
+	}
+	sig, err := SignTimed(env, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	skew := 30 * time.Second
+
+	tests := []struct {
+		now    time.Time
+		expect error
+	}{
+		{issued.Add(-time.Minute), ErrNotYetValid},
+		{issued.Add(-29 * time.Second), nil},
+		{issued.Add(30 * time.Minute), nil},
+		{issued.Add(time.Hour + 29*time.Second), nil},
+		{issued.Add(time.Hour + 30*time.Second), ErrExpired},
+		{issued.Add(24 * time.Hour), ErrExpired},
+	}
+	for _, test := range tests {
+		err := VerifyTimed(env, pub, sig, test.now, skew)
+		if !errors.Is(err, test.expect) || (test.expect == nil && err != nil) {
+			t.Fatalf("at %v: got %v, expect %v", test.now, err, test.expect)
+		}
+	}
+
+	// stretching the window breaks the signature, even at a time that
+	// would be fine
+	stretched := env
+	stretched.ExpiresAt += 3600
+	err = VerifyTimed(stretched, pub, sig, issued.Add(90*time.Minute), skew)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+	err = VerifyTimed(env, pub, sig, issued.Add(90*time.Minute), 0)
+	if !errors.Is(err, ErrExpired) {
+		t.Fatalf("got %v, expect ErrExpired", err)
+	}
+
+	_, err = NewTimedMessage(data, issued, 0)
+	if err == nil {
+		t.Fatalf("zero ttl returned no error")
+	}
+}
+
+// TestTimedMessageBytes round trips the canonical encoding.
+func TestTimedMessageBytes(t *testing.T) {
+	env, err := NewTimedMessage([]byte("x"), time.Unix(1518609600, 0), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := env.Bytes()
+	if len(b) != TIMED_MESSAGE_BYTES {
+		t.Fatalf("got %d bytes, expect %d", len(b), TIMED_MESSAGE_BYTES)
+	}
+	decoded, err := TimedMessageFromBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded != env || decoded.ExpiresAt != 1518609660 {
+		t.Fatalf("got %+v, expect %+v", decoded, env)
+	}
+	_, err = TimedMessageFromBytes(b[1:])
+	if !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+}
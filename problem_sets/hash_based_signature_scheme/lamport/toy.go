@@ -0,0 +1,18 @@
+package lamport
+
+import (
+	"crypto/sha256"
+)
+
+// ParamsToy64 sign 64 bit messages with 8 byte blocks, SHA-256 cut down to
+// its first 8 bytes, for both the key blocks and Message.  Keys are 1024
+// bytes and signatures 512.  It's far too small to be secure, which is the
+// point: after a handful of signatures a forgery takes seconds rather than
+// the problem set's hours, so a class can watch one happen.  It goes
+// through the same Params code as every other scheme.
+var ParamsToy64 = Params{MessageBits: 64, Hash: toy64Hash}
+
+func toy64Hash(b []byte) []byte {
+	h := sha256.Sum256(b)
+	return h[:8]
+}
@@ -0,0 +1,113 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParamsToy64 checks the toy sizes and a sign and verify round trip.
+func TestParamsToy64(t *testing.T) {
+	params := ParamsToy64
+	if err := params.Check(); err != nil {
+		t.Fatal(err)
+	}
+	if params.BlockBytes() != 8 || params.MessageBytes() != 8 {
+		t.Fatalf("got %d byte blocks and %d byte messages, expect 8 and 8",
+			params.BlockBytes(), params.MessageBytes())
+	}
+	pri, pub, err := params.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := params.Message([]byte("toy"))
+	sig, err := params.Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := params.Verify(msg, pub, sig); err != nil {
+		t.Fatal(err)
+	}
+	err = params.Verify(params.Message([]byte("other")), pub, sig)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+	// a full size signature is the wrong shape
+	full := Signature{}.Generic()
+	var pe *ParamsError
+	if err := params.Verify(msg, pub, full); !errors.As(err, &pe) {
+		t.Fatalf("got %v, expect *ParamsError", err)
+	}
+}
+
+// TestGenericRevealedKey checks a toy key signed on a message and its
+// inverse can be forged on anything, and that it agrees with RevealedKey
+// for the provided signatures.
+func TestGenericRevealedKey(t *testing.T) {
+	params := ParamsToy64
+	pri, pub, err := params.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rk := params.NewRevealedKey()
+	if len(rk.MissingBits()) != 64 {
+		t.Fatalf("got %d missing bits, expect 64", len(rk.MissingBits()))
+	}
+	msg := params.Message([]byte("revealed"))
+	sig, _ := params.Sign(msg, pri)
+	if err := rk.Add(pub, msg, sig); err != nil {
+		t.Fatal(err)
+	}
+	if rk.Difficulty() != 64 || len(rk.MissingBits()) != 0 {
+		t.Fatalf("got difficulty %d and %d missing, expect 64 and 0",
+			rk.Difficulty(), len(rk.MissingBits()))
+	}
+	other := params.Message([]byte("other"))
+	if _, err := rk.Sign(other); !errors.Is(err, ErrUnforgeable) {
+		t.Fatalf("got %v, expect ErrUnforgeable", err)
+	}
+	if err := rk.Add(pub, other, sig); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+	_, pub2, _ := params.GenerateKey(nil)
+	if err := rk.Add(pub2, msg, sig); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("got %v, expect ErrWrongKey", err)
+	}
+
+	inverse := make([]byte, len(msg))
+	for i := range msg {
+		inverse[i] = ^msg[i]
+	}
+	sig, _ = params.Sign(inverse, pri)
+	if err := rk.Add(pub, inverse, sig); err != nil {
+		t.Fatal(err)
+	}
+	if rk.Len() != 2 || rk.Difficulty() != 0 {
+		t.Fatalf("got %d signatures and difficulty %d, expect 2 and 0",
+			rk.Len(), rk.Difficulty())
+	}
+	forged, err := rk.Sign(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := params.Verify(other, pub, forged); err != nil {
+		t.Fatal(err)
+	}
+
+	fixed, _ := providedRevealed(t)
+	generic := DefaultParams.NewRevealedKey()
+	for i, s := range []string{hexSignature1, hexSignature2, hexSignature3, hexSignature4} {
+		sig, _ := HexToSignature(s)
+		msg := GetMessageFromString(string(rune('1' + i)))
+		if err := generic.Add(fixed.PublicKey().Generic(), msg[:], sig.Generic()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	zero, one := fixed.Coverage()
+	gzero, gone := generic.Coverage()
+	if string(zero[:]) != string(gzero) || string(one[:]) != string(gone) {
+		t.Fatalf("generic coverage differs from RevealedKey")
+	}
+	if generic.Difficulty() != fixed.Difficulty() {
+		t.Fatalf("got difficulty %d, expect %d", generic.Difficulty(), fixed.Difficulty())
+	}
+}
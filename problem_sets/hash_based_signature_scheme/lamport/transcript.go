@@ -0,0 +1,118 @@
+package lamport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+/*
+A Transcript records a key generation from a committed seed, so a setup
+ceremony can publish the commitment first and later prove the key came from
+it by revealing the seed.  Its canonical encoding, and the payload of a
+CONTAINER_TRANSCRIPT container, is
+
+    commitment   32 bytes, sha256 of the seed
+    algorithm     2 bytes, big endian, TRANSCRIPT_ALG_SEED_SHA256
+    fingerprint  32 bytes, Fingerprint of the pubkey
+*/
+
+const TRANSCRIPT_BYTES = 32 + 2 + 32 // 66
+
+// TRANSCRIPT_ALG_SEED_SHA256 is the only derivation so far: the seed
+// expanded block by block as SeedPrivateKey does.
+const TRANSCRIPT_ALG_SEED_SHA256 uint16 = 1
+
+// ErrTranscriptMismatch is wrapped by VerifyTranscript when the seed or
+// pubkey doesn't match what the transcript recorded.
+var ErrTranscriptMismatch = errors.New("transcript doesn't match")
+
+// Transcript records what GenerateKeyAudited did.
+type Transcript struct {
+	Commitment  [32]byte
+	Algorithm   uint16
+	Fingerprint Fingerprint
+}
+
+// GenerateKeyAudited expands seed into a key as SeedPrivateKey.Expand does
+// and returns it with the transcript of the derivation.
+func GenerateKeyAudited(seed [SEED_BYTES]byte) (PrivateKey, PublicKey, Transcript) {
+	spri, pub := GenerateKeyFromSeed(seed)
+	t := Transcript{
+		Commitment:  sha256.Sum256(seed[:]),
+		Algorithm:   TRANSCRIPT_ALG_SEED_SHA256,
+		Fingerprint: pub.Fingerprint(),
+	}
+	return spri.Expand(), pub, t
+}
+
+// VerifyTranscript re-derives the key from seed and checks that seed, the
+// algorithm and pub are the ones t records.
+func VerifyTranscript(t Transcript, seed [SEED_BYTES]byte, pub PublicKey) error {
+	if t.Algorithm != TRANSCRIPT_ALG_SEED_SHA256 {
+		return fmt.Errorf("%w: unknown algorithm %d", ErrTranscriptMismatch, t.Algorithm)
+	}
+	if sha256.Sum256(seed[:]) != t.Commitment {
+		return fmt.Errorf("%w: seed isn't the committed one", ErrTranscriptMismatch)
+	}
+	_, derived := GenerateKeyFromSeed(seed)
+	if derived.Fingerprint() != t.Fingerprint {
+		return fmt.Errorf("%w: seed derives pubkey %v, transcript has %v",
+			ErrTranscriptMismatch, derived.Fingerprint(), t.Fingerprint)
+	}
+	if pub != derived {
+		return fmt.Errorf("%w: pubkey %v isn't the derived one",
+			ErrTranscriptMismatch, pub.Fingerprint())
+	}
+	return nil
+}
+
+// Bytes returns the transcript's canonical encoding.
+func (self Transcript) Bytes() []byte {
+	b := make([]byte, 0, TRANSCRIPT_BYTES)
+	b = append(b, self.Commitment[:]...)
+	b = binary.BigEndian.AppendUint16(b, self.Algorithm)
+	return append(b, self.Fingerprint[:]...)
+}
+
+// TranscriptFromBytes is the inverse of Transcript.Bytes.
+func TranscriptFromBytes(b []byte) (Transcript, error) {
+	var t Transcript
+	if len(b) != TRANSCRIPT_BYTES {
+		return t, fmt.Errorf("%w: transcript %d bytes, expect %d",
+			ErrWrongLength, len(b), TRANSCRIPT_BYTES)
+	}
+	copy(t.Commitment[:], b[:32])
+	t.Algorithm = binary.BigEndian.Uint16(b[32:34])
+	copy(t.Fingerprint[:], b[34:])
+	return t, nil
+}
+
+// MarshalBinary encodes the transcript as a CONTAINER_TRANSCRIPT container.
+func (self Transcript) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := WriteContainer(&buf, CONTAINER_TRANSCRIPT, self.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a transcript from MarshalBinary.
+func (self *Transcript) UnmarshalBinary(data []byte) error {
+	c, err := ReadContainer(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if c.Type != CONTAINER_TRANSCRIPT {
+		return ContainerTypeError{Type: c.Type, Expect: CONTAINER_TRANSCRIPT}
+	}
+	t, err := TranscriptFromBytes(c.Payload)
+	if err != nil {
+		return err
+	}
+	*self = t
+	return nil
+}
@@ -0,0 +1,124 @@
+package lamport
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// TestGenerateKeyAudited checks the transcript for the seed 00 01 .. 1f
+// against the seed key vectors and that it verifies.
+func TestGenerateKeyAudited(t *testing.T) {
+	seed := testSeedKey().Seed
+	pri, pub, tr := GenerateKeyAudited(seed)
+	if pri != testSeedKey().Expand() || pub != pri.GetPublicKey() {
+		t.Fatalf("audited key differs from the seed key")
+	}
+	commitment := "630dcd2966c4336691125448bbb25b4ff412a49c732db2c8abc1b8581bd710dd"
+	if hex.EncodeToString(tr.Commitment[:]) != commitment {
+		t.Fatalf("commitment %x, expect %s", tr.Commitment, commitment)
+	}
+	if hex.EncodeToString(tr.Fingerprint[:]) != SEED_KAT_PUBKEY {
+		t.Fatalf("fingerprint %x, expect %s", tr.Fingerprint[:], SEED_KAT_PUBKEY)
+	}
+	if tr.Algorithm != TRANSCRIPT_ALG_SEED_SHA256 {
+		t.Fatalf("algorithm %d, expect %d", tr.Algorithm, TRANSCRIPT_ALG_SEED_SHA256)
+	}
+	if err := VerifyTranscript(tr, seed, pub); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestVerifyTranscriptTampered changes each part of the transcript, the
+// seed and the pubkey in turn and checks VerifyTranscript rejects them.
+func TestVerifyTranscriptTampered(t *testing.T) {
+	seed := testSeedKey().Seed
+	_, pub, tr := GenerateKeyAudited(seed)
+
+	bad := tr
+	bad.Commitment[0] ^= 1
+	if err := VerifyTranscript(bad, seed, pub); !errors.Is(err, ErrTranscriptMismatch) {
+		t.Fatalf("commitment: got %v, expect ErrTranscriptMismatch", err)
+	}
+	bad = tr
+	bad.Algorithm = 2
+	if err := VerifyTranscript(bad, seed, pub); !errors.Is(err, ErrTranscriptMismatch) {
+		t.Fatalf("algorithm: got %v, expect ErrTranscriptMismatch", err)
+	}
+	bad = tr
+	bad.Fingerprint[31] ^= 1
+	if err := VerifyTranscript(bad, seed, pub); !errors.Is(err, ErrTranscriptMismatch) {
+		t.Fatalf("fingerprint: got %v, expect ErrTranscriptMismatch", err)
+	}
+
+	other := seed
+	other[0] ^= 1
+	if err := VerifyTranscript(tr, other, pub); !errors.Is(err, ErrTranscriptMismatch) {
+		t.Fatalf("seed: got %v, expect ErrTranscriptMismatch", err)
+	}
+	wrong := pub
+	wrong.OneHash[7][0] ^= 1
+	if err := VerifyTranscript(tr, seed, wrong); !errors.Is(err, ErrTranscriptMismatch) {
+		t.Fatalf("pubkey: got %v, expect ErrTranscriptMismatch", err)
+	}
+
+	// a transcript tampered with in transit fails the same way
+	data, err := tr.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[len(data)-1] ^= 1
+	var back Transcript
+	if err := back.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyTranscript(back, seed, pub); !errors.Is(err, ErrTranscriptMismatch) {
+		t.Fatalf("decoded: got %v, expect ErrTranscriptMismatch", err)
+	}
+}
+
+// TestTranscriptMarshal round trips a transcript through Bytes and a
+// container.
+func TestTranscriptMarshal(t *testing.T) {
+	_, _, tr := GenerateKeyAudited(testSeedKey().Seed)
+	b := tr.Bytes()
+	if len(b) != TRANSCRIPT_BYTES {
+		t.Fatalf("got %d bytes, expect %d", len(b), TRANSCRIPT_BYTES)
+	}
+	back, err := TranscriptFromBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back != tr {
+		t.Fatalf("transcript round trip differs")
+	}
+	if _, err := TranscriptFromBytes(b[1:]); !errors.Is(err, ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+
+	data, err := tr.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != CONTAINER_HEADER_BYTES+TRANSCRIPT_BYTES {
+		t.Fatalf("got %d bytes, expect %d", len(data), CONTAINER_HEADER_BYTES+TRANSCRIPT_BYTES)
+	}
+	var got Transcript
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got != tr {
+		t.Fatalf("transcript container round trip differs")
+	}
+	seedData, err := testSeedKey().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var typeErr ContainerTypeError
+	if err := got.UnmarshalBinary(seedData); !errors.As(err, &typeErr) {
+		t.Fatalf("got %v, expect ContainerTypeError", err)
+	}
+	if got != tr {
+		t.Fatalf("failed UnmarshalBinary changed the transcript")
+	}
+}
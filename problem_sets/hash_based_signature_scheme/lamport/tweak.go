@@ -0,0 +1,161 @@
+package lamport
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+/*
+Tweakable hashing.  A plain Scheme hashes every key block the same way, so
+an attacker holding many pubkeys can try each guess against all their
+blocks at once: with 2^k targets a preimage costs 2^k times less.  A
+Tweaked scheme hashes each block as
+
+    TH(pubSeed, adrs, block) = H(pubSeed || adrs || block)
+
+where pubSeed is a public random seed per key pair and adrs is the block's
+address, 16 bytes:
+
+    key ID       4 bytes, which key of the owner's this is
+    row          4 bytes, 0 for ZeroHash and 1 for OneHash
+    chain index  4 bytes, the message bit
+    hash step    4 bytes, always 0 for Lamport's single hash per block
+
+all big endian, so every hash anywhere is a different function and a guess
+only ever tests one target.  This is the addressing WOTS+ and the xmss
+package already use for their chains and trees.
+
+The seed and key ID are public and needed to verify, so a TweakedPublicKey
+carries them.  It's saved as a CONTAINER_TWEAKED_PUB container, with the
+scheme's ID, whose payload is
+
+    public seed  SEED_BYTES
+    key ID       4 bytes, big endian
+    pubkey       PUBKEY_BYTES
+*/
+
+const ADRS_BYTES = 16
+const TWEAKED_PUBKEY_BYTES = SEED_BYTES + 4 + PUBKEY_BYTES
+
+// ADRS is the address of one hash call.
+type ADRS struct {
+	KeyID uint32
+	Row   uint32
+	Index uint32
+	Step  uint32
+}
+
+// Bytes returns the address as ADRS_BYTES, big endian.
+func (self ADRS) Bytes() []byte {
+	b := make([]byte, ADRS_BYTES)
+	binary.BigEndian.PutUint32(b, self.KeyID)
+	binary.BigEndian.PutUint32(b[4:], self.Row)
+	binary.BigEndian.PutUint32(b[8:], self.Index)
+	binary.BigEndian.PutUint32(b[12:], self.Step)
+	return b
+}
+
+// TH is the tweaked hash of SCHEME_SHA256_TWEAKED.
+func TH(pubSeed []byte, adrs ADRS, data []byte) Block {
+	return SCHEME_SHA256_TWEAKED.TH(pubSeed, adrs, data)
+}
+
+// TH hashes data with the scheme's hash, tweaked by pubSeed and adrs.
+func (self *Scheme) TH(pubSeed []byte, adrs ADRS, data []byte) Block {
+	var out Block
+	h := self.New()
+	h.Write(pubSeed)
+	h.Write(adrs.Bytes())
+	h.Write(data)
+	h.Sum(out[:0])
+	return out
+}
+
+// tweak is what a Tweaked scheme hashes a key's blocks under.
+type tweak struct {
+	pubSeed [SEED_BYTES]byte
+	keyID   uint32
+}
+
+// hashAt hashes the block at row and index of a key: with TH under tw for
+// a Tweaked scheme, plainly otherwise.
+func (self *Scheme) hashAt(tw *tweak, row, index int, b Block) Block {
+	if !self.Tweaked {
+		return self.HashBlock(b)
+	}
+	return self.TH(tw.pubSeed[:],
+		ADRS{KeyID: tw.keyID, Row: uint32(row), Index: uint32(index)}, b[:])
+}
+
+// TweakedPublicKey is a pubkey along with the public seed and key ID its
+// blocks were hashed under.  For a scheme that isn't Tweaked the seed and
+// ID don't change anything.
+type TweakedPublicKey struct {
+	PubSeed [SEED_BYTES]byte
+	KeyID   uint32
+	Key     PublicKey
+}
+
+// GenerateTweakedKey reads a private key and then a public seed from r, or
+// crypto/rand if r is nil, and hashes the key under them and keyID.
+func (self *Scheme) GenerateTweakedKey(r io.Reader, keyID uint32) (PrivateKey, TweakedPublicKey, error) {
+	if r == nil {
+		r = rand.Reader
+	}
+	pri, _, err := GenerateKeyFrom(r)
+	if err != nil {
+		return PrivateKey{}, TweakedPublicKey{}, err
+	}
+	var pubSeed [SEED_BYTES]byte
+	_, err = io.ReadFull(r, pubSeed[:])
+	if err != nil {
+		pri.Zeroize()
+		return PrivateKey{}, TweakedPublicKey{}, fmt.Errorf(
+			"reading %d byte public seed: %w", SEED_BYTES, err)
+	}
+	return pri, self.TweakedPublicKey(pri, pubSeed, keyID), nil
+}
+
+// TweakedPublicKey hashes every block of pri under pubSeed and keyID.
+func (self *Scheme) TweakedPublicKey(pri PrivateKey, pubSeed [SEED_BYTES]byte,
+	keyID uint32) TweakedPublicKey {
+	tw := tweak{pubSeed: pubSeed, keyID: keyID}
+	return TweakedPublicKey{PubSeed: pubSeed, KeyID: keyID,
+		Key: self.publicKey(&pri, &tw)}
+}
+
+// VerifyTweaked checks sig on msg against pub, hashing under pub's seed and
+// key ID.
+func (self *Scheme) VerifyTweaked(msg Message, pub TweakedPublicKey, sig Signature) bool {
+	tw := tweak{pubSeed: pub.PubSeed, keyID: pub.KeyID}
+	return self.verify(msg, &pub.Key, &sig, &tw)
+}
+
+// MarshalTweakedPublicKey writes pub in a CONTAINER_TWEAKED_PUB container
+// with the scheme's ID.
+func (self *Scheme) MarshalTweakedPublicKey(pub TweakedPublicKey) ([]byte, error) {
+	payload := make([]byte, SEED_BYTES+4, TWEAKED_PUBKEY_BYTES)
+	copy(payload, pub.PubSeed[:])
+	binary.BigEndian.PutUint32(payload[SEED_BYTES:], pub.KeyID)
+	payload = append(payload, pub.Key.Bytes()...)
+	return self.marshal(CONTAINER_TWEAKED_PUB, payload)
+}
+
+// ParseTweakedPublicKey reads a pubkey written by MarshalTweakedPublicKey.
+// A container from another scheme is a SchemeMismatchError.
+func (self *Scheme) ParseTweakedPublicKey(data []byte) (TweakedPublicKey, error) {
+	payload, err := self.parse(data, CONTAINER_TWEAKED_PUB)
+	if err != nil {
+		return TweakedPublicKey{}, err
+	}
+	var pub TweakedPublicKey
+	copy(pub.PubSeed[:], payload)
+	pub.KeyID = binary.BigEndian.Uint32(payload[SEED_BYTES:])
+	pub.Key, err = PubkeyFromBytes(payload[SEED_BYTES+4:])
+	if err != nil {
+		return TweakedPublicKey{}, err
+	}
+	return pub, nil
+}
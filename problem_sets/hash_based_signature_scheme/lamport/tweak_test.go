@@ -0,0 +1,127 @@
+package lamport
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func tweakTestSeed() [SEED_BYTES]byte {
+	var seed [SEED_BYTES]byte
+	for i := range seed {
+		seed[i] = byte(0x80 + i)
+	}
+	return seed
+}
+
+// TestTHVectors pins TH for fixed addresses, computed independently with
+// Python's hashlib, so other implementations can check their layout.
+func TestTHVectors(t *testing.T) {
+	seed := tweakTestSeed()
+	vectors := []struct {
+		adrs   ADRS
+		data   []byte
+		expect string
+	}{
+		{ADRS{}, []byte("abc"),
+			"41446216db851e8f2cde5203ed1fd8861dc0614e1806c4e1b28c1a94488d6ee3"},
+		{ADRS{KeyID: 7, Row: 1, Index: 255}, []byte("abc"),
+			"1fb11cd17ad58994b5d89e81aa8334055b65d3b963d0fa844e180010f9472f1d"},
+		{ADRS{KeyID: 0xdeadbeef, Index: 3, Step: 9}, make([]byte, 32),
+			"0604c49e7a36b3f231b62777c65e76d4ef4c10b00f4e3b52af651ed4e0d35b42"},
+	}
+	for _, v := range vectors {
+		got := TH(seed[:], v.adrs, v.data)
+		if hex.EncodeToString(got[:]) != v.expect {
+			t.Fatalf("TH at %+v: got %x, expect %s", v.adrs, got, v.expect)
+		}
+	}
+
+	pri, _, err := GenerateKeyFrom(bytes.NewReader(schemeTestSecret()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := SCHEME_SHA256_TWEAKED.TweakedPublicKey(pri, seed, 3)
+	fp := pub.Key.Fingerprint()
+	expect := "6834a643d044b6378967acec7181b5fc4434d074f40a8d98104213fcdfcd9cfd"
+	if hex.EncodeToString(fp[:]) != expect {
+		t.Fatalf("tweaked pubkey fingerprint %x, expect %s", fp, expect)
+	}
+}
+
+// TestTweakedKey signs with a tweaked key and checks it only verifies under
+// its own seed and key ID, while a legacy scheme ignores both.
+func TestTweakedKey(t *testing.T) {
+	s := SCHEME_SHA256_TWEAKED
+	pri, pub, err := s.GenerateTweakedKey(nil, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := s.GetMessage([]byte("tweaked"))
+	sig, err := s.Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.VerifyTweaked(msg, pub, sig) {
+		t.Fatalf("VerifyTweaked returned false, expected true")
+	}
+	if s.VerifyTweaked(s.GetMessage([]byte("other")), pub, sig) {
+		t.Fatalf("VerifyTweaked returned true for the wrong message")
+	}
+	moved := pub
+	moved.KeyID++
+	if s.VerifyTweaked(msg, moved, sig) {
+		t.Fatalf("VerifyTweaked returned true under another key ID")
+	}
+	moved = pub
+	moved.PubSeed[0] ^= 1
+	if s.VerifyTweaked(msg, moved, sig) {
+		t.Fatalf("VerifyTweaked returned true under another seed")
+	}
+	if Verify(msg, pub.Key, sig) || s.Verify(msg, pub.Key, sig) {
+		t.Fatalf("tweaked key verified without its tweak")
+	}
+
+	// the default scheme hashes plain blocks whatever the tweak
+	legacy := SCHEME_SHA256.TweakedPublicKey(pri, pub.PubSeed, 5)
+	if legacy.Key != pri.GetPublicKey() {
+		t.Fatalf("SCHEME_SHA256 tweaked pubkey differs from GetPublicKey")
+	}
+	if !SCHEME_SHA256.VerifyTweaked(msg, legacy, sig) || !Verify(msg, legacy.Key, sig) {
+		t.Fatalf("legacy tweaked pubkey doesn't verify")
+	}
+
+	if _, _, err := s.GenerateTweakedKey(bytes.NewReader(make([]byte, PRIVKEY_BYTES)), 0); err == nil {
+		t.Fatalf("GenerateTweakedKey worked with no bytes left for the seed")
+	}
+}
+
+// TestTweakedPublicKeyMarshal round trips a tweaked pubkey and checks it
+// won't load under another scheme.
+func TestTweakedPublicKeyMarshal(t *testing.T) {
+	s := SCHEME_SHA256_TWEAKED
+	_, pub, err := s.GenerateTweakedKey(nil, 0x01020304)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := s.MarshalTweakedPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != CONTAINER_HEADER_BYTES+TWEAKED_PUBKEY_BYTES {
+		t.Fatalf("got %d bytes, expect %d", len(data),
+			CONTAINER_HEADER_BYTES+TWEAKED_PUBKEY_BYTES)
+	}
+	back, err := s.ParseTweakedPublicKey(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back != pub {
+		t.Fatalf("tweaked pubkey round trip differs")
+	}
+	var mismatch SchemeMismatchError
+	if _, err := SCHEME_SHA256.ParseTweakedPublicKey(data); !errors.As(err, &mismatch) {
+		t.Fatalf("got %v, expect SchemeMismatchError", err)
+	}
+}
@@ -0,0 +1,112 @@
+package lamport
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WeakKeyError lists what's wrong with a pubkey that fails Validate.  Blocks
+// are named like the text encoding does, zero[i] and one[i].
+type WeakKeyError struct {
+	// bits whose two rows are the same block, so a signature says nothing
+	// about that bit
+	SameBothRows []int
+	// blocks that are all zeros, most likely never filled in
+	ZeroBlocks []string
+	// blocks equal to some other block at a different bit
+	Repeated []string
+}
+
+func (self *WeakKeyError) Error() string {
+	var parts []string
+	if len(self.SameBothRows) > 0 {
+		parts = append(parts, fmt.Sprintf("same block in both rows at bits %v",
+			self.SameBothRows))
+	}
+	if len(self.ZeroBlocks) > 0 {
+		parts = append(parts, "zero blocks "+strings.Join(self.ZeroBlocks, " "))
+	}
+	if len(self.Repeated) > 0 {
+		parts = append(parts, "repeated blocks "+strings.Join(self.Repeated, " "))
+	}
+	return "weak key: " + strings.Join(parts, "; ")
+}
+
+// Is makes WeakKeyError match ErrWeakKey.
+func (self *WeakKeyError) Is(target error) bool {
+	return target == ErrWeakKey
+}
+
+// Validate checks the pubkey can't give ambiguous signatures: no bit has the
+// same block in both rows, no block is all zeros, and no block appears more
+// than once anywhere in the key.  None of these happen with a real key
+// except by breaking sha256, so any of them means a buggy keygen or a key
+// built to cheat.  The error is a *WeakKeyError.
+func (self PublicKey) Validate() error {
+	var werr WeakKeyError
+	seen := make(map[Block]string, 2*MESSAGE_BITS)
+
+	check := func(name string, block Block) {
+		if block == (Block{}) {
+			werr.ZeroBlocks = append(werr.ZeroBlocks, name)
+			return
+		}
+		if _, ok := seen[block]; ok {
+			werr.Repeated = append(werr.Repeated, name)
+			return
+		}
+		seen[block] = name
+	}
+	for i := range self.ZeroHash {
+		check(fmt.Sprintf("zero[%d]", i), self.ZeroHash[i])
+	}
+	for i := range self.OneHash {
+		if self.OneHash[i] == self.ZeroHash[i] && self.OneHash[i] != (Block{}) {
+			// reported here rather than as a repeat
+			werr.SameBothRows = append(werr.SameBothRows, i)
+			continue
+		}
+		check(fmt.Sprintf("one[%d]", i), self.OneHash[i])
+	}
+
+	if werr.SameBothRows == nil && werr.ZeroBlocks == nil && werr.Repeated == nil {
+		return nil
+	}
+	return &werr
+}
+
+// VerifyStrict is VerifyDetailed on a pubkey that has to pass Validate
+// first, for keys that come from somewhere untrusted.
+func VerifyStrict(msg Message, pub PublicKey, sig Signature) error {
+	err := pub.Validate()
+	if err != nil {
+		return err
+	}
+	return VerifyDetailed(msg, pub, sig)
+}
+
+// PubkeyFromBytesStrict is PubkeyFromBytes followed by Validate.
+func PubkeyFromBytesStrict(b []byte) (PublicKey, error) {
+	pub, err := PubkeyFromBytes(b)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	err = pub.Validate()
+	if err != nil {
+		return PublicKey{}, err
+	}
+	return pub, nil
+}
+
+// HexToPubkeyStrict is HexToPubkey followed by Validate.
+func HexToPubkeyStrict(s string) (PublicKey, error) {
+	pub, err := HexToPubkey(s)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	err = pub.Validate()
+	if err != nil {
+		return PublicKey{}, err
+	}
+	return pub, nil
+}
@@ -0,0 +1,84 @@
+package lamport
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestValidate checks real keys pass and a deliberately weak one is caught
+// with every problem listed.
+func TestValidate(t *testing.T) {
+	pub, err := HexToPubkey(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pub.Validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = HexToPubkeyStrict(hexPubkey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	weak := pub
+	weak.OneHash[3] = weak.ZeroHash[3]
+	weak.OneHash[200] = weak.ZeroHash[200]
+	weak.ZeroHash[10] = Block{}
+	weak.OneHash[50] = weak.ZeroHash[7]
+
+	err = weak.Validate()
+	if !errors.Is(err, ErrWeakKey) {
+		t.Fatalf("got %v, expect ErrWeakKey", err)
+	}
+	var werr *WeakKeyError
+	if !errors.As(err, &werr) {
+		t.Fatalf("got %v, expect a *WeakKeyError", err)
+	}
+	if !reflect.DeepEqual(werr.SameBothRows, []int{3, 200}) {
+		t.Fatalf("SameBothRows %v, expect [3 200]", werr.SameBothRows)
+	}
+	if !reflect.DeepEqual(werr.ZeroBlocks, []string{"zero[10]"}) {
+		t.Fatalf("ZeroBlocks %v, expect [zero[10]]", werr.ZeroBlocks)
+	}
+	if !reflect.DeepEqual(werr.Repeated, []string{"one[50]"}) {
+		t.Fatalf("Repeated %v, expect [one[50]]", werr.Repeated)
+	}
+
+	_, err = HexToPubkeyStrict(weak.ToHex())
+	if !errors.Is(err, ErrWeakKey) {
+		t.Fatalf("HexToPubkeyStrict: got %v, expect ErrWeakKey", err)
+	}
+	_, err = PubkeyFromBytesStrict(weak.Bytes())
+	if !errors.Is(err, ErrWeakKey) {
+		t.Fatalf("PubkeyFromBytesStrict: got %v, expect ErrWeakKey", err)
+	}
+}
+
+// TestVerifyStrict checks VerifyStrict refuses a weak key even with a
+// signature that verifies under it.
+func TestVerifyStrict(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("strict")
+	err = VerifyStrict(msg, pub, mustSign(t, msg, pri))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// same preimage in both rows of bit 0: a signature on either value of
+	// bit 0 verifies
+	pri.OneHash[0] = pri.ZeroHash[0]
+	pub = pri.GetPublicKey()
+	sig := mustSign(t, msg, pri)
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	err = VerifyStrict(msg, pub, sig)
+	if !errors.Is(err, ErrWeakKey) {
+		t.Fatalf("got %v, expect ErrWeakKey", err)
+	}
+}
@@ -0,0 +1,39 @@
+package lamport
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// The provided pubkey and signatures live in the assignment package now,
+// which imports this one, so the tests here read their own copy of them from
+// testdata/assignment.json.  The names are the ones from signatures.go.
+var (
+	hexPubkey1    string
+	hexSignature1 string
+	hexSignature2 string
+	hexSignature3 string
+	hexSignature4 string
+)
+
+func init() {
+	data, err := os.ReadFile("testdata/assignment.json")
+	if err != nil {
+		panic(err)
+	}
+	var f struct {
+		Pubkey     string `json:"pubkey"`
+		Signatures []struct {
+			Signature string `json:"signature"`
+		} `json:"signatures"`
+	}
+	err = json.Unmarshal(data, &f)
+	if err != nil {
+		panic(err)
+	}
+	hexPubkey1 = f.Pubkey
+	hexSignature1 = f.Signatures[0].Signature
+	hexSignature2 = f.Signatures[1].Signature
+	hexSignature3 = f.Signatures[2].Signature
+	hexSignature4 = f.Signatures[3].Signature
+}
@@ -0,0 +1,57 @@
+package lamport
+
+import (
+	"fmt"
+)
+
+// VerifyError is what VerifyDetailed returns for a signature that doesn't
+// verify.  Bit is the first message bit whose preimage is wrong, Expect is
+// the pubkey block for that bit and Actual is the hash of the preimage that
+// was given.  Mismatches counts all the bad bits, which helps tell a single
+// corrupted block (1) from an encoding mixup (around 256).
+type VerifyError struct {
+	Bit        int
+	Expect     Block
+	Actual     Block
+	Mismatches int
+}
+
+func (self *VerifyError) Error() string {
+	return fmt.Sprintf(
+		"signature fails at bit %d (%d of %d bits bad): hash %x, expect %x",
+		self.Bit, self.Mismatches, MESSAGE_BITS, self.Actual, self.Expect)
+}
+
+// Is makes VerifyError match ErrInvalidSignature.
+func (self *VerifyError) Is(target error) bool {
+	return target == ErrInvalidSignature
+}
+
+// VerifyDetailed is Verify, but returns a *VerifyError describing the
+// failure instead of false, and nil instead of true.  It checks every bit
+// rather than stopping at the first bad one, so use Verify when only the
+// answer matters.  An all zero pubkey is ErrZeroKey.
+func VerifyDetailed(msg Message, pub PublicKey, sig Signature) error {
+	if pub.IsZero() {
+		return fmt.Errorf("%w: pubkey", ErrZeroKey)
+	}
+	var verr *VerifyError
+	for i := 0; i < MESSAGE_BITS; i++ {
+		expect := pub.ZeroHash[i]
+		if msg.Bit(i) == 1 {
+			expect = pub.OneHash[i]
+		}
+		actual := sig.Preimage[i].Hash()
+		if actual.Equal(expect) {
+			continue
+		}
+		if verr == nil {
+			verr = &VerifyError{Bit: i, Expect: expect, Actual: actual}
+		}
+		verr.Mismatches++
+	}
+	if verr != nil {
+		return verr
+	}
+	return nil
+}
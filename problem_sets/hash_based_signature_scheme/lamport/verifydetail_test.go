@@ -0,0 +1,56 @@
+package lamport
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestVerifyDetailed breaks one block of a good signature and checks the
+// error points at it.
+func TestVerifyDetailed(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := GetMessageFromString("detailed")
+	sig := mustSign(t, msg, pri)
+
+	err = VerifyDetailed(msg, pub, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, bit := range []int{0, 77, 255} {
+		bad := sig
+		bad.Preimage[bit] = bad.Preimage[bit].Hash()
+		err = VerifyDetailed(msg, pub, bad)
+		var verr *VerifyError
+		if !errors.As(err, &verr) {
+			t.Fatalf("got %v, expect a *VerifyError", err)
+		}
+		if verr.Bit != bit || verr.Mismatches != 1 {
+			t.Fatalf("got bit %d with %d mismatches, expect bit %d with 1",
+				verr.Bit, verr.Mismatches, bit)
+		}
+		if verr.Actual != bad.Preimage[bit].Hash() {
+			t.Fatalf("Actual isn't the hash of the bad preimage")
+		}
+		expect := pub.ZeroHash[bit]
+		if msg[bit/8]>>(7-(bit%8))&0x01 == 1 {
+			expect = pub.OneHash[bit]
+		}
+		if verr.Expect != expect {
+			t.Fatalf("Expect isn't the pubkey block for bit %d", bit)
+		}
+	}
+
+	// the wrong message gets about half the bits wrong
+	err = VerifyDetailed(GetMessageFromString("other"), pub, sig)
+	var verr *VerifyError
+	if !errors.As(err, &verr) {
+		t.Fatalf("got %v, expect a *VerifyError", err)
+	}
+	if verr.Mismatches < 64 || verr.Mismatches > 192 {
+		t.Fatalf("%d mismatches for a different message", verr.Mismatches)
+	}
+}
@@ -0,0 +1,63 @@
+package lamport
+
+// Zeroize overwrites both rows of the private key with zeros.  Go can't
+// promise no other copies are lying around (every value receiver and
+// argument is one), but this clears the one you have.  It's fine to call
+// more than once.
+func (self *PrivateKey) Zeroize() {
+	for i := range self.ZeroHash {
+		self.ZeroHash[i] = Block{}
+	}
+	for i := range self.OneHash {
+		self.OneHash[i] = Block{}
+	}
+}
+
+// IsZero reports whether every block of the private key is zero, as it is
+// after Zeroize or for a PrivateKey{} that was never filled in.
+func (self PrivateKey) IsZero() bool {
+	var acc byte
+	for i := range self.ZeroHash {
+		for j := range self.ZeroHash[i] {
+			acc |= self.ZeroHash[i][j] | self.OneHash[i][j]
+		}
+	}
+	return acc == 0
+}
+
+// IsZero reports whether every block of the pubkey is zero.  A real pubkey
+// never is, so this is one that was never filled in.
+func (self PublicKey) IsZero() bool {
+	var acc byte
+	for i := range self.ZeroHash {
+		for j := range self.ZeroHash[i] {
+			acc |= self.ZeroHash[i][j] | self.OneHash[i][j]
+		}
+	}
+	return acc == 0
+}
+
+// IsZero reports whether every block of the signature is zero, which is what
+// signing with a zeroized key used to produce.
+func (self Signature) IsZero() bool {
+	var acc byte
+	for i := range self.Preimage {
+		for j := range self.Preimage[i] {
+			acc |= self.Preimage[i][j]
+		}
+	}
+	return acc == 0
+}
+
+// zeroizeUnrevealed wipes the blocks of pri that a signature on msg doesn't
+// reveal: the one row for each bit of msg.  What's left is exactly the
+// signature on msg.
+func zeroizeUnrevealed(pri *PrivateKey, msg Message) {
+	for i := 0; i < MESSAGE_BITS; i++ {
+		if msg.Bit(i) == 0 {
+			pri.OneHash[i] = Block{}
+		} else {
+			pri.ZeroHash[i] = Block{}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package lamport
+
+import (
+	"testing"
+)
+
+// TestZeroize wipes a key and checks every byte of both rows is zero.
+func TestZeroize(t *testing.T) {
+	pri, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri.IsZero() {
+		t.Fatalf("new key IsZero")
+	}
+
+	pri.Zeroize()
+	for i := range pri.ZeroHash {
+		if pri.ZeroHash[i] != (Block{}) || pri.OneHash[i] != (Block{}) {
+			t.Fatalf("block %d not zeroed", i)
+		}
+	}
+	if !pri.IsZero() {
+		t.Fatalf("zeroized key not IsZero")
+	}
+	pri.Zeroize()
+	if !pri.IsZero() {
+		t.Fatalf("zeroizing twice un-zeroed the key")
+	}
+
+	// one stray byte is enough to not be zero
+	pri.OneHash[255][31] = 1
+	if pri.IsZero() {
+		t.Fatalf("key with a nonzero byte IsZero")
+	}
+}
+
+// TestSignerWipeUnused signs with WipeUnused and checks only the revealed
+// blocks survive, and that a zeroized Signer refuses to sign.
+func TestSignerWipeUnused(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSigner(pri)
+	s.AllowResign = true
+	s.WipeUnused = true
+
+	msg := GetMessageFromString("wipe")
+	sig, err := s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < MESSAGE_BITS; i++ {
+		revealed, unrevealed := s.pri.ZeroHash[i], s.pri.OneHash[i]
+		if msg[i/8]>>(7-(i%8))&0x01 == 1 {
+			revealed, unrevealed = unrevealed, revealed
+		}
+		if unrevealed != (Block{}) {
+			t.Fatalf("unrevealed block %d not wiped", i)
+		}
+		if revealed != sig.Preimage[i] {
+			t.Fatalf("revealed block %d changed", i)
+		}
+	}
+
+	sig2, err := s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(msg, pub, sig2) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+
+	s.Zeroize()
+	if !s.pri.IsZero() {
+		t.Fatalf("Signer.Zeroize left key material")
+	}
+	_, err = NewSigner(PrivateKey{}).Sign(msg)
+	if err != ErrZeroKey {
+		t.Fatalf("got %v, expect ErrZeroKey", err)
+	}
+}
@@ -0,0 +1,231 @@
+// Package mss is the Merkle signature scheme: a many-time key made of a tree
+// of one-time keys.  A signer derives 2^h WOTS key pairs from a seed and
+// publishes only the 32 byte root of a Merkle tree over the hashes of their
+// pubkeys.  Each signature uses the next unused leaf and carries the WOTS
+// signature, the leaf's index and pubkey, and the sibling hashes needed to
+// climb from the leaf to the root.
+//
+// Leaves are the L-tree root of the WOTS pubkey's chain ends (see
+// wots.LTreeRoot), and interior nodes are sha256(left || right).
+package mss
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"ps/01/lamport"
+	"ps/01/wots"
+)
+
+/*
+A MerkleSignature encodes as
+
+    signature  wots.SIGNATURE_BYTES
+    pubkey     wots.PUBKEY_BYTES
+    path       the rest, as lamport.AuthPath.Bytes
+*/
+
+// MAX_HEIGHT is the tallest tree NewMerkleSigner will build: 65536 leaves.
+const MAX_HEIGHT = 16
+
+var ErrTreeExhausted = errors.New("merkle signer: every leaf has been used")
+
+// MerkleSignature is a WOTS signature by leaf Path.Index of the tree, along
+// with what it takes to get from there to the root.
+type MerkleSignature struct {
+	Signature wots.WotsSignature
+	LeafPub   wots.WotsPublicKey
+	Path      lamport.AuthPath
+}
+
+// MerkleSigner holds a tree of one-time keys and signs with each leaf once,
+// in order.  It keeps only a secret seed the leaf keys are derived from and
+// the traversal state that produces each auth path in turn (see
+// traversal.go), so signing doesn't rebuild the tree.  It's safe to call
+// from several goroutines.
+type MerkleSigner struct {
+	mu     sync.Mutex
+	seed   [32]byte
+	height int
+	retain int
+	root   lamport.Block
+	next   int
+	// the auth path for leaf next
+	auth []lamport.Block
+	// one treehash per level below the retained ones
+	stacks []treehash
+	// every node of the top retain levels, lowest level first
+	kept [][]lamport.Block
+	// leaves computed since keygen, for benchmarks
+	leaves int
+	// the last leaf is the PoP leaf, which Sign doesn't use, and whether
+	// it's proved yet
+	pop     bool
+	popUsed bool
+}
+
+// NewMerkleSigner generates a tree of 2^height keys from crypto/rand.
+func NewMerkleSigner(height int) (*MerkleSigner, error) {
+	return NewMerkleSignerFrom(rand.Reader, height)
+}
+
+// NewMerkleSignerFrom generates a tree of 2^height keys, reading the 32 byte
+// seed they're derived from from r.
+func NewMerkleSignerFrom(r io.Reader, height int) (*MerkleSigner, error) {
+	return NewMerkleSignerWith(r, height, Traversal{})
+}
+
+// leafKey derives the private key of leaf i: chain j starts at
+// sha256(seed || i || j), both 4 bytes big endian.
+func leafKey(seed [32]byte, i int) wots.WotsPrivateKey {
+	var pri wots.WotsPrivateKey
+	var buf [32 + 8]byte
+	copy(buf[:], seed[:])
+	binary.BigEndian.PutUint32(buf[32:], uint32(i))
+	for j := range pri.Chains {
+		binary.BigEndian.PutUint32(buf[36:], uint32(j))
+		pri.Chains[j] = sha256.Sum256(buf[:])
+	}
+	return pri
+}
+
+// leaf computes the tree's leaf i from scratch.
+func (self *MerkleSigner) leaf(i int) lamport.Block {
+	self.leaves++
+	return leafHash(leafKey(self.seed, i).GetPublicKey())
+}
+
+// Height returns the height of the tree.
+func (self *MerkleSigner) Height() int {
+	return self.height
+}
+
+// Root returns the root of the tree, which is the public key.
+func (self *MerkleSigner) Root() [32]byte {
+	return self.root
+}
+
+// Remaining returns the number of leaves that haven't signed yet, not
+// counting a PoP leaf.
+func (self *MerkleSigner) Remaining() int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.signable() - self.next
+}
+
+// signable returns how many leaves, from the first, Sign may use: all of
+// them, or all but the PoP leaf.  It's called with mu held.
+func (self *MerkleSigner) signable() int {
+	if self.pop {
+		return 1<<self.height - 1
+	}
+	return 1 << self.height
+}
+
+// Sign signs msg with the next unused leaf and moves the traversal on to
+// the leaf after it.  Once every leaf but a PoP leaf has signed it's
+// ErrTreeExhausted.
+func (self *MerkleSigner) Sign(msg lamport.Message) (MerkleSignature, error) {
+	self.mu.Lock()
+	if self.next >= self.signable() {
+		self.mu.Unlock()
+		return MerkleSignature{}, ErrTreeExhausted
+	}
+	index := self.next
+	path := lamport.AuthPath{Index: uint32(index), Height: self.height,
+		Siblings: append([]lamport.Block(nil), self.auth...)}
+	pri := leafKey(self.seed, index)
+	self.next++
+	self.advance()
+	self.mu.Unlock()
+
+	return MerkleSignature{
+		Signature: wots.Sign(msg, pri),
+		LeafPub:   pri.GetPublicKey(),
+		Path:      path,
+	}, nil
+}
+
+// VerifyMerkle checks msig on msg against the tree with the given root and
+// height.  The height comes from the key, not the signature, so a path for
+// a shorter or taller tree is refused before it's climbed.  It returns nil
+// for a good signature and wraps lamport.ErrInvalidSignature otherwise.
+func VerifyMerkle(root [32]byte, height int, msg lamport.Message, msig MerkleSignature) error {
+	if msig.Path.Height != height {
+		return fmt.Errorf("%w: path height %d, expect %d", lamport.ErrInvalidSignature,
+			msig.Path.Height, height)
+	}
+	err := msig.Path.Validate()
+	if err != nil {
+		return fmt.Errorf("%w: %v", lamport.ErrInvalidSignature, err)
+	}
+	if !wots.Verify(msg, msig.LeafPub, msig.Signature) {
+		return fmt.Errorf("%w: leaf %d signature", lamport.ErrInvalidSignature,
+			msig.Path.Index)
+	}
+	if msig.Path.ComputeRoot(leafHash(msig.LeafPub)) != root {
+		return fmt.Errorf("%w: leaf %d isn't in the tree", lamport.ErrInvalidSignature,
+			msig.Path.Index)
+	}
+	return nil
+}
+
+func leafHash(pub wots.WotsPublicKey) lamport.Block {
+	return pub.LTreeRoot()
+}
+
+// Bytes returns the signature's binary encoding.
+func (self MerkleSignature) Bytes() []byte {
+	b := make([]byte, 0, wots.SIGNATURE_BYTES+wots.PUBKEY_BYTES+5+
+		len(self.Path.Siblings)*wots.N)
+	b = append(b, self.Signature.Bytes()...)
+	b = append(b, self.LeafPub.Bytes()...)
+	return append(b, self.Path.Bytes()...)
+}
+
+// SignatureFromBytes is the inverse of MerkleSignature.Bytes.  It checks the
+// path is valid, but not the signature itself.
+func SignatureFromBytes(b []byte) (MerkleSignature, error) {
+	const ots = wots.SIGNATURE_BYTES + wots.PUBKEY_BYTES
+	if len(b) < ots {
+		return MerkleSignature{}, fmt.Errorf("%w: MerkleSignature %d bytes, expect at least %d",
+			lamport.ErrWrongLength, len(b), ots)
+	}
+	var msig MerkleSignature
+	var err error
+	msig.Signature, err = wots.SignatureFromBytes(b[:wots.SIGNATURE_BYTES])
+	if err != nil {
+		return MerkleSignature{}, err
+	}
+	msig.LeafPub, err = wots.PubkeyFromBytes(b[wots.SIGNATURE_BYTES:ots])
+	if err != nil {
+		return MerkleSignature{}, err
+	}
+	msig.Path, err = lamport.AuthPathFromBytes(b[ots:])
+	if err != nil {
+		return MerkleSignature{}, err
+	}
+	return msig, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the layout of
+// Bytes().
+func (self MerkleSignature) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  On error the
+// receiver is left untouched.
+func (self *MerkleSignature) UnmarshalBinary(data []byte) error {
+	msig, err := SignatureFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = msig
+	return nil
+}
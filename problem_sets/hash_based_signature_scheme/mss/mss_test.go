@@ -0,0 +1,196 @@
+package mss
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestSignAllLeaves signs with every leaf of a small tree, checks each
+// signature, and checks the signer refuses once the leaves run out.
+func TestSignAllLeaves(t *testing.T) {
+	signer, err := NewMerkleSigner(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := signer.Root()
+	for i := 0; i < 8; i++ {
+		msg := lamport.GetMessageFromString(fmt.Sprint(i))
+		msig, err := signer.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msig.Path.Index != uint32(i) || msig.Path.Height != 3 {
+			t.Fatalf("got leaf %d of height %d, expect leaf %d of 3",
+				msig.Path.Index, msig.Path.Height, i)
+		}
+		err = VerifyMerkle(root, 3, msg, msig)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if signer.Remaining() != 0 {
+		t.Fatalf("got %d leaves remaining, expect 0", signer.Remaining())
+	}
+	_, err = signer.Sign(lamport.GetMessageFromString("more"))
+	if !errors.Is(err, ErrTreeExhausted) {
+		t.Fatalf("got %v, expect ErrTreeExhausted", err)
+	}
+}
+
+// TestTampered checks a signature is rejected with a changed path, index,
+// leaf pubkey or message, or against another root.
+func TestTampered(t *testing.T) {
+	signer, err := NewMerkleSigner(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := signer.Root()
+	signer.Sign(lamport.GetMessageFromString("skip"))
+	msg := lamport.GetMessageFromString("tamper")
+	msig, err := signer.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyMerkle(root, 4, msg, msig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := []func(m *MerkleSignature){
+		func(m *MerkleSignature) { m.Path.Siblings[2][0] ^= 1 },
+		func(m *MerkleSignature) {
+			s := m.Path.Siblings
+			s[0], s[1] = s[1], s[0]
+		},
+		func(m *MerkleSignature) { m.Path.Siblings = m.Path.Siblings[:3] },
+		func(m *MerkleSignature) {
+			m.Path.Siblings = m.Path.Siblings[:3]
+			m.Path.Height = 3
+		},
+		func(m *MerkleSignature) { m.Path.Index = 0 },
+		func(m *MerkleSignature) { m.Path.Index = 16 },
+		func(m *MerkleSignature) { m.LeafPub.Chains[0][0] ^= 1 },
+	}
+	for i, tamper := range tampered {
+		m := msig
+		m.Path.Siblings = append([]lamport.Block(nil), msig.Path.Siblings...)
+		tamper(&m)
+		err = VerifyMerkle(root, 4, msg, m)
+		if !errors.Is(err, lamport.ErrInvalidSignature) {
+			t.Fatalf("tamper %d: got %v, expect ErrInvalidSignature", i, err)
+		}
+	}
+	err = VerifyMerkle(root, 4, lamport.GetMessageFromString("other"), msig)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("other message: got %v, expect ErrInvalidSignature", err)
+	}
+	other, err := NewMerkleSigner(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyMerkle(other.Root(), 4, msg, msig)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("other root: got %v, expect ErrInvalidSignature", err)
+	}
+}
+
+// TestPathHeight checks a signature whose path is cut down to a subtree
+// isn't taken against that subtree's node: the height is the key's, not
+// the signature's.
+func TestPathHeight(t *testing.T) {
+	signer, err := NewMerkleSigner(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("subtree")
+	msig, err := signer.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	short := msig
+	short.Path.Height = 2
+	short.Path.Siblings = msig.Path.Siblings[:2]
+	node := short.Path.ComputeRoot(leafHash(short.LeafPub))
+	err = VerifyMerkle(node, 2, msg, short)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyMerkle(node, 4, msg, short)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+	err = VerifyMerkle(signer.Root(), 3, msg, msig)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("got %v, expect ErrInvalidSignature", err)
+	}
+}
+
+// TestHeights checks the smallest and largest trees that get used, and
+// that heights out of range are refused.
+func TestHeights(t *testing.T) {
+	for _, h := range []int{0, 10} {
+		signer, err := NewMerkleSigner(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signer.Height() != h || signer.Remaining() != 1<<h {
+			t.Fatalf("height %d: got height %d and %d leaves", h,
+				signer.Height(), signer.Remaining())
+		}
+		msg := lamport.GetMessageFromString("height")
+		msig, err := signer.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = VerifyMerkle(signer.Root(), signer.Height(), msg, msig)
+		if err != nil {
+			t.Fatalf("height %d: %v", h, err)
+		}
+	}
+	for _, h := range []int{-1, MAX_HEIGHT + 1} {
+		if _, err := NewMerkleSigner(h); err == nil {
+			t.Fatalf("NewMerkleSigner(%d) returned nil, expected an error", h)
+		}
+	}
+}
+
+// TestSignatureEncoding round trips a signature and checks truncated ones
+// are refused.
+func TestSignatureEncoding(t *testing.T) {
+	signer, err := NewMerkleSigner(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("encode")
+	msig, err := signer.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msig.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msig2 MerkleSignature
+	err = msig2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyMerkle(signer.Root(), signer.Height(), msg, msig2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []int{0, 4, len(data) - 1} {
+		err = msig2.UnmarshalBinary(data[:n])
+		if !errors.Is(err, lamport.ErrWrongLength) {
+			t.Fatalf("%d bytes: got %v, expect ErrWrongLength", n, err)
+		}
+	}
+	// leaf 4 of a tree of 4
+	data[len(data)-2*32-5+3] = 4
+	if _, err = SignatureFromBytes(data); !errors.Is(err, lamport.ErrInvalidAuthPath) {
+		t.Fatalf("got %v, expect ErrInvalidAuthPath", err)
+	}
+}
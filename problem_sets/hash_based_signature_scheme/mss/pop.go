@@ -0,0 +1,96 @@
+package mss
+
+import (
+	"fmt"
+
+	"ps/01/lamport"
+	"ps/01/wots"
+)
+
+/*
+Proof of possession for a Merkle key, the way lamport.ProvePossession does
+it for a KeyRing.  ReservePoPLeaf sets the tree's last leaf aside, Sign
+stops before it, and ProvePossession spends it on
+
+    lamport.GetMessage(lamport.POP_DOMAIN, challenge || root)
+
+The root is the whole pubkey, so the proof vouches for every leaf under it,
+not just the one that signed.
+*/
+
+// PoPMessage is what a Merkle key with the given root signs to answer c.
+// Package xmss uses it too.
+func PoPMessage(c lamport.Challenge, root [32]byte) lamport.Message {
+	return lamport.GetMessage(lamport.POP_DOMAIN, append(c[:], root[:]...))
+}
+
+// ReservePoPLeaf sets the tree's last leaf aside as its PoP leaf, so Sign
+// stops before it and ProvePossession can use it.  The tree needs at least
+// one other leaf, and the last leaf mustn't have signed yet.  Reserving it
+// again does nothing.
+func (self *MerkleSigner) ReservePoPLeaf() error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if self.height == 0 {
+		return fmt.Errorf("merkle signer: 1 leaf, a PoP leaf needs at least 2")
+	}
+	if self.pop {
+		return nil
+	}
+	if self.next == 1<<self.height {
+		return fmt.Errorf("%w: merkle signer leaf %d", lamport.ErrKeyAlreadyUsed,
+			1<<self.height-1)
+	}
+	self.pop = true
+	return nil
+}
+
+// PoPIndex returns the index of the tree's PoP leaf, its last, or -1 if it
+// hasn't reserved one.
+func (self *MerkleSigner) PoPIndex() int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if !self.pop {
+		return -1
+	}
+	return 1<<self.height - 1
+}
+
+// ProvePossession spends the PoP leaf on a proof for c.  Its auth path isn't
+// one the traversal keeps, so it's rebuilt, which costs about as much as
+// keygen did.  A signer without a PoP leaf is lamport.ErrNoPoPLeaf, and a
+// second proof is lamport.ErrKeyAlreadyUsed.
+func (self *MerkleSigner) ProvePossession(c lamport.Challenge) (MerkleSignature, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if !self.pop {
+		return MerkleSignature{}, lamport.ErrNoPoPLeaf
+	}
+	index := 1<<self.height - 1
+	if self.popUsed {
+		return MerkleSignature{}, fmt.Errorf("%w: merkle signer PoP leaf %d",
+			lamport.ErrKeyAlreadyUsed, index)
+	}
+	self.popUsed = true
+	pri := leafKey(self.seed, index)
+	return MerkleSignature{
+		Signature: wots.Sign(PoPMessage(c, self.root), pri),
+		LeafPub:   pri.GetPublicKey(),
+		Path: lamport.AuthPath{Index: uint32(index), Height: self.height,
+			Siblings: self.authPath(index)},
+	}, nil
+}
+
+// VerifyPossession checks proof answers c for the Merkle key with the given
+// root and height.  A proof by any leaf but the last, or one that doesn't
+// verify, as for a replayed proof, wraps lamport.ErrInvalidSignature.
+func VerifyPossession(c lamport.Challenge, root [32]byte, height int, proof MerkleSignature) error {
+	if height < 1 || height > MAX_HEIGHT {
+		return fmt.Errorf("merkle signer: height %d, expect 1 to %d", height, MAX_HEIGHT)
+	}
+	if proof.Path.Index != 1<<height-1 {
+		return fmt.Errorf("%w: proof by leaf %d, expect PoP leaf %d",
+			lamport.ErrInvalidSignature, proof.Path.Index, 1<<height-1)
+	}
+	return VerifyMerkle(root, height, PoPMessage(c, root), proof)
+}
@@ -0,0 +1,94 @@
+package mss
+
+import (
+	"errors"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestProvePossession reserves a PoP leaf, signs the tree dry, proves with
+// it, and checks the proof only answers its own challenge for its own root.
+// The signer is saved and loaded in between to check the reservation
+// survives it.
+func TestProvePossession(t *testing.T) {
+	for retain := 0; retain <= 3; retain += 3 {
+		signer, err := NewMerkleSignerWith(testSeed(3), 3, Traversal{Retain: retain})
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := lamport.NewChallenge()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := signer.ProvePossession(c); !errors.Is(err, lamport.ErrNoPoPLeaf) {
+			t.Fatalf("got %v, expect ErrNoPoPLeaf", err)
+		}
+		if err := signer.ReservePoPLeaf(); err != nil {
+			t.Fatal(err)
+		}
+		if signer.PoPIndex() != 7 || signer.Remaining() != 7 {
+			t.Fatalf("got PoP leaf %d and %d leaves left, expect 7 and 7",
+				signer.PoPIndex(), signer.Remaining())
+		}
+		data, err := signer.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var loaded MerkleSigner
+		if err := loaded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		// a signing leaf's signature on the same message isn't a proof
+		early, err := loaded.Sign(PoPMessage(c, loaded.Root()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyPossession(c, loaded.Root(), 3, early); !errors.Is(err, lamport.ErrInvalidSignature) {
+			t.Fatalf("signing leaf: got %v, expect ErrInvalidSignature", err)
+		}
+		msg := lamport.GetMessageFromString("pop")
+		for i := 1; i < 7; i++ {
+			if _, err := loaded.Sign(msg); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := loaded.Sign(msg); !errors.Is(err, ErrTreeExhausted) {
+			t.Fatalf("got %v, expect ErrTreeExhausted", err)
+		}
+
+		proof, err := loaded.ProvePossession(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root := loaded.Root()
+		if err := VerifyPossession(c, root, 3, proof); err != nil {
+			t.Fatalf("retain %d: %v", retain, err)
+		}
+		c2, err := lamport.NewChallenge()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyPossession(c2, root, 3, proof); !errors.Is(err, lamport.ErrInvalidSignature) {
+			t.Fatalf("replay: got %v, expect ErrInvalidSignature", err)
+		}
+		other, err := NewMerkleSignerFrom(testSeed(4), 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyPossession(c, other.Root(), 3, proof); !errors.Is(err, lamport.ErrInvalidSignature) {
+			t.Fatalf("other root: got %v, expect ErrInvalidSignature", err)
+		}
+		if _, err := loaded.ProvePossession(c); !errors.Is(err, lamport.ErrKeyAlreadyUsed) {
+			t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+		}
+	}
+
+	single, err := NewMerkleSignerFrom(testSeed(5), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := single.ReservePoPLeaf(); err == nil {
+		t.Fatalf("reserved a PoP leaf in a tree of 1 leaf")
+	}
+}
@@ -0,0 +1,389 @@
+package mss
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"ps/01/lamport"
+)
+
+/*
+Tree traversal, after Szydlo, "Merkle Tree Traversal in Log Space and Time".
+Keygen builds the whole tree once, keeping only the auth path for leaf 0
+and, for each level h, the left node at h.  After that every level has a
+treehash instance that computes, one leaf at a time, the node at h the auth
+path will need once the current one is used up.  Each signature spends at
+most 2h-1 leaf computations on whichever instance is furthest behind, which
+is enough for all of them to finish in time.
+
+Traversal.Retain keeps the top levels of the tree whole instead: 2^(r+1)-2
+nodes for Retain=r, and r fewer treehash instances to run, so each
+signature computes 2r fewer leaves.  Retain equal to the height keeps the
+whole tree.
+
+A MerkleSigner's state encodes as
+
+    seed      32 bytes, secret
+    height    1 byte
+    retain    1 byte, plus 0x80 if the last leaf is a PoP leaf and 0x40
+              once it's proved
+    next      4 bytes, big endian, the next unused leaf
+    root      32 bytes
+    auth      height times 32 bytes, the auth path for next
+    height-retain times, one per treehash, lowest level first:
+        next      4 bytes, big endian, its next leaf
+        done      1 byte, 0 or 1
+        node      32 bytes, the finished node if done
+        count     1 byte
+        count times, bottom of the stack first:
+            level  1 byte
+            node   32 bytes
+    every node of the top retain levels, lowest level first
+
+It holds the seed every leaf key comes from, so it's as secret as a private
+key, and it has to be saved after every Sign: loading an old copy signs
+with leaves that have already signed.
+*/
+
+// Traversal trades memory for signing time in a MerkleSigner.
+type Traversal struct {
+	// Retain keeps every node of the top Retain levels of the tree in
+	// memory, from 0 up to the height of the tree.
+	Retain int
+}
+
+// treehash computes one node at level, from 2^level leaves starting at
+// next, a leaf per update.
+type treehash struct {
+	level  int
+	next   int
+	done   bool
+	node   lamport.Block
+	stack  []lamport.Block
+	levels []int
+}
+
+// start sets the instance to compute the node whose first leaf is leaf.
+func (self *treehash) start(leaf int) {
+	self.next = leaf
+	self.done = false
+	self.stack = self.stack[:0]
+	self.levels = self.levels[:0]
+}
+
+// low is the level of the lowest node on the stack: how far behind this
+// instance is.
+func (self *treehash) low() int {
+	if self.done {
+		return math.MaxInt
+	}
+	if len(self.stack) == 0 {
+		return self.level
+	}
+	return self.levels[len(self.levels)-1]
+}
+
+// update computes the next leaf and merges it into the stack.
+func (self *treehash) update(signer *MerkleSigner) {
+	node := signer.leaf(self.next)
+	self.next++
+	level := 0
+	for n := len(self.stack); n > 0 && self.levels[n-1] == level; n-- {
+		node = lamport.MerkleParent(self.stack[n-1], node)
+		self.stack = self.stack[:n-1]
+		self.levels = self.levels[:n-1]
+		level++
+	}
+	if level == self.level {
+		self.done = true
+		self.node = node
+		return
+	}
+	self.stack = append(self.stack, node)
+	self.levels = append(self.levels, level)
+}
+
+// NewMerkleSignerWith generates a tree of 2^height keys, reading the 32 byte
+// seed they're derived from from r, and keeps as much of it as t says.
+func NewMerkleSignerWith(r io.Reader, height int, t Traversal) (*MerkleSigner, error) {
+	if height < 0 || height > MAX_HEIGHT {
+		return nil, fmt.Errorf("merkle signer: height %d, expect 0 to %d",
+			height, MAX_HEIGHT)
+	}
+	if t.Retain < 0 || t.Retain > height {
+		return nil, fmt.Errorf("merkle signer: retain %d levels, expect 0 to %d",
+			t.Retain, height)
+	}
+	signer := &MerkleSigner{height: height, retain: t.Retain}
+	_, err := io.ReadFull(r, signer.seed[:])
+	if err != nil {
+		return nil, fmt.Errorf("reading 32 byte seed: %w", err)
+	}
+	signer.build()
+	return signer, nil
+}
+
+// build computes every leaf to find the root, and keeps what the traversal
+// starts from.
+func (self *MerkleSigner) build() {
+	bottom := self.height - self.retain
+	self.auth = make([]lamport.Block, self.height)
+	self.stacks = make([]treehash, bottom)
+	for h := range self.stacks {
+		self.stacks[h].level = h
+	}
+	self.kept = make([][]lamport.Block, self.retain)
+	for i := range self.kept {
+		self.kept[i] = make([]lamport.Block, 1<<(self.height-bottom-i))
+	}
+
+	keep := func(level, index int, node lamport.Block) {
+		if level == self.height {
+			self.root = node
+			return
+		}
+		if index == 1 {
+			self.auth[level] = node
+		}
+		if level >= bottom {
+			self.kept[level-bottom][index] = node
+		} else if index == 0 {
+			self.stacks[level].done = true
+			self.stacks[level].node = node
+		}
+	}
+	var stack []lamport.Block
+	for i := 0; i < 1<<self.height; i++ {
+		node := self.leaf(i)
+		keep(0, i, node)
+		for level := 0; i>>level&1 == 1; level++ {
+			node = lamport.MerkleParent(stack[len(stack)-1], node)
+			stack = stack[:len(stack)-1]
+			keep(level+1, i>>(level+1), node)
+		}
+		stack = append(stack, node)
+	}
+	self.leaves = 0
+}
+
+// advance moves the auth path on from leaf next-1 to next, then spends the
+// signature's budget of leaf computations on the treehash instances.
+func (self *MerkleSigner) advance() {
+	if self.next == 1<<self.height {
+		return
+	}
+	bottom := self.height - self.retain
+	for h := 0; h < self.height && self.next%(1<<h) == 0; h++ {
+		if h >= bottom {
+			self.auth[h] = self.kept[h-bottom][self.next>>h^1]
+			continue
+		}
+		t := &self.stacks[h]
+		// never happens with the full budget, but a wrong auth path
+		// would be worse than a slow signature
+		for !t.done {
+			t.update(self)
+		}
+		self.auth[h] = t.node
+		if start := (self.next + 1<<h) ^ 1<<h; start < 1<<self.height {
+			t.start(start)
+		}
+	}
+
+	for budget := 2*bottom - 1; budget > 0; budget-- {
+		focus := -1
+		for h := range self.stacks {
+			low := self.stacks[h].low()
+			if low != math.MaxInt && (focus < 0 || low < self.stacks[focus].low()) {
+				focus = h
+			}
+		}
+		if focus < 0 {
+			break
+		}
+		self.stacks[focus].update(self)
+	}
+}
+
+// authPath computes the auth path for leaf index from scratch, taking nodes
+// from the retained levels where it can.  Below them it costs a leaf
+// computation for every leaf in the tree but index.
+func (self *MerkleSigner) authPath(index int) []lamport.Block {
+	bottom := self.height - self.retain
+	path := make([]lamport.Block, self.height)
+	for h := range path {
+		sibling := index>>h ^ 1
+		if h >= bottom {
+			path[h] = self.kept[h-bottom][sibling]
+			continue
+		}
+		t := treehash{level: h}
+		t.start(sibling << h)
+		for !t.done {
+			t.update(self)
+		}
+		path[h] = t.node
+	}
+	return path
+}
+
+// MemoryBytes returns roughly how many bytes the signer's state takes: the
+// auth path, the treehash stacks, and the retained levels.
+func (self *MerkleSigner) MemoryBytes() int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	nodes := len(self.auth)
+	for _, t := range self.stacks {
+		nodes += 1 + len(t.stack)
+	}
+	for _, level := range self.kept {
+		nodes += len(level)
+	}
+	return 32 + nodes*lamport.MESSAGE_BYTES
+}
+
+// MarshalBinary saves the signer's whole state, seed included, so it can
+// pick up where it left off without rebuilding the tree.
+func (self *MerkleSigner) MarshalBinary() ([]byte, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	var b bytes.Buffer
+	b.Write(self.seed[:])
+	b.WriteByte(byte(self.height))
+	flags := byte(self.retain)
+	if self.pop {
+		flags |= 0x80
+	}
+	if self.popUsed {
+		flags |= 0x40
+	}
+	b.WriteByte(flags)
+	binary.Write(&b, binary.BigEndian, uint32(self.next))
+	b.Write(self.root[:])
+	for _, node := range self.auth {
+		b.Write(node[:])
+	}
+	for _, t := range self.stacks {
+		binary.Write(&b, binary.BigEndian, uint32(t.next))
+		if t.done {
+			b.WriteByte(1)
+		} else {
+			b.WriteByte(0)
+		}
+		b.Write(t.node[:])
+		b.WriteByte(byte(len(t.stack)))
+		for i, node := range t.stack {
+			b.WriteByte(byte(t.levels[i]))
+			b.Write(node[:])
+		}
+	}
+	for _, level := range self.kept {
+		for _, node := range level {
+			b.Write(node[:])
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// UnmarshalBinary loads state saved by MarshalBinary.  On error the
+// receiver is left untouched.
+func (self *MerkleSigner) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	var s MerkleSigner
+	var header [32 + 1 + 1 + 4 + 32]byte
+	err := readFull(r, header[:])
+	if err != nil {
+		return err
+	}
+	copy(s.seed[:], header[:])
+	s.height = int(header[32])
+	s.retain = int(header[33] & 0x3f)
+	s.pop = header[33]&0x80 != 0
+	s.popUsed = header[33]&0x40 != 0
+	s.next = int(binary.BigEndian.Uint32(header[34:]))
+	copy(s.root[:], header[38:])
+	if s.height > MAX_HEIGHT || s.retain > s.height || s.next > s.signable() {
+		return fmt.Errorf("merkle signer: height %d, retain %d, next leaf %d",
+			s.height, s.retain, s.next)
+	}
+	if s.popUsed && !s.pop || s.pop && s.height == 0 {
+		return fmt.Errorf("merkle signer: PoP leaf flags %#x in a tree of height %d",
+			header[33]&0xc0, s.height)
+	}
+
+	bottom := s.height - s.retain
+	s.auth = make([]lamport.Block, s.height)
+	for i := range s.auth {
+		err = readFull(r, s.auth[i][:])
+		if err != nil {
+			return err
+		}
+	}
+	s.stacks = make([]treehash, bottom)
+	for h := range s.stacks {
+		t := &s.stacks[h]
+		t.level = h
+		var th [4 + 1 + 32 + 1]byte
+		err = readFull(r, th[:])
+		if err != nil {
+			return err
+		}
+		t.next = int(binary.BigEndian.Uint32(th[:]))
+		t.done = th[4] == 1
+		copy(t.node[:], th[5:])
+		count := int(th[37])
+		if th[4] > 1 || count > h || t.next > 1<<s.height {
+			return fmt.Errorf("merkle signer: treehash %d is corrupt", h)
+		}
+		for i := 0; i < count; i++ {
+			var entry [1 + 32]byte
+			err = readFull(r, entry[:])
+			if err != nil {
+				return err
+			}
+			t.levels = append(t.levels, int(entry[0]))
+			t.stack = append(t.stack, lamport.Block(entry[1:]))
+		}
+	}
+	s.kept = make([][]lamport.Block, s.retain)
+	for i := range s.kept {
+		s.kept[i] = make([]lamport.Block, 1<<(s.height-bottom-i))
+		for j := range s.kept[i] {
+			err = readFull(r, s.kept[i][j][:])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if r.Len() != 0 {
+		return fmt.Errorf("%w: %d bytes left over in merkle signer state",
+			lamport.ErrWrongLength, r.Len())
+	}
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.seed = s.seed
+	self.height = s.height
+	self.retain = s.retain
+	self.root = s.root
+	self.next = s.next
+	self.auth = s.auth
+	self.stacks = s.stacks
+	self.kept = s.kept
+	self.leaves = 0
+	self.pop = s.pop
+	self.popUsed = s.popUsed
+	return nil
+}
+
+func readFull(r io.Reader, b []byte) error {
+	_, err := io.ReadFull(r, b)
+	if err != nil {
+		return fmt.Errorf("%w: merkle signer state is truncated",
+			lamport.ErrWrongLength)
+	}
+	return nil
+}
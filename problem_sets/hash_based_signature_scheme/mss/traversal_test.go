@@ -0,0 +1,175 @@
+package mss
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// fullTree builds the whole tree for signer's seed the slow way.
+func fullTree(signer *MerkleSigner) [][]lamport.Block {
+	leaves := make([]lamport.Block, 1<<signer.height)
+	for i := range leaves {
+		leaves[i] = leafHash(leafKey(signer.seed, i).GetPublicKey())
+	}
+	return lamport.MerkleLevels(leaves)
+}
+
+func testSeed(b byte) *bytes.Reader {
+	return bytes.NewReader(bytes.Repeat([]byte{b}, 32))
+}
+
+// TestTraversalPaths checks every auth path the traversal produces against
+// the full tree, for every retain setting, and that no signature computes
+// more than its budget of 2(height-retain)-1 leaves.
+func TestTraversalPaths(t *testing.T) {
+	for height := 0; height <= 6; height++ {
+		for retain := 0; retain <= height; retain++ {
+			signer, err := NewMerkleSignerWith(testSeed(byte(height)), height,
+				Traversal{Retain: retain})
+			if err != nil {
+				t.Fatal(err)
+			}
+			levels := fullTree(signer)
+			if signer.Root() != levels[height][0] {
+				t.Fatalf("height %d retain %d: wrong root", height, retain)
+			}
+			budget := 2*(height-retain) - 1
+			if budget < 0 {
+				budget = 0
+			}
+			msg := lamport.GetMessageFromString("traverse")
+			for i := 0; i < 1<<height; i++ {
+				before := signer.leaves
+				msig, err := signer.Sign(msg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if n := signer.leaves - before; n > budget {
+					t.Fatalf("height %d retain %d leaf %d: computed %d leaves, budget %d",
+						height, retain, i, n, budget)
+				}
+				expect := lamport.NewAuthPath(levels, i)
+				for h := range expect.Siblings {
+					if msig.Path.Siblings[h] != expect.Siblings[h] {
+						t.Fatalf("height %d retain %d leaf %d: wrong sibling at level %d",
+							height, retain, i, h)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestTraversalRetain checks retaining more levels uses more memory, and
+// that retain out of range is refused.
+func TestTraversalRetain(t *testing.T) {
+	last := 0
+	for retain := 0; retain <= 6; retain += 3 {
+		signer, err := NewMerkleSignerWith(testSeed(1), 6, Traversal{Retain: retain})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signer.MemoryBytes() <= last {
+			t.Fatalf("retain %d uses %d bytes, no more than %d", retain,
+				signer.MemoryBytes(), last)
+		}
+		last = signer.MemoryBytes()
+	}
+	for _, retain := range []int{-1, 7} {
+		_, err := NewMerkleSignerWith(testSeed(1), 6, Traversal{Retain: retain})
+		if err == nil {
+			t.Fatalf("retain %d of height 6 returned nil, expected an error", retain)
+		}
+	}
+}
+
+// TestSignerState saves a signer part way through, loads it into a new one,
+// and checks both carry on with the same signatures.
+func TestSignerState(t *testing.T) {
+	signer, err := NewMerkleSignerWith(testSeed(7), 5, Traversal{Retain: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("state")
+	for i := 0; i < 11; i++ {
+		signer.Sign(msg)
+	}
+	data, err := signer.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded MerkleSigner
+	err = loaded.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Root() != signer.Root() || loaded.Remaining() != 21 {
+		t.Fatalf("loaded signer has %d leaves left, expect 21", loaded.Remaining())
+	}
+	for i := 11; i < 32; i++ {
+		msig, err := signer.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msig2, err := loaded.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(msig.Bytes(), msig2.Bytes()) {
+			t.Fatalf("leaf %d: loaded signer signed differently", i)
+		}
+		err = VerifyMerkle(loaded.Root(), loaded.Height(), msg, msig2)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if loaded.leaves > 32*2*4 {
+		t.Fatalf("loaded signer computed %d leaves, it shouldn't rebuild the tree",
+			loaded.leaves)
+	}
+
+	for _, n := range []int{0, 70, len(data) - 1} {
+		err = loaded.UnmarshalBinary(data[:n])
+		if !errors.Is(err, lamport.ErrWrongLength) {
+			t.Fatalf("%d bytes: got %v, expect ErrWrongLength", n, err)
+		}
+	}
+	err = loaded.UnmarshalBinary(append(data, 0))
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("trailing byte: got %v, expect ErrWrongLength", err)
+	}
+	if loaded.Remaining() != 0 {
+		t.Fatalf("failed UnmarshalBinary changed the signer")
+	}
+}
+
+// BenchmarkTraversal signs with trees of growing height, reporting how many
+// leaves each signature computes: it grows with the height, not with the
+// number of leaves.
+func BenchmarkTraversal(b *testing.B) {
+	msg := lamport.GetMessageFromString("benchmark")
+	for _, height := range []int{4, 8, 12} {
+		signer, err := NewMerkleSignerWith(testSeed(0), height, Traversal{})
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("h=%d", height), func(b *testing.B) {
+			leaves := 0
+			for i := 0; i < b.N; i++ {
+				if signer.Remaining() == 0 {
+					b.StopTimer()
+					signer, _ = NewMerkleSignerWith(testSeed(0), height, Traversal{})
+					b.StartTimer()
+				}
+				before := signer.leaves
+				signer.Sign(msg)
+				leaves += signer.leaves - before
+			}
+			b.ReportMetric(float64(leaves)/float64(b.N), "leaves/op")
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package sphincslite
+
+import (
+	"fmt"
+	"strings"
+
+	"ps/01/wots"
+)
+
+// HashCounts counts the hash calls of one operation by kind.  F and H are
+// the tweakable hashes of package xmss; each derives its key and masks with
+// PRF calls of its own, which PRF doesn't count, so one F is 3 SHA-256
+// calls and one H is 4.  Msg counts HMAC for R (2 SHA-256 calls) and the
+// digest (1).
+type HashCounts struct {
+	PRF int
+	F   int
+	H   int
+	Msg int
+}
+
+// SHA256 is the total number of SHA-256 calls.
+func (self HashCounts) SHA256() int {
+	return self.PRF + 3*self.F + 4*self.H + self.Msg
+}
+
+func (self HashCounts) String() string {
+	return fmt.Sprintf("%d PRF, %d F, %d H, %d SHA-256 in all",
+		self.PRF, self.F, self.H, self.SHA256())
+}
+
+// SignCounts is what Sign costs when none of the trees it needs are built
+// yet: a FORS key's K trees, and one XMSS tree per layer.  WOTS+ chains
+// are counted at their average, (w-1)/2 steps.
+func (self Params) SignCounts() HashCounts {
+	wp, _ := wots.NewWotsParams(self.W)
+	leaves := 1 << self.Height
+	// every FORS leaf's secret and F, its tree, the roots' L-tree, and the
+	// K secrets revealed
+	counts := HashCounts{
+		PRF: self.K*self.T + self.K,
+		F:   self.K * self.T,
+		H:   self.K*(self.T-1) + self.K - 1,
+		Msg: 3,
+	}
+	// per layer, every leaf's WOTS+ key, chains and L-tree, the tree, then
+	// the WOTS+ signature
+	counts.PRF += self.Layers * (leaves*wp.Len() + wp.Len())
+	counts.F += self.Layers * (leaves*wp.Len()*(self.W-1) + wp.Len()*(self.W-1)/2)
+	counts.H += self.Layers * (leaves*(wp.Len()-1) + leaves - 1)
+	return counts
+}
+
+// VerifyCounts is what Verify costs, with WOTS+ chains at their average.
+func (self Params) VerifyCounts() HashCounts {
+	wp, _ := wots.NewWotsParams(self.W)
+	return HashCounts{
+		F: self.K + self.Layers*wp.Len()*(self.W-1)/2,
+		H: self.K*self.fors().LogT() + self.K - 1 +
+			self.Layers*(wp.Len()-1+self.Height),
+		Msg: 1,
+	}
+}
+
+// Describe lays out what a signature with these params is made of and what
+// it costs to make and check.
+func (self Params) Describe() string {
+	err := self.Check()
+	if err != nil {
+		return err.Error()
+	}
+	wp, _ := wots.NewWotsParams(self.W)
+	logT := self.fors().LogT()
+	var b strings.Builder
+	fmt.Fprintf(&b, "sphincslite: %d layers of XMSS trees %d high with w=%d, "+
+		"2^%d FORS keys of %d trees of %d leaves\n",
+		self.Layers, self.Height, self.W, self.Layers*self.Height, self.K, self.T)
+	fmt.Fprintf(&b, "pubkey:    %6d bytes\n", PUBKEY_BYTES)
+	fmt.Fprintf(&b, "signature: %6d bytes\n", self.SignatureBytes())
+	fmt.Fprintf(&b, "  R          %6d\n", N)
+	fmt.Fprintf(&b, "  FORS       %6d  %d trees x (secret + %d auth nodes)\n",
+		self.fors().SignatureBytes(), self.K, logT)
+	fmt.Fprintf(&b, "  hypertree  %6d  %d layers x (%d WOTS+ values + %d auth nodes)\n",
+		self.SignatureBytes()-N-self.fors().SignatureBytes(),
+		self.Layers, wp.Len(), self.Height)
+	fmt.Fprintf(&b, "sign:   %v\n", self.SignCounts())
+	fmt.Fprintf(&b, "verify: %v\n", self.VerifyCounts())
+	return b.String()
+}
@@ -0,0 +1,169 @@
+package sphincslite
+
+import (
+	"fmt"
+	"math/bits"
+
+	"ps/01/lamport"
+	"ps/01/xmss"
+)
+
+/*
+Params encode as 5 bytes: layers, height, log2(w), log2(t) and k.  Then
+
+    pubkey      params || root || PUB_SEED
+    private key params || SK_SEED || SK_PRF || PUB_SEED || root
+    signature   R || FORS signature || per layer, bottom first, the WOTS+
+                signature then Height auth nodes
+
+A signature doesn't say what params it's for; its length comes from the
+pubkey's.
+*/
+
+const PARAMS_BYTES = 5
+const PUBKEY_BYTES = PARAMS_BYTES + 2*N
+const PRIVKEY_BYTES = PARAMS_BYTES + 4*N
+
+func (self Params) bytes() []byte {
+	return []byte{byte(self.Layers), byte(self.Height),
+		byte(bits.TrailingZeros(uint(self.W))),
+		byte(bits.TrailingZeros(uint(self.T))), byte(self.K)}
+}
+
+func paramsFromBytes(b []byte) (Params, error) {
+	if b[2] > 8 || b[3] > 16 {
+		return Params{}, fmt.Errorf("sphincslite: log2(w) %d and log2(t) %d, expect at most 8 and 16",
+			b[2], b[3])
+	}
+	p := Params{Layers: int(b[0]), Height: int(b[1]), W: 1 << b[2], T: 1 << b[3],
+		K: int(b[4])}
+	return p, p.Check()
+}
+
+// SignatureBytes is the size of a signature with these params.
+func (self Params) SignatureBytes() int {
+	return N + self.fors().SignatureBytes() + self.hyper().SignatureBytes() - 8 - N
+}
+
+// Bytes returns the pubkey's encoding, PUBKEY_BYTES long.
+func (self *PublicKey) Bytes() []byte {
+	b := make([]byte, 0, PUBKEY_BYTES)
+	b = append(b, self.Params.bytes()...)
+	b = append(b, self.Root[:]...)
+	return append(b, self.PubSeed[:]...)
+}
+
+// PubkeyFromBytes is the inverse of PublicKey.Bytes.
+func PubkeyFromBytes(b []byte) (*PublicKey, error) {
+	if len(b) != PUBKEY_BYTES {
+		return nil, fmt.Errorf("%w: sphincslite pubkey %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), PUBKEY_BYTES)
+	}
+	params, err := paramsFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	pub := &PublicKey{Params: params}
+	copy(pub.Root[:], b[PARAMS_BYTES:])
+	copy(pub.PubSeed[:], b[PARAMS_BYTES+N:])
+	return pub, nil
+}
+
+// Bytes returns the private key's encoding, PRIVKEY_BYTES long.
+func (self *PrivateKey) Bytes() []byte {
+	b := make([]byte, 0, PRIVKEY_BYTES)
+	b = append(b, self.Params.bytes()...)
+	b = append(b, self.SKSeed[:]...)
+	b = append(b, self.SKPRF[:]...)
+	b = append(b, self.PubSeed[:]...)
+	return append(b, self.Root[:]...)
+}
+
+// PrivkeyFromBytes is the inverse of PrivateKey.Bytes.  It rebuilds the top
+// tree, and checks its root is the one saved.
+func PrivkeyFromBytes(b []byte) (*PrivateKey, error) {
+	if len(b) != PRIVKEY_BYTES {
+		return nil, fmt.Errorf("%w: sphincslite private key %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), PRIVKEY_BYTES)
+	}
+	params, err := paramsFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	pri, _, err := GenerateKey(params, b[PARAMS_BYTES:PARAMS_BYTES+SEED_BYTES])
+	if err != nil {
+		return nil, err
+	}
+	if string(pri.Root[:]) != string(b[PARAMS_BYTES+SEED_BYTES:]) {
+		return nil, lamport.ErrKeyPairMismatch
+	}
+	return pri, nil
+}
+
+// Bytes returns the signature's encoding.
+func (self *Signature) Bytes() []byte {
+	b := append([]byte(nil), self.R[:]...)
+	b = append(b, self.Fors.Bytes()...)
+	// the hypertree part is a HyperSignature's encoding without its index
+	// and R
+	hyper := &xmss.HyperSignature{Layers: self.Layers}
+	return append(b, hyper.Bytes()[8+N:]...)
+}
+
+// SignatureFromBytes decodes a signature made with params.
+func SignatureFromBytes(params Params, b []byte) (*Signature, error) {
+	err := params.Check()
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != params.SignatureBytes() {
+		return nil, fmt.Errorf("%w: sphincslite signature %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), params.SignatureBytes())
+	}
+	sig := &Signature{}
+	copy(sig.R[:], b)
+	forsEnd := N + params.fors().SignatureBytes()
+	sig.Fors, err = xmss.ForsSignatureFromBytes(params.fors(), b[N:forsEnd])
+	if err != nil {
+		return nil, err
+	}
+	hyper := append(make([]byte, 8+N), b[forsEnd:]...)
+	hsig, err := xmss.HyperSignatureFromBytes(params.hyper(), hyper)
+	if err != nil {
+		return nil, err
+	}
+	sig.Layers = hsig.Layers
+	return sig, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the layout of
+// Bytes().
+func (self *PublicKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  On error the
+// receiver is left untouched.
+func (self *PublicKey) UnmarshalBinary(data []byte) error {
+	pub, err := PubkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = *pub
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (self *PrivateKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *PrivateKey) UnmarshalBinary(data []byte) error {
+	pri, err := PrivkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = *pri
+	return nil
+}
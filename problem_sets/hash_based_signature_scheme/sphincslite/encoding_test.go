@@ -0,0 +1,69 @@
+package sphincslite
+
+import (
+	"errors"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestEncoding round trips keys and a signature, and checks bad lengths and
+// a private key whose root isn't its own are refused.
+func TestEncoding(t *testing.T) {
+	pri, pub := testKey(t)
+	msg := []byte("encode")
+	sig, err := Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pub2 PublicKey
+	data, _ := pub.MarshalBinary()
+	err = pub2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig2, err := SignatureFromBytes(pub2.Params, sig.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Verify(msg, sig2, &pub2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pri2 PrivateKey
+	data, _ = pri.MarshalBinary()
+	if len(data) != PRIVKEY_BYTES {
+		t.Fatalf("private key %d bytes, expect %d", len(data), PRIVKEY_BYTES)
+	}
+	err = pri2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig3, err := Sign(msg, &pri2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(sig3.Bytes()) != string(sig.Bytes()) {
+		t.Fatalf("decoded private key signs differently")
+	}
+
+	data[len(data)-1] ^= 1
+	err = pri2.UnmarshalBinary(data)
+	if !errors.Is(err, lamport.ErrKeyPairMismatch) {
+		t.Fatalf("changed root: got %v, expect ErrKeyPairMismatch", err)
+	}
+	err = pri2.UnmarshalBinary(data[1:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short private key: got %v, expect ErrWrongLength", err)
+	}
+	_, err = PubkeyFromBytes(pub.Bytes()[1:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short pubkey: got %v, expect ErrWrongLength", err)
+	}
+	_, err = SignatureFromBytes(SmallParams, sig.Bytes()[N:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short signature: got %v, expect ErrWrongLength", err)
+	}
+}
@@ -0,0 +1,212 @@
+// Package sphincslite is a stateless hash-based signature scheme put
+// together from the pieces in xmss, the way SPHINCS+ is: a hypertree of
+// XMSS trees whose bottom leaves each certify a FORS key, and FORS keys
+// that sign messages.  Nothing records which keys have signed.  Instead the
+// message picks, pseudorandomly, which FORS key signs it, and FORS can take
+// the occasional reuse when two messages pick the same one.
+//
+// It's for learning how the parts fit, not for use: it isn't SPHINCS+ bit
+// for bit, and SmallParams are far too small to be secure.
+package sphincslite
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+
+	"ps/01/hors"
+	"ps/01/lamport"
+	"ps/01/xmss"
+)
+
+/*
+To sign msg:
+
+    R       = HMAC-SHA256(SK_PRF, msg)
+    digest  = sha256(R || PUB_SEED || root || msg)
+
+The first K*log2(T) bits of the digest are the FORS message, as
+hors.Params.Indexes reads them, and the next Layers*Height bits, read big
+endian, are the hypertree index.  The FORS key at layer 0, tree index >>
+Height, key pair index mod 2^Height signs the digest, and the hypertree
+signs that FORS key's pubkey with index.  Everything is deterministic, so
+signing the same message twice gives the same signature.
+*/
+
+// N is the size of every hash, seed and node.
+const N = xmss.N
+
+// SEED_BYTES is the size of the seed GenerateKey takes: SK_SEED, SK_PRF and
+// PUB_SEED, in that order.
+const SEED_BYTES = xmss.SEED_BYTES
+
+// Params are the hypertree's shape and Winternitz parameter, and the FORS
+// keys' K trees of T leaves.
+type Params struct {
+	Layers int
+	Height int
+	W      int
+	K      int
+	T      int
+}
+
+// SmallParams are 2 layers of trees 4 high, so 256 FORS keys in all, each
+// of 10 trees of 64 leaves.  Keys and signatures take milliseconds, and
+// with only 256 FORS keys a few hundred signatures reuse each one often
+// enough to forge with, so they're only for tests and for reading.
+var SmallParams = Params{Layers: 2, Height: 4, W: 16, K: 10, T: 64}
+
+func (self Params) hyper() xmss.HyperParams {
+	return xmss.HyperParams{Layers: self.Layers, Height: self.Height, W: self.W}
+}
+
+func (self Params) fors() xmss.ForsParams {
+	return xmss.ForsParams{Params: hors.Params{T: self.T, K: self.K}}
+}
+
+// Check makes sure the params can be used, and that the FORS message and
+// the index fit in one digest.
+func (self Params) Check() error {
+	err := self.hyper().Check()
+	if err != nil {
+		return err
+	}
+	err = self.fors().Check()
+	if err != nil {
+		return err
+	}
+	if bits := self.K*self.fors().LogT() + self.hyper().TotalHeight(); bits > 8*N {
+		return fmt.Errorf("sphincslite: %+v needs %d digest bits, have %d",
+			self, bits, 8*N)
+	}
+	return nil
+}
+
+// PrivateKey is a sphincslite private key.  It's safe to sign with from
+// several goroutines.
+type PrivateKey struct {
+	Params  Params
+	SKSeed  [N]byte
+	SKPRF   [N]byte
+	PubSeed [N]byte
+	Root    [N]byte
+
+	// builds and caches the hypertree's trees
+	hyper *xmss.HyperSigner
+}
+
+// PublicKey is the root of the top tree and the seed keying every hash.
+type PublicKey struct {
+	Params  Params
+	Root    [N]byte
+	PubSeed [N]byte
+}
+
+// Signature is the randomness R, the FORS signature on the digest, and the
+// hypertree's signature on the FORS pubkey, bottom layer first.
+type Signature struct {
+	R      [N]byte
+	Fors   *xmss.ForsSignature
+	Layers []xmss.LayerSignature
+}
+
+// GenerateKey makes a key pair from a SEED_BYTES seed.  The same seed
+// always gives the same keys.
+func GenerateKey(params Params, seed []byte) (*PrivateKey, *PublicKey, error) {
+	err := params.Check()
+	if err != nil {
+		return nil, nil, err
+	}
+	hyper, hpub, err := xmss.GenerateHyperKey(params.hyper(), seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	pri := &PrivateKey{Params: params, Root: hpub.Root, hyper: hyper}
+	copy(pri.SKSeed[:], seed)
+	copy(pri.SKPRF[:], seed[N:])
+	copy(pri.PubSeed[:], seed[2*N:])
+	return pri, pri.PublicKey(), nil
+}
+
+// PublicKey returns the pubkey for pri.
+func (self *PrivateKey) PublicKey() *PublicKey {
+	return &PublicKey{Params: self.Params, Root: self.Root, PubSeed: self.PubSeed}
+}
+
+// digest hashes msg with R and splits the hash into the FORS message and
+// the hypertree index.
+func (self Params) digest(r, pubSeed, root [N]byte, msg []byte) (lamport.Message, uint64) {
+	h := sha256.New()
+	h.Write(r[:])
+	h.Write(pubSeed[:])
+	h.Write(root[:])
+	h.Write(msg)
+	var digest lamport.Message
+	h.Sum(digest[:0])
+
+	var index uint64
+	start := self.K * self.fors().LogT()
+	for i := 0; i < self.hyper().TotalHeight(); i++ {
+		index = index<<1 | uint64(digest.Bit(start+i))
+	}
+	return digest, index
+}
+
+// forsAddress is the address of the FORS key hypertree index certifies.
+func (self Params) forsAddress(index uint64) xmss.ADRS {
+	return xmss.ADRS{Tree: index >> self.Height,
+		KeyPair: uint32(index & (1<<self.Height - 1))}
+}
+
+// Sign signs msg.  There's no state to save afterwards.
+func Sign(msg []byte, pri *PrivateKey) (*Signature, error) {
+	if pri.hyper == nil {
+		return nil, fmt.Errorf("sphincslite: private key wasn't made by GenerateKey or decoded")
+	}
+	params := pri.Params
+	mac := hmac.New(sha256.New, pri.SKPRF[:])
+	mac.Write(msg)
+	sig := &Signature{}
+	mac.Sum(sig.R[:0])
+	digest, index := params.digest(sig.R, pri.PubSeed, pri.Root, msg)
+
+	fors := &xmss.ForsPrivateKey{Params: params.fors(), SKSeed: pri.SKSeed,
+		PubSeed: pri.PubSeed, Address: params.forsAddress(index)}
+	sig.Fors = xmss.SignFors(digest, fors)
+	var err error
+	sig.Layers, err = pri.hyper.SignNode(index, lamport.Message(fors.PublicKey().Root))
+	if err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// Verify checks sig on msg against pub.  It returns nil for a good
+// signature and wraps lamport.ErrInvalidSignature otherwise.
+func Verify(msg []byte, sig *Signature, pub *PublicKey) error {
+	params := pub.Params
+	err := params.Check()
+	if err != nil {
+		return err
+	}
+	if sig.Fors == nil {
+		return fmt.Errorf("%w: no FORS signature", lamport.ErrInvalidSignature)
+	}
+	digest, index := params.digest(sig.R, pub.PubSeed, pub.Root, msg)
+	fors := &xmss.ForsPublicKey{Params: params.fors(), PubSeed: pub.PubSeed,
+		Address: params.forsAddress(index)}
+	forsRoot, err := xmss.ForsPubkeyFromSignature(digest, sig.Fors, fors)
+	if err != nil {
+		return err
+	}
+	root, err := xmss.HyperRootFromLayers(params.hyper(), pub.PubSeed, index,
+		lamport.Message(forsRoot), sig.Layers)
+	if err != nil {
+		return err
+	}
+	if root != pub.Root {
+		return fmt.Errorf("%w: FORS key %d isn't certified by the root",
+			lamport.ErrInvalidSignature, index)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package sphincslite
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// Known answers for a SmallParams key from the seed 00 01 .. 5f: the whole
+// pubkey, and the sha256 of its signature on "abc".
+const (
+	KAT_PUBKEY    = "020404060a33006e8693b54bca936bc4e01b65f55d1bd17ec6ea2a4a4276998b25f9752cba404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"
+	KAT_SIGNATURE = "3b32d46de139a2eafe110230a47bdd6327b71e63453b6ae93910b361b3a079ce"
+)
+
+func testSeed() []byte {
+	seed := make([]byte, SEED_BYTES)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	return seed
+}
+
+func testKey(t *testing.T) (*PrivateKey, *PublicKey) {
+	pri, pub, err := GenerateKey(SmallParams, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pri, pub
+}
+
+// TestKAT checks the key and signature against the known answers.
+func TestKAT(t *testing.T) {
+	pri, pub := testKey(t)
+	if hex.EncodeToString(pub.Bytes()) != KAT_PUBKEY {
+		t.Fatalf("pubkey %x, expect %s", pub.Bytes(), KAT_PUBKEY)
+	}
+	sig, err := Sign([]byte("abc"), pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := sha256.Sum256(sig.Bytes())
+	if hex.EncodeToString(h[:]) != KAT_SIGNATURE {
+		t.Fatalf("signature hash %x, expect %s", h, KAT_SIGNATURE)
+	}
+	err = Verify([]byte("abc"), sig, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestSignVerify signs a few messages with no state kept in between, and
+// checks signing again gives the same signature.
+func TestSignVerify(t *testing.T) {
+	pri, pub := testKey(t)
+	for i := 0; i < 8; i++ {
+		msg := []byte(fmt.Sprint("message ", i))
+		sig, err := Sign(msg, pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = Verify(msg, sig, pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, err := Sign(msg, pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(again.Bytes()) != string(sig.Bytes()) {
+			t.Fatalf("signing %q twice gave different signatures", msg)
+		}
+	}
+}
+
+// TestVerifyRejects checks other messages, changes to each part of a
+// signature, and other keys are rejected.
+func TestVerifyRejects(t *testing.T) {
+	pri, pub := testKey(t)
+	msg := []byte("abc")
+	sig, err := Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectInvalid := func(what string, msg []byte, sig *Signature, pub *PublicKey) {
+		err := Verify(msg, sig, pub)
+		if !errors.Is(err, lamport.ErrInvalidSignature) {
+			t.Fatalf("%s: got %v, expect ErrInvalidSignature", what, err)
+		}
+	}
+	expectInvalid("other message", []byte("abd"), sig, pub)
+
+	sig.R[0] ^= 1
+	expectInvalid("changed R", msg, sig, pub)
+	sig.R[0] ^= 1
+	sig.Fors.Trees[4].Secret[0] ^= 1
+	expectInvalid("changed FORS secret", msg, sig, pub)
+	sig.Fors.Trees[4].Secret[0] ^= 1
+	sig.Layers[1].WOTS[7][0] ^= 1
+	expectInvalid("changed WOTS+ value", msg, sig, pub)
+	sig.Layers[1].WOTS[7][0] ^= 1
+	sig.Layers[0].Auth[3][0] ^= 1
+	expectInvalid("changed auth node", msg, sig, pub)
+	sig.Layers[0].Auth[3][0] ^= 1
+	expectInvalid("short hypertree", msg, &Signature{R: sig.R, Fors: sig.Fors,
+		Layers: sig.Layers[:1]}, pub)
+	expectInvalid("no FORS", msg, &Signature{R: sig.R, Layers: sig.Layers}, pub)
+
+	seed := testSeed()
+	seed[0] ^= 1
+	_, other, err := GenerateKey(SmallParams, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.PubSeed = pub.PubSeed
+	expectInvalid("other key", msg, sig, other)
+
+	err = Verify(msg, sig, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestParamsCheck checks params whose FORS message and index don't fit in
+// a digest are refused.
+func TestParamsCheck(t *testing.T) {
+	for _, p := range []Params{
+		{Layers: 2, Height: 4, W: 16, K: 42, T: 64},
+		{Layers: 2, Height: 4, W: 16, K: 10, T: 60},
+		{Layers: 2, Height: 4, W: 3, K: 10, T: 64},
+		{Layers: 0, Height: 4, W: 16, K: 10, T: 64},
+	} {
+		if p.Check() == nil {
+			t.Fatalf("Check(%+v) returned nil, expected an error", p)
+		}
+		if _, _, err := GenerateKey(p, testSeed()); err == nil {
+			t.Fatalf("GenerateKey(%+v) returned nil, expected an error", p)
+		}
+	}
+	_, _, err := GenerateKey(SmallParams, testSeed()[1:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short seed: got %v, expect ErrWrongLength", err)
+	}
+}
+
+// TestDescribe checks Describe's sizes add up to real signatures.
+func TestDescribe(t *testing.T) {
+	pri, _ := testKey(t)
+	sig, err := Sign([]byte("abc"), pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := SmallParams.Describe()
+	for _, expect := range []string{
+		fmt.Sprintf("signature: %6d bytes", len(sig.Bytes())),
+		fmt.Sprintf("FORS       %6d", len(sig.Fors.Bytes())),
+		fmt.Sprintf("pubkey:    %6d bytes", PUBKEY_BYTES),
+		"sign:   ",
+		"verify: ",
+	} {
+		if !strings.Contains(d, expect) {
+			t.Fatalf("Describe doesn't say %q:\n%s", expect, d)
+		}
+	}
+
+	// the hypertree is most of the cost of signing, and verifying is far
+	// cheaper
+	sign, verify := SmallParams.SignCounts(), SmallParams.VerifyCounts()
+	if verify.SHA256()*10 > sign.SHA256() {
+		t.Fatalf("verify %v isn't a tenth of sign %v", verify, sign)
+	}
+	if verify.H != 10*6+9+2*(67-1+4) {
+		t.Fatalf("verify does %d H, expect %d", verify.H, 10*6+9+2*(67-1+4))
+	}
+}
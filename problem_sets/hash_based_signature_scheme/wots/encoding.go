@@ -0,0 +1,167 @@
+package wots
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"ps/01/lamport"
+)
+
+// Keys and signatures encode as their LEN chain values in order, N bytes
+// each, and as hex of that.
+
+func chainsToBytes(chains []lamport.Block) []byte {
+	b := make([]byte, 0, len(chains)*N)
+	for _, c := range chains {
+		b = append(b, c[:]...)
+	}
+	return b
+}
+
+func chainsFromBytes(kind string, chains []lamport.Block, b []byte) error {
+	if len(b) != len(chains)*N {
+		return fmt.Errorf("%w: %s %d bytes, expect %d", lamport.ErrWrongLength,
+			kind, len(b), len(chains)*N)
+	}
+	for i := range chains {
+		copy(chains[i][:], b[i*N:])
+	}
+	return nil
+}
+
+func hexToBytes(kind string, s string, expect int) ([]byte, error) {
+	if len(s) != 2*expect {
+		return nil, fmt.Errorf("%w: %s %d hex characters, expect %d",
+			lamport.ErrWrongLength, kind, len(s), 2*expect)
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", lamport.ErrInvalidHex, kind, err)
+	}
+	return b, nil
+}
+
+// Bytes returns the private key's chain starts, PRIVKEY_BYTES long.
+func (self WotsPrivateKey) Bytes() []byte {
+	return chainsToBytes(self.Chains[:])
+}
+
+// Bytes returns the pubkey's chain ends, PUBKEY_BYTES long.
+func (self WotsPublicKey) Bytes() []byte {
+	return chainsToBytes(self.Chains[:])
+}
+
+// Bytes returns the signature's chain values, SIGNATURE_BYTES long.
+func (self WotsSignature) Bytes() []byte {
+	return chainsToBytes(self.Chains[:])
+}
+
+// PrivkeyFromBytes is the inverse of WotsPrivateKey.Bytes.
+func PrivkeyFromBytes(b []byte) (WotsPrivateKey, error) {
+	var pri WotsPrivateKey
+	err := chainsFromBytes("WotsPrivateKey", pri.Chains[:], b)
+	return pri, err
+}
+
+// PubkeyFromBytes is the inverse of WotsPublicKey.Bytes.
+func PubkeyFromBytes(b []byte) (WotsPublicKey, error) {
+	var pub WotsPublicKey
+	err := chainsFromBytes("WotsPublicKey", pub.Chains[:], b)
+	return pub, err
+}
+
+// SignatureFromBytes is the inverse of WotsSignature.Bytes.
+func SignatureFromBytes(b []byte) (WotsSignature, error) {
+	var sig WotsSignature
+	err := chainsFromBytes("WotsSignature", sig.Chains[:], b)
+	return sig, err
+}
+
+// ToHex returns the private key's binary encoding as hex.
+func (self WotsPrivateKey) ToHex() string {
+	return hex.EncodeToString(self.Bytes())
+}
+
+// ToHex returns the pubkey's binary encoding as hex.
+func (self WotsPublicKey) ToHex() string {
+	return hex.EncodeToString(self.Bytes())
+}
+
+// ToHex returns the signature's binary encoding as hex.
+func (self WotsSignature) ToHex() string {
+	return hex.EncodeToString(self.Bytes())
+}
+
+// HexToPrivkey is the inverse of WotsPrivateKey.ToHex.
+func HexToPrivkey(s string) (WotsPrivateKey, error) {
+	b, err := hexToBytes("WotsPrivateKey", s, PRIVKEY_BYTES)
+	if err != nil {
+		return WotsPrivateKey{}, err
+	}
+	return PrivkeyFromBytes(b)
+}
+
+// HexToPubkey is the inverse of WotsPublicKey.ToHex.
+func HexToPubkey(s string) (WotsPublicKey, error) {
+	b, err := hexToBytes("WotsPublicKey", s, PUBKEY_BYTES)
+	if err != nil {
+		return WotsPublicKey{}, err
+	}
+	return PubkeyFromBytes(b)
+}
+
+// HexToSignature is the inverse of WotsSignature.ToHex.
+func HexToSignature(s string) (WotsSignature, error) {
+	b, err := hexToBytes("WotsSignature", s, SIGNATURE_BYTES)
+	if err != nil {
+		return WotsSignature{}, err
+	}
+	return SignatureFromBytes(b)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the layout of
+// Bytes().
+func (self WotsPrivateKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  On error the
+// receiver is left untouched.
+func (self *WotsPrivateKey) UnmarshalBinary(data []byte) error {
+	pri, err := PrivkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = pri
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (self WotsPublicKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *WotsPublicKey) UnmarshalBinary(data []byte) error {
+	pub, err := PubkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = pub
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (self WotsSignature) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *WotsSignature) UnmarshalBinary(data []byte) error {
+	sig, err := SignatureFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = sig
+	return nil
+}
@@ -0,0 +1,73 @@
+package wots
+
+import (
+	"errors"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestEncoding round trips keys and signatures through binary and hex, and
+// checks bad lengths and characters are refused.
+func TestEncoding(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("encode")
+	sig := Sign(msg, pri)
+
+	pri2, err := HexToPrivkey(pri.ToHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub2, err := HexToPubkey(pub.ToHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig2, err := HexToSignature(sig.ToHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri2 != pri || pub2 != pub || sig2 != sig {
+		t.Fatalf("hex round trip changed something")
+	}
+
+	var pub3 WotsPublicKey
+	data, err := pub.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pub3.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig3 WotsSignature
+	data, err = sig.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sig3.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(msg, pub3, sig3) {
+		t.Fatalf("Verify after binary round trip returned false, expected true")
+	}
+
+	err = sig3.UnmarshalBinary(data[1:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+	if sig3 != sig {
+		t.Fatalf("failed UnmarshalBinary changed the signature")
+	}
+	_, err = HexToSignature(sig.ToHex()[2:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+	_, err = HexToPubkey("zz" + pub.ToHex()[2:])
+	if !errors.Is(err, lamport.ErrInvalidHex) {
+		t.Fatalf("got %v, expect ErrInvalidHex", err)
+	}
+}
@@ -0,0 +1,83 @@
+package wots
+
+import (
+	"ps/01/lamport"
+)
+
+// LTreeRoot hashes chains, usually the chain ends of a pubkey, down to one
+// node with an L-tree: an unbalanced binary tree built a level at a time,
+// pairing nodes left to right with sha256(left || right) and promoting an
+// odd one out at the end of a level unchanged.  One chain is its own root,
+// and no chains give the zero block.
+func LTreeRoot(chains [][32]byte) [32]byte {
+	if len(chains) == 0 {
+		return [32]byte{}
+	}
+	level := make([]lamport.Block, len(chains))
+	for i, c := range chains {
+		level[i] = c
+	}
+	for len(level) > 1 {
+		next := level[:0]
+		for i := 0; i+1 < len(level); i += 2 {
+			next = append(next, lamport.MerkleParent(level[i], level[i+1]))
+		}
+		if len(level)%2 == 1 {
+			next = append(next, level[len(level)-1])
+		}
+		level = next
+	}
+	return level[0]
+}
+
+// LTree computes the same root as LTreeRoot one chain at a time, keeping at
+// most one node per level, so a verifier can fold in each chain end as it
+// finishes it instead of holding the whole pubkey.
+type LTree struct {
+	nodes  []lamport.Block
+	levels []int
+	count  int
+}
+
+// Add appends the next chain.
+func (self *LTree) Add(chain [32]byte) {
+	node := lamport.Block(chain)
+	level := 0
+	for n := len(self.nodes); n > 0 && self.levels[n-1] == level; n-- {
+		node = lamport.MerkleParent(self.nodes[n-1], node)
+		self.nodes = self.nodes[:n-1]
+		self.levels = self.levels[:n-1]
+		level++
+	}
+	self.nodes = append(self.nodes, node)
+	self.levels = append(self.levels, level)
+	self.count++
+}
+
+// Len returns the number of chains added.
+func (self *LTree) Len() int {
+	return self.count
+}
+
+// Root returns the L-tree root of the chains added so far.  The odd ones out
+// that LTreeRoot promotes are exactly the partial subtrees left on the
+// stack, so they're joined right to left.
+func (self *LTree) Root() [32]byte {
+	if len(self.nodes) == 0 {
+		return [32]byte{}
+	}
+	node := self.nodes[len(self.nodes)-1]
+	for i := len(self.nodes) - 2; i >= 0; i-- {
+		node = lamport.MerkleParent(self.nodes[i], node)
+	}
+	return node
+}
+
+// LTreeRoot returns the L-tree root of the pubkey's chain ends.
+func (self WotsPublicKey) LTreeRoot() [32]byte {
+	var tree LTree
+	for _, c := range self.Chains {
+		tree.Add(c)
+	}
+	return tree.Root()
+}
@@ -0,0 +1,72 @@
+package wots
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+// ltreeChains returns n chains, chain i being sha256 of the single byte i.
+func ltreeChains(n int) [][32]byte {
+	chains := make([][32]byte, n)
+	for i := range chains {
+		chains[i] = sha256.Sum256([]byte{byte(i)})
+	}
+	return chains
+}
+
+// TestLTreeRootKAT pins the root for 1, 2, 3 and 67 chains.
+func TestLTreeRootKAT(t *testing.T) {
+	for _, tc := range []struct {
+		n      int
+		expect string
+	}{
+		{1, "6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d"},
+		{2, "30e1867424e66e8b6d159246db94e3486778136f7e386ff5f001859d6b8484ab"},
+		{3, "773a93ac37ea78b3f14ac31872c83886b0a0f1fec562c4e848e023c889c2ce9f"},
+		{LEN, "8a567e35f0973faeafe647eb0cecfae15a60ff8f2bb23160f3f87fcc2de79781"},
+	} {
+		root := LTreeRoot(ltreeChains(tc.n))
+		if hex.EncodeToString(root[:]) != tc.expect {
+			t.Fatalf("%d chains: got %x, expect %s", tc.n, root, tc.expect)
+		}
+	}
+	if LTreeRoot(nil) != [32]byte{} {
+		t.Fatalf("no chains didn't give the zero block")
+	}
+}
+
+// TestLTreeStreaming checks LTree gives the same root as LTreeRoot for every
+// count up to 130, and keeps no more nodes than there are levels.
+func TestLTreeStreaming(t *testing.T) {
+	chains := ltreeChains(130)
+	var tree LTree
+	for n := 1; n <= len(chains); n++ {
+		tree.Add(chains[n-1])
+		if tree.Len() != n {
+			t.Fatalf("Len is %d, expect %d", tree.Len(), n)
+		}
+		if tree.Root() != LTreeRoot(chains[:n]) {
+			t.Fatalf("%d chains: streaming root differs", n)
+		}
+		if len(tree.nodes) > 8 {
+			t.Fatalf("%d chains: holding %d nodes", n, len(tree.nodes))
+		}
+	}
+}
+
+// TestPubkeyLTreeRoot checks the pubkey method matches LTreeRoot over its
+// chains.
+func TestPubkeyLTreeRoot(t *testing.T) {
+	_, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chains := make([][32]byte, LEN)
+	for i, c := range pub.Chains {
+		chains[i] = c
+	}
+	if pub.LTreeRoot() != LTreeRoot(chains) {
+		t.Fatalf("WotsPublicKey.LTreeRoot differs from LTreeRoot")
+	}
+}
@@ -0,0 +1,230 @@
+package wots
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"ps/01/lamport"
+)
+
+/*
+WotsParams describes a Winternitz scheme at run time, for w other than 16.
+A bigger w means fewer, longer chains: smaller keys and signatures, but up
+to w-1 hashes per chain to sign or verify.  With w=2 every chain is a single
+hash, each message bit is one digit, and the checksum is the number of zero
+bits, so it signs exactly what lamport.SignChecksummed does with half the
+pubkey.
+
+The message is read big endian, LogW bits per digit; if LogW doesn't divide
+256 the last digit is padded with zero bits.  The checksum is written with
+the fewest base w digits that can hold Len1*(W-1), big endian.  DefaultParams
+matches the fixed size types exactly.
+*/
+
+// WotsParams holds w and the chain counts that follow from it.
+type WotsParams struct {
+	W    int
+	LogW int
+	// chains for the message digits and for the checksum digits
+	Len1 int
+	Len2 int
+}
+
+// DefaultParams are the parameters of the fixed size types, w=16.
+var DefaultParams = mustParams(W)
+
+func mustParams(w int) WotsParams {
+	p, err := NewWotsParams(w)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// NewWotsParams returns the parameters for w, which has to be a power of two
+// from 2 to 256.
+func NewWotsParams(w int) (WotsParams, error) {
+	if w < 2 || w > 256 || w&(w-1) != 0 {
+		return WotsParams{}, fmt.Errorf(
+			"wots: w=%d, must be a power of two from 2 to 256", w)
+	}
+	logw := bits.TrailingZeros(uint(w))
+	len1 := (8*N + logw - 1) / logw
+	// the checksum is at most len1*(w-1), which takes this many digits
+	len2 := (bits.Len(uint(len1*(w-1))) + logw - 1) / logw
+	return WotsParams{W: w, LogW: logw, Len1: len1, Len2: len2}, nil
+}
+
+// Len is the total number of chains.
+func (self WotsParams) Len() int {
+	return self.Len1 + self.Len2
+}
+
+// SignatureBytes is the size of a signature, which is also the size of a
+// private key and a pubkey.
+func (self WotsParams) SignatureBytes() int {
+	return self.Len() * N
+}
+
+// check makes sure the params came from NewWotsParams.
+func (self WotsParams) check() error {
+	p, err := NewWotsParams(self.W)
+	if err != nil {
+		return err
+	}
+	if p != self {
+		return fmt.Errorf("wots: params %+v don't match w=%d, use NewWotsParams",
+			self, self.W)
+	}
+	return nil
+}
+
+// Digits returns the Len() digits signed for msg: the message digits then
+// the checksum digits, each from 0 to W-1.
+func (self WotsParams) Digits(msg lamport.Message) []int {
+	d := make([]int, self.Len())
+	checksum := 0
+	for i := 0; i < self.Len1; i++ {
+		for j := 0; j < self.LogW; j++ {
+			bit := i*self.LogW + j
+			d[i] <<= 1
+			if bit < 8*N {
+				d[i] |= int(msg.Bit(bit))
+			}
+		}
+		checksum += self.W - 1 - d[i]
+	}
+	for i := self.Len() - 1; i >= self.Len1; i-- {
+		d[i] = checksum & (self.W - 1)
+		checksum >>= self.LogW
+	}
+	return d
+}
+
+// GenericPrivateKey is a private key for some WotsParams: Len() chain
+// starts.
+type GenericPrivateKey struct {
+	Chains []lamport.Block
+}
+
+// GenericPublicKey is a pubkey for some WotsParams.
+type GenericPublicKey struct {
+	Chains []lamport.Block
+}
+
+// GenericSignature is a signature for some WotsParams.
+type GenericSignature struct {
+	Chains []lamport.Block
+}
+
+// GenerateKey makes a key pair, reading the chain starts from r (or
+// crypto/rand if r is nil).
+func (self WotsParams) GenerateKey(r io.Reader) (*GenericPrivateKey, *GenericPublicKey, error) {
+	err := self.check()
+	if err != nil {
+		return nil, nil, err
+	}
+	if r == nil {
+		r = rand.Reader
+	}
+	pri := &GenericPrivateKey{Chains: make([]lamport.Block, self.Len())}
+	for i := range pri.Chains {
+		_, err = io.ReadFull(r, pri.Chains[i][:])
+		if err != nil {
+			return nil, nil, fmt.Errorf(
+				"reading %d bytes of key material: %w", self.SignatureBytes(), err)
+		}
+	}
+	return pri, self.PublicKey(pri), nil
+}
+
+// PublicKey hashes each chain of pri to its end.
+func (self WotsParams) PublicKey(pri *GenericPrivateKey) *GenericPublicKey {
+	pub := &GenericPublicKey{Chains: make([]lamport.Block, len(pri.Chains))}
+	for i, x := range pri.Chains {
+		pub.Chains[i] = chain(x, 0, self.W-1)
+	}
+	return pub
+}
+
+// Sign signs msg with pri.
+func (self WotsParams) Sign(msg lamport.Message, pri *GenericPrivateKey) (*GenericSignature, error) {
+	err := self.checkShape(len(pri.Chains))
+	if err != nil {
+		return nil, err
+	}
+	sig := &GenericSignature{Chains: make([]lamport.Block, self.Len())}
+	for i, d := range self.Digits(msg) {
+		sig.Chains[i] = chain(pri.Chains[i], 0, d)
+	}
+	return sig, nil
+}
+
+// Verify checks sig on msg against pub.  It returns nil for a good
+// signature, lamport.ErrInvalidSignature for a bad one, and a
+// *lamport.ParamsError if pub or sig has the wrong number of chains.
+func (self WotsParams) Verify(msg lamport.Message, pub *GenericPublicKey, sig *GenericSignature) error {
+	err := self.checkShape(len(pub.Chains))
+	if err != nil {
+		return err
+	}
+	if len(sig.Chains) != self.Len() {
+		return &lamport.ParamsError{Field: "signature chains",
+			Got: len(sig.Chains), Expect: self.Len()}
+	}
+	for i, d := range self.Digits(msg) {
+		if !chain(sig.Chains[i], d, self.W-1-d).Equal(pub.Chains[i]) {
+			return lamport.ErrInvalidSignature
+		}
+	}
+	return nil
+}
+
+func (self WotsParams) checkShape(chains int) error {
+	err := self.check()
+	if err != nil {
+		return err
+	}
+	if chains != self.Len() {
+		return &lamport.ParamsError{Field: "key chains", Got: chains, Expect: self.Len()}
+	}
+	return nil
+}
+
+// Generic returns the key as a GenericPrivateKey for DefaultParams.
+func (self WotsPrivateKey) Generic() *GenericPrivateKey {
+	return &GenericPrivateKey{Chains: append([]lamport.Block{}, self.Chains[:]...)}
+}
+
+// Generic returns the pubkey as a GenericPublicKey for DefaultParams.
+func (self WotsPublicKey) Generic() *GenericPublicKey {
+	return &GenericPublicKey{Chains: append([]lamport.Block{}, self.Chains[:]...)}
+}
+
+// Generic returns the signature as a GenericSignature for DefaultParams.
+func (self WotsSignature) Generic() *GenericSignature {
+	return &GenericSignature{Chains: append([]lamport.Block{}, self.Chains[:]...)}
+}
+
+// Fixed converts a pubkey made with DefaultParams back to a WotsPublicKey.
+func (self *GenericPublicKey) Fixed() (WotsPublicKey, error) {
+	var pub WotsPublicKey
+	if len(self.Chains) != LEN {
+		return pub, &lamport.ParamsError{Field: "chains", Got: len(self.Chains), Expect: LEN}
+	}
+	copy(pub.Chains[:], self.Chains)
+	return pub, nil
+}
+
+// Fixed converts a signature made with DefaultParams back to a
+// WotsSignature.
+func (self *GenericSignature) Fixed() (WotsSignature, error) {
+	var sig WotsSignature
+	if len(self.Chains) != LEN {
+		return sig, &lamport.ParamsError{Field: "chains", Got: len(self.Chains), Expect: LEN}
+	}
+	copy(sig.Chains[:], self.Chains)
+	return sig, nil
+}
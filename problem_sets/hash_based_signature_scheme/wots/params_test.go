@@ -0,0 +1,175 @@
+package wots
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestNewWotsParams checks the chain counts for every allowed w and that
+// anything else is rejected.
+func TestNewWotsParams(t *testing.T) {
+	expect := map[int][2]int{
+		2: {256, 9}, 4: {128, 5}, 8: {86, 4}, 16: {64, 3},
+		32: {52, 3}, 64: {43, 2}, 128: {37, 2}, 256: {32, 2},
+	}
+	for w, lens := range expect {
+		p, err := NewWotsParams(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Len1 != lens[0] || p.Len2 != lens[1] {
+			t.Fatalf("w=%d: got len1 %d len2 %d, expect %v", w, p.Len1, p.Len2, lens)
+		}
+	}
+	if DefaultParams.Len() != LEN || DefaultParams.SignatureBytes() != SIGNATURE_BYTES {
+		t.Fatalf("DefaultParams %+v don't match the fixed types", DefaultParams)
+	}
+	for _, w := range []int{-2, 0, 1, 3, 12, 100, 512} {
+		if _, err := NewWotsParams(w); err == nil {
+			t.Fatalf("NewWotsParams(%d) returned nil, expected an error", w)
+		}
+	}
+	bad := WotsParams{W: 16, LogW: 4, Len1: 64, Len2: 2}
+	if _, _, err := bad.GenerateKey(nil); err == nil {
+		t.Fatalf("GenerateKey with hand made params returned nil, expected an error")
+	}
+}
+
+// TestParamsSignVerify signs and verifies with every w, and checks a wrong
+// message and a short signature are rejected.
+func TestParamsSignVerify(t *testing.T) {
+	msg := lamport.GetMessageFromString("params")
+	for w := 2; w <= 256; w *= 2 {
+		p, err := NewWotsParams(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pri, pub, err := p.GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := p.Sign(msg, pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = p.Verify(msg, pub, sig)
+		if err != nil {
+			t.Fatalf("w=%d: %v", w, err)
+		}
+		err = p.Verify(lamport.GetMessageFromString("other"), pub, sig)
+		if !errors.Is(err, lamport.ErrInvalidSignature) {
+			t.Fatalf("w=%d: got %v, expect ErrInvalidSignature", w, err)
+		}
+		sig.Chains = sig.Chains[1:]
+		err = p.Verify(msg, pub, sig)
+		var perr *lamport.ParamsError
+		if !errors.As(err, &perr) {
+			t.Fatalf("w=%d: got %v, expect a ParamsError", w, err)
+		}
+	}
+}
+
+// TestDefaultParamsMatchFixed checks DefaultParams signs exactly what Sign
+// does, and that the two verify each other's signatures.
+func TestDefaultParamsMatchFixed(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("abc")
+	gsig, err := DefaultParams.Sign(msg, pri.Generic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := gsig.Fixed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig != Sign(msg, pri) {
+		t.Fatalf("DefaultParams.Sign differs from Sign")
+	}
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	err = DefaultParams.Verify(msg, pub.Generic(), Sign(msg, pri).Generic())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestW2Lamport checks that w=2 signs the same 265 bits as checksummed
+// Lamport, the message then the count of its zero bits, with the same
+// signature size and half the pubkey.
+func TestW2Lamport(t *testing.T) {
+	p, err := NewWotsParams(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Len() != lamport.CHECKSUM_MESSAGE_BITS {
+		t.Fatalf("w=2 has %d chains, expect %d", p.Len(), lamport.CHECKSUM_MESSAGE_BITS)
+	}
+	for _, s := range []string{"abc", "1", "2", "w=2"} {
+		msg := lamport.GetMessageFromString(s)
+		d := p.Digits(msg)
+		for i := 0; i < lamport.MESSAGE_BITS; i++ {
+			if d[i] != int(msg.Bit(i)) {
+				t.Fatalf("%q: digit %d is %d, expect bit %d", s, i, d[i], msg.Bit(i))
+			}
+		}
+		checksum := 0
+		for _, x := range d[lamport.MESSAGE_BITS:] {
+			checksum = checksum<<1 | x
+		}
+		if checksum != lamport.Checksum(msg) {
+			t.Fatalf("%q: checksum %d, expect %d", s, checksum, lamport.Checksum(msg))
+		}
+	}
+
+	_, lpub, err := lamport.GenerateChecksumKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lamport.CHECKSUM_MESSAGE_BITS*N != p.SignatureBytes() {
+		t.Fatalf("signature sizes differ: lamport %d, wots %d",
+			lamport.CHECKSUM_MESSAGE_BITS*N, p.SignatureBytes())
+	}
+	lpubBytes := (len(lpub.ZeroHash) + len(lpub.OneHash)) * N
+	if lpubBytes != 2*p.SignatureBytes() {
+		t.Fatalf("lamport pubkey is %d bytes, expect twice %d", lpubBytes, p.SignatureBytes())
+	}
+}
+
+// BenchmarkParams signs and verifies with every w, reporting the signature
+// size alongside the time.
+func BenchmarkParams(b *testing.B) {
+	msg := lamport.GetMessageFromString("benchmark")
+	for w := 2; w <= 256; w *= 2 {
+		p, err := NewWotsParams(w)
+		if err != nil {
+			b.Fatal(err)
+		}
+		pri, pub, err := p.GenerateKey(nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		sig, err := p.Sign(msg, pri)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("w=%d/sign", w), func(b *testing.B) {
+			b.ReportMetric(float64(p.SignatureBytes()), "sigbytes")
+			for i := 0; i < b.N; i++ {
+				p.Sign(msg, pri)
+			}
+		})
+		b.Run(fmt.Sprintf("w=%d/verify", w), func(b *testing.B) {
+			b.ReportMetric(float64(p.SignatureBytes()), "sigbytes")
+			for i := 0; i < b.N; i++ {
+				p.Verify(msg, pub, sig)
+			}
+		})
+	}
+}
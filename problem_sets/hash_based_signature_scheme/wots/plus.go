@@ -0,0 +1,276 @@
+package wots
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"ps/01/lamport"
+)
+
+/*
+WOTS+ keys every hash in every chain differently, so an attacker who wants
+to invert one chain value can't attack the values of all keys at once.  Each
+step i of chain c computes
+
+    x = sha256(key || x XOR mask)
+
+where key and mask come from a PRF over the public seed, the key's address,
+c and i:
+
+    sha256(seed || address || c (4 bytes) || i (4 bytes) || 0 for the key, 1 for the mask)
+
+all big endian.  The address says where the key sits in a tree of keys; a
+key on its own uses the zero Address.
+
+Private keys and pubkeys encode as seed || address || chains, so a pubkey
+has everything Verify needs.  Signatures are just the LEN chain values.
+*/
+
+const ADDRESS_BYTES = 16
+
+const PLUS_PRIVKEY_BYTES = N + ADDRESS_BYTES + LEN*N // 2192
+const PLUS_PUBKEY_BYTES = N + ADDRESS_BYTES + LEN*N  // 2192
+const PLUS_SIGNATURE_BYTES = LEN * N                 // 2144
+
+const (
+	prfKey  = 0
+	prfMask = 1
+)
+
+// PublicSeed is the public randomness the masks and keys of WOTS+ chains
+// are derived from.
+type PublicSeed [N]byte
+
+// Address places a key in a tree of keys: the layer of tree, the tree
+// within the layer, and the key pair within the tree.
+type Address struct {
+	Layer   uint32
+	Tree    uint64
+	KeyPair uint32
+}
+
+// Bytes returns the address as ADDRESS_BYTES, big endian.
+func (self Address) Bytes() []byte {
+	b := make([]byte, ADDRESS_BYTES)
+	binary.BigEndian.PutUint32(b, self.Layer)
+	binary.BigEndian.PutUint64(b[4:], self.Tree)
+	binary.BigEndian.PutUint32(b[12:], self.KeyPair)
+	return b
+}
+
+func addressFromBytes(b []byte) Address {
+	return Address{
+		Layer:   binary.BigEndian.Uint32(b),
+		Tree:    binary.BigEndian.Uint64(b[4:]),
+		KeyPair: binary.BigEndian.Uint32(b[12:]),
+	}
+}
+
+// PlusPrivateKey holds the start of each chain, along with the seed and
+// address every step of the chains is keyed with.
+type PlusPrivateKey struct {
+	Seed    PublicSeed
+	Address Address
+	Chains  [LEN]lamport.Block
+}
+
+// PlusPublicKey holds the end of each chain, and the seed and address
+// needed to get there.
+type PlusPublicKey struct {
+	Seed    PublicSeed
+	Address Address
+	Chains  [LEN]lamport.Block
+}
+
+// PlusSignature holds, for each chain, the value as many steps in as the
+// digit it signs.
+type PlusSignature struct {
+	Chains [LEN]lamport.Block
+}
+
+// prf derives the key or mask for one step of one chain.
+func prf(seed PublicSeed, addr Address, c, i int, purpose byte) lamport.Block {
+	var b [N + ADDRESS_BYTES + 9]byte
+	copy(b[:], seed[:])
+	copy(b[N:], addr.Bytes())
+	binary.BigEndian.PutUint32(b[N+ADDRESS_BYTES:], uint32(c))
+	binary.BigEndian.PutUint32(b[N+ADDRESS_BYTES+4:], uint32(i))
+	b[N+ADDRESS_BYTES+8] = purpose
+	return sha256.Sum256(b[:])
+}
+
+// chainPlus is chain for WOTS+: it takes x from position start in chain c
+// of the key at addr, steps steps further.
+func chainPlus(x lamport.Block, seed PublicSeed, addr Address, c, start, steps int) lamport.Block {
+	var b [2 * N]byte
+	for i := start; i < start+steps; i++ {
+		key := prf(seed, addr, c, i, prfKey)
+		mask := prf(seed, addr, c, i, prfMask)
+		copy(b[:], key[:])
+		for j := range x {
+			b[N+j] = x[j] ^ mask[j]
+		}
+		x = sha256.Sum256(b[:])
+	}
+	return x
+}
+
+// GeneratePlusKey generates a key pair at the zero address from
+// crypto/rand.
+func GeneratePlusKey() (PlusPrivateKey, PlusPublicKey, error) {
+	return GeneratePlusKeyFrom(rand.Reader, Address{})
+}
+
+// GeneratePlusKeyFrom reads the public seed and then the LEN chain starts
+// from r, and places the key at addr.
+func GeneratePlusKeyFrom(r io.Reader, addr Address) (PlusPrivateKey, PlusPublicKey, error) {
+	pri := PlusPrivateKey{Address: addr}
+	secret := make([]byte, N+LEN*N)
+	_, err := io.ReadFull(r, secret)
+	if err != nil {
+		return PlusPrivateKey{}, PlusPublicKey{}, fmt.Errorf(
+			"reading %d bytes of key material: %w", len(secret), err)
+	}
+	copy(pri.Seed[:], secret)
+	chainsFromBytes("PlusPrivateKey", pri.Chains[:], secret[N:])
+	return pri, pri.GetPublicKey(), nil
+}
+
+// GetPublicKey steps each chain to its end.
+func (self PlusPrivateKey) GetPublicKey() PlusPublicKey {
+	pub := PlusPublicKey{Seed: self.Seed, Address: self.Address}
+	for i, x := range self.Chains {
+		pub.Chains[i] = chainPlus(x, self.Seed, self.Address, i, 0, W-1)
+	}
+	return pub
+}
+
+// SignPlus signs msg, which must already be a digest.
+func SignPlus(msg lamport.Message, pri PlusPrivateKey) PlusSignature {
+	var sig PlusSignature
+	for i, d := range digits(msg) {
+		sig.Chains[i] = chainPlus(pri.Chains[i], pri.Seed, pri.Address, i, 0, d)
+	}
+	return sig
+}
+
+// VerifyPlus finishes each chain of sig with the seed and address of pub
+// and checks it ends at pub.
+func VerifyPlus(msg lamport.Message, pub PlusPublicKey, sig PlusSignature) bool {
+	for i, d := range digits(msg) {
+		end := chainPlus(sig.Chains[i], pub.Seed, pub.Address, i, d, W-1-d)
+		if !end.Equal(pub.Chains[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func plusKeyBytes(seed PublicSeed, addr Address, chains []lamport.Block) []byte {
+	b := make([]byte, 0, PLUS_PUBKEY_BYTES)
+	b = append(b, seed[:]...)
+	b = append(b, addr.Bytes()...)
+	return append(b, chainsToBytes(chains)...)
+}
+
+func plusKeyFromBytes(kind string, seed *PublicSeed, addr *Address, chains []lamport.Block, b []byte) error {
+	if len(b) != PLUS_PUBKEY_BYTES {
+		return fmt.Errorf("%w: %s %d bytes, expect %d", lamport.ErrWrongLength,
+			kind, len(b), PLUS_PUBKEY_BYTES)
+	}
+	copy(seed[:], b)
+	*addr = addressFromBytes(b[N:])
+	return chainsFromBytes(kind, chains, b[N+ADDRESS_BYTES:])
+}
+
+// Bytes returns seed, address and chain starts, PLUS_PRIVKEY_BYTES long.
+func (self PlusPrivateKey) Bytes() []byte {
+	return plusKeyBytes(self.Seed, self.Address, self.Chains[:])
+}
+
+// Bytes returns seed, address and chain ends, PLUS_PUBKEY_BYTES long.
+func (self PlusPublicKey) Bytes() []byte {
+	return plusKeyBytes(self.Seed, self.Address, self.Chains[:])
+}
+
+// Bytes returns the signature's chain values, PLUS_SIGNATURE_BYTES long.
+func (self PlusSignature) Bytes() []byte {
+	return chainsToBytes(self.Chains[:])
+}
+
+// PlusPrivkeyFromBytes is the inverse of PlusPrivateKey.Bytes.
+func PlusPrivkeyFromBytes(b []byte) (PlusPrivateKey, error) {
+	var pri PlusPrivateKey
+	err := plusKeyFromBytes("PlusPrivateKey", &pri.Seed, &pri.Address, pri.Chains[:], b)
+	if err != nil {
+		return PlusPrivateKey{}, err
+	}
+	return pri, nil
+}
+
+// PlusPubkeyFromBytes is the inverse of PlusPublicKey.Bytes.
+func PlusPubkeyFromBytes(b []byte) (PlusPublicKey, error) {
+	var pub PlusPublicKey
+	err := plusKeyFromBytes("PlusPublicKey", &pub.Seed, &pub.Address, pub.Chains[:], b)
+	if err != nil {
+		return PlusPublicKey{}, err
+	}
+	return pub, nil
+}
+
+// PlusSignatureFromBytes is the inverse of PlusSignature.Bytes.
+func PlusSignatureFromBytes(b []byte) (PlusSignature, error) {
+	var sig PlusSignature
+	err := chainsFromBytes("PlusSignature", sig.Chains[:], b)
+	return sig, err
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the layout of
+// Bytes().
+func (self PlusPrivateKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  On error the
+// receiver is left untouched.
+func (self *PlusPrivateKey) UnmarshalBinary(data []byte) error {
+	pri, err := PlusPrivkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = pri
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (self PlusPublicKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *PlusPublicKey) UnmarshalBinary(data []byte) error {
+	pub, err := PlusPubkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = pub
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (self PlusSignature) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *PlusSignature) UnmarshalBinary(data []byte) error {
+	sig, err := PlusSignatureFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = sig
+	return nil
+}
@@ -0,0 +1,158 @@
+package wots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// Known answers for WOTS+.  The chain vectors start from x = 00 01 .. 1f
+// with seed 20 21 .. 3f at Address{1, 2, 3}, chain 5; the key is read from
+// plusSecret() and signs sha256("abc").
+const (
+	KAT_PLUS_PRF_KEY0       = "b85136ca6ea6b1f1239802feda57a256a73770817bb1c2746c8a7b883ca048c7"
+	KAT_PLUS_PRF_MASK0      = "3435b207db6092f4174e373348ccced16fa7609421049733c0d2591daf1dacf9"
+	KAT_PLUS_CHAIN_END      = "07b534bf50754bb1afec7943786e6764d3d199e00416d136eeedfa92697afabd"
+	KAT_PLUS_PUBKEY_HASH    = "c1d2626e9a4a39350ca2665134ff6d38f645e02d34c1b834537179c2050466f7"
+	KAT_PLUS_SIGNATURE_HASH = "53e66db1326cf406f9fcea6e18bcfeb9a75cba6585b2e780ca4da70e54b52cbe"
+)
+
+func plusSecret() []byte {
+	secret := make([]byte, N+LEN*N)
+	for i := range secret {
+		secret[i] = byte(i % 251)
+	}
+	return secret
+}
+
+// TestChainPlusKAT pins the PRF and a whole chain.
+func TestChainPlusKAT(t *testing.T) {
+	var x lamport.Block
+	var seed PublicSeed
+	for i := range x {
+		x[i] = byte(i)
+		seed[i] = byte(32 + i)
+	}
+	addr := Address{Layer: 1, Tree: 2, KeyPair: 3}
+	for _, tc := range []struct {
+		got    lamport.Block
+		expect string
+	}{
+		{prf(seed, addr, 5, 0, prfKey), KAT_PLUS_PRF_KEY0},
+		{prf(seed, addr, 5, 0, prfMask), KAT_PLUS_PRF_MASK0},
+		{chainPlus(x, seed, addr, 5, 0, W-1), KAT_PLUS_CHAIN_END},
+	} {
+		if hex.EncodeToString(tc.got[:]) != tc.expect {
+			t.Fatalf("got %x, expect %s", tc.got, tc.expect)
+		}
+	}
+
+	// a chain can be walked in pieces
+	mid := chainPlus(x, seed, addr, 5, 0, 6)
+	end := chainPlus(mid, seed, addr, 5, 6, 9)
+	if hex.EncodeToString(end[:]) != KAT_PLUS_CHAIN_END {
+		t.Fatalf("chain in two pieces ends somewhere else")
+	}
+}
+
+// TestPlusKAT checks the seeded key and its signature on "abc".
+func TestPlusKAT(t *testing.T) {
+	pri, pub, err := GeneratePlusKeyFrom(bytes.NewReader(plusSecret()), Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("abc")
+	sig := SignPlus(msg, pri)
+	h := sha256.Sum256(pub.Bytes())
+	if hex.EncodeToString(h[:]) != KAT_PLUS_PUBKEY_HASH {
+		t.Fatalf("pubkey hash %x, expect %s", h, KAT_PLUS_PUBKEY_HASH)
+	}
+	h = sha256.Sum256(sig.Bytes())
+	if hex.EncodeToString(h[:]) != KAT_PLUS_SIGNATURE_HASH {
+		t.Fatalf("signature hash %x, expect %s", h, KAT_PLUS_SIGNATURE_HASH)
+	}
+	if !VerifyPlus(msg, pub, sig) {
+		t.Fatalf("VerifyPlus returned false, expected true")
+	}
+}
+
+// TestPlusWrongSeed checks a signature doesn't verify once the pubkey's
+// seed or address changes, or for another message.
+func TestPlusWrongSeed(t *testing.T) {
+	pri, pub, err := GeneratePlusKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("seed")
+	sig := SignPlus(msg, pri)
+	if !VerifyPlus(msg, pub, sig) {
+		t.Fatalf("VerifyPlus returned false, expected true")
+	}
+	if VerifyPlus(lamport.GetMessageFromString("other"), pub, sig) {
+		t.Fatalf("VerifyPlus returned true for another message, expected false")
+	}
+	wrong := pub
+	wrong.Seed[0] ^= 1
+	if VerifyPlus(msg, wrong, sig) {
+		t.Fatalf("VerifyPlus returned true with the wrong seed, expected false")
+	}
+	wrong = pub
+	wrong.Address.KeyPair = 1
+	if VerifyPlus(msg, wrong, sig) {
+		t.Fatalf("VerifyPlus returned true at the wrong address, expected false")
+	}
+}
+
+// TestPlusEncoding round trips keys and signatures, checking the seed and
+// address survive, and that bad lengths are refused.
+func TestPlusEncoding(t *testing.T) {
+	addr := Address{Layer: 2, Tree: 1 << 40, KeyPair: 7}
+	pri, pub, err := GeneratePlusKeyFrom(bytes.NewReader(plusSecret()), addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("encode")
+	sig := SignPlus(msg, pri)
+
+	var pri2 PlusPrivateKey
+	var pub2 PlusPublicKey
+	var sig2 PlusSignature
+	for _, tc := range []struct {
+		m    interface{ MarshalBinary() ([]byte, error) }
+		u    interface{ UnmarshalBinary([]byte) error }
+		size int
+	}{
+		{pri, &pri2, PLUS_PRIVKEY_BYTES},
+		{pub, &pub2, PLUS_PUBKEY_BYTES},
+		{sig, &sig2, PLUS_SIGNATURE_BYTES},
+	} {
+		data, err := tc.m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(data) != tc.size {
+			t.Fatalf("%T encodes to %d bytes, expect %d", tc.m, len(data), tc.size)
+		}
+		err = tc.u.UnmarshalBinary(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = tc.u.UnmarshalBinary(data[1:])
+		if !errors.Is(err, lamport.ErrWrongLength) {
+			t.Fatalf("%T: got %v, expect ErrWrongLength", tc.u, err)
+		}
+	}
+	if pri2 != pri || pub2 != pub || sig2 != sig {
+		t.Fatalf("binary round trip changed something")
+	}
+	if pub2.Address != addr {
+		t.Fatalf("got address %+v, expect %+v", pub2.Address, addr)
+	}
+	if !VerifyPlus(msg, pub2, sig2) {
+		t.Fatalf("VerifyPlus returned false after decoding, expected true")
+	}
+}
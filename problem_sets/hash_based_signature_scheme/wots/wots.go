@@ -0,0 +1,123 @@
+// Package wots implements Winternitz one-time signatures with w=16.  Where
+// Lamport reveals one of two blocks per message bit, Winternitz reveals one
+// point along a hash chain per 4 bit digit: the private key is 67 chain
+// starts, the pubkey is the end of each chain after 15 hashes, and a
+// signature on a digit d is the chain d hashes in.  Verifying hashes each
+// signature chain the rest of the way and compares with the pubkey.
+//
+// Anyone can hash a chain forwards, so a signature on digit d also gives
+// away every digit above d.  A checksum of the digits is signed along with
+// them to stop that: raising any message digit lowers the checksum, and
+// lowering a checksum digit means hashing backwards.
+//
+// WOTS+ (SignPlus and VerifyPlus) masks and keys every step of every chain
+// from a public seed, so inverting one chain value doesn't help with any
+// other.
+//
+// Keys, like Lamport keys, must only ever sign one message.
+package wots
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"ps/01/lamport"
+)
+
+const W = 16
+const LOG_W = 4
+const N = lamport.MESSAGE_BYTES // 32, bytes per chain value
+
+// LEN1 chains sign the message digits and LEN2 the checksum, which is at
+// most LEN1*(W-1) = 960 and so takes 3 digits.
+const LEN1 = 8 * N / LOG_W // 64
+const LEN2 = 3
+const LEN = LEN1 + LEN2 // 67
+
+const PRIVKEY_BYTES = LEN * N   // 2144
+const PUBKEY_BYTES = LEN * N    // 2144
+const SIGNATURE_BYTES = LEN * N // 2144
+
+// WotsPrivateKey holds the start of each chain.
+type WotsPrivateKey struct {
+	Chains [LEN]lamport.Block
+}
+
+// WotsPublicKey holds the end of each chain, W-1 hashes from the start.
+type WotsPublicKey struct {
+	Chains [LEN]lamport.Block
+}
+
+// WotsSignature holds, for each chain, the value as many hashes in as the
+// digit it signs.
+type WotsSignature struct {
+	Chains [LEN]lamport.Block
+}
+
+// chain hashes x steps times, starting from position start in the chain.
+// start doesn't change the result here; it does in chainPlus, which keys
+// each step by its position.
+func chain(x lamport.Block, start, steps int) lamport.Block {
+	for i := start; i < start+steps; i++ {
+		x = x.Hash()
+	}
+	return x
+}
+
+// digits returns the LEN base W digits signed for msg: its LEN1 nibbles, high
+// nibble first, then the checksum sum(W-1-d) as LEN2 digits, big endian.
+func digits(msg lamport.Message) [LEN]int {
+	var d [LEN]int
+	copy(d[:], DefaultParams.Digits(msg))
+	return d
+}
+
+// GenerateKey generates a key pair from crypto/rand.
+func GenerateKey() (WotsPrivateKey, WotsPublicKey, error) {
+	return GenerateKeyFrom(rand.Reader)
+}
+
+// GenerateKeyFrom reads the LEN chain starts from r, in order.
+func GenerateKeyFrom(r io.Reader) (WotsPrivateKey, WotsPublicKey, error) {
+	secret := make([]byte, PRIVKEY_BYTES)
+	_, err := io.ReadFull(r, secret)
+	if err != nil {
+		return WotsPrivateKey{}, WotsPublicKey{}, fmt.Errorf(
+			"reading %d bytes of key material: %w", PRIVKEY_BYTES, err)
+	}
+	pri, err := PrivkeyFromBytes(secret)
+	if err != nil {
+		return WotsPrivateKey{}, WotsPublicKey{}, err
+	}
+	return pri, pri.GetPublicKey(), nil
+}
+
+// GetPublicKey hashes each chain to its end.
+func (self WotsPrivateKey) GetPublicKey() WotsPublicKey {
+	var pub WotsPublicKey
+	for i, x := range self.Chains {
+		pub.Chains[i] = chain(x, 0, W-1)
+	}
+	return pub
+}
+
+// Sign signs msg, which must already be a digest, like
+// lamport.GetMessageFromString returns.
+func Sign(msg lamport.Message, pri WotsPrivateKey) WotsSignature {
+	var sig WotsSignature
+	for i, d := range digits(msg) {
+		sig.Chains[i] = chain(pri.Chains[i], 0, d)
+	}
+	return sig
+}
+
+// Verify finishes each chain of sig and checks it ends at pub.
+func Verify(msg lamport.Message, pub WotsPublicKey, sig WotsSignature) bool {
+	for i, d := range digits(msg) {
+		if !chain(sig.Chains[i], d, W-1-d).Equal(pub.Chains[i]) {
+			return false
+		}
+	}
+	return true
+}
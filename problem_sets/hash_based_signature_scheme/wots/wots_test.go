@@ -0,0 +1,98 @@
+package wots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// Known answers for the key read from testSecret() and a signature on
+// sha256("abc"): the sha256 of each encoding.
+const (
+	KAT_PUBKEY_HASH    = "8c66d64024c4cbaca3d440a668424bf9987babe474d59c04769f0e09064a9aae"
+	KAT_SIGNATURE_HASH = "9075460e798bd6731763953f9997c53041ec89a67eb4f005ac8b1b79958dd8e0"
+)
+
+func testSecret() []byte {
+	secret := make([]byte, PRIVKEY_BYTES)
+	for i := range secret {
+		secret[i] = byte(i % 251)
+	}
+	return secret
+}
+
+// TestKAT checks the seeded key and its signature on "abc" against the
+// known answers.
+func TestKAT(t *testing.T) {
+	pri, pub, err := GenerateKeyFrom(bytes.NewReader(testSecret()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("abc")
+	sig := Sign(msg, pri)
+	h := sha256.Sum256(pub.Bytes())
+	if hex.EncodeToString(h[:]) != KAT_PUBKEY_HASH {
+		t.Fatalf("pubkey hash %x, expect %s", h, KAT_PUBKEY_HASH)
+	}
+	h = sha256.Sum256(sig.Bytes())
+	if hex.EncodeToString(h[:]) != KAT_SIGNATURE_HASH {
+		t.Fatalf("signature hash %x, expect %s", h, KAT_SIGNATURE_HASH)
+	}
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+}
+
+// TestDigits checks the message digits and checksum for "abc", which has a
+// checksum of 530 = 0x212.
+func TestDigits(t *testing.T) {
+	msg := lamport.GetMessageFromString("abc")
+	d := digits(msg)
+	if d[0] != 0xb || d[1] != 0xa || d[63] != 0xd {
+		t.Fatalf("got message digits %x %x ... %x, expect b a ... d", d[0], d[1], d[63])
+	}
+	if d[64] != 2 || d[65] != 1 || d[66] != 2 {
+		t.Fatalf("got checksum digits %v, expect [2 1 2]", d[64:])
+	}
+
+	var zero lamport.Message
+	d = digits(zero)
+	if d[64] != 3 || d[65] != 0xc || d[66] != 0 {
+		t.Fatalf("got checksum digits %v for all zeros, expect [3 12 0]", d[64:])
+	}
+}
+
+// TestSignVerify signs a random message and checks that neither a
+// different message nor a signature pushed further along its chains
+// verifies.
+func TestSignVerify(t *testing.T) {
+	pri, pub, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := lamport.GetMessageFromString("winternitz")
+	sig := Sign(msg, pri)
+	if !Verify(msg, pub, sig) {
+		t.Fatalf("Verify returned false, expected true")
+	}
+	if Verify(lamport.GetMessageFromString("other"), pub, sig) {
+		t.Fatalf("Verify returned true for the wrong message")
+	}
+
+	// Raising the first message digit is easy for anyone holding the
+	// signature, but the checksum then has to go down, which it can't.
+	d := digits(msg)
+	if d[0] == W-1 {
+		t.Skip("first digit is already at the top")
+	}
+	forged := msg
+	forged[0] += 1 << LOG_W
+	fsig := sig
+	fsig.Chains[0] = chain(sig.Chains[0], d[0], 1)
+	if Verify(forged, pub, fsig) {
+		t.Fatalf("forged signature with a raised digit verified")
+	}
+}
@@ -0,0 +1,185 @@
+package xmss
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"ps/01/lamport"
+)
+
+/*
+Params encode as 2 bytes, the height and log2(w).  Then
+
+    pubkey      params || root || PUB_SEED                          66 bytes
+    private key params || index (4) || SK_SEED || SK_PRF || root || PUB_SEED
+                                                                    134 bytes
+    signature   index (4) || R || len WOTS+ values || height auth nodes
+
+all big endian.  A private key's index has 1<<31 added if its last leaf is
+a PoP leaf, and 1<<30 once that has proved.  A signature doesn't say what
+params it's for; its length comes from the pubkey's.
+*/
+
+const PARAMS_BYTES = 2
+const PUBKEY_BYTES = PARAMS_BYTES + 2*N
+const PRIVKEY_BYTES = PARAMS_BYTES + 4 + 4*N
+
+func (self Params) bytes() []byte {
+	return []byte{byte(self.Height), byte(bits.TrailingZeros(uint(self.W)))}
+}
+
+func paramsFromBytes(b []byte) (Params, error) {
+	if b[1] > 8 {
+		return Params{}, fmt.Errorf("xmss: log2(w) %d, expect at most 8", b[1])
+	}
+	p := Params{Height: int(b[0]), W: 1 << b[1]}
+	_, err := p.wots()
+	if err != nil {
+		return Params{}, err
+	}
+	return p, nil
+}
+
+// Bytes returns the pubkey's encoding, PUBKEY_BYTES long.
+func (self *PublicKey) Bytes() []byte {
+	b := make([]byte, 0, PUBKEY_BYTES)
+	b = append(b, self.Params.bytes()...)
+	b = append(b, self.Root[:]...)
+	return append(b, self.PubSeed[:]...)
+}
+
+// PubkeyFromBytes is the inverse of PublicKey.Bytes.
+func PubkeyFromBytes(b []byte) (*PublicKey, error) {
+	if len(b) != PUBKEY_BYTES {
+		return nil, fmt.Errorf("%w: xmss pubkey %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), PUBKEY_BYTES)
+	}
+	params, err := paramsFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	pub := &PublicKey{Params: params}
+	copy(pub.Root[:], b[PARAMS_BYTES:])
+	copy(pub.PubSeed[:], b[PARAMS_BYTES+N:])
+	return pub, nil
+}
+
+// Bytes returns the private key's encoding, PRIVKEY_BYTES long, including
+// the index of the next leaf.
+func (self *PrivateKey) Bytes() []byte {
+	b := make([]byte, 0, PRIVKEY_BYTES)
+	b = append(b, self.Params.bytes()...)
+	index := self.Index
+	if self.pop {
+		index |= 1 << 31
+	}
+	if self.popUsed {
+		index |= 1 << 30
+	}
+	b = binary.BigEndian.AppendUint32(b, index)
+	b = append(b, self.SKSeed[:]...)
+	b = append(b, self.SKPRF[:]...)
+	b = append(b, self.Root[:]...)
+	return append(b, self.PubSeed[:]...)
+}
+
+// PrivkeyFromBytes is the inverse of PrivateKey.Bytes.
+func PrivkeyFromBytes(b []byte) (*PrivateKey, error) {
+	if len(b) != PRIVKEY_BYTES {
+		return nil, fmt.Errorf("%w: xmss private key %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), PRIVKEY_BYTES)
+	}
+	params, err := paramsFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	index := binary.BigEndian.Uint32(b[PARAMS_BYTES:])
+	pri := &PrivateKey{Params: params, Index: index &^ (3 << 30),
+		pop: index>>31 == 1, popUsed: index>>30&1 == 1}
+	if pri.Remaining() < 0 {
+		return nil, fmt.Errorf("xmss: index %d in a tree of %d leaves",
+			pri.Index, 1<<params.Height)
+	}
+	if pri.popUsed && !pri.pop || pri.pop && params.Height == 0 {
+		return nil, fmt.Errorf("xmss: PoP leaf flags %#x in a tree of height %d",
+			index>>30, params.Height)
+	}
+	b = b[PARAMS_BYTES+4:]
+	copy(pri.SKSeed[:], b)
+	copy(pri.SKPRF[:], b[N:])
+	copy(pri.Root[:], b[2*N:])
+	copy(pri.PubSeed[:], b[3*N:])
+	return pri, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the layout of
+// Bytes().
+func (self *PublicKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  On error the
+// receiver is left untouched.
+func (self *PublicKey) UnmarshalBinary(data []byte) error {
+	pub, err := PubkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = *pub
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (self *PrivateKey) MarshalBinary() ([]byte, error) {
+	return self.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (self *PrivateKey) UnmarshalBinary(data []byte) error {
+	pri, err := PrivkeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*self = *pri
+	return nil
+}
+
+// Bytes returns the signature's encoding.
+func (self *Signature) Bytes() []byte {
+	b := make([]byte, 0, 4+N+(len(self.WOTS)+len(self.Auth))*N)
+	b = binary.BigEndian.AppendUint32(b, self.Index)
+	b = append(b, self.R[:]...)
+	for _, x := range self.WOTS {
+		b = append(b, x[:]...)
+	}
+	for _, x := range self.Auth {
+		b = append(b, x[:]...)
+	}
+	return b
+}
+
+// SignatureFromBytes decodes a signature made with params.
+func SignatureFromBytes(params Params, b []byte) (*Signature, error) {
+	wp, err := params.wots()
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != params.SignatureBytes() {
+		return nil, fmt.Errorf("%w: xmss signature %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), params.SignatureBytes())
+	}
+	sig := &Signature{Index: binary.BigEndian.Uint32(b)}
+	copy(sig.R[:], b[4:])
+	b = b[4+N:]
+	sig.WOTS = make([]lamport.Block, wp.Len())
+	for i := range sig.WOTS {
+		copy(sig.WOTS[i][:], b[i*N:])
+	}
+	b = b[wp.Len()*N:]
+	sig.Auth = make([]lamport.Block, params.Height)
+	for i := range sig.Auth {
+		copy(sig.Auth[i][:], b[i*N:])
+	}
+	return sig, nil
+}
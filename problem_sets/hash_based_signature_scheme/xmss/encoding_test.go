@@ -0,0 +1,82 @@
+package xmss
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestEncoding round trips keys and a signature, checks a reloaded private
+// key carries on from the same leaf, and checks bad lengths are refused.
+func TestEncoding(t *testing.T) {
+	pri, pub, err := GenerateKey(3, 16, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("encode")
+	sig, err := Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pub2 PublicKey
+	data, err := pub.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pub2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub2 != *pub {
+		t.Fatalf("pubkey round trip changed it")
+	}
+	sig2, err := SignatureFromBytes(pub2.Params, sig.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Verify(msg, sig2, &pub2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pri2 PrivateKey
+	data, err = pri.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pri2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri2.Index != 1 {
+		t.Fatalf("reloaded key is at leaf %d, expect 1", pri2.Index)
+	}
+	a, err := Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Sign(msg, &pri2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(a.Bytes(), b.Bytes()) {
+		t.Fatalf("reloaded key signs differently")
+	}
+
+	if _, err = PubkeyFromBytes(pub.Bytes()[1:]); !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short pubkey: got %v, expect ErrWrongLength", err)
+	}
+	if _, err = PrivkeyFromBytes(data[1:]); !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short private key: got %v, expect ErrWrongLength", err)
+	}
+	if _, err = SignatureFromBytes(pub.Params, sig.Bytes()[1:]); !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short signature: got %v, expect ErrWrongLength", err)
+	}
+	data[1] = 9
+	if err = pri2.UnmarshalBinary(data); err == nil {
+		t.Fatalf("log2(w) 9 returned nil, expected an error")
+	}
+}
@@ -0,0 +1,311 @@
+package xmss
+
+import (
+	"fmt"
+	"math"
+
+	"ps/01/hors"
+	"ps/01/lamport"
+)
+
+/*
+FORS, from SPHINCS+, is a few-time scheme of K Merkle trees of T leaves
+each.  The secret behind leaf j of tree i is
+
+    PRF(SK_SEED, ADRS_FORS_TREE, key pair, height 0, index i*T + j)
+
+its leaf is F of it at the same address, and nodes are RAND_HASH with the
+address of the node made.  The K roots are hashed together by an L-tree
+with ADRS_FORS_ROOTS to give the pubkey.  A digest is cut into K indexes of
+log2(T) bits, as in HORS (hors.Params.Indexes), and index i picks the leaf
+of tree i to reveal.
+
+A FORS signature encodes as K times the revealed secret then log2(T) auth
+nodes, bottom first; a pubkey as
+
+    log2(T)  1 byte
+    K        2 bytes, big endian
+    root || PUB_SEED
+*/
+
+// FORS_SEED_BYTES is the size of the seed GenerateForsKey takes: SK_SEED
+// then PUB_SEED.
+const FORS_SEED_BYTES = 2 * N
+
+const FORS_PARAMS_BYTES = 3
+const FORS_PUBKEY_BYTES = FORS_PARAMS_BYTES + 2*N
+
+// ForsParams are the number of trees K and the leaves in each, T.  The
+// digest bits are shared out as for HORS, so the same limits apply.
+type ForsParams struct {
+	hors.Params
+}
+
+// SignatureBytes is the size of an encoded FORS signature.
+func (self ForsParams) SignatureBytes() int {
+	return self.K * (1 + self.LogT()) * N
+}
+
+// ForgeProbabilityAfter estimates the chance that a random digest can be
+// signed with only what r signatures revealed.  Each tree has its own
+// indexes, so the leaf a digest picks in a tree has been revealed with
+// probability 1 - (1-1/T)^r, and all K have to be:
+//
+//	(1 - (1-1/T)^r)^K
+//
+// That's lower than for HORS with the same T and K, where every reveal can
+// land on any of the T secrets.
+func (self ForsParams) ForgeProbabilityAfter(r int) float64 {
+	if r <= 0 {
+		return 0
+	}
+	hidden := math.Pow(1-1/float64(self.T), float64(r))
+	return math.Pow(1-hidden, float64(self.K))
+}
+
+// ForsPrivateKey is a FORS key at an address: Layer, Tree and KeyPair of
+// Address place it in a hypertree, and are zero for a key on its own.
+type ForsPrivateKey struct {
+	Params  ForsParams
+	SKSeed  [N]byte
+	PubSeed [N]byte
+	Address ADRS
+
+	// every level of each tree, built the first time they're needed
+	trees [][][]lamport.Block
+}
+
+// ForsPublicKey is the hash of the K roots, and what's needed to recompute
+// it from a signature.
+type ForsPublicKey struct {
+	Params  ForsParams
+	Root    lamport.Block
+	PubSeed [N]byte
+	Address ADRS
+}
+
+// ForsTreeSignature is one tree's part of a FORS signature: the revealed
+// secret and its auth path.
+type ForsTreeSignature struct {
+	Secret lamport.Block
+	Auth   []lamport.Block
+}
+
+// ForsSignature has one ForsTreeSignature for each of the K trees.
+type ForsSignature struct {
+	Trees []ForsTreeSignature
+}
+
+// GenerateForsKey makes a key pair from a FORS_SEED_BYTES seed, at the zero
+// address.
+func GenerateForsKey(params ForsParams, seed []byte) (*ForsPrivateKey, *ForsPublicKey, error) {
+	err := params.Check()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(seed) != FORS_SEED_BYTES {
+		return nil, nil, fmt.Errorf("%w: fors seed %d bytes, expect %d",
+			lamport.ErrWrongLength, len(seed), FORS_SEED_BYTES)
+	}
+	pri := &ForsPrivateKey{Params: params}
+	copy(pri.SKSeed[:], seed)
+	copy(pri.PubSeed[:], seed[N:])
+	return pri, pri.PublicKey(), nil
+}
+
+// treeAdrs is the ADRS_FORS_TREE address of the key, for the node at
+// height and index to fill in.
+func (self *ForsPrivateKey) treeAdrs() ADRS {
+	return ADRS{Layer: self.Address.Layer, Tree: self.Address.Tree,
+		Type: ADRS_FORS_TREE, KeyPair: self.Address.KeyPair}
+}
+
+// secret returns the secret behind leaf j of tree i.
+func (self *ForsPrivateKey) secret(i, j int) lamport.Block {
+	adrs := self.treeAdrs()
+	adrs.Hash = uint32(i*self.Params.T + j)
+	return prf(self.SKSeed, adrs)
+}
+
+// levels returns every level of tree i, leaves first, building all K trees
+// the first time.
+func (self *ForsPrivateKey) levels(i int) [][]lamport.Block {
+	if self.trees == nil {
+		self.trees = make([][][]lamport.Block, self.Params.K)
+		for j := range self.trees {
+			self.trees[j] = self.buildTree(j)
+		}
+	}
+	return self.trees[i]
+}
+
+// buildTree builds every level of tree i.
+func (self *ForsPrivateKey) buildTree(i int) [][]lamport.Block {
+	adrs := self.treeAdrs()
+	leaves := make([]lamport.Block, self.Params.T)
+	for j := range leaves {
+		adrs.Hash = uint32(i*self.Params.T + j)
+		leaves[j] = fHash(self.secret(i, j), self.PubSeed, adrs)
+	}
+	levels := [][]lamport.Block{leaves}
+	for level := leaves; len(level) > 1; {
+		adrs.Chain = uint32(len(levels))
+		next := make([]lamport.Block, len(level)/2)
+		for j := range next {
+			adrs.Hash = uint32(i*len(next) + j)
+			next[j] = randHash(level[2*j], level[2*j+1], self.PubSeed, adrs)
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return levels
+}
+
+// PublicKey builds all K trees and hashes their roots.
+func (self *ForsPrivateKey) PublicKey() *ForsPublicKey {
+	roots := make([]lamport.Block, self.Params.K)
+	for i := range roots {
+		levels := self.levels(i)
+		roots[i] = levels[len(levels)-1][0]
+	}
+	return &ForsPublicKey{Params: self.Params, PubSeed: self.PubSeed,
+		Address: self.Address, Root: forsRoots(roots, self.PubSeed, self.Address)}
+}
+
+// forsRoots hashes the K roots to a pubkey.
+func forsRoots(roots []lamport.Block, seed [N]byte, address ADRS) lamport.Block {
+	adrs := ADRS{Layer: address.Layer, Tree: address.Tree, Type: ADRS_FORS_ROOTS,
+		KeyPair: address.KeyPair}
+	return ltree(roots, seed, adrs)
+}
+
+// SignFors reveals, in each tree, the leaf digest picks for it.  A FORS key
+// can sign a few digests, but each one makes forging likelier; see
+// ForsParams.ForgeProbabilityAfter.
+func SignFors(digest lamport.Message, pri *ForsPrivateKey) *ForsSignature {
+	sig := &ForsSignature{Trees: make([]ForsTreeSignature, pri.Params.K)}
+	for i, idx := range pri.Params.Indexes(digest) {
+		sig.Trees[i] = ForsTreeSignature{
+			Secret: pri.secret(i, idx),
+			Auth:   lamport.NewAuthPath(pri.levels(i), idx).Siblings,
+		}
+	}
+	return sig
+}
+
+// ForsPubkeyFromSignature climbs from each revealed secret to its tree's
+// root and hashes the roots, giving the pubkey sig is for if it's good.
+// pub supplies the params, PUB_SEED and address; its Root isn't used.
+func ForsPubkeyFromSignature(digest lamport.Message, sig *ForsSignature, pub *ForsPublicKey) (lamport.Block, error) {
+	params := pub.Params
+	err := params.Check()
+	if err != nil {
+		return lamport.Block{}, err
+	}
+	if len(sig.Trees) != params.K {
+		return lamport.Block{}, fmt.Errorf("%w: %d fors trees, expect %d",
+			lamport.ErrInvalidSignature, len(sig.Trees), params.K)
+	}
+	key := ForsPrivateKey{Params: params, Address: pub.Address}
+	roots := make([]lamport.Block, params.K)
+	for i, idx := range params.Indexes(digest) {
+		tree := sig.Trees[i]
+		if len(tree.Auth) != params.LogT() {
+			return lamport.Block{}, fmt.Errorf("%w: fors tree %d has %d auth nodes, expect %d",
+				lamport.ErrInvalidSignature, i, len(tree.Auth), params.LogT())
+		}
+		adrs := key.treeAdrs()
+		adrs.Hash = uint32(i*params.T + idx)
+		node := fHash(tree.Secret, pub.PubSeed, adrs)
+		for h, sibling := range tree.Auth {
+			adrs.Chain = uint32(h + 1)
+			adrs.Hash = uint32((i*params.T + idx) >> (h + 1))
+			if idx>>h&1 == 0 {
+				node = randHash(node, sibling, pub.PubSeed, adrs)
+			} else {
+				node = randHash(sibling, node, pub.PubSeed, adrs)
+			}
+		}
+		roots[i] = node
+	}
+	return forsRoots(roots, pub.PubSeed, pub.Address), nil
+}
+
+// VerifyFors checks sig on digest against pub.  It returns nil for a good
+// signature and wraps lamport.ErrInvalidSignature otherwise.
+func VerifyFors(digest lamport.Message, sig *ForsSignature, pub *ForsPublicKey) error {
+	root, err := ForsPubkeyFromSignature(digest, sig, pub)
+	if err != nil {
+		return err
+	}
+	if root != pub.Root {
+		return fmt.Errorf("%w: fors roots don't hash to the pubkey",
+			lamport.ErrInvalidSignature)
+	}
+	return nil
+}
+
+// Bytes returns the signature's encoding.
+func (self *ForsSignature) Bytes() []byte {
+	var b []byte
+	for _, tree := range self.Trees {
+		b = append(b, tree.Secret[:]...)
+		for _, node := range tree.Auth {
+			b = append(b, node[:]...)
+		}
+	}
+	return b
+}
+
+// ForsSignatureFromBytes decodes a signature made with params.
+func ForsSignatureFromBytes(params ForsParams, b []byte) (*ForsSignature, error) {
+	err := params.Check()
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != params.SignatureBytes() {
+		return nil, fmt.Errorf("%w: fors signature %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), params.SignatureBytes())
+	}
+	sig := &ForsSignature{Trees: make([]ForsTreeSignature, params.K)}
+	for i := range sig.Trees {
+		tree := &sig.Trees[i]
+		copy(tree.Secret[:], b)
+		b = b[N:]
+		tree.Auth = make([]lamport.Block, params.LogT())
+		for h := range tree.Auth {
+			copy(tree.Auth[h][:], b)
+			b = b[N:]
+		}
+	}
+	return sig, nil
+}
+
+// Bytes returns the pubkey's encoding, FORS_PUBKEY_BYTES long.  The
+// address isn't included; a standalone key's is zero.
+func (self *ForsPublicKey) Bytes() []byte {
+	b := make([]byte, 0, FORS_PUBKEY_BYTES)
+	b = append(b, byte(self.Params.LogT()), byte(self.Params.K>>8), byte(self.Params.K))
+	b = append(b, self.Root[:]...)
+	return append(b, self.PubSeed[:]...)
+}
+
+// ForsPubkeyFromBytes is the inverse of ForsPublicKey.Bytes.
+func ForsPubkeyFromBytes(b []byte) (*ForsPublicKey, error) {
+	if len(b) != FORS_PUBKEY_BYTES {
+		return nil, fmt.Errorf("%w: fors pubkey %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), FORS_PUBKEY_BYTES)
+	}
+	if b[0] > 16 {
+		return nil, fmt.Errorf("xmss: fors log2(t) %d, expect at most 16", b[0])
+	}
+	params := ForsParams{hors.Params{T: 1 << b[0], K: int(b[1])<<8 | int(b[2])}}
+	err := params.Check()
+	if err != nil {
+		return nil, err
+	}
+	pub := &ForsPublicKey{Params: params}
+	copy(pub.Root[:], b[FORS_PARAMS_BYTES:])
+	copy(pub.PubSeed[:], b[FORS_PARAMS_BYTES+N:])
+	return pub, nil
+}
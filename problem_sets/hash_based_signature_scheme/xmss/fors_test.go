@@ -0,0 +1,196 @@
+package xmss
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"ps/01/hors"
+	"ps/01/lamport"
+)
+
+// Known answers for a k=4, t=16 FORS key from the seed 00 01 .. 3f: the
+// root, and the sha256 of its signature on "fors".
+const (
+	KAT_FORS_ROOT      = "21dd2b20bb8ebcdcc505a95a54d0da4b3ebc33080b78ad2ecc2ef67fa081251e"
+	KAT_FORS_SIGNATURE = "0b6cf836fdb442f3b3e9c8fe5fc7d47c6e64f8d1a104cfee1d699e4e5bab4ef7"
+)
+
+var testForsParams = ForsParams{hors.Params{T: 16, K: 4}}
+
+func testForsKey(t *testing.T) (*ForsPrivateKey, *ForsPublicKey) {
+	pri, pub, err := GenerateForsKey(testForsParams, testSeed()[:FORS_SEED_BYTES])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pri, pub
+}
+
+// TestForsKAT checks the k=4, t=16 key and signature against the known
+// answers.
+func TestForsKAT(t *testing.T) {
+	pri, pub := testForsKey(t)
+	if hex.EncodeToString(pub.Root[:]) != KAT_FORS_ROOT {
+		t.Fatalf("root %x, expect %s", pub.Root, KAT_FORS_ROOT)
+	}
+	digest := lamport.GetMessageFromString("fors")
+	sig := SignFors(digest, pri)
+	h := sha256.Sum256(sig.Bytes())
+	if hex.EncodeToString(h[:]) != KAT_FORS_SIGNATURE {
+		t.Fatalf("signature hash %x, expect %s", h, KAT_FORS_SIGNATURE)
+	}
+	err := VerifyFors(digest, sig, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestForsVerify checks other digests, changed secrets and auth nodes, and
+// keys at other addresses are rejected.
+func TestForsVerify(t *testing.T) {
+	pri, pub := testForsKey(t)
+	digest := lamport.GetMessageFromString("fors")
+	sig := SignFors(digest, pri)
+
+	err := VerifyFors(lamport.GetMessageFromString("other"), sig, pub)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("other digest: got %v, expect ErrInvalidSignature", err)
+	}
+	sig.Trees[1].Secret[0] ^= 1
+	err = VerifyFors(digest, sig, pub)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("changed secret: got %v, expect ErrInvalidSignature", err)
+	}
+	sig.Trees[1].Secret[0] ^= 1
+	sig.Trees[3].Auth[2][0] ^= 1
+	err = VerifyFors(digest, sig, pub)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("changed auth node: got %v, expect ErrInvalidSignature", err)
+	}
+	sig.Trees[3].Auth[2][0] ^= 1
+	sig.Trees[0].Auth = sig.Trees[0].Auth[1:]
+	err = VerifyFors(digest, sig, pub)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("short auth path: got %v, expect ErrInvalidSignature", err)
+	}
+
+	// the same seeds at another key pair address make another key
+	pri = &ForsPrivateKey{Params: pri.Params, SKSeed: pri.SKSeed, PubSeed: pri.PubSeed,
+		Address: ADRS{KeyPair: 1}}
+	moved := pri.PublicKey()
+	if moved.Root == pub.Root {
+		t.Fatalf("key at key pair 1 has the same root as key pair 0")
+	}
+	err = VerifyFors(digest, SignFors(digest, pri), moved)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestForsEncoding round trips a pubkey and a signature.
+func TestForsEncoding(t *testing.T) {
+	pri, pub := testForsKey(t)
+	digest := lamport.GetMessageFromString("fors")
+	sig, err := ForsSignatureFromBytes(testForsParams, SignFors(digest, pri).Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sig.Bytes()) != testForsParams.SignatureBytes() {
+		t.Fatalf("signature %d bytes, expect %d", len(sig.Bytes()),
+			testForsParams.SignatureBytes())
+	}
+	pub2, err := ForsPubkeyFromBytes(pub.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyFors(digest, sig, pub2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ForsSignatureFromBytes(testForsParams, sig.Bytes()[1:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short signature: got %v, expect ErrWrongLength", err)
+	}
+	_, err = ForsPubkeyFromBytes(pub.Bytes()[1:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short pubkey: got %v, expect ErrWrongLength", err)
+	}
+}
+
+// TestForsReuse checks ForgeProbabilityAfter against how often random
+// digests are covered by r earlier ones, then reuses a key 32 times and
+// forges a signature from what it revealed.
+func TestForsReuse(t *testing.T) {
+	params := testForsParams
+	rng := rand.New(rand.NewSource(1))
+	random := func() lamport.Message {
+		var m lamport.Message
+		rng.Read(m[:])
+		return m
+	}
+
+	const keys, tries = 200, 200
+	for _, r := range []int{4, 8, 16, 32} {
+		forged := 0
+		for k := 0; k < keys; k++ {
+			revealed := make(map[[2]int]bool)
+			for i := 0; i < r; i++ {
+				for tree, idx := range params.Indexes(random()) {
+					revealed[[2]int{tree, idx}] = true
+				}
+			}
+			for i := 0; i < tries; i++ {
+				ok := true
+				for tree, idx := range params.Indexes(random()) {
+					ok = ok && revealed[[2]int{tree, idx}]
+				}
+				if ok {
+					forged++
+				}
+			}
+		}
+		got := float64(forged) / (keys * tries)
+		expect := params.ForgeProbabilityAfter(r)
+		if math.Abs(got-expect) > 0.02+0.25*expect {
+			t.Fatalf("after %d signatures: forged %.4f of digests, estimate %.4f",
+				r, got, expect)
+		}
+		if expect >= (hors.Params{T: params.T, K: params.K}).ForgeProbabilityAfter(r) {
+			t.Fatalf("after %d signatures fors is no stronger than hors", r)
+		}
+	}
+
+	pri, pub := testForsKey(t)
+	revealed := make(map[[2]int]ForsTreeSignature)
+	for i := 0; i < 32; i++ {
+		digest := random()
+		sig := SignFors(digest, pri)
+		for tree, idx := range params.Indexes(digest) {
+			revealed[[2]int{tree, idx}] = sig.Trees[tree]
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		digest := random()
+		forgery := &ForsSignature{}
+		for tree, idx := range params.Indexes(digest) {
+			part, ok := revealed[[2]int{tree, idx}]
+			if !ok {
+				break
+			}
+			forgery.Trees = append(forgery.Trees, part)
+		}
+		if len(forgery.Trees) < params.K {
+			continue
+		}
+		err := VerifyFors(digest, forgery, pub)
+		if err != nil {
+			t.Fatalf("forgery from revealed leaves didn't verify: %v", err)
+		}
+		return
+	}
+	t.Fatalf("no forgery in 1000 digests after 32 signatures, estimate %.3f each",
+		params.ForgeProbabilityAfter(32))
+}
@@ -0,0 +1,172 @@
+package xmss
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"ps/01/lamport"
+)
+
+/*
+Every hash in XMSS is keyed by where it happens.  An ADRS encodes as eight
+32 bit big endian words, the same layout as RFC 8391:
+
+    0  layer
+    1  tree, high word
+    2  tree, low word
+    3  type: ADRS_OTS, ADRS_LTREE, ADRS_HASHTREE, ADRS_FORS_TREE or
+       ADRS_FORS_ROOTS
+    4  OTS: key pair     L-tree: L-tree index    hash tree: 0
+    5  OTS: chain        L-tree: tree height     hash tree: tree height
+    6  OTS: hash step    L-tree: tree index      hash tree: tree index
+    7  key and mask: 0 for a key, 1 and 2 for bitmasks
+
+The FORS types follow SPHINCS+: a FORS tree address has the key pair in
+word 4 and the node's height and index in words 5 and 6, the index counting
+across all K trees as if they were one wide tree; a FORS roots address has
+only the key pair, and the L-tree over the roots uses words 5 and 6 as an
+L-tree address does.
+
+The hash functions are SHA-256 with a 32 byte prefix saying which one it is,
+as in RFC 8391:
+
+    F(key, m)          sha256(0 || key || m)         m 32 bytes
+    H(key, m)          sha256(1 || key || m)         m 64 bytes
+    H_msg(key, m)      sha256(2 || key || m)         key is r || root || index
+    PRF(key, adrs)     sha256(3 || key || adrs)
+*/
+
+const (
+	ADRS_OTS        = 0
+	ADRS_LTREE      = 1
+	ADRS_HASHTREE   = 2
+	ADRS_FORS_TREE  = 3
+	ADRS_FORS_ROOTS = 4
+)
+
+const ADRS_BYTES = 32
+
+const (
+	padF    = 0
+	padH    = 1
+	padHMsg = 2
+	padPRF  = 3
+)
+
+// ADRS is the address of one hash call.  Which fields mean what depends on
+// Type; see the layout above.
+type ADRS struct {
+	Layer      uint32
+	Tree       uint64
+	Type       uint32
+	KeyPair    uint32
+	Chain      uint32
+	Hash       uint32
+	KeyAndMask uint32
+}
+
+// Bytes returns the address as ADRS_BYTES.
+func (self ADRS) Bytes() [ADRS_BYTES]byte {
+	var b [ADRS_BYTES]byte
+	binary.BigEndian.PutUint32(b[0:], self.Layer)
+	binary.BigEndian.PutUint64(b[4:], self.Tree)
+	binary.BigEndian.PutUint32(b[12:], self.Type)
+	binary.BigEndian.PutUint32(b[16:], self.KeyPair)
+	binary.BigEndian.PutUint32(b[20:], self.Chain)
+	binary.BigEndian.PutUint32(b[24:], self.Hash)
+	binary.BigEndian.PutUint32(b[28:], self.KeyAndMask)
+	return b
+}
+
+// hashPadded returns sha256(pad as 32 bytes || parts...).
+func hashPadded(pad byte, parts ...[]byte) lamport.Block {
+	h := sha256.New()
+	var prefix [N]byte
+	prefix[N-1] = pad
+	h.Write(prefix[:])
+	for _, p := range parts {
+		h.Write(p)
+	}
+	var out lamport.Block
+	h.Sum(out[:0])
+	return out
+}
+
+func prf(key [N]byte, adrs ADRS) lamport.Block {
+	a := adrs.Bytes()
+	return hashPadded(padPRF, key[:], a[:])
+}
+
+// prfIndex is PRF(SK_PRF, toByte(index, 32)), the randomness for the
+// message hash of signature index.
+func prfIndex(key [N]byte, index uint64) lamport.Block {
+	var idx [N]byte
+	binary.BigEndian.PutUint64(idx[N-8:], index)
+	return hashPadded(padPRF, key[:], idx[:])
+}
+
+// hashMessage is H_msg(r || root || toByte(index, 32), msg).
+func hashMessage(r, root [N]byte, index uint64, msg []byte) lamport.Message {
+	var idx [N]byte
+	binary.BigEndian.PutUint64(idx[N-8:], index)
+	return lamport.Message(hashPadded(padHMsg, r[:], root[:], idx[:], msg))
+}
+
+// fHash is F keyed by PRF(seed, adrs), over x masked by its own PRF output.
+func fHash(x lamport.Block, seed [N]byte, adrs ADRS) lamport.Block {
+	adrs.KeyAndMask = 0
+	key := prf(seed, adrs)
+	adrs.KeyAndMask = 1
+	mask := prf(seed, adrs)
+	for j := range x {
+		x[j] ^= mask[j]
+	}
+	return hashPadded(padF, key[:], x[:])
+}
+
+// chain takes x from position start of the chain at adrs, steps steps
+// further, with an fHash at each step.
+func chain(x lamport.Block, start, steps int, seed [N]byte, adrs ADRS) lamport.Block {
+	for i := start; i < start+steps; i++ {
+		adrs.Hash = uint32(i)
+		x = fHash(x, seed, adrs)
+	}
+	return x
+}
+
+// randHash is RAND_HASH: H keyed by PRF(seed, adrs) over the two children,
+// each masked by its own PRF output.
+func randHash(left, right lamport.Block, seed [N]byte, adrs ADRS) lamport.Block {
+	adrs.KeyAndMask = 0
+	key := prf(seed, adrs)
+	adrs.KeyAndMask = 1
+	mask0 := prf(seed, adrs)
+	adrs.KeyAndMask = 2
+	mask1 := prf(seed, adrs)
+	var m [2 * N]byte
+	for j := 0; j < N; j++ {
+		m[j] = left[j] ^ mask0[j]
+		m[N+j] = right[j] ^ mask1[j]
+	}
+	return hashPadded(padH, key[:], m[:])
+}
+
+// ltree hashes a WOTS+ pubkey down to a leaf, a level at a time, promoting
+// the odd node out like wots.LTreeRoot but with randHash at each node.
+// adrs is an ADRS_LTREE address with KeyPair set to the leaf.
+func ltree(pk []lamport.Block, seed [N]byte, adrs ADRS) lamport.Block {
+	level := append([]lamport.Block(nil), pk...)
+	for height := 0; len(level) > 1; height++ {
+		adrs.Chain = uint32(height)
+		next := level[:0]
+		for i := 0; i+1 < len(level); i += 2 {
+			adrs.Hash = uint32(i / 2)
+			next = append(next, randHash(level[i], level[i+1], seed, adrs))
+		}
+		if len(level)%2 == 1 {
+			next = append(next, level[len(level)-1])
+		}
+		level = next
+	}
+	return level[0]
+}
@@ -0,0 +1,64 @@
+package xmss
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"ps/01/lamport"
+)
+
+// TestHashKAT pins the address layout and the keyed hashes, with key and
+// seed 00 01 .. 1f.
+func TestHashKAT(t *testing.T) {
+	var seed [N]byte
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	adrs := ADRS{Layer: 1, Tree: 0x0203040506070809, Type: ADRS_OTS,
+		KeyPair: 4, Chain: 5, Hash: 6, KeyAndMask: 1}
+	b := adrs.Bytes()
+	if hex.EncodeToString(b[:]) !=
+		"0000000102030405060708090000000000000004000000050000000600000001" {
+		t.Fatalf("ADRS encodes as %x", b)
+	}
+
+	var zero lamport.Block
+	for _, tc := range []struct {
+		name   string
+		got    lamport.Block
+		expect string
+	}{
+		{"PRF", prf(seed, adrs),
+			"8c3c2798add429153b18df9fd1d6302b04d37505494bdd61f11178740fef9466"},
+		{"chain", chain(zero, 0, 15, seed, adrs),
+			"52ef9cf0a69fc730f181ba6c86d65c60c0542266db3125cb7a73ae861d19d634"},
+		{"RAND_HASH", randHash(zero, seed, seed, ADRS{Type: ADRS_HASHTREE, Chain: 1, Hash: 2}),
+			"aae7331a9d5205c497e4e8b89ee368bba28169ede1441e87b471613c40e7844b"},
+	} {
+		if hex.EncodeToString(tc.got[:]) != tc.expect {
+			t.Fatalf("%s: got %x, expect %s", tc.name, tc.got, tc.expect)
+		}
+	}
+}
+
+// TestAddressesDiffer checks changing any field of the address changes the
+// keyed hash, so no two positions share a key.
+func TestAddressesDiffer(t *testing.T) {
+	var seed [N]byte
+	var x lamport.Block
+	base := ADRS{Type: ADRS_OTS}
+	seen := map[lamport.Block]string{chain(x, 0, 1, seed, base): "base"}
+	for name, adrs := range map[string]ADRS{
+		"layer":    {Layer: 1, Type: ADRS_OTS},
+		"tree":     {Tree: 1, Type: ADRS_OTS},
+		"type":     {Type: ADRS_LTREE},
+		"key pair": {Type: ADRS_OTS, KeyPair: 1},
+		"chain":    {Type: ADRS_OTS, Chain: 1},
+	} {
+		h := chain(x, 0, 1, seed, adrs)
+		if other, ok := seen[h]; ok {
+			t.Fatalf("%s hashes the same as %s", name, other)
+		}
+		seen[h] = name
+	}
+}
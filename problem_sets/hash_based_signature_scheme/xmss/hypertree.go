@@ -0,0 +1,450 @@
+package xmss
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"sync"
+
+	"ps/01/lamport"
+	"ps/01/mss"
+)
+
+/*
+A hypertree stacks Layers layers of XMSS trees, each Height tall.  The one
+tree on the top layer is the pubkey; each of its leaves signs the root of a
+tree on the layer below, and so on down to layer 0, whose leaves sign
+messages.  Signature index i uses, on layer j,
+
+    tree  i >> ((j+1) * Height)
+    leaf  (i >> (j * Height)) mod 2^Height
+
+and every hash in that tree has Layer j and Tree set in its address, so the
+trees are all different though they come from the same seeds.  Only the top
+tree is built at keygen; the others are built from the seed when a
+signature first needs them, and each layer keeps just its current tree.
+
+A HyperSigner's state encodes as
+
+    params   3 bytes: layers, height, log2(w)
+    next     8 bytes, big endian, plus 1<<63 if the last index is a PoP
+             leaf and 1<<62 once it's proved
+    SK_SEED || SK_PRF || PUB_SEED || root
+
+a hyper pubkey as params || root || PUB_SEED, and a signature as
+
+    index    8 bytes, big endian
+    R        32 bytes
+    per layer, bottom first: the WOTS+ signature, then Height auth nodes
+*/
+
+// MAX_TOTAL_HEIGHT bounds Layers * Height, so signature indexes fit in 64
+// bits with room to spare.
+const MAX_TOTAL_HEIGHT = 60
+
+const HYPER_PARAMS_BYTES = 3
+const HYPER_PUBKEY_BYTES = HYPER_PARAMS_BYTES + 2*N
+const HYPER_STATE_BYTES = HYPER_PARAMS_BYTES + 8 + 4*N
+
+// HyperParams are the number of layers, the height of each tree, and the
+// Winternitz parameter of every WOTS+ key.
+type HyperParams struct {
+	Layers int
+	Height int
+	W      int
+}
+
+// Check makes sure the params can be used.
+func (self HyperParams) Check() error {
+	_, err := Params{Height: self.Height, W: self.W}.wots()
+	if err != nil {
+		return err
+	}
+	if self.Layers < 1 || self.Height < 1 || self.Layers*self.Height > MAX_TOTAL_HEIGHT {
+		return fmt.Errorf("xmss: %d layers of height %d, expect at least 1 of 1 and at most %d in all",
+			self.Layers, self.Height, MAX_TOTAL_HEIGHT)
+	}
+	return nil
+}
+
+// TotalHeight is the height of the whole hypertree.
+func (self HyperParams) TotalHeight() int {
+	return self.Layers * self.Height
+}
+
+// SignatureBytes is the size of a hypertree signature.
+func (self HyperParams) SignatureBytes() int {
+	single := Params{Height: self.Height, W: self.W}.SignatureBytes() - 4 - N
+	return 8 + N + self.Layers*single
+}
+
+// subtree returns the tree at layer and tree; skSeed is zero on the
+// verifying side.
+func (self HyperParams) subtree(skSeed, pubSeed [N]byte, layer int, tree uint64) subtree {
+	wp, _ := Params{Height: self.Height, W: self.W}.wots()
+	return subtree{wp: wp, height: self.Height, skSeed: skSeed, pubSeed: pubSeed,
+		layer: uint32(layer), tree: tree}
+}
+
+// position returns which tree and leaf on layer signature index uses.
+func (self HyperParams) position(index uint64, layer int) (tree uint64, leaf uint32) {
+	tree = index >> ((layer + 1) * self.Height)
+	leaf = uint32(index>>(layer*self.Height)) & (1<<self.Height - 1)
+	return tree, leaf
+}
+
+// HyperPublicKey is the root of the top tree and the seed keying every
+// hash in the hypertree.
+type HyperPublicKey struct {
+	Params  HyperParams
+	Root    [N]byte
+	PubSeed [N]byte
+}
+
+// LayerSignature is one layer's part of a hypertree signature: the WOTS+
+// signature on the root of the tree below (or the message, on layer 0), and
+// the auth path of the leaf that made it.
+type LayerSignature struct {
+	WOTS []lamport.Block
+	Auth []lamport.Block
+}
+
+// HyperSignature is a signature by hypertree index Index: one
+// LayerSignature per layer, bottom first.
+type HyperSignature struct {
+	Index  uint64
+	R      [N]byte
+	Layers []LayerSignature
+}
+
+// layerTree is the tree a HyperSigner currently has built on one layer.
+type layerTree struct {
+	tree   uint64
+	levels [][]lamport.Block
+}
+
+// HyperSigner signs with each index of a hypertree once, in order.  It's
+// safe to call from several goroutines.
+type HyperSigner struct {
+	Params HyperParams
+
+	mu      sync.Mutex
+	next    uint64
+	skSeed  [N]byte
+	skPRF   [N]byte
+	pubSeed [N]byte
+	root    [N]byte
+	trees   []layerTree
+	// trees built since keygen or load, for tests
+	built int
+	// the last index is the PoP leaf, which Sign doesn't use, and whether
+	// it's proved yet
+	pop     bool
+	popUsed bool
+}
+
+// GenerateHyperKey makes a hypertree key from a SEED_BYTES seed, building
+// only the top tree.  The same seed always gives the same keys and
+// signatures.
+func GenerateHyperKey(params HyperParams, seed []byte) (*HyperSigner, *HyperPublicKey, error) {
+	err := params.Check()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(seed) != SEED_BYTES {
+		return nil, nil, fmt.Errorf("%w: xmss seed %d bytes, expect %d",
+			lamport.ErrWrongLength, len(seed), SEED_BYTES)
+	}
+	signer := &HyperSigner{Params: params}
+	copy(signer.skSeed[:], seed)
+	copy(signer.skPRF[:], seed[N:])
+	copy(signer.pubSeed[:], seed[2*N:])
+	signer.trees = make([]layerTree, params.Layers)
+	top := signer.tree(params.Layers-1, 0)
+	signer.root = top[params.Height][0]
+	return signer, signer.PublicKey(), nil
+}
+
+// PublicKey returns the signer's pubkey.
+func (self *HyperSigner) PublicKey() *HyperPublicKey {
+	return &HyperPublicKey{Params: self.Params, Root: self.root, PubSeed: self.pubSeed}
+}
+
+// tree returns the levels of the given tree on layer, building it if it
+// isn't the one the layer has.  Call with mu held, or before the signer is
+// shared.
+func (self *HyperSigner) tree(layer int, tree uint64) [][]lamport.Block {
+	t := &self.trees[layer]
+	if t.levels == nil || t.tree != tree {
+		t.tree = tree
+		t.levels = self.Params.subtree(self.skSeed, self.pubSeed, layer, tree).levels()
+		self.built++
+	}
+	return t.levels
+}
+
+// Index returns the index the next signature will use.
+func (self *HyperSigner) Index() uint64 {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.next
+}
+
+// Position returns the tree and leaf the next signature will use on layer.
+func (self *HyperSigner) Position(layer int) (tree uint64, leaf uint32) {
+	return self.Params.position(self.Index(), layer)
+}
+
+// Remaining returns the number of signatures left, not counting a PoP leaf.
+func (self *HyperSigner) Remaining() uint64 {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.signable() - self.next
+}
+
+// signable returns how many indexes, from the first, Sign may use: all of
+// them, or all but the PoP leaf.  It's called with mu held.
+func (self *HyperSigner) signable() uint64 {
+	if self.pop {
+		return 1<<self.Params.TotalHeight() - 1
+	}
+	return 1 << self.Params.TotalHeight()
+}
+
+// Sign signs msg with the next index.  Once every index but a PoP leaf has
+// signed it's mss.ErrTreeExhausted.
+func (self *HyperSigner) Sign(msg []byte) (*HyperSignature, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if self.next >= self.signable() {
+		return nil, mss.ErrTreeExhausted
+	}
+	index := self.next
+	self.next++
+	return self.sign(msg, index), nil
+}
+
+// sign signs msg with index, whatever next says.  Call with mu held.
+func (self *HyperSigner) sign(msg []byte, index uint64) *HyperSignature {
+	sig := &HyperSignature{Index: index, R: prfIndex(self.skPRF, index)}
+	digest := hashMessage(sig.R, self.root, index, msg)
+	sig.Layers = self.signNode(index, digest)
+	return sig
+}
+
+// SignNode signs node, a digest or the root of some other key, with the
+// layers of index, and doesn't touch the signer's next index.  It's for
+// schemes that pick the index themselves, like sphincslite, and must never
+// be mixed with Sign on the same signer: an index can sign only once.
+func (self *HyperSigner) SignNode(index uint64, node lamport.Message) ([]LayerSignature, error) {
+	if index>>self.Params.TotalHeight() != 0 {
+		return nil, fmt.Errorf("xmss: index %d of %d", index,
+			uint64(1)<<self.Params.TotalHeight())
+	}
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.signNode(index, node), nil
+}
+
+// signNode signs node with every layer of index, bottom first.  Call with
+// mu held.
+func (self *HyperSigner) signNode(index uint64, node lamport.Message) []LayerSignature {
+	layers := make([]LayerSignature, self.Params.Layers)
+	for j := range layers {
+		tree, leaf := self.Params.position(index, j)
+		levels := self.tree(j, tree)
+		st := self.Params.subtree(self.skSeed, self.pubSeed, j, tree)
+		layers[j] = LayerSignature{
+			WOTS: st.sign(node, leaf),
+			Auth: lamport.NewAuthPath(levels, int(leaf)).Siblings,
+		}
+		node = lamport.Message(levels[self.Params.Height][0])
+	}
+	return layers
+}
+
+// HyperRootFromLayers climbs from node through layers, the signature of
+// index on it, and returns the top root they lead to.  Layers that don't
+// fit params wrap lamport.ErrInvalidSignature.
+func HyperRootFromLayers(params HyperParams, pubSeed [N]byte, index uint64, node lamport.Message, layers []LayerSignature) (lamport.Block, error) {
+	err := params.Check()
+	if err != nil {
+		return lamport.Block{}, err
+	}
+	if len(layers) != params.Layers || index>>params.TotalHeight() != 0 {
+		return lamport.Block{}, fmt.Errorf("%w: signature doesn't fit params %+v",
+			lamport.ErrInvalidSignature, params)
+	}
+	for j, layer := range layers {
+		tree, leaf := params.position(index, j)
+		st := params.subtree([N]byte{}, pubSeed, j, tree)
+		if len(layer.WOTS) != st.wp.Len() || len(layer.Auth) != params.Height {
+			return lamport.Block{}, fmt.Errorf("%w: layer %d doesn't fit params %+v",
+				lamport.ErrInvalidSignature, j, params)
+		}
+		node = lamport.Message(st.root(node, leaf, layer.WOTS, layer.Auth))
+	}
+	return lamport.Block(node), nil
+}
+
+// VerifyHyper checks sig on msg against pub, climbing every layer to the
+// top root.  It returns nil for a good signature and wraps
+// lamport.ErrInvalidSignature otherwise.
+func VerifyHyper(msg []byte, sig *HyperSignature, pub *HyperPublicKey) error {
+	node := hashMessage(sig.R, pub.Root, sig.Index, msg)
+	root, err := HyperRootFromLayers(pub.Params, pub.PubSeed, sig.Index, node, sig.Layers)
+	if err != nil {
+		return err
+	}
+	if root != pub.Root {
+		return fmt.Errorf("%w: index %d doesn't reach the root",
+			lamport.ErrInvalidSignature, sig.Index)
+	}
+	return nil
+}
+
+func (self HyperParams) bytes() []byte {
+	return []byte{byte(self.Layers), byte(self.Height),
+		byte(bits.TrailingZeros(uint(self.W)))}
+}
+
+func hyperParamsFromBytes(b []byte) (HyperParams, error) {
+	p, err := paramsFromBytes(b[1:])
+	if err != nil {
+		return HyperParams{}, err
+	}
+	hp := HyperParams{Layers: int(b[0]), Height: p.Height, W: p.W}
+	err = hp.Check()
+	if err != nil {
+		return HyperParams{}, err
+	}
+	return hp, nil
+}
+
+// Bytes returns the pubkey's encoding, HYPER_PUBKEY_BYTES long.
+func (self *HyperPublicKey) Bytes() []byte {
+	b := make([]byte, 0, HYPER_PUBKEY_BYTES)
+	b = append(b, self.Params.bytes()...)
+	b = append(b, self.Root[:]...)
+	return append(b, self.PubSeed[:]...)
+}
+
+// HyperPubkeyFromBytes is the inverse of HyperPublicKey.Bytes.
+func HyperPubkeyFromBytes(b []byte) (*HyperPublicKey, error) {
+	if len(b) != HYPER_PUBKEY_BYTES {
+		return nil, fmt.Errorf("%w: hyper pubkey %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), HYPER_PUBKEY_BYTES)
+	}
+	params, err := hyperParamsFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	pub := &HyperPublicKey{Params: params}
+	copy(pub.Root[:], b[HYPER_PARAMS_BYTES:])
+	copy(pub.PubSeed[:], b[HYPER_PARAMS_BYTES+N:])
+	return pub, nil
+}
+
+// Bytes returns the signature's encoding.
+func (self *HyperSignature) Bytes() []byte {
+	b := binary.BigEndian.AppendUint64(nil, self.Index)
+	b = append(b, self.R[:]...)
+	for _, layer := range self.Layers {
+		for _, x := range layer.WOTS {
+			b = append(b, x[:]...)
+		}
+		for _, x := range layer.Auth {
+			b = append(b, x[:]...)
+		}
+	}
+	return b
+}
+
+// HyperSignatureFromBytes decodes a signature made with params.
+func HyperSignatureFromBytes(params HyperParams, b []byte) (*HyperSignature, error) {
+	err := params.Check()
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != params.SignatureBytes() {
+		return nil, fmt.Errorf("%w: hyper signature %d bytes, expect %d",
+			lamport.ErrWrongLength, len(b), params.SignatureBytes())
+	}
+	sig := &HyperSignature{Index: binary.BigEndian.Uint64(b)}
+	copy(sig.R[:], b[8:])
+	b = b[8+N:]
+	next := func(n int) []lamport.Block {
+		blocks := make([]lamport.Block, n)
+		for i := range blocks {
+			copy(blocks[i][:], b[i*N:])
+		}
+		b = b[n*N:]
+		return blocks
+	}
+	wp, _ := Params{Height: params.Height, W: params.W}.wots()
+	sig.Layers = make([]LayerSignature, params.Layers)
+	for j := range sig.Layers {
+		sig.Layers[j].WOTS = next(wp.Len())
+		sig.Layers[j].Auth = next(params.Height)
+	}
+	return sig, nil
+}
+
+// MarshalBinary saves the signer's seeds, root and next index.  Trees aren't
+// saved; they're rebuilt from the seed as needed.
+func (self *HyperSigner) MarshalBinary() ([]byte, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	b := make([]byte, 0, HYPER_STATE_BYTES)
+	b = append(b, self.Params.bytes()...)
+	next := self.next
+	if self.pop {
+		next |= 1 << 63
+	}
+	if self.popUsed {
+		next |= 1 << 62
+	}
+	b = binary.BigEndian.AppendUint64(b, next)
+	b = append(b, self.skSeed[:]...)
+	b = append(b, self.skPRF[:]...)
+	b = append(b, self.pubSeed[:]...)
+	return append(b, self.root[:]...), nil
+}
+
+// UnmarshalBinary loads state saved by MarshalBinary.  On error the
+// receiver is left untouched.
+func (self *HyperSigner) UnmarshalBinary(data []byte) error {
+	if len(data) != HYPER_STATE_BYTES {
+		return fmt.Errorf("%w: hyper signer state %d bytes, expect %d",
+			lamport.ErrWrongLength, len(data), HYPER_STATE_BYTES)
+	}
+	params, err := hyperParamsFromBytes(data)
+	if err != nil {
+		return err
+	}
+	next := binary.BigEndian.Uint64(data[HYPER_PARAMS_BYTES:])
+	s := HyperSigner{Params: params, next: next &^ (3 << 62),
+		pop: next>>63 == 1, popUsed: next>>62&1 == 1}
+	if s.next > s.signable() {
+		return fmt.Errorf("xmss: next index %d of %d", s.next,
+			uint64(1)<<params.TotalHeight())
+	}
+	if s.popUsed && !s.pop {
+		return fmt.Errorf("xmss: PoP leaf flags %#x", next>>62)
+	}
+	b := data[HYPER_PARAMS_BYTES+8:]
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.Params = params
+	self.next = s.next
+	self.pop = s.pop
+	self.popUsed = s.popUsed
+	copy(self.skSeed[:], b)
+	copy(self.skPRF[:], b[N:])
+	copy(self.pubSeed[:], b[2*N:])
+	copy(self.root[:], b[3*N:])
+	self.trees = make([]layerTree, params.Layers)
+	self.built = 0
+	return nil
+}
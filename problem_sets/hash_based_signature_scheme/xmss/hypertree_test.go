@@ -0,0 +1,211 @@
+package xmss
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"ps/01/lamport"
+	"ps/01/mss"
+)
+
+// TestHyperBoundary signs across the boundary between the first two bottom
+// trees of a two layer hypertree, and checks bottom trees are only built
+// when a signature first reaches them.
+func TestHyperBoundary(t *testing.T) {
+	signer, pub, err := GenerateHyperKey(HyperParams{Layers: 2, Height: 2, W: 16}, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.built != 1 {
+		t.Fatalf("keygen built %d trees, expect just the top one", signer.built)
+	}
+	for i := 0; i < 6; i++ {
+		tree, leaf := signer.Position(0)
+		if tree != uint64(i/4) || leaf != uint32(i%4) {
+			t.Fatalf("index %d: at tree %d leaf %d", i, tree, leaf)
+		}
+		msg := []byte(fmt.Sprint("message ", i))
+		sig, err := signer.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = VerifyHyper(msg, sig, pub)
+		if err != nil {
+			t.Fatalf("index %d: %v", i, err)
+		}
+		expect := 2
+		if i >= 4 {
+			expect = 3
+		}
+		if signer.built != expect {
+			t.Fatalf("index %d: %d trees built, expect %d", i, signer.built, expect)
+		}
+	}
+	if signer.Index() != 6 || signer.Remaining() != 10 {
+		t.Fatalf("at index %d with %d left, expect 6 with 10",
+			signer.Index(), signer.Remaining())
+	}
+}
+
+// TestHyperDeterministic checks two signers from the same seed, and one
+// reloaded part way, make identical signatures for the same indexes.
+func TestHyperDeterministic(t *testing.T) {
+	params := HyperParams{Layers: 3, Height: 1, W: 4}
+	a, pub, err := GenerateHyperKey(params, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, pub2, err := GenerateHyperKey(params, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *pub != *pub2 {
+		t.Fatalf("same seed gave different pubkeys")
+	}
+	msg := []byte("deterministic")
+	for i := 0; i < 3; i++ {
+		sa, err := a.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sb, err := b.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sa.Bytes(), sb.Bytes()) {
+			t.Fatalf("index %d: signatures differ", i)
+		}
+	}
+
+	data, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c HyperSigner
+	err = c.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 3; i < 8; i++ {
+		sa, err := a.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sc, err := c.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sa.Bytes(), sc.Bytes()) {
+			t.Fatalf("index %d: reloaded signer signs differently", i)
+		}
+		err = VerifyHyper(msg, sc, pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = c.Sign(msg)
+	if !errors.Is(err, mss.ErrTreeExhausted) {
+		t.Fatalf("got %v, expect ErrTreeExhausted", err)
+	}
+	if err = c.UnmarshalBinary(data[1:]); !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short state: got %v, expect ErrWrongLength", err)
+	}
+}
+
+// TestHyperTampered checks a signature fails with a layer changed, with the
+// index of another bottom tree, or on another message.
+func TestHyperTampered(t *testing.T) {
+	signer, pub, err := GenerateHyperKey(HyperParams{Layers: 2, Height: 2, W: 16}, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("tamper")
+	sig, err := signer.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := sig.Bytes()
+	decode := func() *HyperSignature {
+		s, err := HyperSignatureFromBytes(pub.Params, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	if err = VerifyHyper(msg, decode(), pub); err != nil {
+		t.Fatal(err)
+	}
+	for name, tamper := range map[string]func(s *HyperSignature){
+		"bottom wots": func(s *HyperSignature) { s.Layers[0].WOTS[0][0] ^= 1 },
+		"top auth":    func(s *HyperSignature) { s.Layers[1].Auth[1][0] ^= 1 },
+		"other tree":  func(s *HyperSignature) { s.Index = 4 },
+		"too big":     func(s *HyperSignature) { s.Index = 16 },
+		"one layer":   func(s *HyperSignature) { s.Layers = s.Layers[:1] },
+	} {
+		s := decode()
+		tamper(s)
+		err = VerifyHyper(msg, s, pub)
+		if !errors.Is(err, lamport.ErrInvalidSignature) {
+			t.Fatalf("%s: got %v, expect ErrInvalidSignature", name, err)
+		}
+	}
+	if err = VerifyHyper([]byte("other"), decode(), pub); !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("other message: got %v, expect ErrInvalidSignature", err)
+	}
+
+	pub2, err := HyperPubkeyFromBytes(pub.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *pub2 != *pub {
+		t.Fatalf("pubkey round trip changed it")
+	}
+	if _, err = HyperSignatureFromBytes(pub.Params, data[1:]); !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("short signature: got %v, expect ErrWrongLength", err)
+	}
+}
+
+// TestHyperParams checks params out of range are refused.
+func TestHyperParams(t *testing.T) {
+	for _, p := range []HyperParams{
+		{0, 2, 16}, {2, 0, 16}, {2, 2, 5}, {4, 16, 16}, {2, MAX_HEIGHT + 1, 16},
+	} {
+		if _, _, err := GenerateHyperKey(p, testSeed()); err == nil {
+			t.Fatalf("GenerateHyperKey(%+v) returned nil, expected an error", p)
+		}
+	}
+}
+
+// TestHyperSignNode signs a node at a chosen index, checks it climbs to the
+// root, and checks the signer's own next index didn't move.
+func TestHyperSignNode(t *testing.T) {
+	params := HyperParams{Layers: 2, Height: 2, W: 16}
+	signer, pub, err := GenerateHyperKey(params, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := lamport.GetMessageFromString("node")
+	layers, err := signer.SignNode(13, node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.Index() != 0 {
+		t.Fatalf("SignNode moved the next index to %d", signer.Index())
+	}
+	root, err := HyperRootFromLayers(params, pub.PubSeed, 13, node, layers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != pub.Root {
+		t.Fatalf("SignNode's layers don't climb to the root")
+	}
+	root, err = HyperRootFromLayers(params, pub.PubSeed, 12, node, layers)
+	if err != nil || root == pub.Root {
+		t.Fatalf("layers for index 13 climb to the root from index 12")
+	}
+	if _, err = signer.SignNode(16, node); err == nil {
+		t.Fatalf("SignNode(16) returned nil, expected an error")
+	}
+}
@@ -0,0 +1,130 @@
+package xmss
+
+import (
+	"fmt"
+
+	"ps/01/lamport"
+	"ps/01/mss"
+)
+
+/*
+Proof of possession, as for an mss.MerkleSigner: ReservePoPLeaf sets the
+last leaf of a key (or the last index of a hypertree) aside, Sign stops
+before it, and ProvePossession spends it on mss.PoPMessage(challenge, root).
+The proof is an ordinary signature on that message by the PoP leaf.
+*/
+
+// ReservePoPLeaf sets the tree's last leaf aside as its PoP leaf, so Sign
+// stops before it and ProvePossession can use it.  The tree needs at least
+// one other leaf, and the last leaf mustn't have signed yet.  Reserving it
+// again does nothing.
+func (self *PrivateKey) ReservePoPLeaf() error {
+	if self.Params.Height == 0 {
+		return fmt.Errorf("xmss: 1 leaf, a PoP leaf needs at least 2")
+	}
+	if self.pop {
+		return nil
+	}
+	if self.Remaining() == 0 {
+		return fmt.Errorf("%w: xmss leaf %d", lamport.ErrKeyAlreadyUsed,
+			1<<self.Params.Height-1)
+	}
+	self.pop = true
+	return nil
+}
+
+// PoPIndex returns the index of the tree's PoP leaf, its last, or -1 if it
+// hasn't reserved one.
+func (self *PrivateKey) PoPIndex() int {
+	if !self.pop {
+		return -1
+	}
+	return 1<<self.Params.Height - 1
+}
+
+// ProvePossession spends pri's PoP leaf on a proof for c.  A key without a
+// PoP leaf is lamport.ErrNoPoPLeaf, and a second proof is
+// lamport.ErrKeyAlreadyUsed.
+func ProvePossession(c lamport.Challenge, pri *PrivateKey) (*Signature, error) {
+	_, err := pri.Params.wots()
+	if err != nil {
+		return nil, err
+	}
+	if !pri.pop {
+		return nil, lamport.ErrNoPoPLeaf
+	}
+	index := pri.PoPIndex()
+	if pri.popUsed {
+		return nil, fmt.Errorf("%w: xmss PoP leaf %d", lamport.ErrKeyAlreadyUsed, index)
+	}
+	pri.popUsed = true
+	msg := mss.PoPMessage(c, pri.Root)
+	return pri.sign(msg[:], uint32(index)), nil
+}
+
+// VerifyPossession checks sig answers c for pub.  A proof by any leaf but
+// the last, or one that doesn't verify, as for a replayed proof, wraps
+// lamport.ErrInvalidSignature.
+func VerifyPossession(c lamport.Challenge, pub *PublicKey, sig *Signature) error {
+	_, err := pub.Params.wots()
+	if err != nil {
+		return err
+	}
+	if uint64(sig.Index) != 1<<pub.Params.Height-1 || pub.Params.Height == 0 {
+		return fmt.Errorf("%w: proof by leaf %d, expect PoP leaf %d",
+			lamport.ErrInvalidSignature, sig.Index, 1<<pub.Params.Height-1)
+	}
+	msg := mss.PoPMessage(c, pub.Root)
+	return Verify(msg[:], sig, pub)
+}
+
+// ReservePoPLeaf sets the hypertree's last index aside as its PoP leaf, so
+// Sign stops before it and ProvePossession can use it.  The last index
+// mustn't have signed yet.  Reserving it again does nothing.
+func (self *HyperSigner) ReservePoPLeaf() error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if self.pop {
+		return nil
+	}
+	last := uint64(1)<<self.Params.TotalHeight() - 1
+	if self.next > last {
+		return fmt.Errorf("%w: xmss index %d", lamport.ErrKeyAlreadyUsed, last)
+	}
+	self.pop = true
+	return nil
+}
+
+// ProvePossession spends the hypertree's PoP leaf on a proof for c.  A
+// signer without a PoP leaf is lamport.ErrNoPoPLeaf, and a second proof is
+// lamport.ErrKeyAlreadyUsed.
+func (self *HyperSigner) ProvePossession(c lamport.Challenge) (*HyperSignature, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if !self.pop {
+		return nil, lamport.ErrNoPoPLeaf
+	}
+	last := uint64(1)<<self.Params.TotalHeight() - 1
+	if self.popUsed {
+		return nil, fmt.Errorf("%w: xmss PoP index %d", lamport.ErrKeyAlreadyUsed, last)
+	}
+	self.popUsed = true
+	msg := mss.PoPMessage(c, self.root)
+	return self.sign(msg[:], last), nil
+}
+
+// VerifyHyperPossession checks sig answers c for pub, the way
+// VerifyPossession does for a single tree.
+func VerifyHyperPossession(c lamport.Challenge, pub *HyperPublicKey, sig *HyperSignature) error {
+	err := pub.Params.Check()
+	if err != nil {
+		return err
+	}
+	last := uint64(1)<<pub.Params.TotalHeight() - 1
+	if sig.Index != last {
+		return fmt.Errorf("%w: proof by index %d, expect PoP leaf %d",
+			lamport.ErrInvalidSignature, sig.Index, last)
+	}
+	msg := mss.PoPMessage(c, pub.Root)
+	return VerifyHyper(msg[:], sig, pub)
+}
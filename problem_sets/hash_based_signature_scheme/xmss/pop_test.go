@@ -0,0 +1,142 @@
+package xmss
+
+import (
+	"errors"
+	"testing"
+
+	"ps/01/lamport"
+	"ps/01/mss"
+)
+
+// TestProvePossession reserves a key's PoP leaf, saves and loads the key,
+// signs it dry, and checks the proof only answers its own challenge for its
+// own key.
+func TestProvePossession(t *testing.T) {
+	pri, pub, err := GenerateKey(2, 16, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := lamport.NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ProvePossession(c, pri); !errors.Is(err, lamport.ErrNoPoPLeaf) {
+		t.Fatalf("got %v, expect ErrNoPoPLeaf", err)
+	}
+	if err := pri.ReservePoPLeaf(); err != nil {
+		t.Fatal(err)
+	}
+	pri, err = PrivkeyFromBytes(pri.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pri.PoPIndex() != 3 || pri.Remaining() != 3 {
+		t.Fatalf("got PoP leaf %d and %d leaves left, expect 3 and 3",
+			pri.PoPIndex(), pri.Remaining())
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := Sign([]byte("pop"), pri); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := Sign([]byte("pop"), pri); !errors.Is(err, mss.ErrTreeExhausted) {
+		t.Fatalf("got %v, expect ErrTreeExhausted", err)
+	}
+
+	proof, err := ProvePossession(c, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPossession(c, pub, proof); err != nil {
+		t.Fatal(err)
+	}
+	c2, err := lamport.NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPossession(c2, pub, proof); !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("replay: got %v, expect ErrInvalidSignature", err)
+	}
+	seed := testSeed()
+	seed[0] ^= 1
+	_, otherPub, err := GenerateKey(2, 16, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPossession(c, otherPub, proof); !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("other key: got %v, expect ErrInvalidSignature", err)
+	}
+	if _, err := ProvePossession(c, pri); !errors.Is(err, lamport.ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+	back, err := PrivkeyFromBytes(pri.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ProvePossession(c, back); !errors.Is(err, lamport.ErrKeyAlreadyUsed) {
+		t.Fatalf("loaded: got %v, expect ErrKeyAlreadyUsed", err)
+	}
+
+	single, _, err := GenerateKey(0, 16, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := single.ReservePoPLeaf(); err == nil {
+		t.Fatalf("reserved a PoP leaf in a tree of 1 leaf")
+	}
+}
+
+// TestHyperProvePossession does the same for a hypertree.
+func TestHyperProvePossession(t *testing.T) {
+	signer, pub, err := GenerateHyperKey(HyperParams{Layers: 2, Height: 1, W: 16}, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := lamport.NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.ProvePossession(c); !errors.Is(err, lamport.ErrNoPoPLeaf) {
+		t.Fatalf("got %v, expect ErrNoPoPLeaf", err)
+	}
+	if err := signer.ReservePoPLeaf(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := signer.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded HyperSigner
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Remaining() != 3 {
+		t.Fatalf("got %d indexes left, expect 3", loaded.Remaining())
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := loaded.Sign([]byte("pop")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := loaded.Sign([]byte("pop")); !errors.Is(err, mss.ErrTreeExhausted) {
+		t.Fatalf("got %v, expect ErrTreeExhausted", err)
+	}
+
+	proof, err := loaded.ProvePossession(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyHyperPossession(c, pub, proof); err != nil {
+		t.Fatal(err)
+	}
+	c2, err := lamport.NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyHyperPossession(c2, pub, proof); !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("replay: got %v, expect ErrInvalidSignature", err)
+	}
+	if _, err := loaded.ProvePossession(c); !errors.Is(err, lamport.ErrKeyAlreadyUsed) {
+		t.Fatalf("got %v, expect ErrKeyAlreadyUsed", err)
+	}
+}
@@ -0,0 +1,290 @@
+// Package xmss is a single tree XMSS-style signature scheme: the Merkle
+// signature scheme of package mss, built from WOTS+ keys whose pubkeys are
+// hashed to leaves with L-trees, and with every hash call keyed by its
+// address in the tree (see ADRS).  It follows RFC 8391's construction but
+// isn't bit for bit compatible with it.
+//
+// HyperSigner stacks layers of these trees into a hypertree, for more
+// signatures than one tree could be built for up front, and FORS is the
+// few-time scheme SPHINCS+ signs messages with at the bottom of one.
+//
+// Like mss, a private key is stateful: each signature uses the next leaf,
+// and the key has to be saved after every Sign.
+package xmss
+
+import (
+	"fmt"
+
+	"ps/01/lamport"
+	"ps/01/mss"
+	"ps/01/wots"
+)
+
+// N is the size of every hash, seed and node.
+const N = lamport.MESSAGE_BYTES
+
+// SEED_BYTES is the size of the seed GenerateKey takes: SK_SEED, SK_PRF and
+// PUB_SEED, in that order.
+const SEED_BYTES = 3 * N
+
+// MAX_HEIGHT is the tallest tree GenerateKey will build.
+const MAX_HEIGHT = mss.MAX_HEIGHT
+
+// Params are the height of the tree and the Winternitz parameter of its
+// WOTS+ keys.
+type Params struct {
+	Height int
+	W      int
+}
+
+// wots checks the params and returns the WOTS+ parameters.
+func (self Params) wots() (wots.WotsParams, error) {
+	if self.Height < 0 || self.Height > MAX_HEIGHT {
+		return wots.WotsParams{}, fmt.Errorf("xmss: height %d, expect 0 to %d",
+			self.Height, MAX_HEIGHT)
+	}
+	return wots.NewWotsParams(self.W)
+}
+
+// SignatureBytes is the size of a signature with these params.
+func (self Params) SignatureBytes() int {
+	wp, err := self.wots()
+	if err != nil {
+		return 0
+	}
+	return 4 + N + wp.Len()*N + self.Height*N
+}
+
+// PrivateKey is an XMSS private key.  Index is the next leaf to sign with.
+type PrivateKey struct {
+	Params  Params
+	Index   uint32
+	SKSeed  [N]byte
+	SKPRF   [N]byte
+	Root    [N]byte
+	PubSeed [N]byte
+
+	// every level of the tree, built on the first Sign
+	levels [][]lamport.Block
+	// the last leaf is the PoP leaf, which Sign doesn't use, and whether
+	// it's proved yet
+	pop     bool
+	popUsed bool
+}
+
+// PublicKey is an XMSS pubkey: the root of the tree and the seed that keys
+// every hash in it.
+type PublicKey struct {
+	Params  Params
+	Root    [N]byte
+	PubSeed [N]byte
+}
+
+// Signature is a WOTS+ signature by leaf Index, with the randomness R the
+// message was hashed with and the auth path from the leaf to the root.
+type Signature struct {
+	Index uint32
+	R     [N]byte
+	WOTS  []lamport.Block
+	Auth  []lamport.Block
+}
+
+// GenerateKey makes a key pair with a tree of 2^h leaves and WOTS+ with
+// Winternitz parameter w, from a SEED_BYTES seed.  The same seed always
+// gives the same keys.
+func GenerateKey(h, w int, seed []byte) (*PrivateKey, *PublicKey, error) {
+	params := Params{Height: h, W: w}
+	_, err := params.wots()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(seed) != SEED_BYTES {
+		return nil, nil, fmt.Errorf("%w: xmss seed %d bytes, expect %d",
+			lamport.ErrWrongLength, len(seed), SEED_BYTES)
+	}
+	pri := &PrivateKey{Params: params}
+	copy(pri.SKSeed[:], seed)
+	copy(pri.SKPRF[:], seed[N:])
+	copy(pri.PubSeed[:], seed[2*N:])
+	levels := pri.tree()
+	pri.Root = levels[h][0]
+	return pri, pri.PublicKey(), nil
+}
+
+// PublicKey returns the pubkey for pri.
+func (self *PrivateKey) PublicKey() *PublicKey {
+	return &PublicKey{Params: self.Params, Root: self.Root, PubSeed: self.PubSeed}
+}
+
+// Remaining returns the number of leaves that haven't signed yet, not
+// counting a PoP leaf.
+func (self *PrivateKey) Remaining() int {
+	n := 1<<self.Params.Height - int(self.Index)
+	if self.pop {
+		n--
+	}
+	return n
+}
+
+// subtree is one tree of WOTS+ keys: the only one in an XMSS key, or one of
+// many in a hypertree, where layer and tree say which.
+type subtree struct {
+	wp      wots.WotsParams
+	height  int
+	skSeed  [N]byte
+	pubSeed [N]byte
+	layer   uint32
+	tree    uint64
+}
+
+func (self subtree) adrs(typ uint32) ADRS {
+	return ADRS{Layer: self.layer, Tree: self.tree, Type: typ}
+}
+
+// otsKey returns the WOTS+ chain starts of leaf i.
+func (self subtree) otsKey(i uint32) []lamport.Block {
+	sk := make([]lamport.Block, self.wp.Len())
+	adrs := self.adrs(ADRS_OTS)
+	adrs.KeyPair = i
+	for j := range sk {
+		adrs.Chain = uint32(j)
+		sk[j] = prf(self.skSeed, adrs)
+	}
+	return sk
+}
+
+// leaf computes leaf i: the L-tree root of its WOTS+ pubkey.
+func (self subtree) leaf(i uint32) lamport.Block {
+	pk := self.otsKey(i)
+	adrs := self.adrs(ADRS_OTS)
+	adrs.KeyPair = i
+	for j := range pk {
+		adrs.Chain = uint32(j)
+		pk[j] = chain(pk[j], 0, self.wp.W-1, self.pubSeed, adrs)
+	}
+	ladrs := self.adrs(ADRS_LTREE)
+	ladrs.KeyPair = i
+	return ltree(pk, self.pubSeed, ladrs)
+}
+
+// levels builds every level of the tree, leaves first.
+func (self subtree) levels() [][]lamport.Block {
+	leaves := make([]lamport.Block, 1<<self.height)
+	for i := range leaves {
+		leaves[i] = self.leaf(uint32(i))
+	}
+	levels := [][]lamport.Block{leaves}
+	adrs := self.adrs(ADRS_HASHTREE)
+	for level := leaves; len(level) > 1; {
+		adrs.Chain = uint32(len(levels) - 1)
+		next := make([]lamport.Block, len(level)/2)
+		for i := range next {
+			adrs.Hash = uint32(i)
+			next[i] = randHash(level[2*i], level[2*i+1], self.pubSeed, adrs)
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return levels
+}
+
+// sign makes the WOTS+ signature on digest with leaf i.
+func (self subtree) sign(digest lamport.Message, i uint32) []lamport.Block {
+	sk := self.otsKey(i)
+	adrs := self.adrs(ADRS_OTS)
+	adrs.KeyPair = i
+	sig := make([]lamport.Block, self.wp.Len())
+	for j, d := range self.wp.Digits(digest) {
+		adrs.Chain = uint32(j)
+		sig[j] = chain(sk[j], 0, d, self.pubSeed, adrs)
+	}
+	return sig
+}
+
+// root climbs from the WOTS+ signature on digest by leaf i, via auth, to
+// the root the signature claims for the tree.
+func (self subtree) root(digest lamport.Message, i uint32, sig, auth []lamport.Block) lamport.Block {
+	pk := make([]lamport.Block, self.wp.Len())
+	adrs := self.adrs(ADRS_OTS)
+	adrs.KeyPair = i
+	for j, d := range self.wp.Digits(digest) {
+		adrs.Chain = uint32(j)
+		pk[j] = chain(sig[j], d, self.wp.W-1-d, self.pubSeed, adrs)
+	}
+	ladrs := self.adrs(ADRS_LTREE)
+	ladrs.KeyPair = i
+	node := ltree(pk, self.pubSeed, ladrs)
+
+	adrs = self.adrs(ADRS_HASHTREE)
+	for h, sibling := range auth {
+		adrs.Chain = uint32(h)
+		adrs.Hash = i >> (h + 1)
+		if i>>h&1 == 0 {
+			node = randHash(node, sibling, self.pubSeed, adrs)
+		} else {
+			node = randHash(sibling, node, self.pubSeed, adrs)
+		}
+	}
+	return node
+}
+
+// subtree returns the key's only tree.
+func (self *PrivateKey) subtree() subtree {
+	wp, _ := self.Params.wots()
+	return subtree{wp: wp, height: self.Params.Height, skSeed: self.SKSeed,
+		pubSeed: self.PubSeed}
+}
+
+// tree builds, or returns the already built, levels of the tree.
+func (self *PrivateKey) tree() [][]lamport.Block {
+	if self.levels == nil {
+		self.levels = self.subtree().levels()
+	}
+	return self.levels
+}
+
+// Sign signs msg with the next leaf and moves Index on.  Once every leaf but
+// a PoP leaf has signed it's mss.ErrTreeExhausted.  The first Sign after
+// loading a key rebuilds the tree, 2^h leaves.
+func Sign(msg []byte, pri *PrivateKey) (*Signature, error) {
+	_, err := pri.Params.wots()
+	if err != nil {
+		return nil, err
+	}
+	if pri.Remaining() <= 0 {
+		return nil, mss.ErrTreeExhausted
+	}
+	index := pri.Index
+	pri.Index++
+	return pri.sign(msg, index), nil
+}
+
+// sign signs msg with leaf index, whatever Index says.
+func (self *PrivateKey) sign(msg []byte, index uint32) *Signature {
+	sig := &Signature{Index: index, R: prfIndex(self.SKPRF, uint64(index))}
+	digest := hashMessage(sig.R, self.Root, uint64(index), msg)
+	sig.WOTS = self.subtree().sign(digest, index)
+	sig.Auth = lamport.NewAuthPath(self.tree(), int(index)).Siblings
+	return sig
+}
+
+// Verify checks sig on msg against pub.  It returns nil for a good
+// signature and wraps lamport.ErrInvalidSignature otherwise.
+func Verify(msg []byte, sig *Signature, pub *PublicKey) error {
+	wp, err := pub.Params.wots()
+	if err != nil {
+		return err
+	}
+	if len(sig.WOTS) != wp.Len() || len(sig.Auth) != pub.Params.Height ||
+		uint64(sig.Index) >= 1<<pub.Params.Height {
+		return fmt.Errorf("%w: signature doesn't fit params %+v",
+			lamport.ErrInvalidSignature, pub.Params)
+	}
+	digest := hashMessage(sig.R, pub.Root, uint64(sig.Index), msg)
+	tree := subtree{wp: wp, height: pub.Params.Height, pubSeed: pub.PubSeed}
+	if tree.root(digest, sig.Index, sig.WOTS, sig.Auth) != pub.Root {
+		return fmt.Errorf("%w: leaf %d isn't in the tree", lamport.ErrInvalidSignature,
+			sig.Index)
+	}
+	return nil
+}
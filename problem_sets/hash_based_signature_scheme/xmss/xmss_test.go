@@ -0,0 +1,144 @@
+package xmss
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"ps/01/lamport"
+	"ps/01/mss"
+)
+
+// Known answers for a h=2, w=16 key from the seed 00 01 .. 5f: the whole
+// pubkey, and the sha256 of its first two signatures on "abc".
+const (
+	KAT_PUBKEY      = "020444644d917afad259aa2a92c13d78f2de03b49dbcd3a78263c7c6d177093236cf404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"
+	KAT_SIGNATURE_0 = "e2df9de1e06ec5e79074b70a889e73096b4b332dca48634566cd4b08afb4b300"
+	KAT_SIGNATURE_1 = "cc20aecb952631c098b3272f81e47f2a6fa94a6b9452a5df831e03c5bea115dc"
+)
+
+func testSeed() []byte {
+	seed := make([]byte, SEED_BYTES)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	return seed
+}
+
+// TestKAT checks the h=2 key and signatures against the known answers.
+func TestKAT(t *testing.T) {
+	pri, pub, err := GenerateKey(2, 16, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(pub.Bytes()) != KAT_PUBKEY {
+		t.Fatalf("pubkey %x, expect %s", pub.Bytes(), KAT_PUBKEY)
+	}
+	for i, expect := range []string{KAT_SIGNATURE_0, KAT_SIGNATURE_1} {
+		sig, err := Sign([]byte("abc"), pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := sha256.Sum256(sig.Bytes())
+		if hex.EncodeToString(h[:]) != expect {
+			t.Fatalf("signature %d hash %x, expect %s", i, h, expect)
+		}
+		err = Verify([]byte("abc"), sig, pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// TestRoundTrip signs with every leaf of a h=4 tree, checks each signature,
+// and checks the key refuses once the leaves run out.
+func TestRoundTrip(t *testing.T) {
+	pri, pub, err := GenerateKey(4, 16, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 16; i++ {
+		msg := []byte(fmt.Sprint("message ", i))
+		sig, err := Sign(msg, pri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sig.Index != uint32(i) {
+			t.Fatalf("signed with leaf %d, expect %d", sig.Index, i)
+		}
+		err = Verify(msg, sig, pub)
+		if err != nil {
+			t.Fatalf("leaf %d: %v", i, err)
+		}
+	}
+	if pri.Remaining() != 0 {
+		t.Fatalf("got %d leaves remaining, expect 0", pri.Remaining())
+	}
+	_, err = Sign([]byte("more"), pri)
+	if !errors.Is(err, mss.ErrTreeExhausted) {
+		t.Fatalf("got %v, expect ErrTreeExhausted", err)
+	}
+}
+
+// TestTampered checks a signature fails with any part of it changed, with
+// another message, or under another PUB_SEED.
+func TestTampered(t *testing.T) {
+	pri, pub, err := GenerateKey(3, 4, testSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	Sign([]byte("skip"), pri)
+	msg := []byte("tamper")
+	sig, err := Sign(msg, pri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Verify(msg, sig, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tamper := range map[string]func(s *Signature){
+		"index":     func(s *Signature) { s.Index = 2 },
+		"index out": func(s *Signature) { s.Index = 8 },
+		"R":         func(s *Signature) { s.R[0] ^= 1 },
+		"wots":      func(s *Signature) { s.WOTS[10][0] ^= 1 },
+		"auth":      func(s *Signature) { s.Auth[2][0] ^= 1 },
+		"short":     func(s *Signature) { s.Auth = s.Auth[:2] },
+	} {
+		s := *sig
+		s.WOTS = append([]lamport.Block(nil), sig.WOTS...)
+		s.Auth = append([]lamport.Block(nil), sig.Auth...)
+		tamper(&s)
+		err = Verify(msg, &s, pub)
+		if !errors.Is(err, lamport.ErrInvalidSignature) {
+			t.Fatalf("%s: got %v, expect ErrInvalidSignature", name, err)
+		}
+	}
+	err = Verify([]byte("other"), sig, pub)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("other message: got %v, expect ErrInvalidSignature", err)
+	}
+	other := *pub
+	other.PubSeed[0] ^= 1
+	err = Verify(msg, sig, &other)
+	if !errors.Is(err, lamport.ErrInvalidSignature) {
+		t.Fatalf("other PUB_SEED: got %v, expect ErrInvalidSignature", err)
+	}
+}
+
+// TestGenerateKeyErrors checks bad params and seeds are refused.
+func TestGenerateKeyErrors(t *testing.T) {
+	for _, p := range []Params{{-1, 16}, {MAX_HEIGHT + 1, 16}, {2, 3}, {2, 512}} {
+		if _, _, err := GenerateKey(p.Height, p.W, testSeed()); err == nil {
+			t.Fatalf("GenerateKey(%d, %d) returned nil, expected an error",
+				p.Height, p.W)
+		}
+	}
+	_, _, err := GenerateKey(2, 16, testSeed()[1:])
+	if !errors.Is(err, lamport.ErrWrongLength) {
+		t.Fatalf("got %v, expect ErrWrongLength", err)
+	}
+}